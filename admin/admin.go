@@ -35,6 +35,7 @@ type Service struct {
 	issuer           *auth.Issuer
 	VersionNumber    string
 	metricsProjectID string
+	previewLocks     branchLocks
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client, github Github, aiClient ai.Client) (*Service, error) {
@@ -14,6 +14,10 @@ import (
 type AuthToken interface {
 	Token() *authtoken.Token
 	OwnerID() string
+	// APINames returns the names of the custom APIs the token is restricted to calling. If empty, the token is not restricted.
+	APINames() []string
+	// SCIM returns true if the token is restricted to SCIM provisioning.
+	SCIM() bool
 }
 
 // userAuthToken implements AuthToken for tokens belonging to a user.
@@ -34,6 +38,14 @@ func (t *userAuthToken) OwnerID() string {
 	return t.model.UserID
 }
 
+func (t *userAuthToken) APINames() []string {
+	return nil
+}
+
+func (t *userAuthToken) SCIM() bool {
+	return false
+}
+
 // IssueUserAuthToken generates and persists a new auth token for a user.
 func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, displayName string, representingUserID *string, ttl *time.Duration) (AuthToken, error) {
 	tkn := authtoken.NewRandom(authtoken.TypeUser)
@@ -74,8 +86,18 @@ func (t *serviceAuthToken) OwnerID() string {
 	return t.model.ServiceID
 }
 
+func (t *serviceAuthToken) APINames() []string {
+	return t.model.APINames
+}
+
+func (t *serviceAuthToken) SCIM() bool {
+	return t.model.SCIM
+}
+
 // IssueServiceAuthToken generates and persists a new auth token for a service.
-func (s *Service) IssueServiceAuthToken(ctx context.Context, serviceID string, ttl *time.Duration) (AuthToken, error) {
+// If apiNames is not empty, the token can only be used to call the custom APIs with those names.
+// If scim is true, the token can only be used for SCIM provisioning of the service's org.
+func (s *Service) IssueServiceAuthToken(ctx context.Context, serviceID string, apiNames []string, scim bool, ttl *time.Duration) (AuthToken, error) {
 	tkn := authtoken.NewRandom(authtoken.TypeService)
 
 	var expiresOn *time.Time
@@ -89,6 +111,8 @@ func (s *Service) IssueServiceAuthToken(ctx context.Context, serviceID string, t
 		SecretHash: tkn.SecretHash(),
 		ServiceID:  serviceID,
 		ExpiresOn:  expiresOn,
+		APINames:   apiNames,
+		SCIM:       scim,
 	})
 	if err != nil {
 		return nil, err
@@ -111,6 +135,14 @@ func (t *deploymentAuthToken) OwnerID() string {
 	return t.model.DeploymentID
 }
 
+func (t *deploymentAuthToken) APINames() []string {
+	return nil
+}
+
+func (t *deploymentAuthToken) SCIM() bool {
+	return false
+}
+
 // IssueDeploymentAuthToken generates and persists a new auth token for a deployment.
 func (s *Service) IssueDeploymentAuthToken(ctx context.Context, deploymentID string, ttl *time.Duration) (AuthToken, error) {
 	tkn := authtoken.NewRandom(authtoken.TypeDeployment)
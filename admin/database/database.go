@@ -81,8 +81,9 @@ type DB interface {
 	UpdateProject(ctx context.Context, id string, opts *UpdateProjectOptions) (*Project, error)
 	CountProjectsForOrganization(ctx context.Context, orgID string) (int, error)
 
-	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
+	FindExpiredDeployments(ctx context.Context, previewTTL time.Duration) ([]*Deployment, error)
 	FindDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
 	FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
@@ -108,10 +109,17 @@ type DB interface {
 	UpdateSuperuser(ctx context.Context, userID string, superuser bool) error
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 
+	FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*Usergroup, error)
+	FindUsergroup(ctx context.Context, id string) (*Usergroup, error)
+	FindUsergroupByName(ctx context.Context, orgID, name string) (*Usergroup, error)
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
+	UpdateUsergroup(ctx context.Context, id string, opts *UpdateUsergroupOptions) (*Usergroup, error)
+	DeleteUsergroup(ctx context.Context, id string) error
 	FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*Usergroup, error)
+	FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*UsergroupMemberUser, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteAllUsergroupMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
@@ -148,7 +156,19 @@ type DB interface {
 	DeleteExpiredDeviceAuthCodes(ctx context.Context, retention time.Duration) error
 
 	FindOrganizationRole(ctx context.Context, name string) (*OrganizationRole, error)
+	FindOrganizationRoles(ctx context.Context, orgID string) ([]*OrganizationRole, error)
+	FindOrganizationRoleForOrganization(ctx context.Context, orgID, name string) (*OrganizationRole, error)
+	InsertOrganizationRole(ctx context.Context, opts *InsertOrganizationRoleOptions) (*OrganizationRole, error)
+	UpdateOrganizationRole(ctx context.Context, id string, opts *UpdateOrganizationRoleOptions) (*OrganizationRole, error)
+	DeleteOrganizationRole(ctx context.Context, id string) error
+	CheckOrganizationRoleIsInUse(ctx context.Context, id string) (bool, error)
 	FindProjectRole(ctx context.Context, name string) (*ProjectRole, error)
+	FindProjectRoles(ctx context.Context, orgID string) ([]*ProjectRole, error)
+	FindProjectRoleForOrganization(ctx context.Context, orgID, name string) (*ProjectRole, error)
+	InsertProjectRole(ctx context.Context, opts *InsertProjectRoleOptions) (*ProjectRole, error)
+	UpdateProjectRole(ctx context.Context, id string, opts *UpdateProjectRoleOptions) (*ProjectRole, error)
+	DeleteProjectRole(ctx context.Context, id string) error
+	CheckProjectRoleIsInUse(ctx context.Context, id string) (bool, error)
 	ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*OrganizationRole, error)
 	ResolveProjectRolesForUser(ctx context.Context, userID, projectID string) ([]*ProjectRole, error)
 
@@ -159,9 +179,19 @@ type DB interface {
 	UpdateOrganizationMemberUserRole(ctx context.Context, orgID, userID, roleID string) error
 	CountSingleuserOrganizationsForMemberUser(ctx context.Context, userID string) (int, error)
 
+	FindSCIMUsers(ctx context.Context, orgID, email string, offset, limit int) ([]*SCIMUser, error)
+	CountSCIMUsers(ctx context.Context, orgID, email string) (int, error)
+	FindSCIMUser(ctx context.Context, orgID, userID string) (*SCIMUser, error)
+	InsertSCIMDeactivatedUser(ctx context.Context, orgID, userID string) error
+	DeleteSCIMDeactivatedUser(ctx context.Context, orgID, userID string) error
+
 	FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
+	FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*MemberUsergroup, error)
+	FindUsergroupProjectRoles(ctx context.Context, groupID string) ([]*UsergroupProjectRole, error)
 	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	UpdateProjectMemberUsergroupRole(ctx context.Context, groupID, projectID, roleID string) error
+	DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error
 	DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error
 	DeleteAllProjectMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 	UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error
@@ -195,6 +225,9 @@ type DB interface {
 	UpsertVirtualFile(ctx context.Context, opts *InsertVirtualFileOptions) error
 	UpdateVirtualFileDeleted(ctx context.Context, projectID, branch, path string) error
 	DeleteExpiredVirtualFiles(ctx context.Context, retention time.Duration) error
+
+	FindAuditEvents(ctx context.Context, opts *FindAuditEventsOptions) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -320,6 +353,9 @@ const (
 	DeploymentStatusPending     DeploymentStatus = 1
 	DeploymentStatusOK          DeploymentStatus = 2
 	DeploymentStatusError       DeploymentStatus = 4
+	// DeploymentStatusHibernated is set on preview deployments that have been deprovisioned due to inactivity.
+	// They don't have a runtime instance, and are redeployed when accessed or when their branch is pushed to.
+	DeploymentStatusHibernated DeploymentStatus = 5
 )
 
 // Deployment is a single deployment of a git branch.
@@ -340,6 +376,8 @@ type Deployment struct {
 	CreatedOn         time.Time        `db:"created_on"`
 	UpdatedOn         time.Time        `db:"updated_on"`
 	UsedOn            time.Time        `db:"used_on"`
+	// Preview is true for deployments of a non-prod branch. They are not referenced by the project's ProdDeploymentID.
+	Preview bool `db:"preview"`
 }
 
 // InsertDeploymentOptions defines options for inserting a new Deployment.
@@ -355,6 +393,7 @@ type InsertDeploymentOptions struct {
 	RuntimeAudience   string
 	Status            DeploymentStatus
 	StatusMessage     string
+	Preview           bool
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -424,12 +463,41 @@ type Usergroup struct {
 	ID    string `db:"id"`
 	OrgID string `db:"org_id"`
 	Name  string `db:"name" validate:"slug"`
+	// DisplayName is the name of the group in an external identity provider. It is empty for groups that are not provisioned over SCIM.
+	DisplayName string `db:"display_name"`
 }
 
 // InsertUsergroupOptions defines options for inserting a new usergroup
 type InsertUsergroupOptions struct {
-	OrgID string
-	Name  string `validate:"slug"`
+	OrgID       string
+	Name        string `validate:"slug"`
+	DisplayName string
+}
+
+// UpdateUsergroupOptions defines options for updating an existing usergroup
+type UpdateUsergroupOptions struct {
+	Name        string `validate:"slug"`
+	DisplayName string
+}
+
+// UsergroupMemberUser is a user that is a member of a usergroup
+type UsergroupMemberUser struct {
+	ID          string
+	Email       string
+	DisplayName string `db:"display_name"`
+}
+
+// MemberUsergroup is a usergroup that has a role in a project
+type MemberUsergroup struct {
+	ID       string
+	Name     string
+	RoleName string `db:"role_name"`
+}
+
+// UsergroupProjectRole is a role that a usergroup has in a project
+type UsergroupProjectRole struct {
+	ProjectID string `db:"project_id"`
+	ProjectRole
 }
 
 // UserAuthToken is a persistent API token for a user.
@@ -464,6 +532,10 @@ type ServiceAuthToken struct {
 	CreatedOn  time.Time  `db:"created_on"`
 	ExpiresOn  *time.Time `db:"expires_on"`
 	UsedOn     time.Time  `db:"used_on"`
+	// APINames restricts the token to calling the custom APIs with these names. If empty, the token is not restricted.
+	APINames []string `db:"api_names"`
+	// SCIM restricts the token to SCIM provisioning of the service's org.
+	SCIM bool `db:"scim"`
 }
 
 // InsertServiceAuthTokenOptions defines options for creating a ServiceAuthToken.
@@ -472,6 +544,8 @@ type InsertServiceAuthTokenOptions struct {
 	SecretHash []byte
 	ServiceID  string
 	ExpiresOn  *time.Time
+	APINames   []string
+	SCIM       bool
 }
 
 // DeploymentAuthToken is a persistent API token for a deployment.
@@ -541,8 +615,10 @@ const (
 )
 
 // OrganizationRole represents roles for orgs.
+// Built-in roles are shared by all orgs and have a nil OrgID. Custom roles belong to a single org.
 type OrganizationRole struct {
 	ID               string
+	OrgID            *string `db:"org_id"`
 	Name             string
 	ReadOrg          bool `db:"read_org"`
 	ManageOrg        bool `db:"manage_org"`
@@ -554,8 +630,10 @@ type OrganizationRole struct {
 }
 
 // ProjectRole represents roles for projects.
+// Built-in roles are shared by all orgs and have a nil OrgID. Custom roles belong to a single org.
 type ProjectRole struct {
 	ID                   string
+	OrgID                *string `db:"org_id"`
 	Name                 string
 	ReadProject          bool `db:"read_project"`
 	ManageProject        bool `db:"manage_project"`
@@ -573,6 +651,68 @@ type ProjectRole struct {
 	ManageAlerts         bool `db:"manage_alerts"`
 }
 
+// InsertOrganizationRoleOptions defines options for inserting a custom org role.
+type InsertOrganizationRoleOptions struct {
+	OrgID            string `validate:"required"`
+	Name             string `validate:"slug"`
+	ReadOrg          bool
+	ManageOrg        bool
+	ReadProjects     bool
+	CreateProjects   bool
+	ManageProjects   bool
+	ReadOrgMembers   bool
+	ManageOrgMembers bool
+}
+
+// UpdateOrganizationRoleOptions defines options for updating the permissions of a custom org role.
+type UpdateOrganizationRoleOptions struct {
+	ReadOrg          bool
+	ManageOrg        bool
+	ReadProjects     bool
+	CreateProjects   bool
+	ManageProjects   bool
+	ReadOrgMembers   bool
+	ManageOrgMembers bool
+}
+
+// InsertProjectRoleOptions defines options for inserting a custom project role.
+type InsertProjectRoleOptions struct {
+	OrgID                string `validate:"required"`
+	Name                 string `validate:"slug"`
+	ReadProject          bool
+	ManageProject        bool
+	ReadProd             bool
+	ReadProdStatus       bool
+	ManageProd           bool
+	ReadDev              bool
+	ReadDevStatus        bool
+	ManageDev            bool
+	ReadProjectMembers   bool
+	ManageProjectMembers bool
+	CreateReports        bool
+	ManageReports        bool
+	CreateAlerts         bool
+	ManageAlerts         bool
+}
+
+// UpdateProjectRoleOptions defines options for updating the permissions of a custom project role.
+type UpdateProjectRoleOptions struct {
+	ReadProject          bool
+	ManageProject        bool
+	ReadProd             bool
+	ReadProdStatus       bool
+	ManageProd           bool
+	ReadDev              bool
+	ReadDevStatus        bool
+	ManageDev            bool
+	ReadProjectMembers   bool
+	ManageProjectMembers bool
+	CreateReports        bool
+	ManageReports        bool
+	CreateAlerts         bool
+	ManageAlerts         bool
+}
+
 // Member is a convenience type used for display-friendly representation of an org or project member.
 type Member struct {
 	ID          string
@@ -583,6 +723,17 @@ type Member struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// SCIMUser is a user provisioned in an org over SCIM.
+// It is either a member of the org or was deactivated in the org, in which case Active is false.
+type SCIMUser struct {
+	ID          string
+	Email       string
+	DisplayName string    `db:"display_name"`
+	Active      bool      `db:"active"`
+	CreatedOn   time.Time `db:"created_on"`
+	UpdatedOn   time.Time `db:"updated_on"`
+}
+
 // OrganizationInvite represents an outstanding invitation to join an org.
 type OrganizationInvite struct {
 	ID              string
@@ -711,3 +862,43 @@ type InsertVirtualFileOptions struct {
 	Path      string `validate:"required"`
 	Data      []byte `validate:"max=8192"` // 8kb
 }
+
+// AuditEvent represents an action performed on an org or project. Audit events are append-only.
+// The project and actor names are recorded at the time of the event, so they're preserved if the project or actor is renamed or deleted.
+type AuditEvent struct {
+	ID          string
+	OrgID       string         `db:"org_id"`
+	ProjectID   *string        `db:"project_id"`
+	ProjectName string         `db:"project_name"`
+	ActorType   string         `db:"actor_type"`
+	ActorID     string         `db:"actor_id"`
+	ActorName   string         `db:"actor_name"`
+	Action      string         `db:"action"`
+	Metadata    map[string]any `db:"metadata"`
+	CreatedOn   time.Time      `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for inserting an AuditEvent
+type InsertAuditEventOptions struct {
+	OrgID       string `validate:"required"`
+	ProjectID   *string
+	ProjectName string
+	ActorType   string `validate:"required"`
+	ActorID     string
+	ActorName   string
+	Action      string `validate:"required"`
+	Metadata    map[string]any
+}
+
+// FindAuditEventsOptions defines filters for finding audit events.
+// Events are returned most recent first. AfterCreatedOn and AfterID are the created_on and ID of the last event in the previous page.
+type FindAuditEventsOptions struct {
+	OrgID          string
+	ProjectName    string
+	ActorName      string
+	StartTime      time.Time
+	EndTime        time.Time
+	AfterCreatedOn time.Time
+	AfterID        string
+	Limit          int
+}
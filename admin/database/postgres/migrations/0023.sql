@@ -0,0 +1 @@
+ALTER TABLE service_auth_tokens ADD COLUMN api_names TEXT[] DEFAULT '{}'::TEXT[] NOT NULL;
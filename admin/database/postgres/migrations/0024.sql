@@ -0,0 +1,25 @@
+CREATE TABLE audit_events (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	-- The org and project are not foreign keys so the events outlive them. The names are stored for the same reason.
+	org_id UUID NOT NULL,
+	project_id UUID,
+	project_name TEXT NOT NULL DEFAULT '',
+	actor_type TEXT NOT NULL,
+	actor_id TEXT NOT NULL DEFAULT '',
+	actor_name TEXT NOT NULL DEFAULT '',
+	action TEXT NOT NULL,
+	metadata JSONB DEFAULT '{}'::JSONB NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX audit_events_org_id_created_on_idx ON audit_events (org_id, created_on DESC, id DESC);
+
+-- Audit events are append-only
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+	RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only_trigger BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
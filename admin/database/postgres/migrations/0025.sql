@@ -0,0 +1,14 @@
+ALTER TABLE project_roles ADD create_alerts BOOLEAN NOT NULL DEFAULT false;
+UPDATE project_roles SET create_alerts = read_prod;
+
+ALTER TABLE project_roles ADD manage_alerts BOOLEAN NOT NULL DEFAULT false;
+UPDATE project_roles SET manage_alerts = manage_prod;
+
+-- Custom roles are defined by an org and can only be assigned in that org. Built-in roles have a NULL org_id.
+ALTER TABLE org_roles ADD org_id UUID REFERENCES orgs (id) ON DELETE CASCADE;
+DROP INDEX org_roles_name_idx;
+CREATE UNIQUE INDEX org_roles_name_idx ON org_roles (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::UUID), lower(name));
+
+ALTER TABLE project_roles ADD org_id UUID REFERENCES orgs (id) ON DELETE CASCADE;
+DROP INDEX project_roles_name_idx;
+CREATE UNIQUE INDEX project_roles_name_idx ON project_roles (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::UUID), lower(name));
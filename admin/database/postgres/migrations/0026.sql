@@ -0,0 +1,3 @@
+-- Preview deployments deploy a non-prod branch of a project. A project can have at most one preview deployment per branch.
+ALTER TABLE deployments ADD preview BOOLEAN NOT NULL DEFAULT false;
+CREATE UNIQUE INDEX deployments_preview_branch_idx ON deployments (project_id, branch) WHERE preview;
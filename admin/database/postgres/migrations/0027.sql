@@ -0,0 +1,2 @@
+-- Groups provisioned over SCIM keep the display name from the identity provider since group names must be slugs.
+ALTER TABLE usergroups ADD display_name TEXT NOT NULL DEFAULT '';
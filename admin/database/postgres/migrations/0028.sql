@@ -0,0 +1,9 @@
+ALTER TABLE service_auth_tokens ADD COLUMN scim BOOLEAN DEFAULT FALSE NOT NULL;
+
+-- Users deactivated over SCIM are removed from the org, but the identity provider still expects to find them.
+CREATE TABLE scim_deactivated_users (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	PRIMARY KEY (org_id, user_id)
+);
@@ -396,14 +396,16 @@ func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID str
 	return count, nil
 }
 
-// FindExpiredDeployments returns all the deployments which are expired as per prod ttl
-func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
+// FindExpiredDeployments returns all the deployments which are expired as per prod ttl,
+// and the preview deployments which have not been used for longer than previewTTL and are not already hibernated.
+func (c *connection) FindExpiredDeployments(ctx context.Context, previewTTL time.Duration) ([]*database.Deployment, error) {
 	var res []*database.Deployment
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
-	`)
+		WHERE (NOT d.preview AND p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now())
+			OR (d.preview AND d.status <> $2 AND d.used_on + $1 < now())
+	`, previewTTL, database.DeploymentStatusHibernated)
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
@@ -419,6 +421,15 @@ func (c *connection) FindDeploymentsForProject(ctx context.Context, projectID st
 	return res, nil
 }
 
+func (c *connection) FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.branch=$2 AND d.preview", projectID, branch).StructScan(res)
+	if err != nil {
+		return nil, parseErr("preview deployment", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
@@ -444,9 +455,9 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
-		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage,
+		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message, preview)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *`,
+		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage, opts.Preview,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
@@ -497,7 +508,7 @@ func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch stri
 func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
 	res := &database.DeploymentsCount{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		SELECT COUNT(*) as deployments, COALESCE(SUM(slots), 0) as slots FROM deployments WHERE project_id IN (SELECT id FROM projects WHERE org_id = $1)`, orgID).StructScan(res)
+		SELECT COUNT(*) as deployments, COALESCE(SUM(slots), 0) as slots FROM deployments WHERE project_id IN (SELECT id FROM projects WHERE org_id = $1) AND status <> $2`, orgID, database.DeploymentStatusHibernated).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployments count", err)
 	}
@@ -506,7 +517,7 @@ func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID
 
 func (c *connection) ResolveRuntimeSlotsUsed(ctx context.Context) ([]*database.RuntimeSlotsUsed, error) {
 	var res []*database.RuntimeSlotsUsed
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.runtime_host, SUM(d.slots) AS slots_used FROM deployments d GROUP BY d.runtime_host")
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.runtime_host, SUM(d.slots) AS slots_used FROM deployments d WHERE d.status <> $1 GROUP BY d.runtime_host", database.DeploymentStatusHibernated)
 	if err != nil {
 		return nil, parseErr("slots used", err)
 	}
@@ -633,6 +644,37 @@ func (c *connection) CheckUserIsAnOrganizationMember(ctx context.Context, userID
 	return res, nil
 }
 
+func (c *connection) FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.* FROM usergroups ug
+		WHERE ug.org_id = $1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroup(ctx context.Context, id string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE id = $1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgID, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE org_id = $1 AND lower(name) = lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -640,14 +682,32 @@ func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertU
 
 	res := &database.Usergroup{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO usergroups (org_id, name) VALUES ($1, $2) RETURNING *
-	`, opts.OrgID, opts.Name).StructScan(res)
+		INSERT INTO usergroups (org_id, name, display_name) VALUES ($1, $2, $3) RETURNING *
+	`, opts.OrgID, opts.Name, opts.DisplayName).StructScan(res)
 	if err != nil {
 		return nil, parseErr("usergroup", err)
 	}
 	return res, nil
 }
 
+func (c *connection) UpdateUsergroup(ctx context.Context, id string, opts *database.UpdateUsergroupOptions) (*database.Usergroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE usergroups SET name = $1, display_name = $2 WHERE id = $3 RETURNING *", opts.Name, opts.DisplayName, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteUsergroup(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id = $1", id)
+	return checkDeleteRow("usergroup", res, err)
+}
+
 func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*database.Usergroup, error) {
 	var res []*database.Usergroup
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -660,6 +720,20 @@ func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID st
 	return res, nil
 }
 
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.id, u.email, u.display_name FROM users u
+		JOIN usergroups_users uug ON u.id = uug.user_id
+		WHERE uug.usergroup_id = $1 AND lower(u.email) > lower($2)
+		ORDER BY lower(u.email) LIMIT $3
+	`, groupID, afterEmail, limit)
+	if err != nil {
+		return nil, parseErr("usergroup members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroupMember(ctx context.Context, groupID, userID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_users (user_id, usergroup_id) VALUES ($1, $2)", userID, groupID)
 	if err != nil {
@@ -673,6 +747,14 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return checkDeleteRow("usergroup member", res, err)
 }
 
+func (c *connection) DeleteAllUsergroupMemberUserForOrganization(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_users uug WHERE uug.user_id = $1 AND uug.usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id = $2)", userID, orgID)
+	if err != nil {
+		return parseErr("usergroup member", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -812,22 +894,29 @@ func (c *connection) DeleteService(ctx context.Context, id string) error {
 
 // FindSeviceAuthTokens returns a list of service auth tokens.
 func (c *connection) FindServiceAuthTokens(ctx context.Context, serviceID string) ([]*database.ServiceAuthToken, error) {
-	var res []*database.ServiceAuthToken
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM service_auth_tokens t WHERE t.service_id=$1", serviceID)
+	var dtos []*serviceAuthTokenDTO
+	err := c.getDB(ctx).SelectContext(ctx, &dtos, "SELECT t.* FROM service_auth_tokens t WHERE t.service_id=$1", serviceID)
 	if err != nil {
 		return nil, parseErr("service auth tokens", err)
 	}
+	res := make([]*database.ServiceAuthToken, len(dtos))
+	for i, dto := range dtos {
+		res[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return res, nil
 }
 
 // FindServiceAuthToken returns a service auth token.
 func (c *connection) FindServiceAuthToken(ctx context.Context, id string) (*database.ServiceAuthToken, error) {
-	res := &database.ServiceAuthToken{}
+	res := &serviceAuthTokenDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT t.* FROM service_auth_tokens t WHERE t.id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("service auth token", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 // InsertServiceAuthToken inserts a service auth token.
@@ -836,16 +925,20 @@ func (c *connection) InsertServiceAuthToken(ctx context.Context, opts *database.
 		return nil, err
 	}
 
-	res := &database.ServiceAuthToken{}
+	if opts.APINames == nil {
+		opts.APINames = []string{}
+	}
+
+	res := &serviceAuthTokenDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO service_auth_tokens (id, secret_hash, service_id, expires_on)
-		VALUES ($1, $2, $3, $4) RETURNING *`,
-		opts.ID, opts.SecretHash, opts.ServiceID, opts.ExpiresOn,
+		INSERT INTO service_auth_tokens (id, secret_hash, service_id, expires_on, api_names, scim)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`,
+		opts.ID, opts.SecretHash, opts.ServiceID, opts.ExpiresOn, opts.APINames, opts.SCIM,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("service auth token", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 func (c *connection) UpdateServiceAuthTokenUsedOn(ctx context.Context, ids []string) error {
@@ -953,22 +1046,173 @@ func (c *connection) DeleteExpiredDeviceAuthCodes(ctx context.Context, retention
 
 func (c *connection) FindOrganizationRole(ctx context.Context, name string) (*database.OrganizationRole, error) {
 	role := &database.OrganizationRole{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_roles WHERE lower(name)=lower($1)", name).StructScan(role)
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_roles WHERE org_id IS NULL AND lower(name)=lower($1)", name).StructScan(role)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return role, nil
+}
+
+func (c *connection) FindOrganizationRoles(ctx context.Context, orgID string) ([]*database.OrganizationRole, error) {
+	var res []*database.OrganizationRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM org_roles WHERE org_id IS NULL OR org_id=$1 ORDER BY org_id NULLS FIRST, lower(name)", orgID)
+	if err != nil {
+		return nil, parseErr("org roles", err)
+	}
+	return res, nil
+}
+
+// FindOrganizationRoleForOrganization finds a built-in role or a custom role of the org by name.
+func (c *connection) FindOrganizationRoleForOrganization(ctx context.Context, orgID, name string) (*database.OrganizationRole, error) {
+	role := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_roles WHERE (org_id IS NULL OR org_id=$1) AND lower(name)=lower($2) ORDER BY org_id NULLS FIRST", orgID, name).StructScan(role)
 	if err != nil {
 		return nil, parseErr("org role", err)
 	}
 	return role, nil
 }
 
+func (c *connection) InsertOrganizationRole(ctx context.Context, opts *database.InsertOrganizationRoleOptions) (*database.OrganizationRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO org_roles (org_id, name, read_org, manage_org, read_projects, create_projects, manage_projects, read_org_members, manage_org_members)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`,
+		opts.OrgID, opts.Name, opts.ReadOrg, opts.ManageOrg, opts.ReadProjects, opts.CreateProjects, opts.ManageProjects, opts.ReadOrgMembers, opts.ManageOrgMembers,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return res, nil
+}
+
+// UpdateOrganizationRole updates the permissions of a custom org role. Built-in roles can't be updated.
+func (c *connection) UpdateOrganizationRole(ctx context.Context, id string, opts *database.UpdateOrganizationRoleOptions) (*database.OrganizationRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE org_roles
+		SET read_org=$1, manage_org=$2, read_projects=$3, create_projects=$4, manage_projects=$5, read_org_members=$6, manage_org_members=$7
+		WHERE id=$8 AND org_id IS NOT NULL RETURNING *`,
+		opts.ReadOrg, opts.ManageOrg, opts.ReadProjects, opts.CreateProjects, opts.ManageProjects, opts.ReadOrgMembers, opts.ManageOrgMembers, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return res, nil
+}
+
+// DeleteOrganizationRole deletes a custom org role. Built-in roles can't be deleted.
+// Note that it cascades to assignments of the role, so callers should check CheckOrganizationRoleIsInUse first.
+func (c *connection) DeleteOrganizationRole(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM org_roles WHERE id=$1 AND org_id IS NOT NULL", id)
+	return checkDeleteRow("org role", res, err)
+}
+
+// CheckOrganizationRoleIsInUse checks if an org role is assigned to any user, user group, invite or whitelisted domain.
+func (c *connection) CheckOrganizationRoleIsInUse(ctx context.Context, id string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users_orgs_roles WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM usergroups_orgs_roles WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM org_invites WHERE org_role_id=$1)
+			OR EXISTS (SELECT 1 FROM orgs_autoinvite_domains WHERE org_role_id=$1)`, id).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindProjectRole(ctx context.Context, name string) (*database.ProjectRole, error) {
 	role := &database.ProjectRole{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE lower(name)=lower($1)", name).StructScan(role)
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE org_id IS NULL AND lower(name)=lower($1)", name).StructScan(role)
 	if err != nil {
 		return nil, parseErr("project role", err)
 	}
 	return role, nil
 }
 
+func (c *connection) FindProjectRoles(ctx context.Context, orgID string) ([]*database.ProjectRole, error) {
+	var res []*database.ProjectRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM project_roles WHERE org_id IS NULL OR org_id=$1 ORDER BY org_id NULLS FIRST, lower(name)", orgID)
+	if err != nil {
+		return nil, parseErr("project roles", err)
+	}
+	return res, nil
+}
+
+// FindProjectRoleForOrganization finds a built-in role or a custom role of the org by name.
+func (c *connection) FindProjectRoleForOrganization(ctx context.Context, orgID, name string) (*database.ProjectRole, error) {
+	role := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE (org_id IS NULL OR org_id=$1) AND lower(name)=lower($2) ORDER BY org_id NULLS FIRST", orgID, name).StructScan(role)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return role, nil
+}
+
+func (c *connection) InsertProjectRole(ctx context.Context, opts *database.InsertProjectRoleOptions) (*database.ProjectRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO project_roles (org_id, name, read_project, manage_project, read_prod, read_prod_status, manage_prod, read_dev, read_dev_status, manage_dev, read_project_members, manage_project_members, create_reports, manage_reports, create_alerts, manage_alerts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING *`,
+		opts.OrgID, opts.Name, opts.ReadProject, opts.ManageProject, opts.ReadProd, opts.ReadProdStatus, opts.ManageProd, opts.ReadDev, opts.ReadDevStatus, opts.ManageDev, opts.ReadProjectMembers, opts.ManageProjectMembers, opts.CreateReports, opts.ManageReports, opts.CreateAlerts, opts.ManageAlerts,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return res, nil
+}
+
+// UpdateProjectRole updates the permissions of a custom project role. Built-in roles can't be updated.
+func (c *connection) UpdateProjectRole(ctx context.Context, id string, opts *database.UpdateProjectRoleOptions) (*database.ProjectRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE project_roles
+		SET read_project=$1, manage_project=$2, read_prod=$3, read_prod_status=$4, manage_prod=$5, read_dev=$6, read_dev_status=$7, manage_dev=$8, read_project_members=$9, manage_project_members=$10, create_reports=$11, manage_reports=$12, create_alerts=$13, manage_alerts=$14
+		WHERE id=$15 AND org_id IS NOT NULL RETURNING *`,
+		opts.ReadProject, opts.ManageProject, opts.ReadProd, opts.ReadProdStatus, opts.ManageProd, opts.ReadDev, opts.ReadDevStatus, opts.ManageDev, opts.ReadProjectMembers, opts.ManageProjectMembers, opts.CreateReports, opts.ManageReports, opts.CreateAlerts, opts.ManageAlerts, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return res, nil
+}
+
+// DeleteProjectRole deletes a custom project role. Built-in roles can't be deleted.
+// Note that it cascades to assignments of the role, so callers should check CheckProjectRoleIsInUse first.
+func (c *connection) DeleteProjectRole(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_roles WHERE id=$1 AND org_id IS NOT NULL", id)
+	return checkDeleteRow("project role", res, err)
+}
+
+// CheckProjectRoleIsInUse checks if a project role is assigned to any user, user group or invite.
+func (c *connection) CheckProjectRoleIsInUse(ctx context.Context, id string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users_projects_roles WHERE project_role_id=$1)
+			OR EXISTS (SELECT 1 FROM usergroups_projects_roles WHERE project_role_id=$1)
+			OR EXISTS (SELECT 1 FROM project_invites WHERE project_role_id=$1)`, id).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
 func (c *connection) ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*database.OrganizationRole, error) {
 	var res []*database.OrganizationRole
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -1018,6 +1262,65 @@ func (c *connection) FindOrganizationMemberUsers(ctx context.Context, orgID, aft
 	return res, nil
 }
 
+// FindSCIMUsers returns the members of the org and the users deactivated in the org over SCIM, ordered by email.
+// If email is not empty, only the user with that email is returned.
+func (c *connection) FindSCIMUsers(ctx context.Context, orgID, email string, offset, limit int) ([]*database.SCIMUser, error) {
+	var res []*database.SCIMUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, uor.user_id IS NOT NULL AS active FROM users u
+		LEFT JOIN users_orgs_roles uor ON uor.user_id = u.id AND uor.org_id = $1
+		LEFT JOIN scim_deactivated_users sdu ON sdu.user_id = u.id AND sdu.org_id = $1
+		WHERE (uor.user_id IS NOT NULL OR sdu.user_id IS NOT NULL) AND ($2 = '' OR lower(u.email) = lower($2))
+		ORDER BY lower(u.email) OFFSET $3 LIMIT $4
+	`, orgID, email, offset, limit)
+	if err != nil {
+		return nil, parseErr("scim users", err)
+	}
+	return res, nil
+}
+
+// CountSCIMUsers returns the number of users matching the same criteria as FindSCIMUsers.
+func (c *connection) CountSCIMUsers(ctx context.Context, orgID, email string) (int, error) {
+	var count int
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT COUNT(*) FROM users u
+		LEFT JOIN users_orgs_roles uor ON uor.user_id = u.id AND uor.org_id = $1
+		LEFT JOIN scim_deactivated_users sdu ON sdu.user_id = u.id AND sdu.org_id = $1
+		WHERE (uor.user_id IS NOT NULL OR sdu.user_id IS NOT NULL) AND ($2 = '' OR lower(u.email) = lower($2))
+	`, orgID, email).Scan(&count)
+	if err != nil {
+		return 0, parseErr("scim user count", err)
+	}
+	return count, nil
+}
+
+// FindSCIMUser returns a user that is a member of the org or was deactivated in the org over SCIM.
+func (c *connection) FindSCIMUser(ctx context.Context, orgID, userID string) (*database.SCIMUser, error) {
+	res := &database.SCIMUser{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, uor.user_id IS NOT NULL AS active FROM users u
+		LEFT JOIN users_orgs_roles uor ON uor.user_id = u.id AND uor.org_id = $1
+		LEFT JOIN scim_deactivated_users sdu ON sdu.user_id = u.id AND sdu.org_id = $1
+		WHERE u.id = $2 AND (uor.user_id IS NOT NULL OR sdu.user_id IS NOT NULL)
+	`, orgID, userID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("scim user", err)
+	}
+	return res, nil
+}
+
+// InsertSCIMDeactivatedUser records that a user was deactivated in the org over SCIM. It is a no-op if it's already recorded.
+func (c *connection) InsertSCIMDeactivatedUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO scim_deactivated_users (org_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", orgID, userID)
+	return parseErr("scim deactivated user", err)
+}
+
+// DeleteSCIMDeactivatedUser removes the record that a user was deactivated in the org over SCIM. It is a no-op if there's no such record.
+func (c *connection) DeleteSCIMDeactivatedUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM scim_deactivated_users WHERE org_id = $1 AND user_id = $2", orgID, userID)
+	return parseErr("scim deactivated user", err)
+}
+
 func (c *connection) FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(
@@ -1112,6 +1415,34 @@ func (c *connection) InsertProjectMemberUser(ctx context.Context, projectID, use
 	return nil
 }
 
+func (c *connection) FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, r.name as role_name FROM usergroups ug
+		JOIN usergroups_projects_roles ugpr ON ug.id = ugpr.usergroup_id
+		JOIN project_roles r ON r.id = ugpr.project_role_id
+		WHERE ugpr.project_id = $1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, projectID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("project group members", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupProjectRoles(ctx context.Context, groupID string) ([]*database.UsergroupProjectRole, error) {
+	var res []*database.UsergroupProjectRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ugpr.project_id, r.* FROM usergroups_projects_roles ugpr
+		JOIN project_roles r ON r.id = ugpr.project_role_id
+		WHERE ugpr.usergroup_id = $1
+	`, groupID)
+	if err != nil {
+		return nil, parseErr("project group roles", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_projects_roles (usergroup_id, project_id, project_role_id) VALUES ($1, $2, $3)", groupID, projectID, roleID)
 	if err != nil {
@@ -1120,6 +1451,16 @@ func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID,
 	return nil
 }
 
+func (c *connection) UpdateProjectMemberUsergroupRole(ctx context.Context, groupID, projectID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE usergroups_projects_roles SET project_role_id = $1 WHERE usergroup_id = $2 AND project_id = $3", roleID, groupID, projectID)
+	return checkUpdateRow("project group member", res, err)
+}
+
+func (c *connection) DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_projects_roles WHERE usergroup_id = $1 AND project_id = $2", groupID, projectID)
+	return checkDeleteRow("project group member", res, err)
+}
+
 func (c *connection) DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_projects_roles WHERE user_id = $1 AND project_id = $2", userID, projectID)
 	return checkDeleteRow("project member", res, err)
@@ -1385,6 +1726,65 @@ func (c *connection) DeleteExpiredVirtualFiles(ctx context.Context, retention ti
 	return parseErr("virtual files", err)
 }
 
+func (c *connection) FindAuditEvents(ctx context.Context, opts *database.FindAuditEventsOptions) ([]*database.AuditEvent, error) {
+	// Unset filters are passed as NULL
+	var startTime, endTime, afterCreatedOn, afterID any
+	if !opts.StartTime.IsZero() {
+		startTime = opts.StartTime
+	}
+	if !opts.EndTime.IsZero() {
+		endTime = opts.EndTime
+	}
+	if opts.AfterID != "" {
+		afterCreatedOn = opts.AfterCreatedOn
+		afterID = opts.AfterID
+	}
+
+	var res []*auditEventDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM audit_events
+		WHERE org_id=$1
+			AND ($2='' OR lower(project_name)=lower($2))
+			AND ($3='' OR lower(actor_name)=lower($3))
+			AND ($4::TIMESTAMPTZ IS NULL OR created_on>=$4)
+			AND ($5::TIMESTAMPTZ IS NULL OR created_on<$5)
+			AND ($6::TIMESTAMPTZ IS NULL OR (created_on, id)<($6, $7::UUID))
+		ORDER BY created_on DESC, id DESC LIMIT $8
+	`, opts.OrgID, opts.ProjectName, opts.ActorName, startTime, endTime, afterCreatedOn, afterID, opts.Limit)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+
+	events := make([]*database.AuditEvent, len(res))
+	for i, dto := range res {
+		events[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+	if opts.Metadata == nil {
+		opts.Metadata = make(map[string]any)
+	}
+
+	res := &auditEventDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, project_name, actor_type, actor_id, actor_name, action, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
+		opts.OrgID, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorName, opts.Action, opts.Metadata,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res.AsModel()
+}
+
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
@@ -1406,6 +1806,34 @@ func (p *projectDTO) AsProject() (*database.Project, error) {
 	return p.Project, nil
 }
 
+// serviceAuthTokenDTO wraps database.ServiceAuthToken, using the pgtype package to handle types that pgx can't read directly into their native Go types.
+type serviceAuthTokenDTO struct {
+	*database.ServiceAuthToken
+	APINames pgtype.TextArray `db:"api_names"`
+}
+
+func (t *serviceAuthTokenDTO) AsModel() (*database.ServiceAuthToken, error) {
+	err := t.APINames.AssignTo(&t.ServiceAuthToken.APINames)
+	if err != nil {
+		return nil, err
+	}
+	return t.ServiceAuthToken, nil
+}
+
+// auditEventDTO wraps database.AuditEvent, using the pgtype package to handle types that pgx can't read directly into their native Go types.
+type auditEventDTO struct {
+	*database.AuditEvent
+	Metadata pgtype.JSON `db:"metadata"`
+}
+
+func (e *auditEventDTO) AsModel() (*database.AuditEvent, error) {
+	err := e.Metadata.AssignTo(&e.AuditEvent.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return e.AuditEvent, nil
+}
+
 func projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
 	res := make([]*database.Project, len(dtos))
 	for i, dto := range dtos {
@@ -1498,6 +1926,12 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("email has already been invited to the project")
 		case "orgs_autoinvite_domains_org_id_domain_idx":
 			return newAlreadyExistsErr("domain has already been added for the org")
+		case "org_roles_name_idx":
+			return newAlreadyExistsErr("an org role with that name already exists")
+		case "project_roles_name_idx":
+			return newAlreadyExistsErr("a project role with that name already exists")
+		case "deployments_preview_branch_idx":
+			return newAlreadyExistsErr("a preview deployment for that branch already exists")
 		case "service_name_idx":
 			return newAlreadyExistsErr("a service with that name already exists in the org")
 		case "virtual_files_pkey":
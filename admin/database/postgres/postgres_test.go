@@ -32,6 +32,10 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestUsergroups", func(t *testing.T) { testUsergroups(t, db) })
+	t.Run("TestCustomRoles", func(t *testing.T) { testCustomRoles(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
 	// Add new tests here
 
 	require.NoError(t, db.Close())
@@ -419,3 +423,333 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audited"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj", ProdSlots: 1})
+	require.NoError(t, err)
+
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ActorType: "user", ActorName: "a@rilldata.com"})
+	require.Error(t, err) // action is required
+
+	e1, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ProjectID: &proj.ID, ProjectName: proj.Name, ActorType: "user", ActorID: "1", ActorName: "a@rilldata.com", Action: "project.variables.update", Metadata: map[string]any{"keys": []any{"foo"}}})
+	require.NoError(t, err)
+	require.Equal(t, []any{"foo"}, e1.Metadata["keys"])
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ActorType: "service", ActorID: "2", ActorName: "ci", Action: "service.token.issue"})
+	require.NoError(t, err)
+	e3, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ProjectID: &proj.ID, ProjectName: proj.Name, ActorType: "user", ActorID: "1", ActorName: "a@rilldata.com", Action: "project.delete"})
+	require.NoError(t, err)
+
+	// Most recent first
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, e3.ID, events[0].ID)
+	require.Equal(t, e1.ID, events[2].ID)
+
+	// Filters
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ProjectName: "PROJ", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ActorName: "ci", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "service.token.issue", events[0].Action)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, StartTime: time.Now().Add(time.Hour), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// Pagination
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, AfterCreatedOn: events[1].CreatedOn, AfterID: events[1].ID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, e1.ID, events[0].ID)
+
+	// cleanup (the events outlive the org)
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+}
+
+func testUsergroups(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user1, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "analyst1@rilldata.com"})
+	require.NoError(t, err)
+	user2, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "analyst2@rilldata.com"})
+	require.NoError(t, err)
+
+	viewer, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	admin, err := db.FindProjectRole(ctx, database.ProjectRoleNameAdmin)
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "groups"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha"})
+	require.NoError(t, err)
+
+	// create groups
+	finance, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "finance"})
+	require.NoError(t, err)
+	marketing, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "marketing"})
+	require.NoError(t, err)
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "Finance"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	groups, err := db.FindUsergroupsForOrganization(ctx, org.ID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "finance", groups[0].Name)
+	groups, err = db.FindUsergroupsForOrganization(ctx, org.ID, groups[0].Name, 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "marketing", groups[0].Name)
+
+	// rename
+	marketing, err = db.UpdateUsergroup(ctx, marketing.ID, &database.UpdateUsergroupOptions{Name: "growth"})
+	require.NoError(t, err)
+	require.Equal(t, "growth", marketing.Name)
+	group, err := db.FindUsergroupByName(ctx, org.ID, "GROWTH")
+	require.NoError(t, err)
+	require.Equal(t, marketing.ID, group.ID)
+	group, err = db.FindUsergroup(ctx, marketing.ID)
+	require.NoError(t, err)
+	require.Equal(t, "growth", group.Name)
+
+	// members
+	require.NoError(t, db.InsertUsergroupMember(ctx, finance.ID, user1.ID))
+	require.NoError(t, db.InsertUsergroupMember(ctx, finance.ID, user2.ID))
+	require.NoError(t, db.InsertUsergroupMember(ctx, marketing.ID, user2.ID))
+	members, err := db.FindUsergroupMemberUsers(ctx, finance.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.Equal(t, "analyst1@rilldata.com", members[0].Email)
+	require.Equal(t, "analyst2@rilldata.com", members[1].Email)
+
+	// project roles are resolved through group membership
+	roles, err := db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, finance.ID, proj.ID, viewer.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, database.ProjectRoleNameViewer, roles[0].Name)
+	require.NoError(t, db.UpdateProjectMemberUsergroupRole(ctx, finance.ID, proj.ID, admin.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, database.ProjectRoleNameAdmin, roles[0].Name)
+	require.ErrorIs(t, db.UpdateProjectMemberUsergroupRole(ctx, marketing.ID, proj.ID, admin.ID), database.ErrNotFound)
+
+	projGroups, err := db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, projGroups, 1)
+	require.Equal(t, "finance", projGroups[0].Name)
+	require.Equal(t, database.ProjectRoleNameAdmin, projGroups[0].RoleName)
+
+	grants, err := db.FindUsergroupProjectRoles(ctx, finance.ID)
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, proj.ID, grants[0].ProjectID)
+	require.Equal(t, admin.ID, grants[0].ID)
+	require.True(t, grants[0].ManageProd)
+
+	// removing a user from all groups revokes the group roles
+	require.NoError(t, db.DeleteAllUsergroupMemberUserForOrganization(ctx, org.ID, user1.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user1.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+	groups, err = db.FindUsergroupsForUser(ctx, user2.ID, org.ID)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	// deleting a group removes its memberships and project roles
+	require.NoError(t, db.DeleteProjectMemberUsergroup(ctx, finance.ID, proj.ID))
+	require.ErrorIs(t, db.DeleteProjectMemberUsergroup(ctx, finance.ID, proj.ID), database.ErrNotFound)
+	require.NoError(t, db.DeleteUsergroup(ctx, marketing.ID))
+	groups, err = db.FindUsergroupsForUser(ctx, user2.ID, org.ID)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "finance", groups[0].Name)
+
+	// cleanup
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	require.NoError(t, db.DeleteUser(ctx, user1.ID))
+	require.NoError(t, db.DeleteUser(ctx, user2.ID))
+}
+
+func testCustomRoles(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "alerts@rilldata.com"})
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "roles"})
+	require.NoError(t, err)
+	otherOrg, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "other-roles"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha"})
+	require.NoError(t, err)
+
+	// create custom project role
+	role, err := db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{
+		OrgID:        org.ID,
+		Name:         "alert-manager",
+		ReadProject:  true,
+		ReadProd:     true,
+		CreateAlerts: true,
+		ManageAlerts: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, role.OrgID)
+	require.Equal(t, org.ID, *role.OrgID)
+	require.True(t, role.ManageAlerts)
+	require.False(t, role.ManageProject)
+	_, err = db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: org.ID, Name: "Alert-Manager"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	// the same name can be used in another org
+	_, err = db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: otherOrg.ID, Name: "alert-manager"})
+	require.NoError(t, err)
+
+	// custom roles are only visible within their org and never shadow built-in roles
+	roles, err := db.FindProjectRoles(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 3)
+	require.Nil(t, roles[0].OrgID)
+	require.Nil(t, roles[1].OrgID)
+	require.Equal(t, role.ID, roles[2].ID)
+	found, err := db.FindProjectRoleForOrganization(ctx, org.ID, "ALERT-MANAGER")
+	require.NoError(t, err)
+	require.Equal(t, role.ID, found.ID)
+	found, err = db.FindProjectRoleForOrganization(ctx, org.ID, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.Nil(t, found.OrgID)
+	_, err = db.FindProjectRole(ctx, "alert-manager")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// update
+	role, err = db.UpdateProjectRole(ctx, role.ID, &database.UpdateProjectRoleOptions{ReadProject: true, ReadProd: true, CreateAlerts: true})
+	require.NoError(t, err)
+	require.False(t, role.ManageAlerts)
+	_, err = db.UpdateProjectRole(ctx, found.ID, &database.UpdateProjectRoleOptions{ManageProject: true})
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// in use
+	inUse, err := db.CheckProjectRoleIsInUse(ctx, role.ID)
+	require.NoError(t, err)
+	require.False(t, inUse)
+	require.NoError(t, db.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID))
+	inUse, err = db.CheckProjectRoleIsInUse(ctx, role.ID)
+	require.NoError(t, err)
+	require.True(t, inUse)
+	require.NoError(t, db.DeleteProjectMemberUser(ctx, proj.ID, user.ID))
+
+	// delete
+	require.NoError(t, db.DeleteProjectRole(ctx, role.ID))
+	_, err = db.FindProjectRoleForOrganization(ctx, org.ID, "alert-manager")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// custom org roles
+	orgRole, err := db.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{
+		OrgID:          org.ID,
+		Name:           "project-creator",
+		ReadOrg:        true,
+		ReadProjects:   true,
+		CreateProjects: true,
+	})
+	require.NoError(t, err)
+	orgRoles, err := db.FindOrganizationRoles(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, orgRoles, 3)
+	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, user.ID, orgRole.ID))
+	inUse, err = db.CheckOrganizationRoleIsInUse(ctx, orgRole.ID)
+	require.NoError(t, err)
+	require.True(t, inUse)
+
+	require.NoError(t, db.DeleteOrganization(ctx, otherOrg.Name))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "previews"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha", ProdBranch: "main"})
+	require.NoError(t, err)
+
+	opts := &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Provisioner:       "static",
+		Branch:            "main",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "prod",
+	}
+	prod, err := db.InsertDeployment(ctx, opts)
+	require.NoError(t, err)
+	require.False(t, prod.Preview)
+
+	opts.Branch = "feature"
+	opts.RuntimeInstanceID = "preview"
+	opts.Preview = true
+	preview, err := db.InsertDeployment(ctx, opts)
+	require.NoError(t, err)
+	require.True(t, preview.Preview)
+
+	// a branch can only have one preview deployment
+	opts.RuntimeInstanceID = "preview2"
+	_, err = db.InsertDeployment(ctx, opts)
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depl, err := db.FindPreviewDeploymentForBranch(ctx, proj.ID, "feature")
+	require.NoError(t, err)
+	require.Equal(t, preview.ID, depl.ID)
+
+	// the prod deployment is not a preview deployment
+	_, err = db.FindPreviewDeploymentForBranch(ctx, proj.ID, "main")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	depls, err := db.FindDeploymentsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, depls, 2)
+
+	// preview deployments expire after the given TTL even if the project has no prod TTL
+	depls, err = db.FindExpiredDeployments(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, depls, 0)
+	depls, err = db.FindExpiredDeployments(ctx, -time.Hour)
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+	require.Equal(t, preview.ID, depls[0].ID)
+
+	// hibernated preview deployments don't expire again and don't count towards quotas
+	count, err := db.CountDeploymentsForOrganization(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count.Deployments)
+	_, err = db.UpdateDeploymentStatus(ctx, preview.ID, database.DeploymentStatusHibernated, "")
+	require.NoError(t, err)
+	depls, err = db.FindExpiredDeployments(ctx, -time.Hour)
+	require.NoError(t, err)
+	require.Len(t, depls, 0)
+	count, err = db.CountDeploymentsForOrganization(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, count.Deployments)
+
+	require.NoError(t, db.DeleteDeployment(ctx, preview.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, prod.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
@@ -2,10 +2,12 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,6 +22,21 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// previewDeploymentTTL is the duration after which an unused preview deployment is hibernated.
+	previewDeploymentTTL = 24 * time.Hour
+	// previewProvisionTimeout is the timeout for provisioning a preview deployment in the background.
+	previewProvisionTimeout = 10 * time.Minute
+	// previewOLAPDSNVariable is the project variable that sets the DSN of the OLAP database used by preview deployments of projects with an external OLAP driver.
+	previewOLAPDSNVariable = "__preview_olap_dsn"
+)
+
+// ErrPreviewOLAPNotConfigured is returned when creating a preview deployment of a project with an external OLAP driver that doesn't have a separate OLAP database for previews.
+var ErrPreviewOLAPNotConfigured = errors.New("preview OLAP database not configured")
+
+// ErrQuotaExceeded is returned when provisioning a deployment would exceed the org's deployment or slot quotas.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
 type createDeploymentOptions struct {
 	ProjectID      string
 	Provisioner    string
@@ -31,6 +48,7 @@ type createDeploymentOptions struct {
 	ProdOLAPDSN    string
 	ProdSlots      int
 	ProdVersion    string
+	Preview        bool
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
@@ -133,9 +151,11 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeAudience:   alloc.Audience,
 		RuntimeVersion:    runtimeVersion,
 		Status:            database.DeploymentStatusPending,
+		Preview:           opts.Preview,
 	})
 	if err != nil {
-		return nil, err
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
 	}
 
 	// Wait for the runtime to be ready
@@ -222,6 +242,11 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 		return fmt.Errorf("cannot update deployment without specifying a valid branch")
 	}
 
+	// Hibernated deployments don't have a runtime instance. They are configured from the project when they're redeployed.
+	if depl.Status == database.DeploymentStatusHibernated {
+		return nil
+	}
+
 	var modelDefaultMaterialize *bool
 	if opts.Variables != nil { // if variables are nil, it means they were not changed
 		val, err := defaultModelMaterialize(opts.Variables)
@@ -317,9 +342,243 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 	return nil
 }
 
+// CreatePreviewDeployment provisions a preview deployment of a non-prod branch of a project.
+// It is configured the same way as the project's prod deployment, except that it never uses the prod OLAP database (see previewOLAPDSN).
+// If the branch already has a preview deployment, it is returned instead.
+func (s *Service) CreatePreviewDeployment(ctx context.Context, proj *database.Project, branch string) (*database.Deployment, error) {
+	return s.provisionPreviewDeployment(ctx, proj, branch, nil)
+}
+
+// EnsurePreviewDeployment returns a running preview deployment of a branch.
+// It creates the preview deployment if the branch doesn't have one, and redeploys it if it's hibernated.
+func (s *Service) EnsurePreviewDeployment(ctx context.Context, proj *database.Project, branch string) (*database.Deployment, error) {
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err == nil {
+		return s.WakePreviewDeployment(ctx, proj, depl)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	return s.CreatePreviewDeployment(ctx, proj, branch)
+}
+
+// WakePreviewDeployment redeploys a hibernated preview deployment and returns the new deployment.
+// It returns the deployment unchanged if it's not hibernated.
+func (s *Service) WakePreviewDeployment(ctx context.Context, proj *database.Project, depl *database.Deployment) (*database.Deployment, error) {
+	if depl.Status != database.DeploymentStatusHibernated {
+		return depl, nil
+	}
+
+	s.Logger.Info("waking hibernated preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", depl.Branch), observability.ZapCtx(ctx))
+	return s.TriggerPreviewRedeploy(ctx, proj, depl)
+}
+
+// WakePreviewDeploymentInBackground runs WakePreviewDeployment in the background.
+// It's used by RPCs that access a hibernated preview deployment, which shouldn't wait for it to be provisioned.
+// Callers must check that the caller is allowed to manage the project's preview deployments.
+func (s *Service) WakePreviewDeploymentInBackground(proj *database.Project, depl *database.Deployment) {
+	s.runPreviewInBackground(proj, depl.Branch, func(ctx context.Context) error {
+		_, err := s.WakePreviewDeployment(ctx, proj, depl)
+		return err
+	})
+}
+
+// ensurePreviewDeploymentInBackground runs EnsurePreviewDeployment in the background.
+// It's used when processing Github webhooks since provisioning a deployment can take longer than the webhook timeout.
+func (s *Service) ensurePreviewDeploymentInBackground(proj *database.Project, branch string) {
+	s.runPreviewInBackground(proj, branch, func(ctx context.Context) error {
+		_, err := s.EnsurePreviewDeployment(ctx, proj, branch)
+		return err
+	})
+}
+
+// runPreviewInBackground runs fn in a goroutine with a timeout for provisioning a preview deployment of the branch and logs its error.
+func (s *Service) runPreviewInBackground(proj *database.Project, branch string, fn func(ctx context.Context) error) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), previewProvisionTimeout)
+		defer cancel()
+
+		err := fn(ctx)
+		if err != nil {
+			if errors.Is(err, ErrPreviewOLAPNotConfigured) || errors.Is(err, ErrQuotaExceeded) {
+				s.Logger.Info("skipped preview deployment", zap.String("project_id", proj.ID), zap.String("branch", branch), zap.Error(err))
+				return
+			}
+			s.Logger.Error("failed to provision preview deployment", zap.String("project_id", proj.ID), zap.String("branch", branch), zap.Error(err))
+		}
+	}()
+}
+
+// provisionPreviewDeployment provisions the preview deployment of a branch, replacing prevDepl if it's not nil.
+// All preview deployments are provisioned through it, so they're always subject to the org's deployment and slot quotas.
+// Calls for the same branch are serialized. If the branch's preview deployment was created or replaced by a concurrent call, that deployment is returned instead.
+// Calls on different admin servers are not serialized, but the database only allows one preview deployment per branch.
+func (s *Service) provisionPreviewDeployment(ctx context.Context, proj *database.Project, branch string, prevDepl *database.Deployment) (*database.Deployment, error) {
+	if proj.GithubURL == nil {
+		return nil, fmt.Errorf("preview deployments are only supported for projects connected to Github")
+	}
+
+	if branch == proj.ProdBranch {
+		return nil, fmt.Errorf("branch %q is the project's prod branch", branch)
+	}
+
+	olapDSN, err := previewOLAPDSN(proj)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := s.previewLocks.lock(proj.ID, branch)
+	defer unlock()
+
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err == nil {
+		if prevDepl == nil || depl.ID != prevDepl.ID {
+			return depl, nil
+		}
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	} else if prevDepl != nil {
+		return nil, fmt.Errorf("preview deployment %q no longer exists", prevDepl.ID)
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkDeploymentQuota(ctx, org, proj.ProdSlots, prevDepl)
+	if err != nil {
+		return nil, err
+	}
+
+	if prevDepl != nil {
+		err = s.teardownDeployment(ctx, prevDepl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.createDeployment(ctx, &createDeploymentOptions{
+		ProjectID:      proj.ID,
+		Provisioner:    proj.Provisioner,
+		Annotations:    s.NewDeploymentAnnotations(org, proj),
+		VersionNumber:  s.VersionNumber,
+		ProdVersion:    proj.ProdVersion,
+		ProdBranch:     branch,
+		ProdVariables:  proj.ProdVariables,
+		ProdOLAPDriver: proj.ProdOLAPDriver,
+		ProdOLAPDSN:    olapDSN,
+		ProdSlots:      proj.ProdSlots,
+		Preview:        true,
+	})
+}
+
+// checkDeploymentQuota returns an error wrapping ErrQuotaExceeded if the org can't provision another deployment with the given number of slots.
+// If the new deployment replaces an existing deployment, the replaced deployment doesn't count towards the quotas.
+func (s *Service) checkDeploymentQuota(ctx context.Context, org *database.Organization, slots int, replaced *database.Deployment) error {
+	stats, err := s.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return err
+	}
+
+	// Hibernated deployments are not counted
+	if replaced != nil && replaced.Status != database.DeploymentStatusHibernated {
+		stats.Deployments--
+		stats.Slots -= replaced.Slots
+	}
+
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return fmt.Errorf("%w: org %q is limited to %d deployments", ErrQuotaExceeded, org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+slots > org.QuotaSlotsTotal {
+		return fmt.Errorf("%w: org %q is limited to %d total slots", ErrQuotaExceeded, org.Name, org.QuotaSlotsTotal)
+	}
+	return nil
+}
+
+// previewOLAPDSN returns the DSN of the OLAP database to use for preview deployments of a project.
+// Preview deployments must not write to the prod OLAP database. DuckDB is isolated since every deployment gets its own database file.
+// For external OLAP drivers, a separate database for previews must be configured in the previewOLAPDSNVariable project variable.
+func previewOLAPDSN(proj *database.Project) (string, error) {
+	switch proj.ProdOLAPDriver {
+	case "duckdb", "duckdb-ext-storage":
+		return proj.ProdOLAPDSN, nil
+	}
+
+	dsn := proj.ProdVariables[previewOLAPDSNVariable]
+	if dsn == "" || dsn == proj.ProdOLAPDSN {
+		return "", fmt.Errorf("%w: previews of projects using the OLAP driver %q require a separate database, set its DSN in the %q variable", ErrPreviewOLAPNotConfigured, proj.ProdOLAPDriver, previewOLAPDSNVariable)
+	}
+	return dsn, nil
+}
+
+// TriggerPreviewRedeploy de-provisions and re-provisions a preview deployment.
+// Unlike TriggerRedeploy, the old deployment is torn down before the new one is created since a branch can only have one preview deployment.
+func (s *Service) TriggerPreviewRedeploy(ctx context.Context, proj *database.Project, prevDepl *database.Deployment) (*database.Deployment, error) {
+	if !prevDepl.Preview {
+		return nil, fmt.Errorf("deployment %q is not a preview deployment", prevDepl.ID)
+	}
+
+	return s.provisionPreviewDeployment(ctx, proj, prevDepl.Branch, prevDepl)
+}
+
+// branchLocks serializes operations on the preview deployment of a branch within the process.
+// The zero value is ready to use.
+type branchLocks struct {
+	mu    sync.Mutex
+	locks map[string]*branchLock
+}
+
+type branchLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks the given branch of a project and returns a function that unlocks it.
+func (l *branchLocks) lock(projectID, branch string) func() {
+	key := projectID + "/" + branch
+
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*branchLock)
+	}
+	bl, ok := l.locks[key]
+	if !ok {
+		bl = &branchLock{}
+		l.locks[key] = bl
+	}
+	bl.refs++
+	l.mu.Unlock()
+
+	bl.mu.Lock()
+	return func() {
+		bl.mu.Unlock()
+
+		l.mu.Lock()
+		bl.refs--
+		if bl.refs == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// TeardownPreviewDeployment tears down a preview deployment.
+func (s *Service) TeardownPreviewDeployment(ctx context.Context, depl *database.Deployment) error {
+	if !depl.Preview {
+		return fmt.Errorf("deployment %q is not a preview deployment", depl.ID)
+	}
+
+	unlock := s.previewLocks.lock(depl.ProjectID, depl.Branch)
+	defer unlock()
+
+	return s.teardownDeployment(ctx, depl)
+}
+
 // HibernateDeployments tears down unused deployments
 func (s *Service) HibernateDeployments(ctx context.Context) error {
-	depls, err := s.DB.FindExpiredDeployments(ctx)
+	depls, err := s.DB.FindExpiredDeployments(ctx, previewDeploymentTTL)
 	if err != nil {
 		return err
 	}
@@ -337,6 +596,23 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			continue
 		}
 
+		// Preview deployments are deprovisioned, but kept so they can be redeployed when accessed or pushed to
+		if depl.Preview {
+			s.Logger.Info("hibernate: hibernating preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID))
+
+			err = s.deprovisionDeployment(ctx, depl)
+			if err != nil {
+				s.Logger.Error("hibernate: deprovision preview deployment error", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+				continue
+			}
+
+			_, err = s.DB.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusHibernated, "")
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		s.Logger.Info("hibernate: deleting deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID))
 
 		err = s.teardownDeployment(ctx, depl)
@@ -372,6 +648,25 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 }
 
 func (s *Service) teardownDeployment(ctx context.Context, depl *database.Deployment) error {
+	// Hibernated deployments have already been deprovisioned
+	if depl.Status != database.DeploymentStatusHibernated {
+		err := s.deprovisionDeployment(ctx, depl)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Delete the deployment
+	err := s.DB.DeleteDeployment(ctx, depl.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deprovisionDeployment deletes the deployment's runtime instance and releases its resources from the provisioner.
+func (s *Service) deprovisionDeployment(ctx context.Context, depl *database.Deployment) error {
 	// Connect to the deployment's runtime
 	rt, err := s.openRuntimeClientForDeployment(depl)
 	if err != nil {
@@ -398,12 +693,6 @@ func (s *Service) teardownDeployment(ctx context.Context, depl *database.Deploym
 		s.Logger.Warn("provisioner: deprovisioning skipped, provisioner not found", zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.Error(err), observability.ZapCtx(ctx))
 	}
 
-	// Delete the deployment
-	err = s.DB.DeleteDeployment(ctx, depl.ID)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchLocks(t *testing.T) {
+	var l branchLocks
+
+	// Locks of different branches don't block each other
+	unlockA := l.lock("p1", "a")
+	unlockB := l.lock("p1", "b")
+	unlockB()
+
+	// Locks of the same branch are serialized
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		unlock := l.lock("p1", "a")
+		defer unlock()
+		mu.Lock()
+		order = append(order, 2)
+		mu.Unlock()
+	}()
+	mu.Lock()
+	order = append(order, 1)
+	mu.Unlock()
+	unlockA()
+	wg.Wait()
+	require.Equal(t, []int{1, 2}, order)
+
+	// Unused locks are released
+	require.Empty(t, l.locks)
+}
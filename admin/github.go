@@ -182,6 +182,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered when new repos are added to the account (org or user), and the installation has full access to account
 	case *github.InstallationRepositoriesEvent:
 		return s.processGithubInstallationRepositoriesEvent(ctx, event)
+	// Triggered when a pull request is opened, closed, edited, etc.
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	default:
 		return nil
 	}
@@ -212,7 +215,11 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
+			// Pushes to other branches only impact preview deployments
+			err = s.processGithubPushToPreview(ctx, project, branch, event.GetDeleted())
+			if err != nil {
+				s.Logger.Error("process github event: could not update preview deployment", zap.String("project_id", project.ID), zap.String("branch", branch), zap.Error(err), observability.ZapCtx(ctx))
+			}
 			continue
 		}
 
@@ -234,6 +241,64 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	return nil
 }
 
+// processGithubPushToPreview reconciles the preview deployment of a branch that was pushed to.
+// Pushes don't create preview deployments, they're only created when a pull request is opened (or manually).
+// Hibernated preview deployments are left as-is, they pick up the new commits when they're redeployed.
+// If the push deleted the branch, the preview deployment is torn down instead.
+func (s *Service) processGithubPushToPreview(ctx context.Context, project *database.Project, branch string, deleted bool) error {
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, project.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if deleted {
+		s.Logger.Info("github webhook: tearing down preview deployment of deleted branch", zap.String("project_id", project.ID), zap.String("deployment_id", depl.ID), zap.String("branch", branch), observability.ZapCtx(ctx))
+		return s.TeardownPreviewDeployment(ctx, depl)
+	}
+
+	if depl.Status == database.DeploymentStatusHibernated {
+		return nil
+	}
+
+	return s.TriggerReconcile(ctx, depl)
+}
+
+// processGithubPullRequest provisions preview deployments for the head branch of pull requests that are opened or reopened.
+// Pull requests from forks are ignored since their branches are not in the project's repository.
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	action := event.GetAction()
+	if action != "opened" && action != "reopened" {
+		return nil
+	}
+
+	pr := event.GetPullRequest()
+	if pr.GetHead().GetRepo().GetID() != event.GetRepo().GetID() {
+		return nil
+	}
+	branch := pr.GetHead().GetRef()
+
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, event.GetRepo().GetHTMLURL())
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// App is installed on repo not currently deployed. Do nothing.
+			return nil
+		}
+		return err
+	}
+
+	for _, project := range projects {
+		if branch == project.ProdBranch {
+			continue
+		}
+		s.ensurePreviewDeploymentInBackground(project, branch)
+	}
+
+	return nil
+}
+
 func (s *Service) processGithubInstallationEvent(ctx context.Context, event *github.InstallationEvent) error {
 	switch event.GetAction() {
 	case "created", "unsuspend", "new_permissions_accepted":
@@ -31,6 +31,8 @@ func New(t *testing.T) Container {
 				"POSTGRES_PASSWORD": "postgres",
 				"POSTGRES_DB":       "postgres",
 			},
+			// Logical replication is needed to test change data capture
+			Cmd: []string{"postgres", "-c", "wal_level=logical"},
 		},
 	})
 	require.NoError(t, err)
@@ -180,12 +180,16 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
 	for _, d := range ds {
+		// Preview deployments keep deploying their own branch
+		branch := opts.ProdBranch
+		if d.Preview {
+			branch = d.Branch
+		}
+
 		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
 			Version:         opts.ProdVersion,
-			Branch:          opts.ProdBranch,
+			Branch:          branch,
 			Variables:       opts.ProdVariables,
 			Annotations:     annotations,
 			EvictCachedRepo: true,
@@ -219,7 +223,7 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 
 			for _, d := range ds {
 				err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
-					Branch:          proj.ProdBranch,
+					Branch:          d.Branch,
 					Variables:       proj.ProdVariables,
 					Annotations:     s.NewDeploymentAnnotations(org, proj),
 					EvictCachedRepo: false,
@@ -115,6 +115,7 @@ func TestAdmin_RBAC(t *testing.T) {
 		admin:         service,
 		opts:          &Options{},
 		authenticator: authenticator,
+		issuer:        issuer,
 		logger:        logger,
 	}
 
@@ -390,6 +391,15 @@ func TestAdmin_RBAC(t *testing.T) {
 			})
 			require.NoError(t, err)
 			require.Equal(t, 0, len(invitesResp.Invites))
+
+			// inviting and removing the invite is audited
+			events, err := adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: adminOrg.Organization.Name})
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, len(events.Events), 2)
+			require.Equal(t, "org.member.remove", events.Events[0].Action)
+			require.Equal(t, e, events.Events[0].Metadata.AsMap()["email"])
+			require.Equal(t, "org.member.add", events.Events[1].Action)
+			require.Equal(t, true, events.Events[1].Metadata.AsMap()["invite"])
 		})
 	}
 
@@ -627,6 +637,342 @@ func TestAdmin_RBAC(t *testing.T) {
 		require.Equal(t, 3, len(resp.Organizations))
 	})
 
+	t.Run("test user groups", func(t *testing.T) {
+		org := adminOrg.Organization.Name
+
+		// only members that can manage org members can manage groups
+		_, err := testClient.CreateUsergroup(ctx, &adminv1.CreateUsergroupRequest{Organization: org, Name: "analysts"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = adminClient.CreateUsergroup(ctx, &adminv1.CreateUsergroupRequest{Organization: org, Name: "analysts"})
+		require.NoError(t, err)
+		groups, err := adminClient.ListOrganizationUsergroups(ctx, &adminv1.ListOrganizationUsergroupsRequest{Organization: org})
+		require.NoError(t, err)
+		var names []string
+		for _, g := range groups.Usergroups {
+			names = append(names, g.Name)
+		}
+		require.Contains(t, names, "analysts")
+
+		// only org members can be added to a group
+		_, err = adminClient.AddUsergroupMember(ctx, &adminv1.AddUsergroupMemberRequest{Organization: org, Usergroup: "analysts", Email: testUser.Email})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = adminClient.AddUsergroupMember(ctx, &adminv1.AddUsergroupMemberRequest{Organization: org, Usergroup: "analysts", Email: viewerUser.Email})
+		require.NoError(t, err)
+		members, err := adminClient.ListUsergroupMembers(ctx, &adminv1.ListUsergroupMembersRequest{Organization: org, Usergroup: "analysts"})
+		require.NoError(t, err)
+		require.Len(t, members.Members, 1)
+		require.Equal(t, viewerUser.Email, members.Members[0].UserEmail)
+
+		// grant the group a role in a project
+		proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+			OrganizationID: adminOrg.Organization.Id,
+			Name:           "groups-proj",
+			ProdBranch:     "main",
+		})
+		require.NoError(t, err)
+		_, err = testClient.SetUsergroupProjectRole(ctx, &adminv1.SetUsergroupProjectRoleRequest{Organization: org, Project: proj.Name, Usergroup: "analysts", Role: "viewer"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = adminClient.SetUsergroupProjectRole(ctx, &adminv1.SetUsergroupProjectRoleRequest{Organization: org, Project: proj.Name, Usergroup: "analysts", Role: "viewer"})
+		require.NoError(t, err)
+		projGroups, err := adminClient.ListProjectMemberUsergroups(ctx, &adminv1.ListProjectMemberUsergroupsRequest{Organization: org, Project: proj.Name})
+		require.NoError(t, err)
+		require.Len(t, projGroups.Usergroups, 1)
+		require.Equal(t, "analysts", projGroups.Usergroups[0].UsergroupName)
+		require.Equal(t, "viewer", projGroups.Usergroups[0].RoleName)
+
+		// group role grants are audited like member role grants
+		events, err := adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: org, Project: proj.Name})
+		require.NoError(t, err)
+		require.NotEmpty(t, events.Events)
+		require.Equal(t, "project.usergroup.set_role", events.Events[0].Action)
+		require.Equal(t, "analysts", events.Events[0].Metadata.AsMap()["usergroup"])
+
+		// clean up
+		_, err = adminClient.RemoveUsergroupProjectRole(ctx, &adminv1.RemoveUsergroupProjectRoleRequest{Organization: org, Project: proj.Name, Usergroup: "analysts"})
+		require.NoError(t, err)
+		projGroups, err = adminClient.ListProjectMemberUsergroups(ctx, &adminv1.ListProjectMemberUsergroupsRequest{Organization: org, Project: proj.Name})
+		require.NoError(t, err)
+		require.Len(t, projGroups.Usergroups, 0)
+		_, err = adminClient.RemoveUsergroupMember(ctx, &adminv1.RemoveUsergroupMemberRequest{Organization: org, Usergroup: "analysts", Email: viewerUser.Email})
+		require.NoError(t, err)
+		members, err = adminClient.ListUsergroupMembers(ctx, &adminv1.ListUsergroupMembersRequest{Organization: org, Usergroup: "analysts"})
+		require.NoError(t, err)
+		require.Len(t, members.Members, 0)
+		events, err = adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: org})
+		require.NoError(t, err)
+		require.Equal(t, "org.usergroup.member.remove", events.Events[0].Action)
+		_, err = adminClient.RenameUsergroup(ctx, &adminv1.RenameUsergroupRequest{Organization: org, Usergroup: "analysts", Name: "analysts-old"})
+		require.NoError(t, err)
+		_, err = adminClient.DeleteUsergroup(ctx, &adminv1.DeleteUsergroupRequest{Organization: org, Usergroup: "analysts-old"})
+		require.NoError(t, err)
+
+		// creating, renaming and deleting groups is audited
+		events, err = adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: org})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(events.Events), 2)
+		require.Equal(t, "org.usergroup.delete", events.Events[0].Action)
+		require.Equal(t, "analysts-old", events.Events[0].Metadata.AsMap()["usergroup"])
+		require.Equal(t, "org.usergroup.rename", events.Events[1].Action)
+		require.Equal(t, "analysts", events.Events[1].Metadata.AsMap()["usergroup"])
+		require.Equal(t, "analysts-old", events.Events[1].Metadata.AsMap()["name"])
+	})
+
+	t.Run("test custom roles cannot grant permissions they do not have", func(t *testing.T) {
+		org := adminOrg.Organization.Name
+
+		// a custom org role that can manage members, but nothing else
+		_, err := adminClient.CreateOrganizationRole(ctx, &adminv1.CreateOrganizationRoleRequest{
+			Organization: org,
+			Name:         "member-manager",
+			Permissions: &adminv1.OrganizationPermissions{
+				ReadOrg:          true,
+				ReadOrgMembers:   true,
+				ManageOrgMembers: true,
+			},
+		})
+		require.NoError(t, err)
+		_, err = adminClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: org,
+			Email:        testUser.Email,
+			Role:         "member-manager",
+		})
+		require.NoError(t, err)
+
+		// cannot make itself or anyone else an admin
+		_, err = testClient.SetOrganizationMemberRole(ctx, &adminv1.SetOrganizationMemberRoleRequest{
+			Organization: org,
+			Email:        testUser.Email,
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = testClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: org,
+			Email:        "escalate@test.io",
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// can grant a role with a subset of its permissions
+		_, err = testClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+			Organization: org,
+			Email:        "subset@test.io",
+			Role:         "member-manager",
+		})
+		require.NoError(t, err)
+
+		// a custom project role that can manage project members, but nothing else
+		proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+			OrganizationID: adminOrg.Organization.Id,
+			Name:           "roles-proj",
+			ProdBranch:     "main",
+		})
+		require.NoError(t, err)
+		_, err = adminClient.CreateProjectRole(ctx, &adminv1.CreateProjectRoleRequest{
+			Organization: org,
+			Name:         "project-member-manager",
+			Permissions: &adminv1.ProjectPermissions{
+				ReadProject:          true,
+				ReadProjectMembers:   true,
+				ManageProjectMembers: true,
+			},
+		})
+		require.NoError(t, err)
+		_, err = adminClient.AddProjectMember(ctx, &adminv1.AddProjectMemberRequest{
+			Organization: org,
+			Project:      proj.Name,
+			Email:        testUser.Email,
+			Role:         "project-member-manager",
+		})
+		require.NoError(t, err)
+
+		// cannot make itself a project admin, directly or through a user group
+		_, err = testClient.SetProjectMemberRole(ctx, &adminv1.SetProjectMemberRoleRequest{
+			Organization: org,
+			Project:      proj.Name,
+			Email:        testUser.Email,
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = testClient.AddProjectMember(ctx, &adminv1.AddProjectMemberRequest{
+			Organization: org,
+			Project:      proj.Name,
+			Email:        "escalate@test.io",
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = adminClient.CreateUsergroup(ctx, &adminv1.CreateUsergroupRequest{Organization: org, Name: "project-admins"})
+		require.NoError(t, err)
+		_, err = testClient.SetUsergroupProjectRole(ctx, &adminv1.SetUsergroupProjectRoleRequest{
+			Organization: org,
+			Project:      proj.Name,
+			Usergroup:    "project-admins",
+			Role:         "admin",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = adminClient.SetUsergroupProjectRole(ctx, &adminv1.SetUsergroupProjectRoleRequest{
+			Organization: org,
+			Project:      proj.Name,
+			Usergroup:    "project-admins",
+			Role:         "admin",
+		})
+		require.NoError(t, err)
+		_, err = testClient.AddUsergroupMember(ctx, &adminv1.AddUsergroupMemberRequest{
+			Organization: org,
+			Usergroup:    "project-admins",
+			Email:        testUser.Email,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the admin can still grant any role
+		_, err = adminClient.AddUsergroupMember(ctx, &adminv1.AddUsergroupMemberRequest{
+			Organization: org,
+			Usergroup:    "project-admins",
+			Email:        testUser.Email,
+		})
+		require.NoError(t, err)
+
+		// editing a custom role is audited since it changes the permissions of every member that has it
+		_, err = adminClient.UpdateProjectRole(ctx, &adminv1.UpdateProjectRoleRequest{
+			Organization: org,
+			Name:         "project-member-manager",
+			Permissions: &adminv1.ProjectPermissions{
+				ReadProject:        true,
+				ReadProjectMembers: true,
+			},
+		})
+		require.NoError(t, err)
+		events, err := adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: org})
+		require.NoError(t, err)
+		require.NotEmpty(t, events.Events)
+		require.Equal(t, "project.role.update", events.Events[0].Action)
+		require.Equal(t, "project-member-manager", events.Events[0].Metadata.AsMap()["role"])
+
+		// a member that can manage roles cannot put permissions it does not have into a role, including its own
+		_, err = adminClient.CreateOrganizationRole(ctx, &adminv1.CreateOrganizationRoleRequest{
+			Organization: org,
+			Name:         "role-manager",
+			Permissions: &adminv1.OrganizationPermissions{
+				ReadOrg:   true,
+				ManageOrg: true,
+			},
+		})
+		require.NoError(t, err)
+		_, err = adminClient.SetOrganizationMemberRole(ctx, &adminv1.SetOrganizationMemberRoleRequest{
+			Organization: org,
+			Email:        testUser.Email,
+			Role:         "role-manager",
+		})
+		require.NoError(t, err)
+		_, err = testClient.UpdateOrganizationRole(ctx, &adminv1.UpdateOrganizationRoleRequest{
+			Organization: org,
+			Name:         "role-manager",
+			Permissions: &adminv1.OrganizationPermissions{
+				ReadOrg:          true,
+				ManageOrg:        true,
+				ManageOrgMembers: true,
+			},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = testClient.CreateOrganizationRole(ctx, &adminv1.CreateOrganizationRoleRequest{
+			Organization: org,
+			Name:         "escalated",
+			Permissions:  &adminv1.OrganizationPermissions{ManageProjects: true},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = testClient.CreateProjectRole(ctx, &adminv1.CreateProjectRoleRequest{
+			Organization: org,
+			Name:         "escalated",
+			Permissions:  &adminv1.ProjectPermissions{ReadProject: true},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = testClient.UpdateProjectRole(ctx, &adminv1.UpdateProjectRoleRequest{
+			Organization: org,
+			Name:         "project-member-manager",
+			Permissions:  &adminv1.ProjectPermissions{ManageProject: true},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// it can still edit roles within its own permissions
+		_, err = testClient.CreateOrganizationRole(ctx, &adminv1.CreateOrganizationRoleRequest{
+			Organization: org,
+			Name:         "reader",
+			Permissions:  &adminv1.OrganizationPermissions{ReadOrg: true},
+		})
+		require.NoError(t, err)
+
+		// creating and deleting roles is audited
+		_, err = adminClient.DeleteOrganizationRole(ctx, &adminv1.DeleteOrganizationRoleRequest{Organization: org, Name: "reader"})
+		require.NoError(t, err)
+		events, err = adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: org})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(events.Events), 2)
+		require.Equal(t, "org.role.delete", events.Events[0].Action)
+		require.Equal(t, "reader", events.Events[0].Metadata.AsMap()["role"])
+		require.Equal(t, "org.role.create", events.Events[1].Action)
+		require.Equal(t, "reader", events.Events[1].Metadata.AsMap()["role"])
+	})
+
+	t.Run("test project viewers can access preview deployments", func(t *testing.T) {
+		org := adminOrg.Organization.Name
+
+		proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+			OrganizationID: adminOrg.Organization.Id,
+			Name:           "preview-proj",
+			ProdBranch:     "main",
+		})
+		require.NoError(t, err)
+		prodDepl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+			ProjectID:         proj.ID,
+			Provisioner:       "static",
+			Branch:            "main",
+			RuntimeHost:       "http://localhost:9091",
+			RuntimeInstanceID: "prod-instance",
+			RuntimeAudience:   "http://localhost:8081",
+		})
+		require.NoError(t, err)
+		_, err = db.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
+			Name:             proj.Name,
+			ProdBranch:       proj.ProdBranch,
+			ProdVariables:    proj.ProdVariables,
+			ProdDeploymentID: &prodDepl.ID,
+			ProdSlots:        proj.ProdSlots,
+		})
+		require.NoError(t, err)
+		previewDepl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+			ProjectID:         proj.ID,
+			Provisioner:       "static",
+			Branch:            "feature",
+			RuntimeHost:       "http://localhost:9091",
+			RuntimeInstanceID: "preview-instance",
+			RuntimeAudience:   "http://localhost:8081",
+			Preview:           true,
+		})
+		require.NoError(t, err)
+
+		role, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+		require.NoError(t, err)
+		err = db.InsertProjectMemberUser(ctx, proj.ID, testUser.ID, role.ID)
+		require.NoError(t, err)
+
+		// viewers can't get deployment credentials, which are for embedding
+		_, err = testClient.GetDeploymentCredentials(ctx, &adminv1.GetDeploymentCredentialsRequest{Organization: org, Project: proj.Name, Branch: "feature"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// but they can get a JWT for the preview deployment through GetProject
+		res, err := testClient.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: org, Name: proj.Name, Branch: "feature"})
+		require.NoError(t, err)
+		require.Equal(t, previewDepl.ID, res.ProdDeployment.Id)
+		require.NotEmpty(t, res.Jwt)
+
+		// the production branch returns the production deployment
+		res, err = testClient.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: org, Name: proj.Name, Branch: "main"})
+		require.NoError(t, err)
+		require.Equal(t, prodDepl.ID, res.ProdDeployment.Id)
+
+		_, err = testClient.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: org, Name: proj.Name, Branch: "unknown"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
 type bearerTokenCredential struct {
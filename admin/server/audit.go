@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Actions recorded in the audit log
+const (
+	auditActionSetProjectMemberRole    = "project.member.set_role"
+	auditActionSetUsergroupRole        = "project.usergroup.set_role"
+	auditActionRemoveUsergroupRole     = "project.usergroup.remove_role"
+	auditActionCreateUsergroup         = "org.usergroup.create"
+	auditActionRenameUsergroup         = "org.usergroup.rename"
+	auditActionDeleteUsergroup         = "org.usergroup.delete"
+	auditActionAddUsergroupMember      = "org.usergroup.member.add"
+	auditActionRemoveUsergroupMember   = "org.usergroup.member.remove"
+	auditActionUpdateProjectVariables  = "project.variables.update"
+	auditActionDeleteProject           = "project.delete"
+	auditActionIssueServiceAuthToken   = "service.token.issue"
+	auditActionUpdateOrganizationQuota = "org.quotas.update"
+	auditActionUpdateOrganizationRole  = "org.role.update"
+	auditActionUpdateProjectRole       = "project.role.update"
+	// Org and project membership changes
+	auditActionAddOrganizationMember     = "org.member.add"
+	auditActionRemoveOrganizationMember  = "org.member.remove"
+	auditActionSetOrganizationMemberRole = "org.member.set_role"
+	auditActionAddProjectMember          = "project.member.add"
+	auditActionRemoveProjectMember       = "project.member.remove"
+	// Custom role changes
+	auditActionCreateOrganizationRole = "org.role.create"
+	auditActionDeleteOrganizationRole = "org.role.delete"
+	auditActionCreateProjectRole      = "project.role.create"
+	auditActionDeleteProjectRole      = "project.role.delete"
+	// Org membership changes made by an identity provider over SCIM
+	auditActionSCIMAddOrganizationMember    = "org.member.scim_add"
+	auditActionSCIMRemoveOrganizationMember = "org.member.scim_remove"
+)
+
+// ListAuditEvents implements AdminService.
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.actor", req.Actor),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read audit events")
+	}
+
+	token, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	opts := &database.FindAuditEventsOptions{
+		OrgID:       org.ID,
+		ProjectName: req.Project,
+		ActorName:   req.Actor,
+		AfterID:     token.Str,
+		Limit:       pageSize,
+	}
+	if token.Ts != nil {
+		opts.AfterCreatedOn = token.Ts.AsTime()
+	}
+	if req.StartTime != nil {
+		opts.StartTime = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		opts.EndTime = req.EndTime.AsTime()
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(events) >= pageSize {
+		e := events[len(events)-1]
+		nextToken = marshalStringTimestampPageToken(e.ID, e.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i], err = auditEventToPB(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// recordAuditEvent records an action performed by the caller in the audit log. The project is optional.
+// It should be called with the context of the transaction that performs the action, so the event is recorded if and only if the action is committed.
+// Actions that can't be performed in a transaction should be recorded after they succeed, and the request should fail if recording fails.
+func (s *Server) recordAuditEvent(ctx context.Context, orgID string, proj *database.Project, action string, metadata map[string]any) error {
+	claims := auth.GetClaims(ctx)
+	opts := &database.InsertAuditEventOptions{
+		OrgID:     orgID,
+		ActorType: string(claims.OwnerType()),
+		ActorID:   claims.OwnerID(),
+		Action:    action,
+		Metadata:  metadata,
+	}
+	if proj != nil {
+		opts.ProjectID = &proj.ID
+		opts.ProjectName = proj.Name
+	}
+
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err == nil {
+			opts.ActorName = user.Email
+		}
+	case auth.OwnerTypeService:
+		service, err := s.admin.DB.FindService(ctx, claims.OwnerID())
+		if err == nil {
+			opts.ActorName = service.Name
+		}
+	}
+
+	_, err := s.admin.DB.InsertAuditEvent(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// diffVariableNames returns the sorted names of the variables that were added, updated and removed between prev and next.
+func diffVariableNames(prev, next map[string]string) (added, updated, removed []string) {
+	added, updated, removed = []string{}, []string{}, []string{}
+	for k, v := range next {
+		old, ok := prev[k]
+		if !ok {
+			added = append(added, k)
+		} else if old != v {
+			updated = append(updated, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := next[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(updated)
+	sort.Strings(removed)
+	return added, updated, removed
+}
+
+func auditEventToPB(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	metadata, err := structpb.NewStruct(e.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID string
+	if e.ProjectID != nil {
+		projectID = *e.ProjectID
+	}
+
+	return &adminv1.AuditEvent{
+		Id:          e.ID,
+		OrgId:       e.OrgID,
+		ProjectId:   projectID,
+		ProjectName: e.ProjectName,
+		ActorType:   e.ActorType,
+		ActorId:     e.ActorID,
+		ActorName:   e.ActorName,
+		Action:      e.Action,
+		Metadata:    metadata,
+		CreatedOn:   timestamppb.New(e.CreatedOn),
+	}, nil
+}
@@ -30,6 +30,10 @@ type Claims interface {
 	Superuser(ctx context.Context) bool
 	OrganizationPermissions(ctx context.Context, orgID string) *adminv1.OrganizationPermissions
 	ProjectPermissions(ctx context.Context, orgID, projectID string) *adminv1.ProjectPermissions
+	// APINames returns the names of the custom APIs the claims are restricted to calling. If empty, the claims are not restricted.
+	APINames() []string
+	// SCIM returns true if the claims are restricted to SCIM provisioning.
+	SCIM() bool
 }
 
 // claimsContextKey is used to set and get Claims on a request context.
@@ -76,6 +80,14 @@ func (c anonClaims) ProjectPermissions(ctx context.Context, orgID, projectID str
 	return &adminv1.ProjectPermissions{}
 }
 
+func (c anonClaims) APINames() []string {
+	return nil
+}
+
+func (c anonClaims) SCIM() bool {
+	return false
+}
+
 // authTokenClaims represents claims for an admin.AuthToken.
 type authTokenClaims struct {
 	sync.Mutex
@@ -157,6 +169,24 @@ func (c *authTokenClaims) OrganizationPermissions(ctx context.Context, orgID str
 	defer c.Unlock()
 
 	perms, _ := c.organizationPermissionsUnsafe(ctx, orgID)
+
+	// Tokens restricted to custom APIs can only read the organization
+	if len(c.token.APINames()) > 0 {
+		return &adminv1.OrganizationPermissions{
+			ReadOrg:      perms.ReadOrg,
+			ReadProjects: perms.ReadProjects,
+		}
+	}
+
+	// SCIM tokens can only manage the organization's members
+	if c.token.SCIM() {
+		return &adminv1.OrganizationPermissions{
+			ReadOrg:          perms.ReadOrg,
+			ReadOrgMembers:   perms.ReadOrgMembers,
+			ManageOrgMembers: perms.ManageOrgMembers,
+		}
+	}
+
 	return perms
 }
 
@@ -169,6 +199,11 @@ func (c *authTokenClaims) ProjectPermissions(ctx context.Context, orgID, project
 		return perm
 	}
 
+	// SCIM tokens have no access to projects
+	if c.token.SCIM() {
+		return &adminv1.ProjectPermissions{}
+	}
+
 	orgPerms, ok := c.organizationPermissionsUnsafe(ctx, orgID)
 	if !ok {
 		return &adminv1.ProjectPermissions{}
@@ -192,10 +227,26 @@ func (c *authTokenClaims) ProjectPermissions(ctx context.Context, orgID, project
 		return &adminv1.ProjectPermissions{}
 	}
 
+	// Tokens restricted to custom APIs can only read the project and access its prod deployment
+	if len(c.token.APINames()) > 0 {
+		perm = &adminv1.ProjectPermissions{
+			ReadProject: perm.ReadProject,
+			ReadProd:    perm.ReadProd,
+		}
+	}
+
 	c.projectPermissionsCache[projectID] = perm
 	return perm
 }
 
+func (c *authTokenClaims) APINames() []string {
+	return c.token.APINames()
+}
+
+func (c *authTokenClaims) SCIM() bool {
+	return c.token.SCIM()
+}
+
 // organizationPermissionsUnsafe resolves organization permissions.
 // organizationPermissionsUnsafe accesses the cache without locking, so it should only be called from a function that already has a lock.
 func (c *authTokenClaims) organizationPermissionsUnsafe(ctx context.Context, orgID string) (*adminv1.OrganizationPermissions, bool) {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -117,21 +118,57 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
-	_, err := s.admin.TriggerRedeploy(ctx, proj, depl)
+	var err error
+	if depl != nil && depl.Preview {
+		_, err = s.admin.TriggerPreviewRedeploy(ctx, proj, depl)
+	} else {
+		_, err = s.admin.TriggerRedeploy(ctx, proj, depl)
+	}
 	if err != nil {
+		if errors.Is(err, admin.ErrQuotaExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return &adminv1.TriggerRedeployResponse{}, nil
 }
 
-// GetDeploymentCredentials returns runtime info and JWT on behalf of a specific user, or alternatively for a raw set of JWT attributes
-func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetDeploymentCredentialsRequest) (*adminv1.GetDeploymentCredentialsResponse, error) {
+func (s *Server) ListDeployments(ctx context.Context, req *adminv1.ListDeploymentsRequest) (*adminv1.ListDeploymentsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadProd && !permissions.ReadDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read deployments")
+	}
+
+	depls, err := s.admin.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Deployment, len(depls))
+	for i, d := range depls {
+		dtos[i] = deploymentToDTO(d)
+	}
+
+	return &adminv1.ListDeploymentsResponse{Deployments: dtos}, nil
+}
+
+func (s *Server) CreateDeployment(ctx context.Context, req *adminv1.CreateDeploymentRequest) (*adminv1.CreateDeploymentResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.organization", req.Organization),
 		attribute.String("args.project", req.Project),
 		attribute.String("args.branch", req.Branch),
-		attribute.String("args.ttl_seconds", strconv.FormatUint(uint64(req.TtlSeconds), 10)),
 	)
 
 	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
@@ -139,17 +176,83 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdDeploymentID == nil {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create preview deployments")
+	}
+
+	if req.Branch == proj.ProdBranch {
+		return nil, status.Error(codes.InvalidArgument, "cannot create a preview deployment of the prod branch")
+	}
+
+	_, err = s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, req.Branch)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a preview deployment for branch %q already exists", req.Branch)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	depl, err := s.admin.CreatePreviewDeployment(ctx, proj, req.Branch)
+	if err != nil {
+		if errors.Is(err, admin.ErrPreviewOLAPNotConfigured) || errors.Is(err, admin.ErrQuotaExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.CreateDeploymentResponse{Deployment: deploymentToDTO(depl)}, nil
+}
+
+func (s *Server) DeleteDeployment(ctx context.Context, req *adminv1.DeleteDeploymentRequest) (*adminv1.DeleteDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.deployment_id", req.DeploymentId),
+	)
+
+	depl, err := s.admin.DB.FindDeployment(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proj, err := s.admin.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete preview deployments")
+	}
+
+	if !depl.Preview {
+		return nil, status.Error(codes.InvalidArgument, "only preview deployments can be deleted")
 	}
 
-	prodDepl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	err = s.admin.TeardownPreviewDeployment(ctx, depl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteDeploymentResponse{}, nil
+}
+
+// GetDeploymentCredentials returns runtime info and JWT on behalf of a specific user, or alternatively for a raw set of JWT attributes
+func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetDeploymentCredentialsRequest) (*adminv1.GetDeploymentCredentialsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.branch", req.Branch),
+		attribute.String("args.ttl_seconds", strconv.FormatUint(uint64(req.TtlSeconds), 10)),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.Branch != "" && req.Branch != prodDepl.Branch {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+	depl, err := s.findDeploymentForBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	claims := auth.GetClaims(ctx)
@@ -160,6 +263,11 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
+	err = s.wakeHibernatedDeployment(proj, depl)
+	if err != nil {
+		return nil, err
+	}
+
 	var attr map[string]any
 	if req.For != nil {
 		switch forVal := req.For.(type) {
@@ -195,11 +303,11 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 
 	// Generate JWT
 	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
-		AudienceURL: prodDepl.RuntimeAudience,
+		AudienceURL: depl.RuntimeAudience,
 		Subject:     claims.OwnerID(),
 		TTL:         ttlDuration,
 		InstancePermissions: map[string][]runtimeauth.Permission{
-			prodDepl.RuntimeInstanceID: {
+			depl.RuntimeInstanceID: {
 				// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
 				runtimeauth.ReadObjects,
 				runtimeauth.ReadMetrics,
@@ -214,11 +322,11 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
 	}
 
-	s.admin.Used.Deployment(prodDepl.ID)
+	s.admin.Used.Deployment(depl.ID)
 
 	return &adminv1.GetDeploymentCredentialsResponse{
-		RuntimeHost: prodDepl.RuntimeHost,
-		InstanceId:  prodDepl.RuntimeInstanceID,
+		RuntimeHost: depl.RuntimeHost,
+		InstanceId:  depl.RuntimeInstanceID,
 		AccessToken: jwt,
 		TtlSeconds:  uint32(ttlDuration.Seconds()),
 	}, nil
@@ -244,17 +352,9 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdDeploymentID == nil {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
-	}
-
-	prodDepl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	depl, err := s.findDeploymentForBranch(ctx, proj, req.Branch)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	if req.Branch != "" && req.Branch != prodDepl.Branch {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+		return nil, err
 	}
 
 	claims := auth.GetClaims(ctx)
@@ -265,6 +365,11 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
+	err = s.wakeHibernatedDeployment(proj, depl)
+	if err != nil {
+		return nil, err
+	}
+
 	var attr map[string]any
 	if req.For != nil {
 		switch forVal := req.For.(type) {
@@ -300,11 +405,11 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 
 	// Generate JWT
 	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
-		AudienceURL: prodDepl.RuntimeAudience,
+		AudienceURL: depl.RuntimeAudience,
 		Subject:     claims.OwnerID(),
 		TTL:         ttlDuration,
 		InstancePermissions: map[string][]runtimeauth.Permission{
-			prodDepl.RuntimeInstanceID: {
+			depl.RuntimeInstanceID: {
 				// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
 				runtimeauth.ReadObjects,
 				runtimeauth.ReadMetrics,
@@ -319,11 +424,11 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
 	}
 
-	s.admin.Used.Deployment(prodDepl.ID)
+	s.admin.Used.Deployment(depl.ID)
 
 	iframeQuery := map[string]string{
-		"runtime_host": prodDepl.RuntimeHost,
-		"instance_id":  prodDepl.RuntimeInstanceID,
+		"runtime_host": depl.RuntimeHost,
+		"instance_id":  depl.RuntimeInstanceID,
 		"access_token": jwt,
 	}
 	if req.Kind == "" {
@@ -354,13 +459,51 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 
 	return &adminv1.GetIFrameResponse{
 		IframeSrc:   iFrameURL,
-		RuntimeHost: prodDepl.RuntimeHost,
-		InstanceId:  prodDepl.RuntimeInstanceID,
+		RuntimeHost: depl.RuntimeHost,
+		InstanceId:  depl.RuntimeInstanceID,
 		AccessToken: jwt,
 		TtlSeconds:  uint32(ttlDuration.Seconds()),
 	}, nil
 }
 
+// wakeHibernatedDeployment starts redeploying a hibernated preview deployment in the background and returns an error asking the client to retry.
+// It returns nil if the deployment is not hibernated. The caller must have checked that the user can manage the deployment.
+func (s *Server) wakeHibernatedDeployment(proj *database.Project, depl *database.Deployment) error {
+	if depl.Status != database.DeploymentStatusHibernated {
+		return nil
+	}
+
+	s.admin.WakePreviewDeploymentInBackground(proj, depl)
+	return status.Error(codes.Unavailable, "the preview deployment is hibernated and is being redeployed, try again in a few minutes")
+}
+
+// findDeploymentForBranch returns the deployment of the given branch of a project.
+// It returns the prod deployment if branch is empty or the prod branch, and otherwise the preview deployment of the branch.
+// Hibernated preview deployments are returned as-is, see wakeHibernatedDeployment.
+func (s *Server) findDeploymentForBranch(ctx context.Context, proj *database.Project, branch string) (*database.Deployment, error) {
+	if branch != "" && branch != proj.ProdBranch {
+		depl, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return depl, nil
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return depl, nil
+}
+
 // getAttributesFor returns a map of attributes for a given user and project.
 // The caller should only provide one of userID or userEmail (if both or neither are set, an error will be returned).
 // NOTE: The value returned from this function must be valid for structpb.NewStruct (e.g. must use []any for slices, not a more specific slice type).
@@ -309,11 +309,16 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org can at most have %d outstanding invitations", org.QuotaOutstandingInvites)
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = checkOrganizationRoleGrant(ctx, claims, org.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
 	var invitedByUserID, invitedByName string
 	if claims.OwnerType() == auth.OwnerTypeUser {
 		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
@@ -324,6 +329,12 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		invitedByName = user.DisplayName
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -344,6 +355,20 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		err = s.recordAuditEvent(ctx, org.ID, nil, auditActionAddOrganizationMember, map[string]any{
+			"email":  req.Email,
+			"role":   role.Name,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		// Send invitation email
 		err = s.admin.Email.SendOrganizationInvite(&email.OrganizationInvite{
 			ToEmail:       req.Email,
@@ -363,12 +388,6 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		}, nil
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	defer func() { _ = tx.Rollback() }()
-
 	err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -382,6 +401,14 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		// If the user is already in the all user group, we can ignore the error
 	}
 
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionAddOrganizationMember, map[string]any{
+		"email": req.Email,
+		"role":  role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -420,6 +447,12 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.PermissionDenied, "not allowed to remove org members")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -437,6 +470,19 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		err = s.recordAuditEvent(ctx, org.ID, nil, auditActionRemoveOrganizationMember, map[string]any{
+			"email":  req.Email,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 		return &adminv1.RemoveOrganizationMemberResponse{}, nil
 	}
 
@@ -456,18 +502,13 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	defer func() { _ = tx.Rollback() }()
 	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	// delete from all user groups, including the all user group
+	err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(ctx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -480,6 +521,14 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		}
 	}
 
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionRemoveOrganizationMember, map[string]any{
+		"email":              req.Email,
+		"keep_project_roles": req.KeepProjectRoles,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -504,11 +553,22 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		return nil, status.Error(codes.PermissionDenied, "not allowed to set org members role")
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = checkOrganizationRoleGrant(ctx, claims, org.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -526,6 +586,19 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		err = s.recordAuditEvent(ctx, org.ID, nil, auditActionSetOrganizationMemberRole, map[string]any{
+			"email":  req.Email,
+			"role":   role.Name,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 		return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 	}
 
@@ -551,6 +624,19 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionSetOrganizationMemberRole, map[string]any{
+		"email": req.Email,
+		"role":  role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
@@ -601,11 +687,19 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	// delete from all user groups, including the all user group
+	err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(ctx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionRemoveOrganizationMember, map[string]any{
+		"leave": true,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -652,7 +746,7 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		}
 	}
 
-	role, err := s.admin.DB.FindOrganizationRole(ctx, req.Role)
+	role, err := s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Role)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "role not found")
@@ -660,6 +754,11 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = checkOrganizationRoleGrant(ctx, claims, org.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
 	// find existing users belonging to the whitelisted domain to the org
 	users, err := s.admin.DB.FindUsersByEmailPattern(ctx, "%@"+req.Domain, "", math.MaxInt)
 	if err != nil {
@@ -823,11 +922,33 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		QuotaOutstandingInvites: int(valOrDefault(req.OutstandingInvites, uint32(org.QuotaOutstandingInvites))),
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	updatedOrg, err := s.admin.DB.UpdateOrganization(ctx, org.ID, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionUpdateOrganizationQuota, map[string]any{
+		"projects":             updatedOrg.QuotaProjects,
+		"deployments":          updatedOrg.QuotaDeployments,
+		"slots_total":          updatedOrg.QuotaSlotsTotal,
+		"slots_per_deployment": updatedOrg.QuotaSlotsPerDeployment,
+		"outstanding_invites":  updatedOrg.QuotaOutstandingInvites,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SudoUpdateOrganizationQuotasResponse{
 		Organization: organizationToDTO(updatedOrg),
 	}, nil
@@ -89,6 +89,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
 		attribute.String("args.project", req.Name),
+		attribute.String("args.branch", req.Branch),
 	)
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.OrganizationName)
@@ -123,22 +124,37 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
-	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
+	// Preview deployments for other branches are returned in place of the production deployment
+	preview := req.Branch != "" && req.Branch != proj.ProdBranch
+	if (!preview && proj.ProdDeploymentID == nil) || !permissions.ReadProd {
 		return &adminv1.GetProjectResponse{
 			Project:            s.projToDTO(proj, org.Name),
 			ProjectPermissions: permissions,
 		}, nil
 	}
 
-	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	depl, err := s.findDeploymentForBranch(ctx, proj, req.Branch)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	if !permissions.ReadProdStatus {
 		depl.StatusMessage = ""
 	}
 
+	// Hibernated preview deployments are redeployed in the background if the user can manage previews.
+	// Until then, the deployment is returned without a JWT.
+	if depl.Status == database.DeploymentStatusHibernated {
+		if permissions.ManageDev {
+			s.admin.WakePreviewDeploymentInBackground(proj, depl)
+		}
+		return &adminv1.GetProjectResponse{
+			Project:            s.projToDTO(proj, org.Name),
+			ProdDeployment:     deploymentToDTO(depl),
+			ProjectPermissions: permissions,
+		}, nil
+	}
+
 	var attr map[string]any
 	if claims.OwnerType() == auth.OwnerTypeUser {
 		attr, err = s.jwtAttributesForUser(ctx, claims.OwnerID(), proj.OrganizationID, permissions)
@@ -157,16 +173,10 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		Subject:     claims.OwnerID(),
 		TTL:         ttlDuration,
 		InstancePermissions: map[string][]runtimeauth.Permission{
-			depl.RuntimeInstanceID: {
-				// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
-				runtimeauth.ReadObjects,
-				runtimeauth.ReadMetrics,
-				runtimeauth.ReadProfiling,
-				runtimeauth.ReadRepo,
-				runtimeauth.ReadAPI,
-			},
+			depl.RuntimeInstanceID: runtimeInstancePermissions(claims, permissions),
 		},
 		Attributes: attr,
+		APINames:   claims.APINames(),
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
@@ -182,6 +192,26 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	}, nil
 }
 
+// runtimeInstancePermissions returns the instance permissions to grant in a runtime JWT issued for the given claims and project permissions.
+// Claims restricted to custom APIs are only granted access to the runtime's custom APIs.
+// Access to the project's files and profiling is only granted to roles with the read_dev permission.
+func runtimeInstancePermissions(claims auth.Claims, permissions *adminv1.ProjectPermissions) []runtimeauth.Permission {
+	if len(claims.APINames()) > 0 {
+		return []runtimeauth.Permission{runtimeauth.ReadAPI}
+	}
+
+	res := []runtimeauth.Permission{
+		runtimeauth.ReadObjects,
+		runtimeauth.ReadMetrics,
+		runtimeauth.ReadAPI,
+	}
+	if permissions.ReadDev {
+		// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
+		res = append(res, runtimeauth.ReadProfiling, runtimeauth.ReadRepo)
+	}
+	return res
+}
+
 func (s *Server) SearchProjectNames(ctx context.Context, req *adminv1.SearchProjectNamesRequest) (*adminv1.SearchProjectNamesResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.pattern", req.NamePattern),
@@ -348,6 +378,12 @@ func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Tearing down the project can't be rolled back, so the request fails if the audit event isn't recorded
+	err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionDeleteProject, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.DeleteProjectResponse{}, nil
 }
 
@@ -481,6 +517,9 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
+	// Only the names of the changed variables are recorded in the audit log since the values may be secrets
+	added, updated, removed := diffVariableNames(proj.ProdVariables, req.Variables)
+
 	proj, err = s.admin.UpdateProject(ctx, proj, &database.UpdateProjectOptions{
 		Name:                 proj.Name,
 		Description:          proj.Description,
@@ -500,6 +539,16 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
+	// Updating the deployments can't be rolled back, so the request fails if the audit event isn't recorded
+	err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionUpdateProjectVariables, map[string]any{
+		"added":   added,
+		"updated": updated,
+		"removed": removed,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
 }
 
@@ -620,11 +669,16 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q can at most have %d outstanding invitations", org.Name, org.QuotaOutstandingInvites)
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = checkProjectRoleGrant(ctx, claims, proj.OrganizationID, proj.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
 	var invitedByUserID, invitedByName string
 	if claims.OwnerType() == auth.OwnerTypeUser {
 		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
@@ -635,6 +689,12 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		invitedByName = user.DisplayName
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -652,6 +712,20 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionAddProjectMember, map[string]any{
+			"email":  req.Email,
+			"role":   role.Name,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		// Send invitation email
 		err = s.admin.Email.SendProjectInvite(&email.ProjectInvite{
 			ToEmail:       req.Email,
@@ -677,6 +751,19 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionAddProjectMember, map[string]any{
+		"email": req.Email,
+		"role":  role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = s.admin.Email.SendProjectAddition(&email.ProjectAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -711,6 +798,12 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		return nil, status.Error(codes.PermissionDenied, "not allowed to remove project members")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -728,6 +821,18 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionRemoveProjectMember, map[string]any{
+			"email":  req.Email,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 		return &adminv1.RemoveProjectMemberResponse{}, nil
 	}
 
@@ -736,6 +841,18 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionRemoveProjectMember, map[string]any{
+		"email": req.Email,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RemoveProjectMemberResponse{}, nil
 }
 
@@ -756,11 +873,22 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.PermissionDenied, "not allowed to set project member roles")
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = checkProjectRoleGrant(ctx, claims, proj.OrganizationID, proj.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotFound) {
@@ -778,6 +906,19 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionSetProjectMemberRole, map[string]any{
+			"email":  req.Email,
+			"role":   role.Name,
+			"invite": true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
@@ -786,6 +927,19 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, proj.OrganizationID, proj, auditActionSetProjectMemberRole, map[string]any{
+		"email": req.Email,
+		"role":  role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -907,6 +1061,8 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_OK
 	case database.DeploymentStatusError:
 		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_ERROR
+	case database.DeploymentStatusHibernated:
+		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_HIBERNATED
 	default:
 		panic(fmt.Errorf("unhandled deployment status %d", d.Status))
 	}
@@ -922,6 +1078,7 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		StatusMessage:     d.StatusMessage,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
+		Preview:           d.Preview,
 	}
 }
 
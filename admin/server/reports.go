@@ -457,6 +457,12 @@ func (s *Server) yamlForCommittedReport(opts *adminv1.ReportOptions) ([]byte, er
 		exportFormat = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		exportFormat = "xlsx"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		exportFormat = "arrow"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		exportFormat = "jsonl"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		exportFormat = "avro"
 	default:
 		exportFormat = opts.ExportFormat.String()
 	}
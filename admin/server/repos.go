@@ -37,8 +37,9 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project repo")
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkRepoBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
@@ -81,8 +82,9 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkRepoBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	permissions := auth.GetClaims(ctx).ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
@@ -120,6 +122,23 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 	}, nil
 }
 
+// checkRepoBranch returns an error unless branch is the prod branch of the project or has a preview deployment.
+func (s *Server) checkRepoBranch(ctx context.Context, proj *database.Project, branch string) error {
+	if proj.ProdBranch == branch {
+		return nil
+	}
+
+	_, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return status.Error(codes.InvalidArgument, "branch not found")
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
 func virtualFileToDTO(vf *database.VirtualFile) *adminv1.VirtualFile {
 	return &adminv1.VirtualFile{
 		Path:      vf.Path,
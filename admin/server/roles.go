@@ -0,0 +1,601 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (s *Server) ListOrganizationRoles(ctx context.Context, req *adminv1.ListOrganizationRolesRequest) (*adminv1.ListOrganizationRolesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read org roles")
+	}
+
+	roles, err := s.admin.DB.FindOrganizationRoles(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.OrganizationRole, len(roles))
+	for i, role := range roles {
+		dtos[i] = organizationRoleToPB(role)
+	}
+
+	return &adminv1.ListOrganizationRolesResponse{
+		Roles: dtos,
+	}, nil
+}
+
+func (s *Server) CreateOrganizationRole(ctx context.Context, req *adminv1.CreateOrganizationRoleRequest) (*adminv1.CreateOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to create org roles")
+	}
+
+	err = checkOrganizationPermissionsGrant(ctx, claims, org.ID, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unique index only covers roles within the org, so check for conflicts with built-in roles explicitly
+	_, err = s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a role named %q already exists", req.Name)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err := s.admin.DB.InsertOrganizationRole(ctx, &database.InsertOrganizationRoleOptions{
+		OrgID:            org.ID,
+		Name:             req.Name,
+		ReadOrg:          p.ReadOrg,
+		ManageOrg:        p.ManageOrg,
+		ReadProjects:     p.ReadProjects,
+		CreateProjects:   p.CreateProjects,
+		ManageProjects:   p.ManageProjects,
+		ReadOrgMembers:   p.ReadOrgMembers,
+		ManageOrgMembers: p.ManageOrgMembers,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dto := organizationRoleToPB(role)
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionCreateOrganizationRole, map[string]any{
+		"role":        role.Name,
+		"permissions": grantedPermissions(dto.Permissions),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateOrganizationRoleResponse{
+		Role: dto,
+	}, nil
+}
+
+func (s *Server) UpdateOrganizationRole(ctx context.Context, req *adminv1.UpdateOrganizationRoleRequest) (*adminv1.UpdateOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update org roles")
+	}
+
+	err = checkOrganizationPermissionsGrant(ctx, claims, org.ID, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update built-in role %q", role.Name)
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err = s.admin.DB.UpdateOrganizationRole(ctx, role.ID, &database.UpdateOrganizationRoleOptions{
+		ReadOrg:          p.ReadOrg,
+		ManageOrg:        p.ManageOrg,
+		ReadProjects:     p.ReadProjects,
+		CreateProjects:   p.CreateProjects,
+		ManageProjects:   p.ManageProjects,
+		ReadOrgMembers:   p.ReadOrgMembers,
+		ManageOrgMembers: p.ManageOrgMembers,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dto := organizationRoleToPB(role)
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionUpdateOrganizationRole, map[string]any{
+		"role":        role.Name,
+		"permissions": grantedPermissions(dto.Permissions),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateOrganizationRoleResponse{
+		Role: dto,
+	}, nil
+}
+
+func (s *Server) DeleteOrganizationRole(ctx context.Context, req *adminv1.DeleteOrganizationRoleRequest) (*adminv1.DeleteOrganizationRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org roles")
+	}
+
+	role, err := s.admin.DB.FindOrganizationRoleForOrganization(ctx, org.ID, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete built-in role %q", role.Name)
+	}
+
+	inUse, err := s.admin.DB.CheckOrganizationRoleIsInUse(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if inUse {
+		return nil, status.Errorf(codes.FailedPrecondition, "role %q is assigned to members, invites or whitelisted domains", role.Name)
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteOrganizationRole(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionDeleteOrganizationRole, map[string]any{
+		"role": role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteOrganizationRoleResponse{}, nil
+}
+
+func (s *Server) ListProjectRoles(ctx context.Context, req *adminv1.ListProjectRolesRequest) (*adminv1.ListProjectRolesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadProjects {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read project roles")
+	}
+
+	roles, err := s.admin.DB.FindProjectRoles(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.ProjectRole, len(roles))
+	for i, role := range roles {
+		dtos[i] = projectRoleToPB(role)
+	}
+
+	return &adminv1.ListProjectRolesResponse{
+		Roles: dtos,
+	}, nil
+}
+
+func (s *Server) CreateProjectRole(ctx context.Context, req *adminv1.CreateProjectRoleRequest) (*adminv1.CreateProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to create project roles")
+	}
+
+	err = checkProjectPermissionsGrant(ctx, claims, org.ID, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unique index only covers roles within the org, so check for conflicts with built-in roles explicitly
+	_, err = s.admin.DB.FindProjectRoleForOrganization(ctx, org.ID, req.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a role named %q already exists", req.Name)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err := s.admin.DB.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{
+		OrgID:                org.ID,
+		Name:                 req.Name,
+		ReadProject:          p.ReadProject,
+		ManageProject:        p.ManageProject,
+		ReadProd:             p.ReadProd,
+		ReadProdStatus:       p.ReadProdStatus,
+		ManageProd:           p.ManageProd,
+		ReadDev:              p.ReadDev,
+		ReadDevStatus:        p.ReadDevStatus,
+		ManageDev:            p.ManageDev,
+		ReadProjectMembers:   p.ReadProjectMembers,
+		ManageProjectMembers: p.ManageProjectMembers,
+		CreateReports:        p.CreateReports,
+		ManageReports:        p.ManageReports,
+		CreateAlerts:         p.CreateAlerts,
+		ManageAlerts:         p.ManageAlerts,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dto := projectRoleToPB(role)
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionCreateProjectRole, map[string]any{
+		"role":        role.Name,
+		"permissions": grantedPermissions(dto.Permissions),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateProjectRoleResponse{
+		Role: dto,
+	}, nil
+}
+
+func (s *Server) UpdateProjectRole(ctx context.Context, req *adminv1.UpdateProjectRoleRequest) (*adminv1.UpdateProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update project roles")
+	}
+
+	err = checkProjectPermissionsGrant(ctx, claims, org.ID, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, org.ID, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update built-in role %q", role.Name)
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p := req.Permissions
+	role, err = s.admin.DB.UpdateProjectRole(ctx, role.ID, &database.UpdateProjectRoleOptions{
+		ReadProject:          p.ReadProject,
+		ManageProject:        p.ManageProject,
+		ReadProd:             p.ReadProd,
+		ReadProdStatus:       p.ReadProdStatus,
+		ManageProd:           p.ManageProd,
+		ReadDev:              p.ReadDev,
+		ReadDevStatus:        p.ReadDevStatus,
+		ManageDev:            p.ManageDev,
+		ReadProjectMembers:   p.ReadProjectMembers,
+		ManageProjectMembers: p.ManageProjectMembers,
+		CreateReports:        p.CreateReports,
+		ManageReports:        p.ManageReports,
+		CreateAlerts:         p.CreateAlerts,
+		ManageAlerts:         p.ManageAlerts,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dto := projectRoleToPB(role)
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionUpdateProjectRole, map[string]any{
+		"role":        role.Name,
+		"permissions": grantedPermissions(dto.Permissions),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateProjectRoleResponse{
+		Role: dto,
+	}, nil
+}
+
+func (s *Server) DeleteProjectRole(ctx context.Context, req *adminv1.DeleteProjectRoleRequest) (*adminv1.DeleteProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete project roles")
+	}
+
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, org.ID, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete built-in role %q", role.Name)
+	}
+
+	inUse, err := s.admin.DB.CheckProjectRoleIsInUse(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if inUse {
+		return nil, status.Errorf(codes.FailedPrecondition, "role %q is assigned to members, user groups or invites", role.Name)
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteProjectRole(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionDeleteProjectRole, map[string]any{
+		"role": role.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteProjectRoleResponse{}, nil
+}
+
+// checkOrganizationRoleGrant returns an error if the role grants an org permission that the caller does not have.
+// It prevents members who can manage other members from escalating their own or others' permissions.
+func checkOrganizationRoleGrant(ctx context.Context, claims auth.Claims, orgID string, role *database.OrganizationRole) error {
+	if claims.Superuser(ctx) {
+		return nil
+	}
+	if !permissionsSubset(organizationRoleToPB(role).Permissions, claims.OrganizationPermissions(ctx, orgID)) {
+		return status.Errorf(codes.PermissionDenied, "not allowed to grant role %q since it has permissions you do not have", role.Name)
+	}
+	return nil
+}
+
+// checkProjectRoleGrant returns an error if the role grants a project permission that the caller does not have.
+// It prevents members who can manage other members from escalating their own or others' permissions.
+func checkProjectRoleGrant(ctx context.Context, claims auth.Claims, orgID, projectID string, role *database.ProjectRole) error {
+	if claims.Superuser(ctx) {
+		return nil
+	}
+	if !permissionsSubset(projectRoleToPB(role).Permissions, claims.ProjectPermissions(ctx, orgID, projectID)) {
+		return status.Errorf(codes.PermissionDenied, "not allowed to grant role %q since it has permissions you do not have", role.Name)
+	}
+	return nil
+}
+
+// checkOrganizationPermissionsGrant returns an error if the permissions of a custom org role include a permission that the caller does not have.
+// It prevents members who can manage roles from escalating their own permissions by editing their role.
+func checkOrganizationPermissionsGrant(ctx context.Context, claims auth.Claims, orgID string, perms *adminv1.OrganizationPermissions) error {
+	if claims.Superuser(ctx) {
+		return nil
+	}
+	if !permissionsSubset(perms, claims.OrganizationPermissions(ctx, orgID)) {
+		return status.Error(codes.PermissionDenied, "not allowed to grant permissions you do not have")
+	}
+	return nil
+}
+
+// checkProjectPermissionsGrant returns an error if the permissions of a custom project role include a permission that the caller does not have.
+// Since a project role can be assigned on any project in the org, the caller must have the permissions on all projects,
+// which is only the case for callers with the ManageProjects permission on the org.
+func checkProjectPermissionsGrant(ctx context.Context, claims auth.Claims, orgID string, perms *adminv1.ProjectPermissions) error {
+	if claims.Superuser(ctx) || claims.OrganizationPermissions(ctx, orgID).ManageProjects {
+		return nil
+	}
+	if !permissionsSubset(perms, &adminv1.ProjectPermissions{}) {
+		return status.Error(codes.PermissionDenied, "not allowed to grant project permissions without the permission to manage all projects")
+	}
+	return nil
+}
+
+// permissionsSubset returns true if every permission set in granted is also set in held.
+// Both must be permission messages of the same type with only bool fields.
+func permissionsSubset(granted, held proto.Message) bool {
+	heldRef := held.ProtoReflect()
+	ok := true
+	granted.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if v.Bool() && !heldRef.Get(fd).Bool() {
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// grantedPermissions returns the names of the permissions set in a permissions message.
+// It returns a []any so the result can be used in audit event metadata.
+func grantedPermissions(p proto.Message) []any {
+	res := []any{}
+	p.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if v.Bool() {
+			res = append(res, string(fd.Name()))
+		}
+		return true
+	})
+	return res
+}
+
+func organizationRoleToPB(r *database.OrganizationRole) *adminv1.OrganizationRole {
+	return &adminv1.OrganizationRole{
+		Id:      r.ID,
+		Name:    r.Name,
+		Builtin: r.OrgID == nil,
+		Permissions: &adminv1.OrganizationPermissions{
+			ReadOrg:          r.ReadOrg,
+			ManageOrg:        r.ManageOrg,
+			ReadProjects:     r.ReadProjects,
+			CreateProjects:   r.CreateProjects,
+			ManageProjects:   r.ManageProjects,
+			ReadOrgMembers:   r.ReadOrgMembers,
+			ManageOrgMembers: r.ManageOrgMembers,
+		},
+	}
+}
+
+func projectRoleToPB(r *database.ProjectRole) *adminv1.ProjectRole {
+	return &adminv1.ProjectRole{
+		Id:      r.ID,
+		Name:    r.Name,
+		Builtin: r.OrgID == nil,
+		Permissions: &adminv1.ProjectPermissions{
+			ReadProject:          r.ReadProject,
+			ManageProject:        r.ManageProject,
+			ReadProd:             r.ReadProd,
+			ReadProdStatus:       r.ReadProdStatus,
+			ManageProd:           r.ManageProd,
+			ReadDev:              r.ReadDev,
+			ReadDevStatus:        r.ReadDevStatus,
+			ManageDev:            r.ManageDev,
+			ReadProjectMembers:   r.ReadProjectMembers,
+			ManageProjectMembers: r.ManageProjectMembers,
+			CreateReports:        r.CreateReports,
+			ManageReports:        r.ManageReports,
+			CreateAlerts:         r.CreateAlerts,
+			ManageAlerts:         r.ManageAlerts,
+		},
+	}
+}
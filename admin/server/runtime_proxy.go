@@ -71,16 +71,10 @@ func (s *Server) runtimeProxyForOrgAndProject(w http.ResponseWriter, r *http.Req
 			Subject:     claims.OwnerID(),
 			TTL:         runtimeAccessTokenDefaultTTL,
 			InstancePermissions: map[string][]runtimeauth.Permission{
-				depl.RuntimeInstanceID: {
-					// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
-					runtimeauth.ReadObjects,
-					runtimeauth.ReadMetrics,
-					runtimeauth.ReadProfiling,
-					runtimeauth.ReadRepo,
-					runtimeauth.ReadAPI,
-				},
+				depl.RuntimeInstanceID: runtimeInstancePermissions(claims, permissions),
 			},
 			Attributes: attr,
+			APINames:   claims.APINames(),
 		})
 		if err != nil {
 			return httputil.Error(http.StatusInternalServerError, err)
@@ -0,0 +1,1257 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+)
+
+// SCIM 2.0 schema URNs (see RFC 7643 and RFC 7644)
+const (
+	scimSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+const (
+	scimContentType      = "application/scim+json"
+	scimDefaultPageSize  = 100
+	scimMaxPageSize      = 1000
+	scimDBPageSize       = 1000
+	scimMaxRequestLength = 1 << 20
+	// Group names must be slugs of this length (see the slug validator in the database package)
+	scimMinGroupNameLength = 3
+	scimMaxGroupNameLength = 40
+)
+
+var (
+	// scimFilterRegexp matches the only filter form we support, which is what identity providers use to look up existing resources.
+	scimFilterRegexp = regexp.MustCompile(`(?i)^\s*(\w+)\s+eq\s+"([^"]*)"\s*$`)
+	// scimMemberPathRegexp matches a path that selects a single group member, e.g. `members[value eq "ID"]`.
+	scimMemberPathRegexp = regexp.MustCompile(`(?i)^\s*members\s*\[\s*value\s+eq\s+"([^"]*)"\s*\]\s*$`)
+)
+
+// registerSCIMEndpoints registers the non-gRPC endpoints for SCIM 2.0 provisioning.
+// The endpoints are authenticated with a service token issued for SCIM. They operate on the org the service belongs to.
+// Users map to org members (provisioned with the viewer role) and groups map to user groups.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	observability.MuxHandle(inner, "GET /scim/v2/Users", s.scimHandler(s.scimListUsers))
+	observability.MuxHandle(inner, "POST /scim/v2/Users", s.scimHandler(s.scimCreateUser))
+	observability.MuxHandle(inner, "GET /scim/v2/Users/{id}", s.scimHandler(s.scimGetUser))
+	observability.MuxHandle(inner, "PUT /scim/v2/Users/{id}", s.scimHandler(s.scimReplaceUser))
+	observability.MuxHandle(inner, "PATCH /scim/v2/Users/{id}", s.scimHandler(s.scimPatchUser))
+	observability.MuxHandle(inner, "DELETE /scim/v2/Users/{id}", s.scimHandler(s.scimDeleteUser))
+	observability.MuxHandle(inner, "GET /scim/v2/Groups", s.scimHandler(s.scimListGroups))
+	observability.MuxHandle(inner, "POST /scim/v2/Groups", s.scimHandler(s.scimCreateGroup))
+	observability.MuxHandle(inner, "GET /scim/v2/Groups/{id}", s.scimHandler(s.scimGetGroup))
+	observability.MuxHandle(inner, "PUT /scim/v2/Groups/{id}", s.scimHandler(s.scimReplaceGroup))
+	observability.MuxHandle(inner, "PATCH /scim/v2/Groups/{id}", s.scimHandler(s.scimPatchGroup))
+	observability.MuxHandle(inner, "DELETE /scim/v2/Groups/{id}", s.scimHandler(s.scimDeleteGroup))
+	mux.Handle("/scim/", observability.Middleware("admin", s.logger, s.authenticator.HTTPMiddleware(inner)))
+}
+
+// scimHandlerFunc is a SCIM endpoint handler. It is called with the org that the request's service token belongs to.
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, org *database.Organization) error
+
+// scimHandler wraps a scimHandlerFunc with authentication and SCIM error handling.
+func (s *Server) scimHandler(fn scimHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		org, err := s.scimOrganization(r.Context())
+		if err == nil {
+			err = fn(w, r, org)
+		}
+		if err != nil {
+			writeSCIMError(w, err)
+		}
+	})
+}
+
+// scimOrganization returns the org of the service that authenticated the request.
+func (s *Server) scimOrganization(ctx context.Context) (*database.Organization, error) {
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeService {
+		return nil, httputil.Errorf(http.StatusUnauthorized, "SCIM endpoints require a service token")
+	}
+	if !claims.SCIM() {
+		return nil, httputil.Errorf(http.StatusForbidden, "SCIM endpoints require a service token issued with the SCIM scope")
+	}
+
+	service, err := s.admin.DB.FindService(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.OrganizationPermissions(ctx, service.OrgID).ManageOrgMembers {
+		return nil, httputil.Errorf(http.StatusForbidden, "not allowed to manage org members")
+	}
+
+	return s.admin.DB.FindOrganization(ctx, service.OrgID)
+}
+
+// scimListUsers implements GET /scim/v2/Users.
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	startIndex, count, err := parseSCIMPagination(r)
+	if err != nil {
+		return err
+	}
+
+	var userName string
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		attr, val, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(attr, "userName") {
+			return scimErrorf(http.StatusBadRequest, "invalidFilter", "filtering on %q is not supported", attr)
+		}
+		userName = val
+	}
+
+	ctx := r.Context()
+	total, err := s.admin.DB.CountSCIMUsers(ctx, org.ID, userName)
+	if err != nil {
+		return err
+	}
+
+	var users []*database.SCIMUser
+	if count > 0 {
+		users, err = s.admin.DB.FindSCIMUsers(ctx, org.ID, userName, startIndex-1, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources := make([]any, len(users))
+	for i, u := range users {
+		resources[i] = scimUserFromSCIMUser(u)
+	}
+
+	return writeSCIM(w, http.StatusOK, newSCIMListResponse(total, startIndex, resources))
+}
+
+// scimCreateUser implements POST /scim/v2/Users.
+// It creates the user if it doesn't exist and adds it to the org.
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimUserRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	email, err := req.email()
+	if err != nil {
+		return err
+	}
+
+	ctx := r.Context()
+	created := false
+	user, err := s.admin.DB.FindUserByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		// Note that creating the user also accepts its pending invites, which may add it to the org
+		user, err = s.admin.CreateOrUpdateUser(ctx, email, req.displayName(), "")
+		if err != nil {
+			return err
+		}
+		created = true
+	}
+
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return err
+	}
+	if isMember && !created {
+		return scimErrorf(http.StatusConflict, "uniqueness", "user %q is already a member of the org", email)
+	}
+
+	active := req.Active == nil || *req.Active
+	if active && !isMember {
+		err = s.scimAddOrganizationMember(ctx, org, user)
+	} else if !active {
+		err = s.scimRemoveOrganizationMember(ctx, org, user, isMember, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusCreated, scimUserFromUser(user, active))
+}
+
+// scimGetUser implements GET /scim/v2/Users/{id}.
+// Users deactivated over SCIM are returned with active set to false.
+func (s *Server) scimGetUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	user, err := s.scimFindUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, scimUserFromSCIMUser(user))
+}
+
+// scimReplaceUser implements PUT /scim/v2/Users/{id}.
+// Users are shared across orgs, so only the "active" attribute is applied. Deactivating a user removes it from the org.
+func (s *Server) scimReplaceUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimUserRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	active := req.Active == nil || *req.Active
+	user, err := s.scimSetUserActive(r.Context(), org, r.PathValue("id"), active)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, scimUserFromUser(user, active))
+}
+
+// scimPatchUser implements PATCH /scim/v2/Users/{id}.
+// Like scimReplaceUser, it only applies changes to the "active" attribute.
+func (s *Server) scimPatchUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimPatchRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	var active *bool
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		default:
+			continue
+		}
+
+		var val json.RawMessage
+		if op.Path == "" {
+			var obj map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &obj); err != nil {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", err)
+			}
+			for k, v := range obj {
+				if strings.EqualFold(k, "active") {
+					val = v
+				}
+			}
+		} else if strings.EqualFold(op.Path, "active") {
+			val = op.Value
+		}
+		if val == nil {
+			continue
+		}
+
+		b, err := parseSCIMBool(val)
+		if err != nil {
+			return err
+		}
+		active = &b
+	}
+
+	// There's nothing to change, so just return the user
+	if active == nil {
+		return s.scimGetUser(w, r, org)
+	}
+
+	user, err := s.scimSetUserActive(r.Context(), org, r.PathValue("id"), *active)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, scimUserFromUser(user, *active))
+}
+
+// scimDeleteUser implements DELETE /scim/v2/Users/{id}.
+// It removes the user from the org. The user itself is not deleted since it may be a member of other orgs.
+func (s *Server) scimDeleteUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+	user, err := s.scimFindUser(ctx, org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	err = s.scimRemoveOrganizationMember(ctx, org, scimUserAsUser(user), user.Active, false)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimListGroups implements GET /scim/v2/Groups.
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	startIndex, count, err := parseSCIMPagination(r)
+	if err != nil {
+		return err
+	}
+
+	var displayName string
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		attr, val, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(attr, "displayName") {
+			return scimErrorf(http.StatusBadRequest, "invalidFilter", "filtering on %q is not supported", attr)
+		}
+		displayName = val
+	}
+
+	ctx := r.Context()
+	var groups []*database.Usergroup
+	afterName := ""
+	for {
+		page, err := s.admin.DB.FindUsergroupsForOrganization(ctx, org.ID, afterName, scimDBPageSize)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, page...)
+		if len(page) < scimDBPageSize {
+			break
+		}
+		afterName = page[len(page)-1].Name
+	}
+
+	var matched []*database.Usergroup
+	for _, g := range groups {
+		if isAllUsergroup(org, g) {
+			continue
+		}
+		if displayName == "" || strings.EqualFold(scimGroupDisplayName(g), displayName) {
+			matched = append(matched, g)
+		}
+	}
+
+	// Only load members for the groups on the requested page
+	page := pageSCIM(matched, startIndex, count)
+	resources := make([]any, len(page))
+	for i, g := range page {
+		resources[i], err = s.scimGroup(ctx, g)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeSCIM(w, http.StatusOK, newSCIMListResponse(len(matched), startIndex, resources))
+}
+
+// scimCreateGroup implements POST /scim/v2/Groups.
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimGroupRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	if req.DisplayName == "" {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "group displayName is required")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	name, err := s.scimUsergroupName(ctx, org, req.DisplayName, "")
+	if err != nil {
+		return err
+	}
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{
+		OrgID:       org.ID,
+		Name:        name,
+		DisplayName: req.DisplayName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range req.Members {
+		err = s.scimAddUsergroupMember(ctx, org, group, m.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(r.Context(), group)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusCreated, res)
+}
+
+// scimGetGroup implements GET /scim/v2/Groups/{id}.
+func (s *Server) scimGetGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.scimFindUsergroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(r.Context(), group)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+// scimReplaceGroup implements PUT /scim/v2/Groups/{id}.
+func (s *Server) scimReplaceGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimGroupRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.scimFindUsergroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err = s.scimRenameUsergroup(ctx, org, group, req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	err = s.scimReplaceUsergroupMembers(ctx, org, group, req.Members)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(r.Context(), group)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+// scimPatchGroup implements PATCH /scim/v2/Groups/{id}.
+// It supports renaming the group and adding, removing and replacing its members.
+func (s *Server) scimPatchGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	var req scimPatchRequest
+	err := decodeSCIMRequest(r, &req)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.scimFindUsergroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, op := range req.Operations {
+		group, err = s.scimApplyGroupPatch(ctx, org, group, op)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(r.Context(), group)
+	if err != nil {
+		return err
+	}
+
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+// scimDeleteGroup implements DELETE /scim/v2/Groups/{id}.
+func (s *Server) scimDeleteGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.scimFindUsergroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteUsergroup(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimApplyGroupPatch applies a single PATCH operation to a group.
+func (s *Server) scimApplyGroupPatch(ctx context.Context, org *database.Organization, group *database.Usergroup, op scimPatchOp) (*database.Usergroup, error) {
+	opName := strings.ToLower(op.Op)
+
+	// A remove operation may select a single member in the path
+	if match := scimMemberPathRegexp.FindStringSubmatch(op.Path); match != nil {
+		if opName != "remove" {
+			return nil, scimErrorf(http.StatusBadRequest, "invalidPath", "unsupported patch operation %q on path %q", op.Op, op.Path)
+		}
+		return group, s.scimRemoveUsergroupMember(ctx, group, match[1])
+	}
+
+	// Without a path, the value is an object of attributes to add or replace
+	if op.Path == "" {
+		if opName != "add" && opName != "replace" {
+			return nil, scimErrorf(http.StatusBadRequest, "noTarget", "patch operation %q requires a path", op.Op)
+		}
+		var req scimGroupPatchValue
+		if err := json.Unmarshal(op.Value, &req); err != nil {
+			return nil, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", err)
+		}
+		if req.DisplayName != nil {
+			var err error
+			group, err = s.scimRenameUsergroup(ctx, org, group, *req.DisplayName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if req.Members != nil {
+			if opName == "replace" {
+				return group, s.scimReplaceUsergroupMembers(ctx, org, group, *req.Members)
+			}
+			for _, m := range *req.Members {
+				if err := s.scimAddUsergroupMember(ctx, org, group, m.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return group, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(op.Path)) {
+	case "displayname":
+		if opName != "add" && opName != "replace" {
+			return nil, scimErrorf(http.StatusBadRequest, "mutability", "the group name can't be removed")
+		}
+		var name string
+		if err := json.Unmarshal(op.Value, &name); err != nil {
+			return nil, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid group name: %s", err)
+		}
+		return s.scimRenameUsergroup(ctx, org, group, name)
+	case "members":
+		var members []scimMember
+		if len(op.Value) > 0 {
+			if err := json.Unmarshal(op.Value, &members); err != nil {
+				return nil, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid members: %s", err)
+			}
+		}
+		switch opName {
+		case "add":
+			for _, m := range members {
+				if err := s.scimAddUsergroupMember(ctx, org, group, m.Value); err != nil {
+					return nil, err
+				}
+			}
+			return group, nil
+		case "remove":
+			// Removing without a value removes all members
+			if len(op.Value) == 0 {
+				return group, s.scimReplaceUsergroupMembers(ctx, org, group, nil)
+			}
+			for _, m := range members {
+				if err := s.scimRemoveUsergroupMember(ctx, group, m.Value); err != nil {
+					return nil, err
+				}
+			}
+			return group, nil
+		case "replace":
+			return group, s.scimReplaceUsergroupMembers(ctx, org, group, members)
+		}
+	}
+
+	return nil, scimErrorf(http.StatusBadRequest, "invalidPath", "unsupported patch operation %q on path %q", op.Op, op.Path)
+}
+
+// scimFindUser finds a user by ID that is a member of the org or was deactivated in the org over SCIM.
+func (s *Server) scimFindUser(ctx context.Context, org *database.Organization, id string) (*database.SCIMUser, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, scimErrorf(http.StatusNotFound, "", "user %q not found", id)
+	}
+
+	user, err := s.admin.DB.FindSCIMUser(ctx, org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, scimErrorf(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// scimSetUserActive adds the user to the org if active is true, and otherwise deactivates it by removing it from the org.
+func (s *Server) scimSetUserActive(ctx context.Context, org *database.Organization, id string, active bool) (*database.User, error) {
+	scimUser, err := s.scimFindUser(ctx, org, id)
+	if err != nil {
+		return nil, err
+	}
+
+	user := scimUserAsUser(scimUser)
+	if active && !scimUser.Active {
+		err = s.scimAddOrganizationMember(ctx, org, user)
+	} else if !active && scimUser.Active {
+		err = s.scimRemoveOrganizationMember(ctx, org, user, true, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// scimAddOrganizationMember adds a user to the org with the viewer role.
+func (s *Server) scimAddOrganizationMember(ctx context.Context, org *database.Organization, user *database.User) error {
+	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	if err != nil {
+		return err
+	}
+
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
+	if err != nil && !errors.Is(err, database.ErrNotUnique) {
+		return err
+	}
+
+	err = s.admin.DB.DeleteSCIMDeactivatedUser(txCtx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordAuditEvent(txCtx, org.ID, nil, auditActionSCIMAddOrganizationMember, map[string]any{
+		"email": user.Email,
+		"role":  role.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// scimRemoveOrganizationMember removes a user from the org, its user groups and its projects if isMember is true.
+// If deactivate is true, the user is recorded as deactivated so it can still be looked up over SCIM. Otherwise, any such record is deleted.
+func (s *Server) scimRemoveOrganizationMember(ctx context.Context, org *database.Organization, user *database.User, isMember, deactivate bool) error {
+	if isMember {
+		role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+		if err != nil {
+			return err
+		}
+
+		admins, err := s.admin.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+		if err != nil {
+			return err
+		}
+		if len(admins) == 1 && admins[0].ID == user.ID {
+			return scimErrorf(http.StatusBadRequest, "mutability", "cannot remove the last owner")
+		}
+	}
+
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if isMember {
+		err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(txCtx, org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(txCtx, org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordAuditEvent(txCtx, org.ID, nil, auditActionSCIMRemoveOrganizationMember, map[string]any{
+			"email": user.Email,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if deactivate {
+		err = s.admin.DB.InsertSCIMDeactivatedUser(txCtx, org.ID, user.ID)
+	} else {
+		err = s.admin.DB.DeleteSCIMDeactivatedUser(txCtx, org.ID, user.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// scimFindUsergroup finds a user group by ID and checks that it belongs to the org.
+// The all-users group is managed by Rill, so it is not exposed over SCIM.
+func (s *Server) scimFindUsergroup(ctx context.Context, org *database.Organization, id string) (*database.Usergroup, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, scimErrorf(http.StatusNotFound, "", "group %q not found", id)
+	}
+
+	group, err := s.admin.DB.FindUsergroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group.OrgID != org.ID || isAllUsergroup(org, group) {
+		return nil, scimErrorf(http.StatusNotFound, "", "group %q not found", id)
+	}
+
+	return group, nil
+}
+
+// scimRenameUsergroup renames a group if its display name has changed.
+func (s *Server) scimRenameUsergroup(ctx context.Context, org *database.Organization, group *database.Usergroup, displayName string) (*database.Usergroup, error) {
+	if displayName == "" || displayName == scimGroupDisplayName(group) {
+		return group, nil
+	}
+
+	name, err := s.scimUsergroupName(ctx, org, displayName, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.admin.DB.UpdateUsergroup(ctx, group.ID, &database.UpdateUsergroupOptions{
+		Name:        name,
+		DisplayName: displayName,
+	})
+}
+
+// scimUsergroupName derives a group name from a SCIM display name.
+// Group names must be slugs, but identity providers allow any display name (such as "Data Team" or "HR").
+// If the name is taken by another group in the org, a numeric suffix is added. groupID is the group being renamed, if any.
+func (s *Server) scimUsergroupName(ctx context.Context, org *database.Organization, displayName, groupID string) (string, error) {
+	base := slugifySCIMDisplayName(displayName)
+	for i := 1; ; i++ {
+		name := base
+		if i > 1 {
+			suffix := "-" + strconv.Itoa(i)
+			name = strings.TrimRight(truncateString(base, scimMaxGroupNameLength-len(suffix)), "-") + suffix
+		}
+
+		g, err := s.admin.DB.FindUsergroupByName(ctx, org.ID, name)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return name, nil
+			}
+			return "", err
+		}
+		if g.ID == groupID {
+			return name, nil
+		}
+	}
+}
+
+// scimAddUsergroupMember adds an org member to a group. It's a no-op if the user is already in the group.
+func (s *Server) scimAddUsergroupMember(ctx context.Context, org *database.Organization, group *database.Usergroup, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "user %q not found", userID)
+	}
+
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, userID, org.ID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "user %q is not a member of the org", userID)
+	}
+
+	err = s.admin.DB.InsertUsergroupMember(ctx, group.ID, userID)
+	if err != nil && !errors.Is(err, database.ErrNotUnique) {
+		return err
+	}
+	return nil
+}
+
+// scimRemoveUsergroupMember removes a user from a group. It's a no-op if the user is not in the group.
+func (s *Server) scimRemoveUsergroupMember(ctx context.Context, group *database.Usergroup, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil
+	}
+
+	err := s.admin.DB.DeleteUsergroupMember(ctx, group.ID, userID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// scimReplaceUsergroupMembers sets the members of a group to exactly the provided members.
+func (s *Server) scimReplaceUsergroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, members []scimMember) error {
+	current, err := s.scimUsergroupMembers(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(members))
+	for _, m := range members {
+		keep[m.Value] = true
+	}
+
+	existing := make(map[string]bool, len(current))
+	for _, m := range current {
+		existing[m.ID] = true
+		if !keep[m.ID] {
+			err = s.scimRemoveUsergroupMember(ctx, group, m.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, m := range members {
+		if existing[m.Value] {
+			continue
+		}
+		err = s.scimAddUsergroupMember(ctx, org, group, m.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scimUsergroupMembers returns all members of a group.
+func (s *Server) scimUsergroupMembers(ctx context.Context, groupID string) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	afterEmail := ""
+	for {
+		page, err := s.admin.DB.FindUsergroupMemberUsers(ctx, groupID, afterEmail, scimDBPageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page...)
+		if len(page) < scimDBPageSize {
+			return res, nil
+		}
+		afterEmail = page[len(page)-1].Email
+	}
+}
+
+// scimGroup builds the SCIM representation of a group, including its members.
+func (s *Server) scimGroup(ctx context.Context, group *database.Usergroup) (*scimGroup, error) {
+	members, err := s.scimUsergroupMembers(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          group.ID,
+		DisplayName: scimGroupDisplayName(group),
+		Members:     make([]scimMember, len(members)),
+		Meta:        &scimMeta{ResourceType: "Group"},
+	}
+	for i, m := range members {
+		res.Members[i] = scimMember{Value: m.ID, Display: m.Email}
+	}
+
+	return res, nil
+}
+
+// scimGroupDisplayName returns the SCIM display name of a group.
+// Groups that were not provisioned over SCIM use their name.
+func scimGroupDisplayName(group *database.Usergroup) string {
+	if group.DisplayName != "" {
+		return group.DisplayName
+	}
+	return group.Name
+}
+
+// slugifySCIMDisplayName converts a display name to a valid group name.
+// It lowercases the name, replaces other characters than letters, digits and underscores with dashes, and pads or truncates it to a valid length.
+func slugifySCIMDisplayName(displayName string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(displayName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	name := strings.TrimRight(truncateString(b.String(), scimMaxGroupNameLength), "-")
+	if name == "" {
+		return "group"
+	}
+	if len(name) < scimMinGroupNameLength {
+		return name + "-group"
+	}
+	return name
+}
+
+func truncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func isAllUsergroup(org *database.Organization, group *database.Usergroup) bool {
+	return org.AllUsergroupID != nil && *org.AllUsergroupID == group.ID
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id"`
+	UserName    string      `json:"userName"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimEmail `json:"emails"`
+	Active      bool        `json:"active"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimUserRequest struct {
+	UserName    string      `json:"userName"`
+	DisplayName string      `json:"displayName"`
+	Name        *scimName   `json:"name"`
+	Emails      []scimEmail `json:"emails"`
+	Active      *bool       `json:"active"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted"`
+	GivenName  string `json:"givenName"`
+	FamilyName string `json:"familyName"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimGroupRequest struct {
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+}
+
+type scimGroupPatchValue struct {
+	DisplayName *string       `json:"displayName"`
+	Members     *[]scimMember `json:"members"`
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+}
+
+type scimPatchRequest struct {
+	Operations []scimPatchOp `json:"Operations"`
+}
+
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// scimHTTPError is an error with a HTTP status code and an optional SCIM error type.
+type scimHTTPError struct {
+	statusCode int
+	scimType   string
+	msg        string
+}
+
+func (e *scimHTTPError) Error() string {
+	return e.msg
+}
+
+func scimErrorf(statusCode int, scimType, format string, args ...any) error {
+	return &scimHTTPError{
+		statusCode: statusCode,
+		scimType:   scimType,
+		msg:        fmt.Sprintf(format, args...),
+	}
+}
+
+// email returns the email address of the user in the request.
+// The userName is used if it's an email address, otherwise the primary email is used.
+func (r *scimUserRequest) email() (string, error) {
+	if _, err := mail.ParseAddress(r.UserName); err == nil {
+		return strings.ToLower(r.UserName), nil
+	}
+
+	var email string
+	for _, e := range r.Emails {
+		if email == "" || e.Primary {
+			email = e.Value
+		}
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return "", scimErrorf(http.StatusBadRequest, "invalidValue", "user must have a valid email address as userName or in emails")
+	}
+
+	return strings.ToLower(email), nil
+}
+
+// displayName returns the display name of the user in the request.
+func (r *scimUserRequest) displayName() string {
+	if r.DisplayName != "" {
+		return r.DisplayName
+	}
+	if r.Name == nil {
+		return ""
+	}
+	if r.Name.Formatted != "" {
+		return r.Name.Formatted
+	}
+	return strings.TrimSpace(r.Name.GivenName + " " + r.Name.FamilyName)
+}
+
+func scimUserFromUser(u *database.User, active bool) *scimUser {
+	return &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          u.ID,
+		UserName:    u.Email,
+		DisplayName: u.DisplayName,
+		Emails:      []scimEmail{{Value: u.Email, Primary: true}},
+		Active:      active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      &u.CreatedOn,
+			LastModified: &u.UpdatedOn,
+		},
+	}
+}
+
+func scimUserFromSCIMUser(u *database.SCIMUser) *scimUser {
+	return scimUserFromUser(scimUserAsUser(u), u.Active)
+}
+
+// scimUserAsUser returns a database.User with the fields of u that are used for SCIM.
+func scimUserAsUser(u *database.SCIMUser) *database.User {
+	return &database.User{
+		ID:          u.ID,
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		CreatedOn:   u.CreatedOn,
+		UpdatedOn:   u.UpdatedOn,
+	}
+}
+
+// parseSCIMFilter parses a filter of the form `attribute eq "value"`.
+func parseSCIMFilter(filter string) (string, string, error) {
+	match := scimFilterRegexp.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter", "unsupported filter %q: only filters of the form 'attribute eq \"value\"' are supported", filter)
+	}
+	return match[1], match[2], nil
+}
+
+// parseSCIMPagination parses the 1-based startIndex and the count query parameters.
+func parseSCIMPagination(r *http.Request) (int, int, error) {
+	startIndex := 1
+	count := scimDefaultPageSize
+
+	q := r.URL.Query()
+	if v := q.Get("startIndex"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		if n > 1 {
+			startIndex = n
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		count = max(0, min(n, scimMaxPageSize))
+	}
+
+	return startIndex, count, nil
+}
+
+// parseSCIMBool parses a boolean value. Some identity providers send booleans as strings.
+func parseSCIMBool(val json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(val, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(val, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+
+	return false, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid boolean value %s", string(val))
+}
+
+// pageSCIM returns the items on the page starting at the 1-based startIndex.
+func pageSCIM[T any](items []T, startIndex, count int) []T {
+	start := startIndex - 1
+	if start >= len(items) {
+		return nil
+	}
+	end := min(start+count, len(items))
+	return items[start:end]
+}
+
+func decodeSCIMRequest(r *http.Request, v any) error {
+	err := json.NewDecoder(io.LimitReader(r.Body, scimMaxRequestLength)).Decode(v)
+	if err != nil {
+		return scimErrorf(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func newSCIMListResponse(total, startIndex int, resources []any) *scimListResponse {
+	return &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func writeSCIM(w http.ResponseWriter, statusCode int, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(statusCode)
+	_, err = w.Write(data)
+	return err
+}
+
+// writeSCIMError writes an error to the response using the SCIM error schema.
+func writeSCIMError(w http.ResponseWriter, err error) {
+	res := &scimError{
+		Schemas: []string{scimSchemaError},
+		Detail:  err.Error(),
+	}
+
+	statusCode := http.StatusInternalServerError
+	var scimErr *scimHTTPError
+	var httpErr httputil.HTTPError
+	switch {
+	case errors.As(err, &scimErr):
+		statusCode = scimErr.statusCode
+		res.ScimType = scimErr.scimType
+	case errors.As(err, &httpErr):
+		statusCode = httpErr.StatusCode
+	case errors.Is(err, database.ErrNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, database.ErrNotUnique):
+		statusCode = http.StatusConflict
+		res.ScimType = "uniqueness"
+	}
+	res.Status = strconv.Itoa(statusCode)
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
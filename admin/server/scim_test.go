@@ -0,0 +1,318 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/ai"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/admin/server/cookies"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSCIM_ParseFilter(t *testing.T) {
+	attr, val, err := parseSCIMFilter(`userName eq "jane@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, "userName", attr)
+	require.Equal(t, "jane@example.com", val)
+
+	attr, val, err = parseSCIMFilter(`displayName EQ "finance"`)
+	require.NoError(t, err)
+	require.Equal(t, "displayName", attr)
+	require.Equal(t, "finance", val)
+
+	_, _, err = parseSCIMFilter(`userName sw "jane"`)
+	require.Error(t, err)
+	_, _, err = parseSCIMFilter(`userName eq "a" or userName eq "b"`)
+	require.Error(t, err)
+}
+
+func TestSCIM_ParsePagination(t *testing.T) {
+	startIndex, count, err := parseSCIMPagination(httptest.NewRequest("GET", "/scim/v2/Users", nil))
+	require.NoError(t, err)
+	require.Equal(t, 1, startIndex)
+	require.Equal(t, scimDefaultPageSize, count)
+
+	startIndex, count, err = parseSCIMPagination(httptest.NewRequest("GET", "/scim/v2/Users?startIndex=0&count=100000", nil))
+	require.NoError(t, err)
+	require.Equal(t, 1, startIndex)
+	require.Equal(t, scimMaxPageSize, count)
+
+	_, _, err = parseSCIMPagination(httptest.NewRequest("GET", "/scim/v2/Users?count=ten", nil))
+	require.Error(t, err)
+
+	items := []int{1, 2, 3, 4, 5}
+	require.Equal(t, []int{1, 2}, pageSCIM(items, 1, 2))
+	require.Equal(t, []int{4, 5}, pageSCIM(items, 4, 10))
+	require.Empty(t, pageSCIM(items, 6, 10))
+}
+
+func TestSCIM_ParseBool(t *testing.T) {
+	for val, want := range map[string]bool{`true`: true, `false`: false, `"False"`: false, `"True"`: true} {
+		got, err := parseSCIMBool(json.RawMessage(val))
+		require.NoError(t, err, val)
+		require.Equal(t, want, got, val)
+	}
+
+	_, err := parseSCIMBool(json.RawMessage(`"yes please"`))
+	require.Error(t, err)
+}
+
+func TestSCIM_UserRequestEmail(t *testing.T) {
+	req := &scimUserRequest{UserName: "Jane@Example.com"}
+	email, err := req.email()
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", email)
+
+	req = &scimUserRequest{
+		UserName: "jane",
+		Emails:   []scimEmail{{Value: "jane@home.com"}, {Value: "jane@example.com", Primary: true}},
+		Name:     &scimName{GivenName: "Jane", FamilyName: "Doe"},
+	}
+	email, err = req.email()
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", email)
+	require.Equal(t, "Jane Doe", req.displayName())
+
+	req = &scimUserRequest{UserName: "jane"}
+	_, err = req.email()
+	require.Error(t, err)
+}
+
+func TestSCIM_SlugifyDisplayName(t *testing.T) {
+	for displayName, want := range map[string]string{
+		"Data Team":             "data-team",
+		"HR":                    "hr-group",
+		"  Sales & Marketing ":  "sales-marketing",
+		"eng_platform":          "eng_platform",
+		"---":                   "group",
+		"Ünïcode Group":         "n-code-group",
+		strings.Repeat("a", 50): strings.Repeat("a", 40),
+	} {
+		got := slugifySCIMDisplayName(displayName)
+		require.Equal(t, want, got, displayName)
+		require.NoError(t, database.Validate(&database.InsertUsergroupOptions{OrgID: "org", Name: got}), displayName)
+	}
+}
+
+func TestSCIM_Provisioning(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	sender, err := email.NewConsoleSender(logger, "rill-test@rilldata.io", "")
+	require.NoError(t, err)
+
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	service, err := admin.New(ctx,
+		&admin.Options{
+			DatabaseDriver:     "postgres",
+			DatabaseDSN:        pg.DatabaseURL,
+			ProvisionerSetJSON: `{"static":{"type":"static","spec":{"runtimes":[{"host":"http://localhost:9091","slots":50,"data_dir":"","audience_url":"http://localhost:8081"}]}}}`,
+			DefaultProvisioner: "static",
+			ExternalURL:        "http://localhost:9090",
+		},
+		logger,
+		issuer,
+		email.New(sender),
+		&mockGithub{},
+		ai.NewNoop(),
+	)
+	require.NoError(t, err)
+	db := service.DB
+
+	authenticator, err := auth.NewAuthenticator(logger, service, cookies.New(logger, nil), &auth.AuthenticatorOptions{
+		AuthDomain: "gorillio-stage.auth0.com",
+	})
+	require.NoError(t, err)
+
+	server := &Server{
+		admin:         service,
+		opts:          &Options{},
+		authenticator: authenticator,
+		logger:        logger,
+	}
+	mux := http.NewServeMux()
+	server.registerSCIMEndpoints(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// create an org with a project and a service token for SCIM
+	owner, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "owner@test.io", DisplayName: "owner", QuotaSingleuserOrgs: 3})
+	require.NoError(t, err)
+	org, err := service.CreateOrganizationForUser(ctx, owner.ID, "scim-org", "")
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "scim-proj", ProdBranch: "main"})
+	require.NoError(t, err)
+	svc, err := db.InsertService(ctx, &database.InsertServiceOptions{OrgID: org.ID, Name: "scim"})
+	require.NoError(t, err)
+	tkn, err := service.IssueServiceAuthToken(ctx, svc.ID, nil, false, nil)
+	require.NoError(t, err)
+	token := tkn.Token().String()
+
+	do := func(method, path string, body, res any) int {
+		var r io.Reader
+		if body != nil {
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+			r = bytes.NewReader(data)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, srv.URL+path, r)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/scim+json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if res != nil && resp.StatusCode < 300 {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(res))
+		}
+		return resp.StatusCode
+	}
+
+	// service tokens that are not scoped to SCIM are rejected
+	require.Equal(t, http.StatusForbidden, do("GET", "/scim/v2/Users", nil, nil))
+	tkn, err = service.IssueServiceAuthToken(ctx, svc.ID, nil, true, nil)
+	require.NoError(t, err)
+	token = tkn.Token().String()
+
+	// create two users
+	var jane, john scimUser
+	require.Equal(t, http.StatusCreated, do("POST", "/scim/v2/Users", map[string]any{"userName": "jane@test.io", "name": map[string]any{"givenName": "Jane", "familyName": "Doe"}}, &jane))
+	require.Equal(t, "jane@test.io", jane.UserName)
+	require.True(t, jane.Active)
+	require.Equal(t, http.StatusCreated, do("POST", "/scim/v2/Users", map[string]any{"userName": "john@test.io"}, &john))
+	require.Equal(t, http.StatusConflict, do("POST", "/scim/v2/Users", map[string]any{"userName": "jane@test.io"}, nil))
+	isMember, err := db.CheckUserIsAnOrganizationMember(ctx, jane.ID, org.ID)
+	require.NoError(t, err)
+	require.True(t, isMember)
+
+	// adding members is audited
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, auditActionSCIMAddOrganizationMember, events[0].Action)
+	require.Equal(t, "john@test.io", events[0].Metadata["email"])
+	require.Equal(t, "scim", events[0].ActorName)
+
+	// list users with a filter and with paging
+	type userList struct {
+		TotalResults int        `json:"totalResults"`
+		Resources    []scimUser `json:"Resources"`
+	}
+	var users userList
+	require.Equal(t, http.StatusOK, do("GET", "/scim/v2/Users?filter="+url.QueryEscape(`userName eq "JOHN@test.io"`), nil, &users))
+	require.Equal(t, 1, users.TotalResults)
+	require.Len(t, users.Resources, 1)
+	require.Equal(t, john.ID, users.Resources[0].ID)
+	require.Equal(t, http.StatusOK, do("GET", "/scim/v2/Users?startIndex=2&count=1", nil, &users))
+	require.Equal(t, 3, users.TotalResults)
+	require.Len(t, users.Resources, 1)
+	require.Equal(t, "john@test.io", users.Resources[0].UserName)
+
+	// create a group with a display name that is not a valid group name
+	var group scimGroup
+	require.Equal(t, http.StatusCreated, do("POST", "/scim/v2/Groups", map[string]any{"displayName": "Data Team", "members": []any{map[string]any{"value": jane.ID}}}, &group))
+	require.Equal(t, "Data Team", group.DisplayName)
+	require.Len(t, group.Members, 1)
+	ug, err := db.FindUsergroupByName(ctx, org.ID, "data-team")
+	require.NoError(t, err)
+	require.Equal(t, group.ID, ug.ID)
+
+	// a second group with a similar name gets a unique name
+	var other scimGroup
+	require.Equal(t, http.StatusCreated, do("POST", "/scim/v2/Groups", map[string]any{"displayName": "Data-Team"}, &other))
+	ug, err = db.FindUsergroupByName(ctx, org.ID, "data-team-2")
+	require.NoError(t, err)
+	require.Equal(t, other.ID, ug.ID)
+	require.Equal(t, http.StatusNoContent, do("DELETE", "/scim/v2/Groups/"+other.ID, nil, nil))
+
+	// patch the group to add a member and rename it
+	patch := map[string]any{"Operations": []any{
+		map[string]any{"op": "add", "path": "members", "value": []any{map[string]any{"value": john.ID}}},
+		map[string]any{"op": "replace", "path": "displayName", "value": "Data & Analytics"},
+	}}
+	require.Equal(t, http.StatusOK, do("PATCH", "/scim/v2/Groups/"+group.ID, patch, &group))
+	require.Equal(t, "Data & Analytics", group.DisplayName)
+	require.Len(t, group.Members, 2)
+	ug, err = db.FindUsergroup(ctx, group.ID)
+	require.NoError(t, err)
+	require.Equal(t, "data-analytics", ug.Name)
+
+	// grant jane a project role
+	role, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.NoError(t, db.InsertProjectMemberUser(ctx, proj.ID, jane.ID, role.ID))
+
+	// deactivating jane removes her from the org, its projects and its groups
+	deactivate := map[string]any{"Operations": []any{map[string]any{"op": "replace", "path": "active", "value": false}}}
+	require.Equal(t, http.StatusOK, do("PATCH", "/scim/v2/Users/"+jane.ID, deactivate, &jane))
+	require.False(t, jane.Active)
+	isMember, err = db.CheckUserIsAnOrganizationMember(ctx, jane.ID, org.ID)
+	require.NoError(t, err)
+	require.False(t, isMember)
+	projMembers, err := db.FindProjectMemberUsers(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Empty(t, projMembers)
+	groupMembers, err := db.FindUsergroupMemberUsers(ctx, group.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, groupMembers, 1)
+	require.Equal(t, "john@test.io", groupMembers[0].Email)
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, auditActionSCIMRemoveOrganizationMember, events[0].Action)
+	require.Equal(t, "jane@test.io", events[0].Metadata["email"])
+
+	// deactivated users can still be looked up
+	var got scimUser
+	require.Equal(t, http.StatusOK, do("GET", "/scim/v2/Users/"+jane.ID, nil, &got))
+	require.False(t, got.Active)
+	require.Equal(t, http.StatusOK, do("GET", "/scim/v2/Users?filter="+url.QueryEscape(`userName eq "jane@test.io"`), nil, &users))
+	require.Len(t, users.Resources, 1)
+	require.False(t, users.Resources[0].Active)
+
+	// reactivating jane adds her back to the org
+	activate := map[string]any{"Operations": []any{map[string]any{"op": "replace", "path": "active", "value": true}}}
+	require.Equal(t, http.StatusOK, do("PATCH", "/scim/v2/Users/"+jane.ID, activate, &jane))
+	require.True(t, jane.Active)
+	isMember, err = db.CheckUserIsAnOrganizationMember(ctx, jane.ID, org.ID)
+	require.NoError(t, err)
+	require.True(t, isMember)
+
+	// deleting john removes him from the org and its groups
+	require.Equal(t, http.StatusNoContent, do("DELETE", "/scim/v2/Users/"+john.ID, nil, nil))
+	isMember, err = db.CheckUserIsAnOrganizationMember(ctx, john.ID, org.ID)
+	require.NoError(t, err)
+	require.False(t, isMember)
+	groupMembers, err = db.FindUsergroupMemberUsers(ctx, group.ID, "", 10)
+	require.NoError(t, err)
+	require.Empty(t, groupMembers)
+	require.Equal(t, http.StatusNotFound, do("GET", "/scim/v2/Users/"+john.ID, nil, nil))
+
+	// deleting a deactivated user removes it
+	require.Equal(t, http.StatusOK, do("PATCH", "/scim/v2/Users/"+jane.ID, deactivate, &jane))
+	require.Equal(t, http.StatusNoContent, do("DELETE", "/scim/v2/Users/"+jane.ID, nil, nil))
+	require.Equal(t, http.StatusNotFound, do("GET", "/scim/v2/Users/"+jane.ID, nil, nil))
+
+	// deleting the group removes it
+	require.Equal(t, http.StatusNoContent, do("DELETE", "/scim/v2/Groups/"+group.ID, nil, nil))
+	_, err = db.FindUsergroup(ctx, group.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
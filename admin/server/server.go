@@ -241,6 +241,9 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM provisioning endpoints (not gRPC handlers, just regular endpoints on /scim/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Build CORS options for admin server
 
 	// If the AllowedOrigins contains a "*" we want to return the requester's origin instead of "*" in the "Access-Control-Allow-Origin" header.
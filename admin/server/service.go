@@ -173,6 +173,8 @@ func (s *Server) ListServiceAuthTokens(ctx context.Context, req *adminv1.ListSer
 			Id:        token.ID,
 			CreatedOn: timestamppb.New(token.CreatedOn),
 			ExpiresOn: timestamppb.New(safeTime(token.ExpiresOn)),
+			ApiNames:  token.APINames,
+			Scim:      token.SCIM,
 		}
 	}
 
@@ -186,6 +188,8 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.service_name", req.ServiceName),
 		attribute.String("args.organization_name", req.OrganizationName),
+		attribute.StringSlice("args.api_names", req.ApiNames),
+		attribute.Bool("args.scim", req.Scim),
 	)
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.OrganizationName)
@@ -203,7 +207,37 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
 	}
 
-	token, err := s.admin.IssueServiceAuthToken(ctx, service.ID, nil)
+	for _, name := range req.ApiNames {
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "API names must not be empty")
+		}
+	}
+	if req.Scim && len(req.ApiNames) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "a token can not be restricted to both SCIM and custom APIs")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	token, err := s.admin.IssueServiceAuthToken(ctx, service.ID, req.ApiNames, req.Scim, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, org.ID, nil, auditActionIssueServiceAuthToken, map[string]any{
+		"service":   service.Name,
+		"token_id":  token.Token().ID.String(),
+		"api_names": req.ApiNames,
+		"scim":      req.Scim,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -46,7 +46,11 @@ func (w *Worker) resetAllDeploymentsForProject(ctx context.Context, proj *databa
 
 	for _, depl := range depls {
 		w.logger.Info("reset all deployments: redeploying deployment", zap.String("deployment_id", depl.ID), observability.ZapCtx(ctx))
-		_, err = w.admin.TriggerRedeploy(ctx, proj, depl)
+		if depl.Preview {
+			_, err = w.admin.TriggerPreviewRedeploy(ctx, proj, depl)
+		} else {
+			_, err = w.admin.TriggerRedeploy(ctx, proj, depl)
+		}
 		if err != nil {
 			return err
 		}
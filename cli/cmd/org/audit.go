@@ -0,0 +1,81 @@
+package org
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, actor, start, end string
+	var pageSize uint32
+	var pageToken string
+
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List audit events for an organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			req := &adminv1.ListAuditEventsRequest{
+				Organization: ch.Org,
+				Project:      project,
+				Actor:        actor,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			}
+
+			if start != "" {
+				t, err := time.Parse(time.RFC3339, start)
+				if err != nil {
+					return fmt.Errorf("invalid --start time %q: %w", start, err)
+				}
+				req.StartTime = timestamppb.New(t)
+			}
+
+			if end != "" {
+				t, err := time.Parse(time.RFC3339, end)
+				if err != nil {
+					return fmt.Errorf("invalid --end time %q: %w", end, err)
+				}
+				req.EndTime = timestamppb.New(t)
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				ch.PrintfWarn("No audit events found\n")
+				return nil
+			}
+
+			ch.PrintAuditEvents(res.Events)
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	auditCmd.Flags().StringVar(&project, "project", "", "Only list events for this project")
+	auditCmd.Flags().StringVar(&actor, "actor", "", "Only list events performed by this user email or service name")
+	auditCmd.Flags().StringVar(&start, "start", "", "Only list events at or after this time (RFC3339 format)")
+	auditCmd.Flags().StringVar(&end, "end", "", "Only list events before this time (RFC3339 format)")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
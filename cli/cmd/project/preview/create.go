@@ -0,0 +1,53 @@
+package preview
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+
+	createCmd := &cobra.Command{
+		Use:   "create <branch>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a preview deployment of a branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			branch := args[0]
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("project") && ch.Interactive {
+				project, err = ch.InferProjectName(ctx, ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.CreateDeployment(ctx, &adminv1.CreateDeploymentRequest{
+				Organization: ch.Org,
+				Project:      project,
+				Branch:       branch,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Created preview deployment of branch %q\n", branch)
+			ch.PrintDeployments([]*adminv1.Deployment{res.Deployment})
+
+			return nil
+		},
+	}
+
+	createCmd.Flags().SortFlags = false
+	createCmd.Flags().StringVar(&project, "project", "", "Project name")
+	createCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+
+	return createCmd
+}
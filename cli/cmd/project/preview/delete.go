@@ -0,0 +1,78 @@
+package preview
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <branch>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete the preview deployment of a branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			branch := args[0]
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("project") && ch.Interactive {
+				project, err = ch.InferProjectName(ctx, ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ListDeployments(ctx, &adminv1.ListDeploymentsRequest{
+				Organization: ch.Org,
+				Project:      project,
+			})
+			if err != nil {
+				return err
+			}
+
+			var depl *adminv1.Deployment
+			for _, d := range res.Deployments {
+				if d.Preview && d.Branch == branch {
+					depl = d
+					break
+				}
+			}
+			if depl == nil {
+				return fmt.Errorf("project %q does not have a preview deployment of branch %q", project, branch)
+			}
+
+			if !force {
+				msg := fmt.Sprintf("This will delete the preview deployment of branch %q. Do you want to continue?", branch)
+				if !cmdutil.ConfirmPrompt(msg, "", false) {
+					return nil
+				}
+			}
+
+			_, err = client.DeleteDeployment(ctx, &adminv1.DeleteDeploymentRequest{DeploymentId: depl.Id})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Deleted preview deployment of branch %q\n", branch)
+
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().SortFlags = false
+	deleteCmd.Flags().StringVar(&project, "project", "", "Project name")
+	deleteCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete without confirmation")
+
+	return deleteCmd
+}
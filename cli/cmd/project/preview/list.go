@@ -0,0 +1,59 @@
+package preview
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+
+	listCmd := &cobra.Command{
+		Use:   "list [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List the deployments of a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && ch.Interactive {
+				project, err = ch.InferProjectName(ctx, ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ListDeployments(ctx, &adminv1.ListDeploymentsRequest{
+				Organization: ch.Org,
+				Project:      project,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Deployments) == 0 {
+				ch.PrintfWarn("No deployments found\n")
+				return nil
+			}
+
+			ch.PrintDeployments(res.Deployments)
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().SortFlags = false
+	listCmd.Flags().StringVar(&project, "project", "", "Project name")
+	listCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+
+	return listCmd
+}
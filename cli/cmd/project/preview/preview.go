@@ -0,0 +1,20 @@
+package preview
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func PreviewCmd(ch *cmdutil.Helper) *cobra.Command {
+	previewCmd := &cobra.Command{
+		Use:               "preview",
+		Short:             "Manage preview deployments of non-prod branches",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	previewCmd.AddCommand(ListCmd(ch))
+	previewCmd.AddCommand(CreateCmd(ch))
+	previewCmd.AddCommand(DeleteCmd(ch))
+
+	return previewCmd
+}
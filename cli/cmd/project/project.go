@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rilldata/rill/cli/cmd/project/preview"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/spf13/cobra"
@@ -29,6 +30,7 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(JwtCmd(ch))
 	projectCmd.AddCommand(RenameCmd(ch))
 	projectCmd.AddCommand(LogsCmd(ch))
+	projectCmd.AddCommand(preview.PreviewCmd(ch))
 
 	return projectCmd
 }
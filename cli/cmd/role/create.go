@@ -0,0 +1,69 @@
+package role
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+	var permissions []string
+
+	createCmd := &cobra.Command{
+		Use:   "create <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create custom role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if roleType == roleTypeOrg {
+				perms := &adminv1.OrganizationPermissions{}
+				if err := parsePermissions(perms, permissions); err != nil {
+					return err
+				}
+
+				res, err := client.CreateOrganizationRole(cmd.Context(), &adminv1.CreateOrganizationRoleRequest{
+					Organization: ch.Org,
+					Name:         args[0],
+					Permissions:  perms,
+				})
+				if err != nil {
+					return err
+				}
+
+				ch.PrintfSuccess("Created organization role %q in organization %q\n", res.Role.Name, ch.Org)
+				return nil
+			}
+
+			perms := &adminv1.ProjectPermissions{}
+			if err := parsePermissions(perms, permissions); err != nil {
+				return err
+			}
+
+			res, err := client.CreateProjectRole(cmd.Context(), &adminv1.CreateProjectRoleRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+				Permissions:  perms,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Created project role %q in organization %q\n", res.Role.Name, ch.Org)
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringVar(&roleType, "type", roleTypeProject, roleTypeFlagUsage())
+	createCmd.Flags().StringSliceVar(&permissions, "permissions", nil, permissionsFlagUsage)
+
+	return createCmd
+}
@@ -0,0 +1,50 @@
+package role
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete custom role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if roleType == roleTypeOrg {
+				_, err = client.DeleteOrganizationRole(cmd.Context(), &adminv1.DeleteOrganizationRoleRequest{
+					Organization: ch.Org,
+					Name:         args[0],
+				})
+			} else {
+				_, err = client.DeleteProjectRole(cmd.Context(), &adminv1.DeleteProjectRoleRequest{
+					Organization: ch.Org,
+					Name:         args[0],
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Deleted %s role: %q\n", roleType, args[0])
+
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().StringVar(&roleType, "type", roleTypeProject, roleTypeFlagUsage())
+
+	return deleteCmd
+}
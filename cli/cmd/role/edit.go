@@ -0,0 +1,69 @@
+package role
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func EditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+	var permissions []string
+
+	editCmd := &cobra.Command{
+		Use:   "edit <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the permissions of a custom role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if roleType == roleTypeOrg {
+				perms := &adminv1.OrganizationPermissions{}
+				if err := parsePermissions(perms, permissions); err != nil {
+					return err
+				}
+
+				res, err := client.UpdateOrganizationRole(cmd.Context(), &adminv1.UpdateOrganizationRoleRequest{
+					Organization: ch.Org,
+					Name:         args[0],
+					Permissions:  perms,
+				})
+				if err != nil {
+					return err
+				}
+
+				ch.PrintfSuccess("Updated organization role %q\n", res.Role.Name)
+				return nil
+			}
+
+			perms := &adminv1.ProjectPermissions{}
+			if err := parsePermissions(perms, permissions); err != nil {
+				return err
+			}
+
+			res, err := client.UpdateProjectRole(cmd.Context(), &adminv1.UpdateProjectRoleRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+				Permissions:  perms,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Updated project role %q\n", res.Role.Name)
+			return nil
+		},
+	}
+
+	editCmd.Flags().StringVar(&roleType, "type", roleTypeProject, roleTypeFlagUsage())
+	editCmd.Flags().StringSliceVar(&permissions, "permissions", nil, permissionsFlagUsage)
+
+	return editCmd
+}
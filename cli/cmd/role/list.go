@@ -0,0 +1,53 @@
+package role
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var roleType string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List built-in and custom roles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateRoleType(roleType); err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if roleType == roleTypeOrg {
+				res, err := client.ListOrganizationRoles(cmd.Context(), &adminv1.ListOrganizationRolesRequest{
+					Organization: ch.Org,
+				})
+				if err != nil {
+					return err
+				}
+
+				ch.PrintOrganizationRoles(res.Roles)
+				return nil
+			}
+
+			res, err := client.ListProjectRoles(cmd.Context(), &adminv1.ListProjectRolesRequest{
+				Organization: ch.Org,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintProjectRoles(res.Roles)
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&roleType, "type", roleTypeProject, roleTypeFlagUsage())
+
+	return listCmd
+}
@@ -0,0 +1,80 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func RoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	roleCmd := &cobra.Command{
+		Use:               "role",
+		Short:             "Manage custom roles",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	roleCmd.PersistentFlags().StringVar(&ch.Org, "org", ch.Org, "Organization Name")
+
+	roleCmd.AddCommand(ListCmd(ch))
+	roleCmd.AddCommand(CreateCmd(ch))
+	roleCmd.AddCommand(EditCmd(ch))
+	roleCmd.AddCommand(DeleteCmd(ch))
+
+	return roleCmd
+}
+
+const (
+	roleTypeOrg     = "org"
+	roleTypeProject = "project"
+)
+
+var roleTypes = []string{roleTypeOrg, roleTypeProject}
+
+const permissionsFlagUsage = "Permissions granted by the role, e.g. read_project,read_prod,create_alerts"
+
+func roleTypeFlagUsage() string {
+	return fmt.Sprintf("Type of role (options: %s)", strings.Join(roleTypes, ", "))
+}
+
+func validateRoleType(roleType string) error {
+	for _, t := range roleTypes {
+		if t == roleType {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid role type %q (options: %s)", roleType, strings.Join(roleTypes, ", "))
+}
+
+// parsePermissions sets the boolean fields named in perms on msg.
+// The names are the snake case field names of the OrganizationPermissions or ProjectPermissions message.
+func parsePermissions(msg proto.Message, perms []string) error {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, p := range perms {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, "%q:true", strings.TrimSpace(p))
+	}
+	sb.WriteString("}")
+
+	err := protojson.UnmarshalOptions{}.Unmarshal([]byte(sb.String()), msg)
+	if err != nil {
+		return fmt.Errorf("invalid permissions (options: %s): %w", strings.Join(permissionNames(msg), ", "), err)
+	}
+	return nil
+}
+
+// permissionNames returns the names of all the permissions that can be set on msg.
+func permissionNames(msg proto.Message) []string {
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	names := make([]string, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		names[i] = string(fields.Get(i).Name())
+	}
+	return names
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/rilldata/rill/cli/cmd/env"
 	"github.com/rilldata/rill/cli/cmd/org"
 	"github.com/rilldata/rill/cli/cmd/project"
+	"github.com/rilldata/rill/cli/cmd/role"
 	"github.com/rilldata/rill/cli/cmd/runtime"
 	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/start"
 	"github.com/rilldata/rill/cli/cmd/sudo"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
+	"github.com/rilldata/rill/cli/cmd/usergroup"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -143,6 +145,8 @@ func runCmd(ctx context.Context, ver cmdutil.Version) error {
 		deploy.DeployCmd(ch),
 		env.EnvCmd(ch),
 		user.UserCmd(ch),
+		usergroup.UsergroupCmd(ch),
+		role.RoleCmd(ch),
 		org.OrgCmd(ch),
 		project.ProjectCmd(ch),
 		service.ServiceCmd(ch),
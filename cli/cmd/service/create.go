@@ -7,6 +7,7 @@ import (
 )
 
 func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var scim bool
 	createCmd := &cobra.Command{
 		Use:   "create <service-name>",
 		Args:  cobra.ExactArgs(1),
@@ -28,6 +29,7 @@ func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
 			res2, err := client.IssueServiceAuthToken(cmd.Context(), &adminv1.IssueServiceAuthTokenRequest{
 				OrganizationName: ch.Org,
 				ServiceName:      res1.Service.Name,
+				Scim:             scim,
 			})
 			if err != nil {
 				return err
@@ -40,5 +42,7 @@ func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
 		},
 	}
 
+	createCmd.Flags().BoolVar(&scim, "scim", false, "Restrict the service's token to SCIM provisioning of the org")
+
 	return createCmd
 }
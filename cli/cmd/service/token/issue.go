@@ -8,6 +8,8 @@ import (
 
 func IssueCmd(ch *cmdutil.Helper) *cobra.Command {
 	var name string
+	var apis []string
+	var scim bool
 	issueCmd := &cobra.Command{
 		Use:   "issue [<service>]",
 		Args:  cobra.MaximumNArgs(1),
@@ -25,6 +27,8 @@ func IssueCmd(ch *cmdutil.Helper) *cobra.Command {
 			res, err := client.IssueServiceAuthToken(cmd.Context(), &adminv1.IssueServiceAuthTokenRequest{
 				OrganizationName: ch.Org,
 				ServiceName:      name,
+				ApiNames:         apis,
+				Scim:             scim,
 			})
 			if err != nil {
 				return err
@@ -38,6 +42,8 @@ func IssueCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	issueCmd.Flags().SortFlags = false
 	issueCmd.Flags().StringVar(&name, "service", "", "Service Name")
+	issueCmd.Flags().StringSliceVar(&apis, "api", nil, "Restrict the token to calling the named custom API(s)")
+	issueCmd.Flags().BoolVar(&scim, "scim", false, "Restrict the token to SCIM provisioning of the org")
 
 	return issueCmd
 }
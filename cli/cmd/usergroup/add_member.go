@@ -0,0 +1,42 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AddMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	addMemberCmd := &cobra.Command{
+		Use:   "add-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add an organization member to a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.AddUsergroupMember(cmd.Context(), &adminv1.AddUsergroupMemberRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("User %q added to the user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	addMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return addMemberCmd
+}
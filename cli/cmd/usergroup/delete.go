@@ -0,0 +1,35 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:   "delete <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.DeleteUsergroup(cmd.Context(), &adminv1.DeleteUsergroupRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Deleted user group: %q\n", args[0])
+
+			return nil
+		},
+	}
+
+	return deleteCmd
+}
@@ -0,0 +1,76 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var pageSize uint32
+	var pageToken string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List user groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			var nextPageToken string
+			if projectName != "" {
+				res, err := client.ListProjectMemberUsergroups(cmd.Context(), &adminv1.ListProjectMemberUsergroupsRequest{
+					Organization: ch.Org,
+					Project:      projectName,
+					PageSize:     pageSize,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return err
+				}
+
+				if len(res.Usergroups) == 0 {
+					ch.PrintfWarn("No user groups have a role in project \"%s/%s\"\n", ch.Org, projectName)
+					return nil
+				}
+
+				ch.PrintMemberUsergroups(res.Usergroups)
+				nextPageToken = res.NextPageToken
+			} else {
+				res, err := client.ListOrganizationUsergroups(cmd.Context(), &adminv1.ListOrganizationUsergroupsRequest{
+					Organization: ch.Org,
+					PageSize:     pageSize,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return err
+				}
+
+				if len(res.Usergroups) == 0 {
+					ch.PrintfWarn("No user groups found in organization %q\n", ch.Org)
+					return nil
+				}
+
+				ch.PrintUsergroups(res.Usergroups)
+				nextPageToken = res.NextPageToken
+			}
+
+			if nextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", nextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&projectName, "project", "", "Only list user groups that have a role in this project")
+	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of user groups to return per page")
+	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listCmd
+}
@@ -0,0 +1,42 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	removeMemberCmd := &cobra.Command{
+		Use:   "remove-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a member from a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RemoveUsergroupMember(cmd.Context(), &adminv1.RemoveUsergroupMemberRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("User %q removed from the user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	removeMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return removeMemberCmd
+}
@@ -0,0 +1,42 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveRoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+
+	removeRoleCmd := &cobra.Command{
+		Use:   "remove-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the role of a user group in a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&projectName, "Enter project name")
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RemoveUsergroupProjectRole(cmd.Context(), &adminv1.RemoveUsergroupProjectRoleRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+				Usergroup:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed the role of user group %q in the project \"%s/%s\"\n", args[0], ch.Org, projectName)
+
+			return nil
+		},
+	}
+
+	removeRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+
+	return removeRoleCmd
+}
@@ -0,0 +1,49 @@
+package usergroup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SetRoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var role string
+
+	setRoleCmd := &cobra.Command{
+		Use:   "set-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the role of a user group in a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&projectName, "Enter project name")
+			cmdutil.SelectPromptIfEmpty(&role, "Select role", projectRoles, "")
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.SetUsergroupProjectRole(cmd.Context(), &adminv1.SetUsergroupProjectRoleRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+				Usergroup:    args[0],
+				Role:         role,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Updated role of user group %q to %q in the project \"%s/%s\"\n", args[0], role, ch.Org, projectName)
+
+			return nil
+		},
+	}
+
+	setRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group (options: %s, or a custom role)", strings.Join(projectRoles, ", ")))
+
+	return setRoleCmd
+}
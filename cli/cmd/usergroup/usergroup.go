@@ -0,0 +1,30 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func UsergroupCmd(ch *cmdutil.Helper) *cobra.Command {
+	usergroupCmd := &cobra.Command{
+		Use:               "usergroup",
+		Short:             "Manage user groups",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	usergroupCmd.PersistentFlags().StringVar(&ch.Org, "org", ch.Org, "Organization Name")
+
+	usergroupCmd.AddCommand(ListCmd(ch))
+	usergroupCmd.AddCommand(CreateCmd(ch))
+	usergroupCmd.AddCommand(RenameCmd(ch))
+	usergroupCmd.AddCommand(DeleteCmd(ch))
+	usergroupCmd.AddCommand(ListMembersCmd(ch))
+	usergroupCmd.AddCommand(AddMemberCmd(ch))
+	usergroupCmd.AddCommand(RemoveMemberCmd(ch))
+	usergroupCmd.AddCommand(SetRoleCmd(ch))
+	usergroupCmd.AddCommand(RemoveRoleCmd(ch))
+
+	return usergroupCmd
+}
+
+var projectRoles = []string{"admin", "viewer"}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func (p *Printer) PrintOrgs(orgs []*adminv1.Organization, defaultOrg string) {
@@ -238,6 +240,8 @@ func toServiceTokenRow(s *adminv1.ServiceToken) *token {
 		ID:        s.Id,
 		CreatedOn: s.CreatedOn.AsTime().Format(time.DateTime),
 		ExpiresOn: expiresOn,
+		APINames:  strings.Join(s.ApiNames, ","),
+		SCIM:      s.Scim,
 	}
 }
 
@@ -245,4 +249,196 @@ type token struct {
 	ID        string `header:"id" json:"id"`
 	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
 	ExpiresOn string `header:"expires_on,timestamp(ms|utc|human)" json:"expires_on"`
+	APINames  string `header:"api_names" json:"api_names"`
+	SCIM      bool   `header:"scim" json:"scim"`
+}
+
+func (p *Printer) PrintUsergroups(ugs []*adminv1.Usergroup) {
+	if len(ugs) == 0 {
+		return
+	}
+	p.PrintData(toUsergroupsTable(ugs))
+}
+
+func toUsergroupsTable(ugs []*adminv1.Usergroup) []*usergroup {
+	groups := make([]*usergroup, 0, len(ugs))
+
+	for _, g := range ugs {
+		groups = append(groups, &usergroup{Name: g.Name})
+	}
+
+	return groups
+}
+
+type usergroup struct {
+	Name string `header:"name" json:"name"`
+}
+
+func (p *Printer) PrintUsergroupMembers(members []*adminv1.UsergroupMember) {
+	if len(members) == 0 {
+		return
+	}
+	p.PrintData(toUsergroupMembersTable(members))
+}
+
+func toUsergroupMembersTable(members []*adminv1.UsergroupMember) []*usergroupMember {
+	allMembers := make([]*usergroupMember, 0, len(members))
+
+	for _, m := range members {
+		allMembers = append(allMembers, &usergroupMember{
+			Name:  m.UserName,
+			Email: m.UserEmail,
+		})
+	}
+
+	return allMembers
+}
+
+type usergroupMember struct {
+	Name  string `header:"name" json:"display_name"`
+	Email string `header:"email" json:"email"`
+}
+
+func (p *Printer) PrintMemberUsergroups(ugs []*adminv1.MemberUsergroup) {
+	if len(ugs) == 0 {
+		return
+	}
+	p.PrintData(toMemberUsergroupsTable(ugs))
+}
+
+func toMemberUsergroupsTable(ugs []*adminv1.MemberUsergroup) []*memberUsergroup {
+	groups := make([]*memberUsergroup, 0, len(ugs))
+
+	for _, g := range ugs {
+		groups = append(groups, &memberUsergroup{
+			Name:     g.UsergroupName,
+			RoleName: g.RoleName,
+		})
+	}
+
+	return groups
+}
+
+type memberUsergroup struct {
+	Name     string `header:"name" json:"name"`
+	RoleName string `header:"role" json:"role_name"`
+}
+
+func (p *Printer) PrintOrganizationRoles(roles []*adminv1.OrganizationRole) {
+	if len(roles) == 0 {
+		return
+	}
+
+	res := make([]*role, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, toRoleRow(r.Name, r.Builtin, r.Permissions))
+	}
+
+	p.PrintData(res)
+}
+
+func (p *Printer) PrintProjectRoles(roles []*adminv1.ProjectRole) {
+	if len(roles) == 0 {
+		return
+	}
+
+	res := make([]*role, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, toRoleRow(r.Name, r.Builtin, r.Permissions))
+	}
+
+	p.PrintData(res)
+}
+
+// toRoleRow lists the permissions granted by a role using the field names of its permissions message.
+func toRoleRow(name string, builtin bool, permissions proto.Message) *role {
+	var granted []string
+	permissions.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if v.Bool() {
+			granted = append(granted, string(fd.Name()))
+		}
+		return true
+	})
+
+	return &role{
+		Name:        name,
+		Builtin:     builtin,
+		Permissions: strings.Join(granted, ", "),
+	}
+}
+
+type role struct {
+	Name        string `header:"name" json:"name"`
+	Builtin     bool   `header:"built-in" json:"builtin"`
+	Permissions string `header:"permissions" json:"permissions"`
+}
+
+func (p *Printer) PrintAuditEvents(events []*adminv1.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+	p.PrintData(toAuditEventsTable(events))
+}
+
+func toAuditEventsTable(evts []*adminv1.AuditEvent) []*auditEvent {
+	events := make([]*auditEvent, 0, len(evts))
+
+	for _, e := range evts {
+		events = append(events, toAuditEventRow(e))
+	}
+
+	return events
+}
+
+func toAuditEventRow(e *adminv1.AuditEvent) *auditEvent {
+	var metadata string
+	if len(e.Metadata.GetFields()) > 0 {
+		data, err := e.Metadata.MarshalJSON()
+		if err == nil {
+			metadata = string(data)
+		}
+	}
+
+	return &auditEvent{
+		CreatedOn: e.CreatedOn.AsTime().Format(time.DateTime),
+		Actor:     e.ActorName,
+		Action:    e.Action,
+		Project:   e.ProjectName,
+		Metadata:  metadata,
+	}
+}
+
+type auditEvent struct {
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+	Actor     string `header:"actor" json:"actor"`
+	Action    string `header:"action" json:"action"`
+	Project   string `header:"project" json:"project"`
+	Metadata  string `header:"metadata" json:"metadata"`
+}
+
+func (p *Printer) PrintDeployments(depls []*adminv1.Deployment) {
+	if len(depls) == 0 {
+		return
+	}
+
+	res := make([]*deployment, 0, len(depls))
+	for _, d := range depls {
+		res = append(res, &deployment{
+			ID:        d.Id,
+			Branch:    d.Branch,
+			Preview:   d.Preview,
+			Status:    strings.TrimPrefix(d.Status.String(), "DEPLOYMENT_STATUS_"),
+			CreatedOn: d.CreatedOn.AsTime().Format(time.DateTime),
+		})
+	}
+
+	p.PrintData(res)
+}
+
+type deployment struct {
+	ID        string `header:"id" json:"id"`
+	Branch    string `header:"branch" json:"branch"`
+	Preview   bool   `header:"preview" json:"preview"`
+	Status    string `header:"status" json:"status"`
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
 }
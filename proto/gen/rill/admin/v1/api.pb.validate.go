@@ -1795,6 +1795,8 @@ func (m *GetProjectRequest) validate(all bool) error {
 
 	// no validation rules for AccessTokenTtlSeconds
 
+	// no validation rules for Branch
+
 	if len(errors) > 0 {
 		return GetProjectRequestMultiError(errors)
 	}
@@ -6137,22 +6139,22 @@ var _ interface {
 	ErrorName() string
 } = TriggerRedeployResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersRequest) Validate() error {
+func (m *ListDeploymentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersRequestMultiError, or nil if none found.
-func (m *ListOrganizationMembersRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentsRequestMultiError, or nil if none found.
+func (m *ListDeploymentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersRequest) validate(all bool) error {
+func (m *ListDeploymentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6161,37 +6163,22 @@ func (m *ListOrganizationMembersRequest) validate(all bool) error {
 
 	// no validation rules for Organization
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for Project
 
 	if len(errors) > 0 {
-		return ListOrganizationMembersRequestMultiError(errors)
+		return ListDeploymentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationMembersRequestMultiError []error
+// ListDeploymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeploymentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersRequestMultiError) Error() string {
+func (m ListDeploymentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6200,12 +6187,11 @@ func (m ListOrganizationMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
+func (m ListDeploymentsRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersRequestValidationError is the validation error
-// returned by ListOrganizationMembersRequest.Validate if the designated
-// constraints aren't met.
-type ListOrganizationMembersRequestValidationError struct {
+// ListDeploymentsRequestValidationError is the validation error returned by
+// ListDeploymentsRequest.Validate if the designated constraints aren't met.
+type ListDeploymentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6213,24 +6199,24 @@ type ListOrganizationMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
+func (e ListDeploymentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
+func (e ListDeploymentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
+func (e ListDeploymentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
+func (e ListDeploymentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
-	return "ListOrganizationMembersRequestValidationError"
+func (e ListDeploymentsRequestValidationError) ErrorName() string {
+	return "ListDeploymentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersRequestValidationError) Error() string {
+func (e ListDeploymentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6242,14 +6228,14 @@ func (e ListOrganizationMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersRequest.%s: %s%s",
+		"invalid %sListDeploymentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersRequestValidationError{}
+var _ error = ListDeploymentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6257,47 +6243,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersRequestValidationError{}
+} = ListDeploymentsRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListDeploymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersResponse) Validate() error {
+func (m *ListDeploymentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersResponseMultiError, or nil if none found.
-func (m *ListOrganizationMembersResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListDeploymentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentsResponseMultiError, or nil if none found.
+func (m *ListDeploymentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersResponse) validate(all bool) error {
+func (m *ListDeploymentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
+	for idx, item := range m.GetDeployments() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6305,8 +6291,8 @@ func (m *ListOrganizationMembersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListOrganizationMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
+				return ListDeploymentsResponseValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6315,22 +6301,20 @@ func (m *ListOrganizationMembersResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationMembersResponseMultiError(errors)
+		return ListDeploymentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationMembersResponseMultiError []error
+// ListDeploymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeploymentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersResponseMultiError) Error() string {
+func (m ListDeploymentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6339,12 +6323,11 @@ func (m ListOrganizationMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
+func (m ListDeploymentsResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersResponseValidationError is the validation error
-// returned by ListOrganizationMembersResponse.Validate if the designated
-// constraints aren't met.
-type ListOrganizationMembersResponseValidationError struct {
+// ListDeploymentsResponseValidationError is the validation error returned by
+// ListDeploymentsResponse.Validate if the designated constraints aren't met.
+type ListDeploymentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6352,24 +6335,24 @@ type ListOrganizationMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
+func (e ListDeploymentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
+func (e ListDeploymentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
+func (e ListDeploymentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
+func (e ListDeploymentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
-	return "ListOrganizationMembersResponseValidationError"
+func (e ListDeploymentsResponseValidationError) ErrorName() string {
+	return "ListDeploymentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersResponseValidationError) Error() string {
+func (e ListDeploymentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6381,14 +6364,14 @@ func (e ListOrganizationMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersResponse.%s: %s%s",
+		"invalid %sListDeploymentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersResponseValidationError{}
+var _ error = ListDeploymentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6396,24 +6379,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersResponseValidationError{}
+} = ListDeploymentsResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesRequest) Validate() error {
+func (m *CreateDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesRequestMultiError, or nil if none found.
-func (m *ListOrganizationInvitesRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDeploymentRequestMultiError, or nil if none found.
+func (m *CreateDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesRequest) validate(all bool) error {
+func (m *CreateDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6422,37 +6405,33 @@ func (m *ListOrganizationInvitesRequest) validate(all bool) error {
 
 	// no validation rules for Organization
 
-	if m.GetPageSize() != 0 {
+	// no validation rules for Project
 
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := CreateDeploymentRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationInvitesRequestMultiError(errors)
+		return CreateDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesRequestMultiError []error
+// CreateDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesRequestMultiError) Error() string {
+func (m CreateDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6461,12 +6440,11 @@ func (m ListOrganizationInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
+func (m CreateDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesRequestValidationError is the validation error
-// returned by ListOrganizationInvitesRequest.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesRequestValidationError struct {
+// CreateDeploymentRequestValidationError is the validation error returned by
+// CreateDeploymentRequest.Validate if the designated constraints aren't met.
+type CreateDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6474,24 +6452,24 @@ type ListOrganizationInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
+func (e CreateDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
+func (e CreateDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
+func (e CreateDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
+func (e CreateDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
-	return "ListOrganizationInvitesRequestValidationError"
+func (e CreateDeploymentRequestValidationError) ErrorName() string {
+	return "CreateDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesRequestValidationError) Error() string {
+func (e CreateDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6503,14 +6481,14 @@ func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
+		"invalid %sCreateDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesRequestValidationError{}
+var _ error = CreateDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6518,80 +6496,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesRequestValidationError{}
+} = CreateDeploymentRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesResponse) Validate() error {
+func (m *CreateDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesResponseMultiError, or nil if none found.
-func (m *ListOrganizationInvitesResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDeploymentResponseMultiError, or nil if none found.
+func (m *CreateDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesResponse) validate(all bool) error {
+func (m *CreateDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListOrganizationInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
+				errors = append(errors, CreateDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationInvitesResponseMultiError(errors)
+		return CreateDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesResponseMultiError []error
+// CreateDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesResponseMultiError) Error() string {
+func (m CreateDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6600,12 +6571,11 @@ func (m ListOrganizationInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
+func (m CreateDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesResponseValidationError is the validation error
-// returned by ListOrganizationInvitesResponse.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesResponseValidationError struct {
+// CreateDeploymentResponseValidationError is the validation error returned by
+// CreateDeploymentResponse.Validate if the designated constraints aren't met.
+type CreateDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6613,24 +6583,24 @@ type ListOrganizationInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
+func (e CreateDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
+func (e CreateDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
+func (e CreateDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
+func (e CreateDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
-	return "ListOrganizationInvitesResponseValidationError"
+func (e CreateDeploymentResponseValidationError) ErrorName() string {
+	return "CreateDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesResponseValidationError) Error() string {
+func (e CreateDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6642,14 +6612,14 @@ func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
+		"invalid %sCreateDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesResponseValidationError{}
+var _ error = CreateDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6657,119 +6627,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesResponseValidationError{}
+} = CreateDeploymentResponseValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberRequest) Validate() error {
+func (m *DeleteDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberRequest with the
+// ValidateAll checks the field values on DeleteDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberRequestMultiError, or nil if none found.
-func (m *AddOrganizationMemberRequest) ValidateAll() error {
+// DeleteDeploymentRequestMultiError, or nil if none found.
+func (m *DeleteDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberRequest) validate(all bool) error {
+func (m *DeleteDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddOrganizationMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for DeploymentId
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Role
-
-	if len(errors) > 0 {
-		return AddOrganizationMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+	if len(errors) > 0 {
+		return DeleteDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// AddOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AddOrganizationMemberRequestMultiError []error
+// DeleteDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberRequestMultiError) Error() string {
+func (m DeleteDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6778,12 +6675,11 @@ func (m AddOrganizationMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+func (m DeleteDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberRequestValidationError is the validation error returned
-// by AddOrganizationMemberRequest.Validate if the designated constraints
-// aren't met.
-type AddOrganizationMemberRequestValidationError struct {
+// DeleteDeploymentRequestValidationError is the validation error returned by
+// DeleteDeploymentRequest.Validate if the designated constraints aren't met.
+type DeleteDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6791,24 +6687,24 @@ type AddOrganizationMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
+func (e DeleteDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+func (e DeleteDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+func (e DeleteDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
+func (e DeleteDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
-	return "AddOrganizationMemberRequestValidationError"
+func (e DeleteDeploymentRequestValidationError) ErrorName() string {
+	return "DeleteDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberRequestValidationError) Error() string {
+func (e DeleteDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6820,14 +6716,14 @@ func (e AddOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
+		"invalid %sDeleteDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberRequestValidationError{}
+var _ error = DeleteDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6835,46 +6731,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberRequestValidationError{}
+} = DeleteDeploymentRequestValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberResponse) Validate() error {
+func (m *DeleteDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberResponseMultiError, or nil if none found.
-func (m *AddOrganizationMemberResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDeploymentResponseMultiError, or nil if none found.
+func (m *DeleteDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberResponse) validate(all bool) error {
+func (m *DeleteDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
-
 	if len(errors) > 0 {
-		return AddOrganizationMemberResponseMultiError(errors)
+		return DeleteDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
-// if the designated constraints aren't met.
-type AddOrganizationMemberResponseMultiError []error
+// DeleteDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberResponseMultiError) Error() string {
+func (m DeleteDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6883,12 +6777,11 @@ func (m AddOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m DeleteDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberResponseValidationError is the validation error
-// returned by AddOrganizationMemberResponse.Validate if the designated
-// constraints aren't met.
-type AddOrganizationMemberResponseValidationError struct {
+// DeleteDeploymentResponseValidationError is the validation error returned by
+// DeleteDeploymentResponse.Validate if the designated constraints aren't met.
+type DeleteDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6896,24 +6789,24 @@ type AddOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e DeleteDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e DeleteDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e DeleteDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e DeleteDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
-	return "AddOrganizationMemberResponseValidationError"
+func (e DeleteDeploymentResponseValidationError) ErrorName() string {
+	return "DeleteDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberResponseValidationError) Error() string {
+func (e DeleteDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6925,14 +6818,14 @@ func (e AddOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
+		"invalid %sDeleteDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberResponseValidationError{}
+var _ error = DeleteDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6940,119 +6833,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberResponseValidationError{}
+} = DeleteDeploymentResponseValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// Validate checks the field values on ListOrganizationMembersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveOrganizationMemberRequest) Validate() error {
+func (m *ListOrganizationMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// ValidateAll checks the field values on ListOrganizationMembersRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberRequestMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
+// ListOrganizationMembersRequestMultiError, or nil if none found.
+func (m *ListOrganizationMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
+func (m *ListOrganizationMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveOrganizationMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for KeepProjectRoles
-
-	if len(errors) > 0 {
-		return RemoveOrganizationMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Organization
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
 
-func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListOrganizationMembersRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// ListOrganizationMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveOrganizationMemberRequestMultiError []error
+type ListOrganizationMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberRequestMultiError) Error() string {
+func (m ListOrganizationMembersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7061,12 +6898,12 @@ func (m RemoveOrganizationMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberRequestValidationError is the validation error
-// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// ListOrganizationMembersRequestValidationError is the validation error
+// returned by ListOrganizationMembersRequest.Validate if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberRequestValidationError struct {
+type ListOrganizationMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7074,24 +6911,24 @@ type RemoveOrganizationMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberRequestValidationError"
+func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
+	return "ListOrganizationMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberRequestValidationError) Error() string {
+func (e ListOrganizationMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7103,14 +6940,14 @@ func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
+		"invalid %sListOrganizationMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberRequestValidationError{}
+var _ error = ListOrganizationMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7118,46 +6955,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberRequestValidationError{}
+} = ListOrganizationMembersRequestValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RemoveOrganizationMemberResponse) Validate() error {
+// Validate checks the field values on ListOrganizationMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// ValidateAll checks the field values on ListOrganizationMembersResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberResponseMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
+// ListOrganizationMembersResponseMultiError, or nil if none found.
+func (m *ListOrganizationMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
+func (m *ListOrganizationMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberResponseMultiError(errors)
+		return ListOrganizationMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// RemoveOrganizationMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveOrganizationMemberResponseMultiError []error
+// ListOrganizationMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberResponseMultiError) Error() string {
+func (m ListOrganizationMembersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7166,12 +7037,12 @@ func (m RemoveOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberResponseValidationError is the validation error
-// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// ListOrganizationMembersResponseValidationError is the validation error
+// returned by ListOrganizationMembersResponse.Validate if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberResponseValidationError struct {
+type ListOrganizationMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7179,24 +7050,24 @@ type RemoveOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberResponseValidationError"
+func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
+	return "ListOrganizationMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberResponseValidationError) Error() string {
+func (e ListOrganizationMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7208,14 +7079,14 @@ func (e RemoveOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
+		"invalid %sListOrganizationMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberResponseValidationError{}
+var _ error = ListOrganizationMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7223,55 +7094,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberResponseValidationError{}
+} = ListOrganizationMembersResponseValidationError{}
 
-// Validate checks the field values on LeaveOrganizationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOrganizationInvitesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationRequest) Validate() error {
+func (m *ListOrganizationInvitesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationRequestMultiError, or nil if none found.
-func (m *LeaveOrganizationRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationInvitesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationInvitesRequestMultiError, or nil if none found.
+func (m *ListOrganizationInvitesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationRequest) validate(all bool) error {
+func (m *ListOrganizationInvitesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := LeaveOrganizationRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Organization
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return LeaveOrganizationRequestMultiError(errors)
+		return ListOrganizationInvitesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type LeaveOrganizationRequestMultiError []error
+// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationInvitesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationRequestMultiError) Error() string {
+func (m ListOrganizationInvitesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7280,11 +7159,12 @@ func (m LeaveOrganizationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationRequestValidationError is the validation error returned by
-// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
-type LeaveOrganizationRequestValidationError struct {
+// ListOrganizationInvitesRequestValidationError is the validation error
+// returned by ListOrganizationInvitesRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationInvitesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7292,24 +7172,24 @@ type LeaveOrganizationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationRequestValidationError) ErrorName() string {
-	return "LeaveOrganizationRequestValidationError"
+func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
+	return "ListOrganizationInvitesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationRequestValidationError) Error() string {
+func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7321,14 +7201,14 @@ func (e LeaveOrganizationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationRequest.%s: %s%s",
+		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationRequestValidationError{}
+var _ error = ListOrganizationInvitesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7336,44 +7216,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationRequestValidationError{}
+} = ListOrganizationInvitesRequestValidationError{}
 
-// Validate checks the field values on LeaveOrganizationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOrganizationInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationResponse) Validate() error {
+func (m *ListOrganizationInvitesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationResponseMultiError, or nil if none found.
-func (m *LeaveOrganizationResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationInvitesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationInvitesResponseMultiError, or nil if none found.
+func (m *ListOrganizationInvitesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationResponse) validate(all bool) error {
+func (m *ListOrganizationInvitesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return LeaveOrganizationResponseMultiError(errors)
+		return ListOrganizationInvitesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationResponse.ValidateAll() if the
-// designated constraints aren't met.
-type LeaveOrganizationResponseMultiError []error
+// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationInvitesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationResponseMultiError) Error() string {
+func (m ListOrganizationInvitesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7382,11 +7298,12 @@ func (m LeaveOrganizationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationResponseValidationError is the validation error returned by
-// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
-type LeaveOrganizationResponseValidationError struct {
+// ListOrganizationInvitesResponseValidationError is the validation error
+// returned by ListOrganizationInvitesResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationInvitesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7394,24 +7311,24 @@ type LeaveOrganizationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationResponseValidationError) ErrorName() string {
-	return "LeaveOrganizationResponseValidationError"
+func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
+	return "ListOrganizationInvitesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationResponseValidationError) Error() string {
+func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7423,14 +7340,14 @@ func (e LeaveOrganizationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationResponse.%s: %s%s",
+		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationResponseValidationError{}
+var _ error = ListOrganizationInvitesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7438,25 +7355,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationResponseValidationError{}
+} = ListOrganizationInvitesResponseValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetOrganizationMemberRoleRequest) Validate() error {
+// Validate checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
+func (m *AddOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7464,7 +7380,7 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
+		err := AddOrganizationMemberRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -7475,7 +7391,7 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	}
 
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetOrganizationMemberRoleRequestValidationError{
+		err = AddOrganizationMemberRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -7486,25 +7402,16 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return SetOrganizationMemberRoleRequestMultiError(errors)
+		return AddOrganizationMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
+func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -7534,7 +7441,7 @@ func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error
 	return nil
 }
 
-func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
+func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -7554,14 +7461,13 @@ func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleRequestMultiError []error
+// AddOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
+func (m AddOrganizationMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7570,12 +7476,12 @@ func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleRequestValidationError is the validation error
-// returned by SetOrganizationMemberRoleRequest.Validate if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleRequestValidationError struct {
+// AddOrganizationMemberRequestValidationError is the validation error returned
+// by AddOrganizationMemberRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7583,24 +7489,24 @@ type SetOrganizationMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleRequestValidationError"
+func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
+func (e AddOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7612,14 +7518,14 @@ func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
+		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleRequestValidationError{}
+var _ error = AddOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7627,46 +7533,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleRequestValidationError{}
+} = AddOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetOrganizationMemberRoleResponse) Validate() error {
+// Validate checks the field values on AddOrganizationMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
+func (m *AddOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PendingSignup
+
 	if len(errors) > 0 {
-		return SetOrganizationMemberRoleResponseMultiError(errors)
+		return AddOrganizationMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleResponseMultiError []error
+// AddOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddOrganizationMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
+func (m AddOrganizationMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7675,12 +7581,12 @@ func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleResponseValidationError is the validation error
-// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// AddOrganizationMemberResponseValidationError is the validation error
+// returned by AddOrganizationMemberResponse.Validate if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleResponseValidationError struct {
+type AddOrganizationMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7688,24 +7594,24 @@ type SetOrganizationMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleResponseValidationError"
+func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
+func (e AddOrganizationMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7717,14 +7623,14 @@ func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
+		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleResponseValidationError{}
+var _ error = AddOrganizationMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7732,180 +7638,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleResponseValidationError{}
+} = AddOrganizationMemberResponseValidationError{}
 
-// Validate checks the field values on ListSuperusersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersRequest) Validate() error {
+func (m *RemoveOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSuperusersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListSuperusersRequestMultiError, or nil if none found.
-func (m *ListSuperusersRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSuperusersRequest) validate(all bool) error {
+func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return ListSuperusersRequestMultiError(errors)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveOrganizationMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveOrganizationMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// ListSuperusersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListSuperusersRequestMultiError []error
+	// no validation rules for KeepProjectRoles
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberRequestMultiError(errors)
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
-
-// ListSuperusersRequestValidationError is the validation error returned by
-// ListSuperusersRequest.Validate if the designated constraints aren't met.
-type ListSuperusersRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
+	return nil
 }
 
-// Field function returns field value.
-func (e ListSuperusersRequestValidationError) Field() string { return e.field }
+func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-// Reason function returns reason value.
-func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
 
-// Cause function returns cause value.
-func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
 
-// Key function returns key value.
-func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
 
-// ErrorName returns error name.
-func (e ListSuperusersRequestValidationError) ErrorName() string {
-	return "ListSuperusersRequestValidationError"
-}
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
 
-// Error satisfies the builtin error interface
-func (e ListSuperusersRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sListSuperusersRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ListSuperusersRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListSuperusersRequestValidationError{}
-
-// Validate checks the field values on ListSuperusersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListSuperusersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListSuperusersResponseMultiError, or nil if none found.
-func (m *ListSuperusersResponse) ValidateAll() error {
-	return m.validate(true)
+	return nil
 }
 
-func (m *ListSuperusersResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
 	}
+	addr = a.Address
 
-	var errors []error
-
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSuperusersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	if len(errors) > 0 {
-		return ListSuperusersResponseMultiError(errors)
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// ListSuperusersResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListSuperusersResponseMultiError []error
+// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersResponseMultiError) Error() string {
+func (m RemoveOrganizationMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7914,11 +7759,12 @@ func (m ListSuperusersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// ListSuperusersResponseValidationError is the validation error returned by
-// ListSuperusersResponse.Validate if the designated constraints aren't met.
-type ListSuperusersResponseValidationError struct {
+// RemoveOrganizationMemberRequestValidationError is the validation error
+// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7926,24 +7772,24 @@ type ListSuperusersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSuperusersResponseValidationError) ErrorName() string {
-	return "ListSuperusersResponseValidationError"
+func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSuperusersResponseValidationError) Error() string {
+func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7955,14 +7801,14 @@ func (e ListSuperusersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSuperusersResponse.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSuperusersResponseValidationError{}
+var _ error = RemoveOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7970,108 +7816,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSuperusersResponseValidationError{}
+} = RemoveOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserRequest) Validate() error {
+// Validate checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetSuperuserRequestMultiError, or nil if none found.
-func (m *SetSuperuserRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserRequest) validate(all bool) error {
+func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetSuperuserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Superuser
-
 	if len(errors) > 0 {
-		return SetSuperuserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *SetSuperuserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return RemoveOrganizationMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetSuperuserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// SetSuperuserRequestMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserRequest.ValidateAll() if the designated
+// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveOrganizationMemberResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetSuperuserRequestMultiError []error
+type RemoveOrganizationMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserRequestMultiError) Error() string {
+func (m RemoveOrganizationMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8080,11 +7864,12 @@ func (m SetSuperuserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
 
-// SetSuperuserRequestValidationError is the validation error returned by
-// SetSuperuserRequest.Validate if the designated constraints aren't met.
-type SetSuperuserRequestValidationError struct {
+// RemoveOrganizationMemberResponseValidationError is the validation error
+// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8092,24 +7877,24 @@ type SetSuperuserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserRequestValidationError) ErrorName() string {
-	return "SetSuperuserRequestValidationError"
+func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserRequestValidationError) Error() string {
+func (e RemoveOrganizationMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8121,14 +7906,14 @@ func (e SetSuperuserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserRequest.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserRequestValidationError{}
+var _ error = RemoveOrganizationMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8136,44 +7921,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserRequestValidationError{}
+} = RemoveOrganizationMemberResponseValidationError{}
 
-// Validate checks the field values on SetSuperuserResponse with the rules
+// Validate checks the field values on LeaveOrganizationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserResponse) Validate() error {
+func (m *LeaveOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeaveOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetSuperuserResponseMultiError, or nil if none found.
-func (m *SetSuperuserResponse) ValidateAll() error {
+// LeaveOrganizationRequestMultiError, or nil if none found.
+func (m *LeaveOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserResponse) validate(all bool) error {
+func (m *LeaveOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := LeaveOrganizationRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return SetSuperuserResponseMultiError(errors)
+		return LeaveOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetSuperuserResponseMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserResponse.ValidateAll() if the designated
+// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetSuperuserResponseMultiError []error
+type LeaveOrganizationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserResponseMultiError) Error() string {
+func (m LeaveOrganizationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8182,11 +7978,11 @@ func (m SetSuperuserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
 
-// SetSuperuserResponseValidationError is the validation error returned by
-// SetSuperuserResponse.Validate if the designated constraints aren't met.
-type SetSuperuserResponseValidationError struct {
+// LeaveOrganizationRequestValidationError is the validation error returned by
+// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
+type LeaveOrganizationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8194,24 +7990,24 @@ type SetSuperuserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserResponseValidationError) ErrorName() string {
-	return "SetSuperuserResponseValidationError"
+func (e LeaveOrganizationRequestValidationError) ErrorName() string {
+	return "LeaveOrganizationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserResponseValidationError) Error() string {
+func (e LeaveOrganizationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8223,14 +8019,14 @@ func (e SetSuperuserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserResponse.%s: %s%s",
+		"invalid %sLeaveOrganizationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserResponseValidationError{}
+var _ error = LeaveOrganizationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8238,109 +8034,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserResponseValidationError{}
+} = LeaveOrganizationRequestValidationError{}
 
-// Validate checks the field values on SudoGetResourceRequest with the rules
+// Validate checks the field values on LeaveOrganizationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceRequest) Validate() error {
+func (m *LeaveOrganizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeaveOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoGetResourceRequestMultiError, or nil if none found.
-func (m *SudoGetResourceRequest) ValidateAll() error {
+// LeaveOrganizationResponseMultiError, or nil if none found.
+func (m *LeaveOrganizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceRequest) validate(all bool) error {
+func (m *LeaveOrganizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *SudoGetResourceRequest_UserId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for UserId
-	case *SudoGetResourceRequest_OrgId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for OrgId
-	case *SudoGetResourceRequest_ProjectId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for ProjectId
-	case *SudoGetResourceRequest_DeploymentId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for DeploymentId
-	case *SudoGetResourceRequest_InstanceId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for InstanceId
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return SudoGetResourceRequestMultiError(errors)
+		return LeaveOrganizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoGetResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SudoGetResourceRequestMultiError []error
+// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type LeaveOrganizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceRequestMultiError) Error() string {
+func (m LeaveOrganizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8349,11 +8080,11 @@ func (m SudoGetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceRequestValidationError is the validation error returned by
-// SudoGetResourceRequest.Validate if the designated constraints aren't met.
-type SudoGetResourceRequestValidationError struct {
+// LeaveOrganizationResponseValidationError is the validation error returned by
+// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
+type LeaveOrganizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8361,24 +8092,24 @@ type SudoGetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceRequestValidationError) ErrorName() string {
-	return "SudoGetResourceRequestValidationError"
+func (e LeaveOrganizationResponseValidationError) ErrorName() string {
+	return "LeaveOrganizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceRequestValidationError) Error() string {
+func (e LeaveOrganizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8390,14 +8121,14 @@ func (e SudoGetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		"invalid %sLeaveOrganizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceRequestValidationError{}
+var _ error = LeaveOrganizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8405,254 +8136,130 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceRequestValidationError{}
+} = LeaveOrganizationResponseValidationError{}
 
-// Validate checks the field values on SudoGetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceResponse) Validate() error {
+// Validate checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetOrganizationMemberRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SudoGetResourceResponseMultiError, or nil if none found.
-func (m *SudoGetResourceResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceResponse) validate(all bool) error {
+func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Resource.(type) {
-	case *SudoGetResourceResponse_User:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-
-		if all {
-			switch v := interface{}(m.GetUser()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *SudoGetResourceResponse_Org:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetOrganizationMemberRoleRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
-
-		if all {
-			switch v := interface{}(m.GetOrg()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Org",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Org",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetOrg()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Org",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *SudoGetResourceResponse_Project:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
 		}
-
-		if all {
-			switch v := interface{}(m.GetProject()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *SudoGetResourceResponse_Deployment:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if len(errors) > 0 {
+		return SetOrganizationMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
 		}
 
-		if all {
-			switch v := interface{}(m.GetDeployment()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
 		}
 
-	case *SudoGetResourceResponse_Instance:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
 		}
 
-		if all {
-			switch v := interface{}(m.GetInstance()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
 			}
 		}
+	}
 
-	default:
-		_ = v // ensures v is used
+	return nil
+}
+
+func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	if len(errors) > 0 {
-		return SudoGetResourceResponseMultiError(errors)
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// SudoGetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
+// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SudoGetResourceResponseMultiError []error
+type SetOrganizationMemberRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceResponseMultiError) Error() string {
+func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8661,11 +8268,12 @@ func (m SudoGetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceResponseValidationError is the validation error returned by
-// SudoGetResourceResponse.Validate if the designated constraints aren't met.
-type SudoGetResourceResponseValidationError struct {
+// SetOrganizationMemberRoleRequestValidationError is the validation error
+// returned by SetOrganizationMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8673,24 +8281,24 @@ type SudoGetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceResponseValidationError) ErrorName() string {
-	return "SudoGetResourceResponseValidationError"
+func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceResponseValidationError) Error() string {
+func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8702,14 +8310,14 @@ func (e SudoGetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceResponseValidationError{}
+var _ error = SetOrganizationMemberRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8717,68 +8325,7086 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceResponseValidationError{}
+} = SetOrganizationMemberRoleRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
+// Validate checks the field values on SetOrganizationMemberRoleResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+func (m *SetOrganizationMemberRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
+// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrgName
-
+	if len(errors) > 0 {
+		return SetOrganizationMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberRoleResponseValidationError is the validation error
+// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberRoleResponseValidationError{}
+
+// Validate checks the field values on ListSuperusersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuperusersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuperusersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuperusersRequestMultiError, or nil if none found.
+func (m *ListSuperusersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuperusersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSuperusersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuperusersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuperusersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuperusersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
+
+// ListSuperusersRequestValidationError is the validation error returned by
+// ListSuperusersRequest.Validate if the designated constraints aren't met.
+type ListSuperusersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuperusersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuperusersRequestValidationError) ErrorName() string {
+	return "ListSuperusersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuperusersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuperusersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuperusersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuperusersRequestValidationError{}
+
+// Validate checks the field values on ListSuperusersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuperusersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuperusersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuperusersResponseMultiError, or nil if none found.
+func (m *ListSuperusersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuperusersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSuperusersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSuperusersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuperusersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuperusersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuperusersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+
+// ListSuperusersResponseValidationError is the validation error returned by
+// ListSuperusersResponse.Validate if the designated constraints aren't met.
+type ListSuperusersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuperusersResponseValidationError) ErrorName() string {
+	return "ListSuperusersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuperusersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuperusersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuperusersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuperusersResponseValidationError{}
+
+// Validate checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserRequestMultiError, or nil if none found.
+func (m *SetSuperuserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetSuperuserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Superuser
+
+	if len(errors) > 0 {
+		return SetSuperuserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetSuperuserRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+
+// SetSuperuserRequestValidationError is the validation error returned by
+// SetSuperuserRequest.Validate if the designated constraints aren't met.
+type SetSuperuserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserRequestValidationError) ErrorName() string {
+	return "SetSuperuserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserRequestValidationError{}
+
+// Validate checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserResponseMultiError, or nil if none found.
+func (m *SetSuperuserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetSuperuserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSuperuserResponseMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+
+// SetSuperuserResponseValidationError is the validation error returned by
+// SetSuperuserResponse.Validate if the designated constraints aren't met.
+type SetSuperuserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserResponseValidationError) ErrorName() string {
+	return "SetSuperuserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserResponseValidationError{}
+
+// Validate checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceRequestMultiError, or nil if none found.
+func (m *SudoGetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *SudoGetResourceRequest_UserId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for UserId
+	case *SudoGetResourceRequest_OrgId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for OrgId
+	case *SudoGetResourceRequest_ProjectId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ProjectId
+	case *SudoGetResourceRequest_DeploymentId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for DeploymentId
+	case *SudoGetResourceRequest_InstanceId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for InstanceId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceRequestValidationError is the validation error returned by
+// SudoGetResourceRequest.Validate if the designated constraints aren't met.
+type SudoGetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceRequestValidationError) ErrorName() string {
+	return "SudoGetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceRequestValidationError{}
+
+// Validate checks the field values on SudoGetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceResponseMultiError, or nil if none found.
+func (m *SudoGetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Resource.(type) {
+	case *SudoGetResourceResponse_User:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUser()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Org:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrg()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Org",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Org",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrg()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Org",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Project:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Deployment:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeployment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Instance:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInstance()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceResponseValidationError is the validation error returned by
+// SudoGetResourceResponse.Validate if the designated constraints aren't met.
+type SudoGetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceResponseValidationError) ErrorName() string {
+	return "SudoGetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgName
+
 	if m.Projects != nil {
 		// no validation rules for Projects
 	}
 
-	if m.Deployments != nil {
-		// no validation rules for Deployments
+	if m.Deployments != nil {
+		// no validation rules for Deployments
+	}
+
+	if m.SlotsTotal != nil {
+		// no validation rules for SlotsTotal
+	}
+
+	if m.SlotsPerDeployment != nil {
+		// no validation rules for SlotsPerDeployment
+	}
+
+	if m.OutstandingInvites != nil {
+		// no validation rules for OutstandingInvites
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateOrganizationQuotasResponseValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if m.SingleuserOrgs != nil {
+		// no validation rules for SingleuserOrgs
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasRequestValidationError is the validation error returned
+// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateUserQuotasResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasResponseValidationError is the validation error returned
+// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateAnnotationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateAnnotationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateAnnotationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateAnnotationsRequestMultiError, or nil if none found.
+func (m *SudoUpdateAnnotationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateAnnotationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SudoUpdateAnnotationsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SudoUpdateAnnotationsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Annotations
+
+	if len(errors) > 0 {
+		return SudoUpdateAnnotationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateAnnotationsRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateAnnotationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateAnnotationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateAnnotationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateAnnotationsRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateAnnotationsRequestValidationError is the validation error returned
+// by SudoUpdateAnnotationsRequest.Validate if the designated constraints
+// aren't met.
+type SudoUpdateAnnotationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateAnnotationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateAnnotationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateAnnotationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateAnnotationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateAnnotationsRequestValidationError) ErrorName() string {
+	return "SudoUpdateAnnotationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateAnnotationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateAnnotationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateAnnotationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateAnnotationsRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateAnnotationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateAnnotationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateAnnotationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SudoUpdateAnnotationsResponseMultiError, or nil if none found.
+func (m *SudoUpdateAnnotationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateAnnotationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateAnnotationsResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateAnnotationsResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateAnnotationsResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateAnnotationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateAnnotationsResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateAnnotationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SudoUpdateAnnotationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateAnnotationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateAnnotationsResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateAnnotationsResponseValidationError is the validation error
+// returned by SudoUpdateAnnotationsResponse.Validate if the designated
+// constraints aren't met.
+type SudoUpdateAnnotationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateAnnotationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateAnnotationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateAnnotationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateAnnotationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateAnnotationsResponseValidationError) ErrorName() string {
+	return "SudoUpdateAnnotationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateAnnotationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateAnnotationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateAnnotationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateAnnotationsResponseValidationError{}
+
+// Validate checks the field values on ListProjectMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersRequestMultiError, or nil if none found.
+func (m *ListProjectMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersRequestValidationError is the validation error returned by
+// ListProjectMembersRequest.Validate if the designated constraints aren't met.
+type ListProjectMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersRequestValidationError) ErrorName() string {
+	return "ListProjectMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersRequestValidationError{}
+
+// Validate checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersResponseMultiError, or nil if none found.
+func (m *ListProjectMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersResponseValidationError is the validation error returned
+// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
+type ListProjectMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersResponseValidationError) ErrorName() string {
+	return "ListProjectMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersResponseValidationError{}
+
+// Validate checks the field values on ListProjectInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectInvitesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectInvitesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesRequestMultiError, or nil if none found.
+func (m *ListProjectInvitesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectInvitesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectInvitesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectInvitesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectInvitesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectInvitesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectInvitesRequestValidationError is the validation error returned by
+// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
+type ListProjectInvitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectInvitesRequestValidationError) ErrorName() string {
+	return "ListProjectInvitesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectInvitesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectInvitesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectInvitesRequestValidationError{}
+
+// Validate checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectInvitesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesResponseMultiError, or nil if none found.
+func (m *ListProjectInvitesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectInvitesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectInvitesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectInvitesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectInvitesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectInvitesResponseValidationError is the validation error returned
+// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
+type ListProjectInvitesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectInvitesResponseValidationError) ErrorName() string {
+	return "ListProjectInvitesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectInvitesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectInvitesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectInvitesResponseValidationError{}
+
+// Validate checks the field values on AddProjectMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectMemberRequestMultiError, or nil if none found.
+func (m *AddProjectMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddProjectMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddProjectMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberRequestValidationError is the validation error returned by
+// AddProjectMemberRequest.Validate if the designated constraints aren't met.
+type AddProjectMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberRequestValidationError) ErrorName() string {
+	return "AddProjectMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberRequestValidationError{}
+
+// Validate checks the field values on AddProjectMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectMemberResponseMultiError, or nil if none found.
+func (m *AddProjectMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PendingSignup
+
+	if len(errors) > 0 {
+		return AddProjectMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberResponseValidationError is the validation error returned by
+// AddProjectMemberResponse.Validate if the designated constraints aren't met.
+type AddProjectMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberResponseValidationError) ErrorName() string {
+	return "AddProjectMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveProjectMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberRequestValidationError is the validation error returned
+// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
+type RemoveProjectMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberResponseValidationError is the validation error returned
+// by RemoveProjectMemberResponse.Validate if the designated constraints
+// aren't met.
+type RemoveProjectMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberResponseValidationError{}
+
+// Validate checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetProjectMemberRoleRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleRequestValidationError is the validation error returned
+// by SetProjectMemberRoleRequest.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleRequestValidationError{}
+
+// Validate checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleResponseValidationError is the validation error returned
+// by SetProjectMemberRoleResponse.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleResponseValidationError{}
+
+// Validate checks the field values on ListOrganizationUsergroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListOrganizationUsergroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListOrganizationUsergroupsRequestMultiError, or nil if none found.
+func (m *ListOrganizationUsergroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationUsergroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListOrganizationUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListOrganizationUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationUsergroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListOrganizationUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationUsergroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationUsergroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListOrganizationUsergroupsRequestValidationError is the validation error
+// returned by ListOrganizationUsergroupsRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationUsergroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationUsergroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationUsergroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationUsergroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationUsergroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationUsergroupsRequestValidationError) ErrorName() string {
+	return "ListOrganizationUsergroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationUsergroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationUsergroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationUsergroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationUsergroupsRequestValidationError{}
+
+// Validate checks the field values on ListOrganizationUsergroupsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListOrganizationUsergroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListOrganizationUsergroupsResponseMultiError, or nil if none found.
+func (m *ListOrganizationUsergroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationUsergroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsergroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Usergroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOrganizationUsergroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationUsergroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListOrganizationUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationUsergroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationUsergroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationUsergroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListOrganizationUsergroupsResponseValidationError is the validation error
+// returned by ListOrganizationUsergroupsResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationUsergroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationUsergroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationUsergroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationUsergroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationUsergroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationUsergroupsResponseValidationError) ErrorName() string {
+	return "ListOrganizationUsergroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationUsergroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationUsergroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationUsergroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationUsergroupsResponseValidationError{}
+
+// Validate checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupRequestMultiError, or nil if none found.
+func (m *CreateUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateUsergroupRequestValidationError is the validation error returned by
+// CreateUsergroupRequest.Validate if the designated constraints aren't met.
+type CreateUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUsergroupRequestValidationError) ErrorName() string {
+	return "CreateUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUsergroupRequestValidationError{}
+
+// Validate checks the field values on CreateUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupResponseMultiError, or nil if none found.
+func (m *CreateUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateUsergroupResponseValidationError is the validation error returned by
+// CreateUsergroupResponse.Validate if the designated constraints aren't met.
+type CreateUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUsergroupResponseValidationError) ErrorName() string {
+	return "CreateUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUsergroupResponseValidationError{}
+
+// Validate checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameUsergroupRequestMultiError, or nil if none found.
+func (m *RenameUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// RenameUsergroupRequestValidationError is the validation error returned by
+// RenameUsergroupRequest.Validate if the designated constraints aren't met.
+type RenameUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameUsergroupRequestValidationError) ErrorName() string {
+	return "RenameUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameUsergroupRequestValidationError{}
+
+// Validate checks the field values on RenameUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameUsergroupResponseMultiError, or nil if none found.
+func (m *RenameUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// RenameUsergroupResponseValidationError is the validation error returned by
+// RenameUsergroupResponse.Validate if the designated constraints aren't met.
+type RenameUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameUsergroupResponseValidationError) ErrorName() string {
+	return "RenameUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameUsergroupResponseValidationError{}
+
+// Validate checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupRequestMultiError, or nil if none found.
+func (m *DeleteUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUsergroupRequestValidationError is the validation error returned by
+// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
+type DeleteUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUsergroupRequestValidationError) ErrorName() string {
+	return "DeleteUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUsergroupRequestValidationError{}
+
+// Validate checks the field values on DeleteUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupResponseMultiError, or nil if none found.
+func (m *DeleteUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// DeleteUsergroupResponseValidationError is the validation error returned by
+// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
+type DeleteUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUsergroupResponseValidationError) ErrorName() string {
+	return "DeleteUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUsergroupResponseValidationError{}
+
+// Validate checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupMembersRequestMultiError, or nil if none found.
+func (m *ListUsergroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMembersRequestValidationError is the validation error returned
+// by ListUsergroupMembersRequest.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMembersRequestValidationError) ErrorName() string {
+	return "ListUsergroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMembersRequestValidationError{}
+
+// Validate checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupMembersResponseMultiError, or nil if none found.
+func (m *ListUsergroupMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMembersResponseValidationError is the validation error returned
+// by ListUsergroupMembersResponse.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMembersResponseValidationError) ErrorName() string {
+	return "ListUsergroupMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMembersResponseValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberRequestMultiError, or nil if none found.
+func (m *AddUsergroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddUsergroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddUsergroupMemberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AddUsergroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberRequestValidationError is the validation error returned by
+// AddUsergroupMemberRequest.Validate if the designated constraints aren't met.
+type AddUsergroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberRequestValidationError) ErrorName() string {
+	return "AddUsergroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberRequestValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberResponseMultiError, or nil if none found.
+func (m *AddUsergroupMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddUsergroupMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberResponseValidationError is the validation error returned
+// by AddUsergroupMemberResponse.Validate if the designated constraints aren't met.
+type AddUsergroupMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberResponseValidationError) ErrorName() string {
+	return "AddUsergroupMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUsergroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveUsergroupMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveUsergroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberRequestValidationError is the validation error returned
+// by RemoveUsergroupMemberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveUsergroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUsergroupMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveUsergroupMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberResponseValidationError is the validation error
+// returned by RemoveUsergroupMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberResponseValidationError{}
+
+// Validate checks the field values on ListProjectMemberUsergroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectMemberUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectMemberUsergroupsRequestValidationError is the validation error
+// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMemberUsergroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMemberUsergroupsRequestValidationError{}
+
+// Validate checks the field values on ListProjectMemberUsergroupsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsergroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Usergroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectMemberUsergroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectMemberUsergroupsResponseValidationError is the validation error
+// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMemberUsergroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMemberUsergroupsResponseValidationError{}
+
+// Validate checks the field values on SetUsergroupProjectRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUsergroupProjectRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUsergroupProjectRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetUsergroupProjectRoleRequestMultiError, or nil if none found.
+func (m *SetUsergroupProjectRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUsergroupProjectRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetUsergroupProjectRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetUsergroupProjectRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetUsergroupProjectRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetUsergroupProjectRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetUsergroupProjectRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUsergroupProjectRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetUsergroupProjectRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetUsergroupProjectRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUsergroupProjectRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUsergroupProjectRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetUsergroupProjectRoleRequestValidationError is the validation error
+// returned by SetUsergroupProjectRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetUsergroupProjectRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUsergroupProjectRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUsergroupProjectRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUsergroupProjectRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUsergroupProjectRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUsergroupProjectRoleRequestValidationError) ErrorName() string {
+	return "SetUsergroupProjectRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetUsergroupProjectRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUsergroupProjectRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUsergroupProjectRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUsergroupProjectRoleRequestValidationError{}
+
+// Validate checks the field values on SetUsergroupProjectRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUsergroupProjectRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUsergroupProjectRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetUsergroupProjectRoleResponseMultiError, or nil if none found.
+func (m *SetUsergroupProjectRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUsergroupProjectRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetUsergroupProjectRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUsergroupProjectRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetUsergroupProjectRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetUsergroupProjectRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUsergroupProjectRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUsergroupProjectRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetUsergroupProjectRoleResponseValidationError is the validation error
+// returned by SetUsergroupProjectRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetUsergroupProjectRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUsergroupProjectRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUsergroupProjectRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUsergroupProjectRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUsergroupProjectRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUsergroupProjectRoleResponseValidationError) ErrorName() string {
+	return "SetUsergroupProjectRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetUsergroupProjectRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUsergroupProjectRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUsergroupProjectRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUsergroupProjectRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveUsergroupProjectRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupProjectRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupProjectRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupProjectRoleRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupProjectRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupProjectRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupProjectRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveUsergroupProjectRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupProjectRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveUsergroupProjectRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUsergroupProjectRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupProjectRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupProjectRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupProjectRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupProjectRoleRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupProjectRoleRequestValidationError is the validation error
+// returned by RemoveUsergroupProjectRoleRequest.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupProjectRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupProjectRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupProjectRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupProjectRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupProjectRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupProjectRoleRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupProjectRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupProjectRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupProjectRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupProjectRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupProjectRoleRequestValidationError{}
+
+// Validate checks the field values on RemoveUsergroupProjectRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupProjectRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupProjectRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupProjectRoleResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupProjectRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupProjectRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveUsergroupProjectRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUsergroupProjectRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupProjectRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupProjectRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupProjectRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupProjectRoleResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupProjectRoleResponseValidationError is the validation error
+// returned by RemoveUsergroupProjectRoleResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupProjectRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupProjectRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupProjectRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupProjectRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupProjectRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupProjectRoleResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupProjectRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupProjectRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupProjectRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupProjectRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupProjectRoleResponseValidationError{}
+
+// Validate checks the field values on ListOrganizationRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrganizationRolesRequestMultiError, or nil if none found.
+func (m *ListOrganizationRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListOrganizationRolesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListOrganizationRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationRolesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationRolesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListOrganizationRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationRolesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationRolesRequestMultiError) AllErrors() []error { return m }
+
+// ListOrganizationRolesRequestValidationError is the validation error returned
+// by ListOrganizationRolesRequest.Validate if the designated constraints
+// aren't met.
+type ListOrganizationRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationRolesRequestValidationError) ErrorName() string {
+	return "ListOrganizationRolesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationRolesRequestValidationError{}
+
+// Validate checks the field values on ListOrganizationRolesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationRolesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationRolesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationRolesResponseMultiError, or nil if none found.
+func (m *ListOrganizationRolesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationRolesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOrganizationRolesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationRolesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationRolesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationRolesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationRolesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationRolesResponseMultiError) AllErrors() []error { return m }
+
+// ListOrganizationRolesResponseValidationError is the validation error
+// returned by ListOrganizationRolesResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationRolesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationRolesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationRolesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationRolesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationRolesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationRolesResponseValidationError) ErrorName() string {
+	return "ListOrganizationRolesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationRolesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationRolesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationRolesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationRolesResponseValidationError{}
+
+// Validate checks the field values on CreateOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateOrganizationRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateOrganizationRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPermissions() == nil {
+		err := CreateOrganizationRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateOrganizationRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationRoleRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationRoleRequestValidationError is the validation error
+// returned by CreateOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationRoleRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationRoleRequestValidationError{}
+
+// Validate checks the field values on CreateOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleResponseMultiError, or nil if none found.
+func (m *CreateOrganizationRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateOrganizationRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationRoleResponseMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationRoleResponseValidationError is the validation error
+// returned by CreateOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationRoleResponseValidationError) ErrorName() string {
+	return "CreateOrganizationRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationRoleResponseValidationError{}
+
+// Validate checks the field values on UpdateOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrganizationRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOrganizationRoleRequestMultiError, or nil if none found.
+func (m *UpdateOrganizationRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrganizationRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := UpdateOrganizationRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateOrganizationRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPermissions() == nil {
+		err := UpdateOrganizationRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrganizationRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrganizationRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOrganizationRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrganizationRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrganizationRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOrganizationRoleRequestValidationError is the validation error
+// returned by UpdateOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type UpdateOrganizationRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrganizationRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrganizationRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrganizationRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrganizationRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrganizationRoleRequestValidationError) ErrorName() string {
+	return "UpdateOrganizationRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrganizationRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrganizationRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrganizationRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrganizationRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrganizationRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOrganizationRoleResponseMultiError, or nil if none found.
+func (m *UpdateOrganizationRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrganizationRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrganizationRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrganizationRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOrganizationRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrganizationRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrganizationRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateOrganizationRoleResponseValidationError is the validation error
+// returned by UpdateOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type UpdateOrganizationRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrganizationRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrganizationRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrganizationRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrganizationRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrganizationRoleResponseValidationError) ErrorName() string {
+	return "UpdateOrganizationRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrganizationRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.SlotsTotal != nil {
-		// no validation rules for SlotsTotal
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.SlotsPerDeployment != nil {
-		// no validation rules for SlotsPerDeployment
+	return fmt.Sprintf(
+		"invalid %sUpdateOrganizationRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrganizationRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrganizationRoleResponseValidationError{}
+
+// Validate checks the field values on DeleteOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOrganizationRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationRoleRequestMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOrganizationRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.OutstandingInvites != nil {
-		// no validation rules for OutstandingInvites
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteOrganizationRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteOrganizationRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteOrganizationRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteOrganizationRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOrganizationRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOrganizationRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteOrganizationRoleRequestValidationError is the validation error
+// returned by DeleteOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOrganizationRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOrganizationRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOrganizationRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOrganizationRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOrganizationRoleRequestValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOrganizationRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOrganizationRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOrganizationRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOrganizationRoleRequestValidationError{}
+
+// Validate checks the field values on DeleteOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOrganizationRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationRoleResponseMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOrganizationRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteOrganizationRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteOrganizationRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOrganizationRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOrganizationRoleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteOrganizationRoleResponseValidationError is the validation error
+// returned by DeleteOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOrganizationRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOrganizationRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOrganizationRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOrganizationRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOrganizationRoleResponseValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOrganizationRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOrganizationRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOrganizationRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOrganizationRoleResponseValidationError{}
+
+// Validate checks the field values on ListProjectRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectRolesRequestMultiError, or nil if none found.
+func (m *ListProjectRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectRolesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+		return ListProjectRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
+// ListProjectRolesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectRolesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestMultiError []error
+type ListProjectRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+func (m ListProjectRolesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8787,12 +15413,11 @@ func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectRolesRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestValidationError struct {
+// ListProjectRolesRequestValidationError is the validation error returned by
+// ListProjectRolesRequest.Validate if the designated constraints aren't met.
+type ListProjectRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8800,24 +15425,24 @@ type SudoUpdateOrganizationQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+func (e ListProjectRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+func (e ListProjectRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasRequestValidationError"
+func (e ListProjectRolesRequestValidationError) ErrorName() string {
+	return "ListProjectRolesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+func (e ListProjectRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8829,14 +15454,14 @@ func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		"invalid %sListProjectRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+var _ error = ListProjectRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8844,75 +15469,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasRequestValidationError{}
+} = ListProjectRolesRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+// Validate checks the field values on ListProjectRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectRolesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectRolesResponseMultiError, or nil if none found.
+func (m *ListProjectRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+func (m *ListProjectRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetOrganization()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
+				return ListProjectRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SudoUpdateOrganizationQuotasResponseValidationError{
-				field:  "Organization",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+		return ListProjectRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
+// ListProjectRolesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectRolesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseMultiError []error
+type ListProjectRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+func (m ListProjectRolesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8921,12 +15549,11 @@ func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectRolesResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseValidationError struct {
+// ListProjectRolesResponseValidationError is the validation error returned by
+// ListProjectRolesResponse.Validate if the designated constraints aren't met.
+type ListProjectRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8934,24 +15561,24 @@ type SudoUpdateOrganizationQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+func (e ListProjectRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+func (e ListProjectRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasResponseValidationError"
+func (e ListProjectRolesResponseValidationError) ErrorName() string {
+	return "ListProjectRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+func (e ListProjectRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8963,14 +15590,14 @@ func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		"invalid %sListProjectRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+var _ error = ListProjectRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8978,50 +15605,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasResponseValidationError{}
+} = ListProjectRolesResponseValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateProjectRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasRequest) Validate() error {
+func (m *CreateProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
+// ValidateAll checks the field values on CreateProjectRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+// CreateProjectRoleRequestMultiError, or nil if none found.
+func (m *CreateProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+func (m *CreateProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateProjectRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if m.SingleuserOrgs != nil {
-		// no validation rules for SingleuserOrgs
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateProjectRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPermissions() == nil {
+		err := CreateProjectRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasRequestMultiError(errors)
+		return CreateProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateUserQuotasRequestMultiError []error
+// CreateProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+func (m CreateProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9030,12 +15713,11 @@ func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+func (m CreateProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasRequestValidationError is the validation error returned
-// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasRequestValidationError struct {
+// CreateProjectRoleRequestValidationError is the validation error returned by
+// CreateProjectRoleRequest.Validate if the designated constraints aren't met.
+type CreateProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9043,24 +15725,24 @@ type SudoUpdateUserQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+func (e CreateProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+func (e CreateProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+func (e CreateProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+func (e CreateProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasRequestValidationError"
+func (e CreateProjectRoleRequestValidationError) ErrorName() string {
+	return "CreateProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+func (e CreateProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9072,14 +15754,14 @@ func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		"invalid %sCreateProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasRequestValidationError{}
+var _ error = CreateProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9087,24 +15769,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasRequestValidationError{}
+} = CreateProjectRoleRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasResponse) Validate() error {
+func (m *CreateProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
+// ValidateAll checks the field values on CreateProjectRoleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+// CreateProjectRoleResponseMultiError, or nil if none found.
+func (m *CreateProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+func (m *CreateProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9112,28 +15794,28 @@ func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetRole()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
+				errors = append(errors, CreateProjectRoleResponseValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
+				errors = append(errors, CreateProjectRoleResponseValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SudoUpdateUserQuotasResponseValidationError{
-				field:  "User",
+			return CreateProjectRoleResponseValidationError{
+				field:  "Role",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9141,19 +15823,19 @@ func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasResponseMultiError(errors)
+		return CreateProjectRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateUserQuotasResponseMultiError []error
+// CreateProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+func (m CreateProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9162,12 +15844,11 @@ func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasResponseValidationError is the validation error returned
-// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasResponseValidationError struct {
+// CreateProjectRoleResponseValidationError is the validation error returned by
+// CreateProjectRoleResponse.Validate if the designated constraints aren't met.
+type CreateProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9175,24 +15856,24 @@ type SudoUpdateUserQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+func (e CreateProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasResponseValidationError"
+func (e CreateProjectRoleResponseValidationError) ErrorName() string {
+	return "CreateProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+func (e CreateProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9204,14 +15885,14 @@ func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		"invalid %sCreateProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasResponseValidationError{}
+var _ error = CreateProjectRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9219,24 +15900,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasResponseValidationError{}
+} = CreateProjectRoleResponseValidationError{}
 
-// Validate checks the field values on SudoUpdateAnnotationsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateProjectRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateAnnotationsRequest) Validate() error {
+func (m *UpdateProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateAnnotationsRequest with the
+// ValidateAll checks the field values on UpdateProjectRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateAnnotationsRequestMultiError, or nil if none found.
-func (m *SudoUpdateAnnotationsRequest) ValidateAll() error {
+// UpdateProjectRoleRequestMultiError, or nil if none found.
+func (m *UpdateProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateAnnotationsRequest) validate(all bool) error {
+func (m *UpdateProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9244,7 +15925,7 @@ func (m *SudoUpdateAnnotationsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SudoUpdateAnnotationsRequestValidationError{
+		err := UpdateProjectRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9254,33 +15935,71 @@ func (m *SudoUpdateAnnotationsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SudoUpdateAnnotationsRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateProjectRoleRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
-		if !all {
-			return err
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPermissions() == nil {
+		err := UpdateProjectRoleRequestValidationError{
+			field:  "Permissions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRoleRequestValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRoleRequestValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Annotations
-
 	if len(errors) > 0 {
-		return SudoUpdateAnnotationsRequestMultiError(errors)
+		return UpdateProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateAnnotationsRequestMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateAnnotationsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateAnnotationsRequestMultiError []error
+// UpdateProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateAnnotationsRequestMultiError) Error() string {
+func (m UpdateProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9289,12 +16008,11 @@ func (m SudoUpdateAnnotationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateAnnotationsRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateAnnotationsRequestValidationError is the validation error returned
-// by SudoUpdateAnnotationsRequest.Validate if the designated constraints
-// aren't met.
-type SudoUpdateAnnotationsRequestValidationError struct {
+// UpdateProjectRoleRequestValidationError is the validation error returned by
+// UpdateProjectRoleRequest.Validate if the designated constraints aren't met.
+type UpdateProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9302,24 +16020,24 @@ type SudoUpdateAnnotationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateAnnotationsRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateAnnotationsRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateAnnotationsRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateAnnotationsRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateAnnotationsRequestValidationError) ErrorName() string {
-	return "SudoUpdateAnnotationsRequestValidationError"
+func (e UpdateProjectRoleRequestValidationError) ErrorName() string {
+	return "UpdateProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateAnnotationsRequestValidationError) Error() string {
+func (e UpdateProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9331,14 +16049,14 @@ func (e SudoUpdateAnnotationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateAnnotationsRequest.%s: %s%s",
+		"invalid %sUpdateProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateAnnotationsRequestValidationError{}
+var _ error = UpdateProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9346,24 +16064,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateAnnotationsRequestValidationError{}
+} = UpdateProjectRoleRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateAnnotationsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateAnnotationsResponse) Validate() error {
+func (m *UpdateProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateAnnotationsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SudoUpdateAnnotationsResponseMultiError, or nil if none found.
-func (m *SudoUpdateAnnotationsResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectRoleResponseMultiError, or nil if none found.
+func (m *UpdateProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateAnnotationsResponse) validate(all bool) error {
+func (m *UpdateProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9371,28 +16089,28 @@ func (m *SudoUpdateAnnotationsResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetRole()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateAnnotationsResponseValidationError{
-					field:  "Project",
+				errors = append(errors, UpdateProjectRoleResponseValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateAnnotationsResponseValidationError{
-					field:  "Project",
+				errors = append(errors, UpdateProjectRoleResponseValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SudoUpdateAnnotationsResponseValidationError{
-				field:  "Project",
+			return UpdateProjectRoleResponseValidationError{
+				field:  "Role",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9400,19 +16118,19 @@ func (m *SudoUpdateAnnotationsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateAnnotationsResponseMultiError(errors)
+		return UpdateProjectRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateAnnotationsResponseMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateAnnotationsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type SudoUpdateAnnotationsResponseMultiError []error
+// UpdateProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateAnnotationsResponseMultiError) Error() string {
+func (m UpdateProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9421,12 +16139,11 @@ func (m SudoUpdateAnnotationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateAnnotationsResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateAnnotationsResponseValidationError is the validation error
-// returned by SudoUpdateAnnotationsResponse.Validate if the designated
-// constraints aren't met.
-type SudoUpdateAnnotationsResponseValidationError struct {
+// UpdateProjectRoleResponseValidationError is the validation error returned by
+// UpdateProjectRoleResponse.Validate if the designated constraints aren't met.
+type UpdateProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9434,24 +16151,24 @@ type SudoUpdateAnnotationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateAnnotationsResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateAnnotationsResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateAnnotationsResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateAnnotationsResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateAnnotationsResponseValidationError) ErrorName() string {
-	return "SudoUpdateAnnotationsResponseValidationError"
+func (e UpdateProjectRoleResponseValidationError) ErrorName() string {
+	return "UpdateProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateAnnotationsResponseValidationError) Error() string {
+func (e UpdateProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9463,14 +16180,14 @@ func (e SudoUpdateAnnotationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateAnnotationsResponse.%s: %s%s",
+		"invalid %sUpdateProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateAnnotationsResponseValidationError{}
+var _ error = UpdateProjectRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9478,24 +16195,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateAnnotationsResponseValidationError{}
+} = UpdateProjectRoleResponseValidationError{}
 
-// Validate checks the field values on ListProjectMembersRequest with the rules
+// Validate checks the field values on DeleteProjectRoleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersRequest) Validate() error {
+func (m *DeleteProjectRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMembersRequest with the
+// ValidateAll checks the field values on DeleteProjectRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectMembersRequestMultiError, or nil if none found.
-func (m *ListProjectMembersRequest) ValidateAll() error {
+// DeleteProjectRoleRequestMultiError, or nil if none found.
+func (m *DeleteProjectRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMembersRequest) validate(all bool) error {
+func (m *DeleteProjectRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9503,7 +16220,7 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectMembersRequestValidationError{
+		err := DeleteProjectRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9513,9 +16230,9 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectMembersRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteProjectRoleRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9524,37 +16241,20 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListProjectMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListProjectMembersRequestMultiError(errors)
+		return DeleteProjectRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMembersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectMembersRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectMembersRequestMultiError []error
+// DeleteProjectRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersRequestMultiError) Error() string {
+func (m DeleteProjectRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9563,11 +16263,11 @@ func (m ListProjectMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRoleRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersRequestValidationError is the validation error returned by
-// ListProjectMembersRequest.Validate if the designated constraints aren't met.
-type ListProjectMembersRequestValidationError struct {
+// DeleteProjectRoleRequestValidationError is the validation error returned by
+// DeleteProjectRoleRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9575,24 +16275,24 @@ type ListProjectMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+func (e DeleteProjectRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+func (e DeleteProjectRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersRequestValidationError) ErrorName() string {
-	return "ListProjectMembersRequestValidationError"
+func (e DeleteProjectRoleRequestValidationError) ErrorName() string {
+	return "DeleteProjectRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersRequestValidationError) Error() string {
+func (e DeleteProjectRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9604,14 +16304,14 @@ func (e ListProjectMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersRequest.%s: %s%s",
+		"invalid %sDeleteProjectRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMembersRequestValidationError{}
+var _ error = DeleteProjectRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9619,80 +16319,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMembersRequestValidationError{}
+} = DeleteProjectRoleRequestValidationError{}
 
-// Validate checks the field values on ListProjectMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteProjectRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersResponse) Validate() error {
+func (m *DeleteProjectRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMembersResponse with the
+// ValidateAll checks the field values on DeleteProjectRoleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectMembersResponseMultiError, or nil if none found.
-func (m *ListProjectMembersResponse) ValidateAll() error {
+// DeleteProjectRoleResponseMultiError, or nil if none found.
+func (m *DeleteProjectRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMembersResponse) validate(all bool) error {
+func (m *DeleteProjectRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectMembersResponseMultiError(errors)
+		return DeleteProjectRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectMembersResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectMembersResponseMultiError []error
+// DeleteProjectRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteProjectRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersResponseMultiError) Error() string {
+func (m DeleteProjectRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9701,11 +16365,11 @@ func (m ListProjectMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRoleResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersResponseValidationError is the validation error returned
-// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
-type ListProjectMembersResponseValidationError struct {
+// DeleteProjectRoleResponseValidationError is the validation error returned by
+// DeleteProjectRoleResponse.Validate if the designated constraints aren't met.
+type DeleteProjectRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9713,24 +16377,24 @@ type ListProjectMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+func (e DeleteProjectRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+func (e DeleteProjectRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersResponseValidationError) ErrorName() string {
-	return "ListProjectMembersResponseValidationError"
+func (e DeleteProjectRoleResponseValidationError) ErrorName() string {
+	return "DeleteProjectRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersResponseValidationError) Error() string {
+func (e DeleteProjectRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9742,98 +16406,59 @@ func (e ListProjectMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersResponse.%s: %s%s",
+		"invalid %sDeleteProjectRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
-		cause)
-}
-
-var _ error = ListProjectMembersResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListProjectMembersResponseValidationError{}
-
-// Validate checks the field values on ListProjectInvitesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListProjectInvitesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesRequestMultiError, or nil if none found.
-func (m *ListProjectInvitesRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListProjectInvitesRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+		cause)
+}
 
-	if m.GetPageSize() != 0 {
+var _ error = DeleteProjectRoleResponseValidationError{}
 
-		if m.GetPageSize() > 1000 {
-			err := ListProjectInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectRoleResponseValidationError{}
+
+// Validate checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *GetCurrentUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for PageToken
+	var errors []error
 
 	if len(errors) > 0 {
-		return ListProjectInvitesRequestMultiError(errors)
+		return GetCurrentUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectInvitesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectInvitesRequestMultiError []error
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesRequestMultiError) Error() string {
+func (m GetCurrentUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9842,11 +16467,11 @@ func (m ListProjectInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesRequestValidationError is the validation error returned by
-// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
-type ListProjectInvitesRequestValidationError struct {
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9854,24 +16479,24 @@ type ListProjectInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesRequestValidationError) ErrorName() string {
-	return "ListProjectInvitesRequestValidationError"
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesRequestValidationError) Error() string {
+func (e GetCurrentUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9883,14 +16508,14 @@ func (e ListProjectInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesRequestValidationError{}
+var _ error = GetCurrentUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9898,80 +16523,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesRequestValidationError{}
+} = GetCurrentUserRequestValidationError{}
 
-// Validate checks the field values on ListProjectInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesResponse) Validate() error {
+func (m *GetCurrentUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesResponseMultiError, or nil if none found.
-func (m *ListProjectInvitesResponse) ValidateAll() error {
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesResponse) validate(all bool) error {
+func (m *GetCurrentUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListProjectInvitesResponseMultiError(errors)
+		return GetCurrentUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectInvitesResponseMultiError []error
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesResponseMultiError) Error() string {
+func (m GetCurrentUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9980,11 +16627,11 @@ func (m ListProjectInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesResponseValidationError is the validation error returned
-// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
-type ListProjectInvitesResponseValidationError struct {
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9992,24 +16639,24 @@ type ListProjectInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesResponseValidationError) ErrorName() string {
-	return "ListProjectInvitesResponseValidationError"
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesResponseValidationError) Error() string {
+func (e GetCurrentUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10021,14 +16668,14 @@ func (e ListProjectInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesResponseValidationError{}
+var _ error = GetCurrentUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10036,54 +16683,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesResponseValidationError{}
+} = GetCurrentUserResponseValidationError{}
 
-// Validate checks the field values on AddProjectMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberRequest) Validate() error {
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddProjectMemberRequestMultiError, or nil if none found.
-func (m *AddProjectMemberRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberRequest) validate(all bool) error {
+func (m *GetUserRequest) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddProjectMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := AddProjectMemberRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+		return nil
 	}
 
+	var errors []error
+
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddProjectMemberRequestValidationError{
+		err = GetUserRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -10094,25 +16719,14 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectMemberRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return AddProjectMemberRequestMultiError(errors)
+		return GetUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *AddProjectMemberRequest) _validateHostname(host string) error {
+func (m *GetUserRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -10142,7 +16756,7 @@ func (m *AddProjectMemberRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
+func (m *GetUserRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -10162,13 +16776,13 @@ func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// AddProjectMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberRequestMultiError []error
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberRequestMultiError) Error() string {
+func (m GetUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10177,11 +16791,11 @@ func (m AddProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberRequestValidationError is the validation error returned by
-// AddProjectMemberRequest.Validate if the designated constraints aren't met.
-type AddProjectMemberRequestValidationError struct {
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10189,24 +16803,22 @@ type AddProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
+func (e GetUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e GetUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberRequestValidationError) ErrorName() string {
-	return "AddProjectMemberRequestValidationError"
-}
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberRequestValidationError) Error() string {
+func (e GetUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10218,14 +16830,14 @@ func (e AddProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberRequest.%s: %s%s",
+		"invalid %sGetUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberRequestValidationError{}
+var _ error = GetUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10233,46 +16845,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberRequestValidationError{}
+} = GetUserRequestValidationError{}
 
-// Validate checks the field values on AddProjectMemberResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberResponse) Validate() error {
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberResponseMultiError, or nil if none found.
-func (m *AddProjectMemberResponse) ValidateAll() error {
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberResponse) validate(all bool) error {
+func (m *GetUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AddProjectMemberResponseMultiError(errors)
+		return GetUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberResponseMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberResponseMultiError []error
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberResponseMultiError) Error() string {
+func (m GetUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10281,11 +16920,11 @@ func (m AddProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberResponseValidationError is the validation error returned by
-// AddProjectMemberResponse.Validate if the designated constraints aren't met.
-type AddProjectMemberResponseValidationError struct {
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10293,24 +16932,22 @@ type AddProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
+func (e GetUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e GetUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberResponseValidationError) ErrorName() string {
-	return "AddProjectMemberResponseValidationError"
-}
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberResponseValidationError) Error() string {
+func (e GetUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10322,14 +16959,14 @@ func (e AddProjectMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberResponse.%s: %s%s",
+		"invalid %sGetUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberResponseValidationError{}
+var _ error = GetUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10337,128 +16974,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberResponseValidationError{}
+} = GetUserResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberRequest) Validate() error {
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserPreferences with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberRequestMultiError, or nil if none found.
-func (m *RemoveProjectMemberRequest) ValidateAll() error {
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberRequest) validate(all bool) error {
+func (m *UserPreferences) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveProjectMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
 	}
 
 	if len(errors) > 0 {
-		return RemoveProjectMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return UserPreferencesMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// RemoveProjectMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RemoveProjectMemberRequestMultiError []error
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberRequestMultiError) Error() string {
+func (m UserPreferencesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10467,11 +17024,11 @@ func (m RemoveProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberRequestValidationError is the validation error returned
-// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
-type RemoveProjectMemberRequestValidationError struct {
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10479,24 +17036,22 @@ type RemoveProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
+func (e UserPreferencesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e UserPreferencesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
-	return "RemoveProjectMemberRequestValidationError"
-}
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberRequestValidationError) Error() string {
+func (e UserPreferencesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10508,14 +17063,14 @@ func (e RemoveProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
+		"invalid %sUserPreferences.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberRequestValidationError{}
+var _ error = UserPreferencesValidationError{}
 
 var _ interface {
 	Field() string
@@ -10523,44 +17078,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberRequestValidationError{}
+} = UserPreferencesValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberResponse with the
+// Validate checks the field values on UpdateUserPreferencesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberResponse) Validate() error {
+func (m *UpdateUserPreferencesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberResponse with the
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberResponseMultiError, or nil if none found.
-func (m *RemoveProjectMemberResponse) ValidateAll() error {
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberResponse) validate(all bool) error {
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RemoveProjectMemberResponseMultiError(errors)
+		return UpdateUserPreferencesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
 // the designated constraints aren't met.
-type RemoveProjectMemberResponseMultiError []error
+type UpdateUserPreferencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberResponseMultiError) Error() string {
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10569,12 +17153,12 @@ func (m RemoveProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberResponseValidationError is the validation error returned
-// by RemoveProjectMemberResponse.Validate if the designated constraints
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
 // aren't met.
-type RemoveProjectMemberResponseValidationError struct {
+type UpdateUserPreferencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10582,24 +17166,24 @@ type RemoveProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
-	return "RemoveProjectMemberResponseValidationError"
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberResponseValidationError) Error() string {
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10611,14 +17195,14 @@ func (e RemoveProjectMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberResponseValidationError{}
+var _ error = UpdateUserPreferencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10626,139 +17210,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberResponseValidationError{}
+} = UpdateUserPreferencesRequestValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleRequest with the
+// Validate checks the field values on UpdateUserPreferencesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleRequest) Validate() error {
+func (m *UpdateUserPreferencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleRequestMultiError, or nil if none found.
-func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetProjectMemberRoleRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SetProjectMemberRoleRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return UpdateUserPreferencesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleRequestMultiError []error
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPreferencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleRequestMultiError) Error() string {
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10767,12 +17285,12 @@ func (m SetProjectMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleRequestValidationError is the validation error returned
-// by SetProjectMemberRoleRequest.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleRequestValidationError struct {
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateUserPreferencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10780,24 +17298,24 @@ type SetProjectMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
-	return "SetProjectMemberRoleRequestValidationError"
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleRequestValidationError) Error() string {
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10809,14 +17327,14 @@ func (e SetProjectMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleRequestValidationError{}
+var _ error = UpdateUserPreferencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10824,44 +17342,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleRequestValidationError{}
+} = UpdateUserPreferencesResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleResponse) Validate() error {
+func (m *ListBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleResponseMultiError, or nil if none found.
-func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+func (m *ListBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ProjectId
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
 	if len(errors) > 0 {
-		return SetProjectMemberRoleResponseMultiError(errors)
+		return ListBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleResponseMultiError []error
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleResponseMultiError) Error() string {
+func (m ListBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10870,12 +17394,11 @@ func (m SetProjectMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleResponseValidationError is the validation error returned
-// by SetProjectMemberRoleResponse.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleResponseValidationError struct {
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10883,24 +17406,24 @@ type SetProjectMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
-	return "SetProjectMemberRoleResponseValidationError"
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleResponseValidationError) Error() string {
+func (e ListBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10912,14 +17435,14 @@ func (e SetProjectMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		"invalid %sListBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleResponseValidationError{}
+var _ error = ListBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10927,44 +17450,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleResponseValidationError{}
+} = ListBookmarksRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
+// Validate checks the field values on ListBookmarksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+func (m *ListBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *ListBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+		return ListBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+type ListBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m ListBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10973,11 +17530,11 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10985,24 +17542,24 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e ListBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11014,14 +17571,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sListBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = ListBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11029,102 +17586,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = ListBookmarksResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
+// Validate checks the field values on GetBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *GetBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *GetBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BookmarkId
 
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return GetBookmarkRequestMultiError(errors)
 	}
 
-	return nil
-}
-
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+	return nil
+}
+
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m GetBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11133,11 +17634,11 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11145,24 +17646,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
+func (e GetBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11174,14 +17675,14 @@ func (e GetCurrentUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		"invalid %sGetBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserResponseValidationError{}
+var _ error = GetBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11189,106 +17690,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
+} = GetBookmarkRequestValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
+func (m *GetBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return GetBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m GetBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11297,11 +17765,11 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11309,22 +17777,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e GetBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11336,14 +17806,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sGetBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = GetBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11351,73 +17821,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = GetBookmarkResponseValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+// Validate checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *CreateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return CreateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m CreateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11426,11 +17883,11 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
-
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11438,22 +17895,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e CreateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11465,14 +17924,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = CreateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11480,48 +17939,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = CreateBookmarkRequestValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+// Validate checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *CreateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return CreateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
-// aren't met.
-type UserPreferencesMultiError []error
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m CreateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11530,11 +18014,11 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11542,22 +18026,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e CreateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11569,14 +18055,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = CreateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11584,73 +18070,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = CreateBookmarkResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *UpdateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *UpdateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BookmarkId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return UpdateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m UpdateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11659,12 +18128,11 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
-// aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11672,24 +18140,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e UpdateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11701,14 +18169,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = UpdateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11716,73 +18184,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = UpdateBookmarkRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *UpdateBookmarkResponse) Validate() error {
 	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+}
+
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return UpdateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m UpdateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11791,12 +18230,11 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11804,24 +18242,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e UpdateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11833,14 +18271,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = UpdateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11848,50 +18286,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = UpdateBookmarkResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
+// Validate checks the field values on RemoveBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *RemoveBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *RemoveBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
+	// no validation rules for BookmarkId
 
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return RemoveBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksRequestMultiError []error
+type RemoveBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m RemoveBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11900,11 +18334,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11912,24 +18346,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e RemoveBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11941,14 +18375,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = RemoveBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11956,78 +18390,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
+} = RemoveBookmarkRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksResponse with the rules
+// Validate checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
+func (m *RemoveBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksResponse) validate(all bool) error {
+func (m *RemoveBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBookmarks() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return RemoveBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksResponseMultiError []error
+type RemoveBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m RemoveBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12036,11 +18436,11 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12048,24 +18448,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e RemoveBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12077,14 +18477,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = RemoveBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12092,46 +18492,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = RemoveBookmarkResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
+// Validate checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// ValidateAll checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetBookmarkRequestMultiError []error
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12140,11 +18566,11 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12152,24 +18578,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12181,14 +18607,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12196,73 +18622,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
+// Validate checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// ValidateAll checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetBookmarkResponseMultiError []error
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12271,11 +18704,11 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12283,24 +18716,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12312,14 +18745,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12327,60 +18760,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for Data
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
-
 	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12389,11 +18806,12 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12401,24 +18819,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12430,14 +18848,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12445,73 +18863,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12520,11 +18911,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12532,24 +18924,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12561,14 +18953,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12576,56 +18968,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = RevokeCurrentAuthTokenResponseValidationError{}
 
-// Validate checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkRequest) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkRequestMultiError, or nil if none found.
-func (m *UpdateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IssueRepresentativeAuthTokenRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for DisplayName
+	// no validation rules for TtlMinutes
 
-	// no validation rules for Description
+	if len(errors) > 0 {
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
+	}
 
-	// no validation rules for Data
+	return nil
+}
 
-	// no validation rules for Default
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-	// no validation rules for Shared
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
 
-	if len(errors) > 0 {
-		return UpdateBookmarkRequestMultiError(errors)
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
 	}
 
 	return nil
 }
 
-// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateBookmarkRequestMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12634,11 +19080,12 @@ func (m UpdateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkRequestValidationError is the validation error returned by
-// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
-type UpdateBookmarkRequestValidationError struct {
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12646,24 +19093,24 @@ type UpdateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkRequestValidationError) ErrorName() string {
-	return "UpdateBookmarkRequestValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12675,14 +19122,14 @@ func (e UpdateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12690,44 +19137,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkRequestValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkResponse) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkResponseMultiError, or nil if none found.
-func (m *UpdateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkResponse) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return UpdateBookmarkResponseMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateBookmarkResponseMultiError []error
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12736,11 +19187,12 @@ func (m UpdateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkResponseValidationError is the validation error returned by
-// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
-type UpdateBookmarkResponseValidationError struct {
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12748,24 +19200,24 @@ type UpdateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkResponseValidationError) ErrorName() string {
-	return "UpdateBookmarkResponseValidationError"
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12777,14 +19229,14 @@ func (e UpdateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12792,46 +19244,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkResponseValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12840,11 +19292,12 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12852,24 +19305,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12881,14 +19334,14 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12896,24 +19349,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12921,19 +19374,19 @@ func (m *RemoveBookmarkResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12942,11 +19395,12 @@ func (m RemoveBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12954,24 +19408,24 @@ type RemoveBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12983,14 +19437,14 @@ func (e RemoveBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkResponseValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12998,33 +19452,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersRequest) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13033,37 +19487,24 @@ func (m *SearchUsersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for ServiceName
 
-	// no validation rules for PageToken
+	// no validation rules for Scim
 
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13072,11 +19513,12 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13084,24 +19526,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13113,14 +19555,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13128,80 +19570,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchUsersResponseMultiError []error
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13210,11 +19618,12 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13222,24 +19631,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13251,14 +19660,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13266,44 +19675,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// Validate checks the field values on ListServiceAuthTokensRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13312,12 +19734,12 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13325,24 +19747,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13354,14 +19776,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13369,46 +19791,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// Validate checks the field values on ListServiceAuthTokensResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13417,12 +19871,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13430,24 +19884,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13459,14 +19913,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13474,110 +19928,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for TtlMinutes
+	// no validation rules for GithubUrl
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return GetGithubRepoStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13586,12 +19976,11 @@ func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13599,24 +19988,24 @@ type IssueRepresentativeAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13628,14 +20017,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13643,48 +20032,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+// Validate checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for HasAccess
+
+	// no validation rules for GrantAccessUrl
+
+	// no validation rules for DefaultBranch
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13693,12 +20084,12 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13706,24 +20097,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13735,14 +20126,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13750,46 +20141,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+func (m *GetGitCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetGitCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsRequestMultiError, or nil if none found.
+func (m *GetGitCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetGitCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return GetGitCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGitCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m GetGitCredentialsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13798,12 +20209,11 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+// GetGitCredentialsRequestValidationError is the validation error returned by
+// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
+type GetGitCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13811,24 +20221,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e GetGitCredentialsRequestValidationError) ErrorName() string {
+	return "GetGitCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e GetGitCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13840,14 +20250,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGitCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = GetGitCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13855,44 +20265,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = GetGitCredentialsRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+func (m *GetGitCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGitCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsResponseMultiError, or nil if none found.
+func (m *GetGitCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetGitCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RepoUrl
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return GetGitCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetGitCredentialsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m GetGitCredentialsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13901,12 +20321,11 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+// GetGitCredentialsResponseValidationError is the validation error returned by
+// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
+type GetGitCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13914,24 +20333,24 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
+func (e GetGitCredentialsResponseValidationError) ErrorName() string {
+	return "GetGitCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e GetGitCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13943,14 +20362,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGitCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = GetGitCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13958,33 +20377,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = GetGitCredentialsResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+func (m *CreateWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13993,22 +20412,42 @@ func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return CreateWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14017,12 +20456,12 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14030,24 +20469,24 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14059,14 +20498,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = CreateWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14074,46 +20513,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = CreateWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+func (m *CreateWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return CreateWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
 // if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
+type CreateWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14122,12 +20559,12 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
 // constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+type CreateWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14135,24 +20572,24 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14164,14 +20601,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = CreateWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14179,33 +20616,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = CreateWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -14214,22 +20651,31 @@ func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return RemoveWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14238,12 +20684,12 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
-// aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14251,24 +20697,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14280,14 +20726,14 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14295,78 +20741,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
+} = RemoveWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensResponse with the
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
+		return RemoveWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type RemoveWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14375,12 +20787,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type RemoveWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14388,24 +20800,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14417,14 +20829,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14432,46 +20844,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = RemoveWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+func (m *ListWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return ListWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14480,11 +20901,12 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14492,24 +20914,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14521,14 +20943,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = ListWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14536,50 +20958,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = ListWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+func (m *ListWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
 
-	// no validation rules for GrantAccessUrl
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for DefaultBranch
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return ListWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14588,12 +21038,12 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14601,24 +21051,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14630,14 +21080,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = ListWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14645,24 +21095,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = ListWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on GetGitCredentialsRequest with the rules
+// Validate checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsRequest) Validate() error {
+func (m *ListAuditEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsRequestMultiError, or nil if none found.
-func (m *GetGitCredentialsRequest) ValidateAll() error {
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsRequest) validate(all bool) error {
+func (m *ListAuditEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14670,7 +21120,7 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
+		err := ListAuditEventsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14680,31 +21130,99 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Project
+
+	// no validation rules for Actor
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return GetGitCredentialsRequestMultiError(errors)
+		return ListAuditEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetGitCredentialsRequestMultiError []error
+type ListAuditEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsRequestMultiError) Error() string {
+func (m ListAuditEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14713,11 +21231,11 @@ func (m GetGitCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsRequestValidationError is the validation error returned by
-// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
-type GetGitCredentialsRequestValidationError struct {
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14725,24 +21243,24 @@ type GetGitCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsRequestValidationError) ErrorName() string {
-	return "GetGitCredentialsRequestValidationError"
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsRequestValidationError) Error() string {
+func (e ListAuditEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14754,14 +21272,14 @@ func (e GetGitCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		"invalid %sListAuditEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsRequestValidationError{}
+var _ error = ListAuditEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14769,54 +21287,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsRequestValidationError{}
+} = ListAuditEventsRequestValidationError{}
 
-// Validate checks the field values on GetGitCredentialsResponse with the rules
+// Validate checks the field values on ListAuditEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsResponse) Validate() error {
+func (m *ListAuditEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsResponse with the
+// ValidateAll checks the field values on ListAuditEventsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsResponseMultiError, or nil if none found.
-func (m *GetGitCredentialsResponse) ValidateAll() error {
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsResponse) validate(all bool) error {
+func (m *ListAuditEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RepoUrl
-
-	// no validation rules for Username
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
 
-	// no validation rules for Password
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Subpath
+	}
 
-	// no validation rules for ProdBranch
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return GetGitCredentialsResponseMultiError(errors)
+		return ListAuditEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetGitCredentialsResponseMultiError []error
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsResponseMultiError) Error() string {
+func (m ListAuditEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14825,11 +21369,11 @@ func (m GetGitCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsResponseValidationError is the validation error returned by
-// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
-type GetGitCredentialsResponseValidationError struct {
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14837,24 +21381,24 @@ type GetGitCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsResponseValidationError) ErrorName() string {
-	return "GetGitCredentialsResponseValidationError"
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsResponseValidationError) Error() string {
+func (e ListAuditEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14866,14 +21410,14 @@ func (e GetGitCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		"invalid %sListAuditEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsResponseValidationError{}
+var _ error = ListAuditEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14881,77 +21425,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsResponseValidationError{}
+} = ListAuditEventsResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+func (m *GetRepoMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaRequestMultiError, or nil if none found.
+func (m *GetRepoMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *GetRepoMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Branch
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return GetRepoMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m GetRepoMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14960,12 +21475,11 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+// GetRepoMetaRequestValidationError is the validation error returned by
+// GetRepoMetaRequest.Validate if the designated constraints aren't met.
+type GetRepoMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14973,24 +21487,24 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
+func (e GetRepoMetaRequestValidationError) ErrorName() string {
+	return "GetRepoMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e GetRepoMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15002,14 +21516,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sGetRepoMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = GetRepoMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15017,44 +21531,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = GetRepoMetaRequestValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+func (m *GetRepoMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaResponseMultiError, or nil if none found.
+func (m *GetRepoMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *GetRepoMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for GitUrl
+
+	if all {
+		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoMetaResponseValidationError{
+				field:  "GitUrlExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GitSubpath
+
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+		return GetRepoMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+// GetRepoMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRepoMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m GetRepoMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15063,12 +21610,11 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+// GetRepoMetaResponseValidationError is the validation error returned by
+// GetRepoMetaResponse.Validate if the designated constraints aren't met.
+type GetRepoMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15076,24 +21622,24 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
+// ErrorName returns error name.
+func (e GetRepoMetaResponseValidationError) ErrorName() string {
+	return "GetRepoMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e GetRepoMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15105,14 +21651,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sGetRepoMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = GetRepoMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15120,66 +21666,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = GetRepoMetaResponseValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+func (m *PullVirtualRepoRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoRequestMultiError, or nil if none found.
+func (m *PullVirtualRepoRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *PullVirtualRepoRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Branch
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 100 {
+			err := PullVirtualRepoRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return PullVirtualRepoRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PullVirtualRepoRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m PullVirtualRepoRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15188,12 +21733,11 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+// PullVirtualRepoRequestValidationError is the validation error returned by
+// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
+type PullVirtualRepoRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15201,24 +21745,24 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
+func (e PullVirtualRepoRequestValidationError) ErrorName() string {
+	return "PullVirtualRepoRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e PullVirtualRepoRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15230,14 +21774,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sPullVirtualRepoRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = PullVirtualRepoRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15245,44 +21789,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = PullVirtualRepoRequestValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PullVirtualRepoResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+func (m *PullVirtualRepoResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on PullVirtualRepoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoResponseMultiError, or nil if none found.
+func (m *PullVirtualRepoResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+func (m *PullVirtualRepoResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PullVirtualRepoResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return PullVirtualRepoResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PullVirtualRepoResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m PullVirtualRepoResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15291,12 +21871,11 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+// PullVirtualRepoResponseValidationError is the validation error returned by
+// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
+type PullVirtualRepoResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15304,24 +21883,24 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
+func (e PullVirtualRepoResponseValidationError) ErrorName() string {
+	return "PullVirtualRepoResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e PullVirtualRepoResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15333,14 +21912,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sPullVirtualRepoResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = PullVirtualRepoResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15348,55 +21927,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = PullVirtualRepoResponseValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+func (m *GetReportMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaRequestMultiError, or nil if none found.
+func (m *GetReportMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *GetReportMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	// no validation rules for Report
+
+	// no validation rules for Annotations
+
+	if all {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportMetaRequestValidationError{
+				field:  "ExecutionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return GetReportMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// GetReportMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m GetReportMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15405,12 +22010,11 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+// GetReportMetaRequestValidationError is the validation error returned by
+// GetReportMetaRequest.Validate if the designated constraints aren't met.
+type GetReportMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15418,24 +22022,24 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e GetReportMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
+func (e GetReportMetaRequestValidationError) ErrorName() string {
+	return "GetReportMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e GetReportMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15447,14 +22051,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sGetReportMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = GetReportMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15462,78 +22066,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = GetReportMetaRequestValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+func (m *GetReportMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaResponseMultiError, or nil if none found.
+func (m *GetReportMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *GetReportMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
+	// no validation rules for OpenUrl
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for ExportUrl
 
-	}
+	// no validation rules for EditUrl
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return GetReportMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// GetReportMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m GetReportMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15542,12 +22118,11 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+// GetReportMetaResponseValidationError is the validation error returned by
+// GetReportMetaResponse.Validate if the designated constraints aren't met.
+type GetReportMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15555,24 +22130,24 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e GetReportMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
+func (e GetReportMetaResponseValidationError) ErrorName() string {
+	return "GetReportMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e GetReportMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15584,14 +22159,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sGetReportMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = GetReportMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15599,24 +22174,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = GetReportMetaResponseValidationError{}
 
-// Validate checks the field values on GetRepoMetaRequest with the rules
+// Validate checks the field values on GetAlertMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaRequest) Validate() error {
+func (m *GetAlertMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// ValidateAll checks the field values on GetAlertMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaRequestMultiError, or nil if none found.
-func (m *GetRepoMetaRequest) ValidateAll() error {
+// GetAlertMetaRequestMultiError, or nil if none found.
+func (m *GetAlertMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaRequest) validate(all bool) error {
+func (m *GetAlertMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15627,20 +22202,53 @@ func (m *GetRepoMetaRequest) validate(all bool) error {
 
 	// no validation rules for Branch
 
+	// no validation rules for Alert
+
+	// no validation rules for Annotations
+
+	switch v := m.QueryFor.(type) {
+	case *GetAlertMetaRequest_QueryForUserId:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserId
+	case *GetAlertMetaRequest_QueryForUserEmail:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserEmail
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return GetRepoMetaRequestMultiError(errors)
+		return GetAlertMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoMetaRequestMultiError []error
+// GetAlertMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaRequestMultiError) Error() string {
+func (m GetAlertMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15649,11 +22257,11 @@ func (m GetRepoMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaRequestValidationError is the validation error returned by
-// GetRepoMetaRequest.Validate if the designated constraints aren't met.
-type GetRepoMetaRequestValidationError struct {
+// GetAlertMetaRequestValidationError is the validation error returned by
+// GetAlertMetaRequest.Validate if the designated constraints aren't met.
+type GetAlertMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15661,24 +22269,24 @@ type GetRepoMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
+func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
+func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaRequestValidationError) ErrorName() string {
-	return "GetRepoMetaRequestValidationError"
+func (e GetAlertMetaRequestValidationError) ErrorName() string {
+	return "GetAlertMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaRequestValidationError) Error() string {
+func (e GetAlertMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15690,14 +22298,14 @@ func (e GetRepoMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaRequest.%s: %s%s",
+		"invalid %sGetAlertMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaRequestValidationError{}
+var _ error = GetAlertMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15705,77 +22313,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaRequestValidationError{}
+} = GetAlertMetaRequestValidationError{}
 
-// Validate checks the field values on GetRepoMetaResponse with the rules
+// Validate checks the field values on GetAlertMetaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaResponse) Validate() error {
+func (m *GetAlertMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// ValidateAll checks the field values on GetAlertMetaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaResponseMultiError, or nil if none found.
-func (m *GetRepoMetaResponse) ValidateAll() error {
+// GetAlertMetaResponseMultiError, or nil if none found.
+func (m *GetAlertMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaResponse) validate(all bool) error {
+func (m *GetAlertMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GitUrl
+	// no validation rules for OpenUrl
+
+	// no validation rules for EditUrl
 
 	if all {
-		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetRepoMetaResponseValidationError{
-				field:  "GitUrlExpiresOn",
+			return GetAlertMetaResponseValidationError{
+				field:  "QueryForAttributes",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for GitSubpath
-
 	if len(errors) > 0 {
-		return GetRepoMetaResponseMultiError(errors)
+		return GetAlertMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// GetAlertMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRepoMetaResponseMultiError []error
+type GetAlertMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaResponseMultiError) Error() string {
+func (m GetAlertMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15784,11 +22392,11 @@ func (m GetRepoMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
+func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaResponseValidationError is the validation error returned by
-// GetRepoMetaResponse.Validate if the designated constraints aren't met.
-type GetRepoMetaResponseValidationError struct {
+// GetAlertMetaResponseValidationError is the validation error returned by
+// GetAlertMetaResponse.Validate if the designated constraints aren't met.
+type GetAlertMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15796,24 +22404,24 @@ type GetRepoMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
+func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
+func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
+func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
+func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaResponseValidationError) ErrorName() string {
-	return "GetRepoMetaResponseValidationError"
+func (e GetAlertMetaResponseValidationError) ErrorName() string {
+	return "GetAlertMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaResponseValidationError) Error() string {
+func (e GetAlertMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15825,14 +22433,14 @@ func (e GetRepoMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaResponse.%s: %s%s",
+		"invalid %sGetAlertMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaResponseValidationError{}
+var _ error = GetAlertMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15840,65 +22448,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaResponseValidationError{}
+} = GetAlertMetaResponseValidationError{}
 
-// Validate checks the field values on PullVirtualRepoRequest with the rules
+// Validate checks the field values on CreateReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoRequest) Validate() error {
+func (m *CreateReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
+// ValidateAll checks the field values on CreateReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoRequestMultiError, or nil if none found.
-func (m *PullVirtualRepoRequest) ValidateAll() error {
+// CreateReportRequestMultiError, or nil if none found.
+func (m *CreateReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoRequest) validate(all bool) error {
+func (m *CreateReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for Organization
 
-	// no validation rules for Branch
+	// no validation rules for Project
 
-	if m.GetPageSize() != 0 {
+	if m.GetOptions() == nil {
+		err := CreateReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() > 100 {
-			err := PullVirtualRepoRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 100",
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoRequestMultiError(errors)
+		return CreateReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
+// CreateReportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PullVirtualRepoRequestMultiError []error
+type CreateReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoRequestMultiError) Error() string {
+func (m CreateReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15907,11 +22538,11 @@ func (m PullVirtualRepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
+func (m CreateReportRequestMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoRequestValidationError is the validation error returned by
-// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
-type PullVirtualRepoRequestValidationError struct {
+// CreateReportRequestValidationError is the validation error returned by
+// CreateReportRequest.Validate if the designated constraints aren't met.
+type CreateReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15919,24 +22550,24 @@ type PullVirtualRepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
+func (e CreateReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
+func (e CreateReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
+func (e CreateReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
+func (e CreateReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoRequestValidationError) ErrorName() string {
-	return "PullVirtualRepoRequestValidationError"
+func (e CreateReportRequestValidationError) ErrorName() string {
+	return "CreateReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoRequestValidationError) Error() string {
+func (e CreateReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15948,14 +22579,14 @@ func (e PullVirtualRepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoRequest.%s: %s%s",
+		"invalid %sCreateReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoRequestValidationError{}
+var _ error = CreateReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15963,80 +22594,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoRequestValidationError{}
+} = CreateReportRequestValidationError{}
 
-// Validate checks the field values on PullVirtualRepoResponse with the rules
+// Validate checks the field values on CreateReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoResponse) Validate() error {
+func (m *CreateReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoResponseMultiError, or nil if none found.
-func (m *PullVirtualRepoResponse) ValidateAll() error {
+// CreateReportResponseMultiError, or nil if none found.
+func (m *CreateReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoResponse) validate(all bool) error {
+func (m *CreateReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFiles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PullVirtualRepoResponseValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return PullVirtualRepoResponseMultiError(errors)
+		return CreateReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
+// CreateReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReportResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PullVirtualRepoResponseMultiError []error
+type CreateReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoResponseMultiError) Error() string {
+func (m CreateReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16045,11 +22642,11 @@ func (m PullVirtualRepoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
+func (m CreateReportResponseMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoResponseValidationError is the validation error returned by
-// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
-type PullVirtualRepoResponseValidationError struct {
+// CreateReportResponseValidationError is the validation error returned by
+// CreateReportResponse.Validate if the designated constraints aren't met.
+type CreateReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16057,24 +22654,24 @@ type PullVirtualRepoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
+func (e CreateReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
+func (e CreateReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
+func (e CreateReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
+func (e CreateReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoResponseValidationError) ErrorName() string {
-	return "PullVirtualRepoResponseValidationError"
+func (e CreateReportResponseValidationError) ErrorName() string {
+	return "CreateReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoResponseValidationError) Error() string {
+func (e CreateReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16086,14 +22683,14 @@ func (e PullVirtualRepoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoResponse.%s: %s%s",
+		"invalid %sCreateReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoResponseValidationError{}
+var _ error = CreateReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16101,61 +22698,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoResponseValidationError{}
+} = CreateReportResponseValidationError{}
 
-// Validate checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaRequest) Validate() error {
+// Validate checks the field values on EditReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaRequest with the rules
+// ValidateAll checks the field values on EditReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaRequestMultiError, or nil if none found.
-func (m *GetReportMetaRequest) ValidateAll() error {
+// EditReportRequestMultiError, or nil if none found.
+func (m *EditReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaRequest) validate(all bool) error {
+func (m *EditReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for Organization
 
-	// no validation rules for Branch
+	// no validation rules for Project
 
-	// no validation rules for Report
+	// no validation rules for Name
 
-	// no validation rules for Annotations
+	if m.GetOptions() == nil {
+		err := EditReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
+		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetReportMetaRequestValidationError{
-				field:  "ExecutionTime",
+			return EditReportRequestValidationError{
+				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -16163,19 +22769,19 @@ func (m *GetReportMetaRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetReportMetaRequestMultiError(errors)
+		return EditReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaRequestMultiError []error
+// EditReportRequestMultiError is an error wrapping multiple validation errors
+// returned by EditReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaRequestMultiError) Error() string {
+func (m EditReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16184,11 +22790,11 @@ func (m GetReportMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+func (m EditReportRequestMultiError) AllErrors() []error { return m }
 
-// GetReportMetaRequestValidationError is the validation error returned by
-// GetReportMetaRequest.Validate if the designated constraints aren't met.
-type GetReportMetaRequestValidationError struct {
+// EditReportRequestValidationError is the validation error returned by
+// EditReportRequest.Validate if the designated constraints aren't met.
+type EditReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16196,24 +22802,24 @@ type GetReportMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+func (e EditReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+func (e EditReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+func (e EditReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+func (e EditReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaRequestValidationError) ErrorName() string {
-	return "GetReportMetaRequestValidationError"
+func (e EditReportRequestValidationError) ErrorName() string {
+	return "EditReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaRequestValidationError) Error() string {
+func (e EditReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16225,14 +22831,14 @@ func (e GetReportMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaRequest.%s: %s%s",
+		"invalid %sEditReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaRequestValidationError{}
+var _ error = EditReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16240,50 +22846,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaRequestValidationError{}
+} = EditReportRequestValidationError{}
 
-// Validate checks the field values on GetReportMetaResponse with the rules
+// Validate checks the field values on EditReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaResponse) Validate() error {
+func (m *EditReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaResponse with the rules
+// ValidateAll checks the field values on EditReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaResponseMultiError, or nil if none found.
-func (m *GetReportMetaResponse) ValidateAll() error {
+// EditReportResponseMultiError, or nil if none found.
+func (m *EditReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaResponse) validate(all bool) error {
+func (m *EditReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
-
-	// no validation rules for ExportUrl
-
-	// no validation rules for EditUrl
-
 	if len(errors) > 0 {
-		return GetReportMetaResponseMultiError(errors)
+		return EditReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaResponseMultiError []error
+// EditReportResponseMultiError is an error wrapping multiple validation errors
+// returned by EditReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaResponseMultiError) Error() string {
+func (m EditReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16292,11 +22892,11 @@ func (m GetReportMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+func (m EditReportResponseMultiError) AllErrors() []error { return m }
 
-// GetReportMetaResponseValidationError is the validation error returned by
-// GetReportMetaResponse.Validate if the designated constraints aren't met.
-type GetReportMetaResponseValidationError struct {
+// EditReportResponseValidationError is the validation error returned by
+// EditReportResponse.Validate if the designated constraints aren't met.
+type EditReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16304,24 +22904,24 @@ type GetReportMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+func (e EditReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+func (e EditReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+func (e EditReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+func (e EditReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaResponseValidationError) ErrorName() string {
-	return "GetReportMetaResponseValidationError"
+func (e EditReportResponseValidationError) ErrorName() string {
+	return "EditReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaResponseValidationError) Error() string {
+func (e EditReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16333,14 +22933,14 @@ func (e GetReportMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaResponse.%s: %s%s",
+		"invalid %sEditReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaResponseValidationError{}
+var _ error = EditReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16348,81 +22948,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaResponseValidationError{}
+} = EditReportResponseValidationError{}
 
-// Validate checks the field values on GetAlertMetaRequest with the rules
+// Validate checks the field values on UnsubscribeReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaRequest) Validate() error {
+func (m *UnsubscribeReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnsubscribeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertMetaRequestMultiError, or nil if none found.
-func (m *GetAlertMetaRequest) ValidateAll() error {
+// UnsubscribeReportRequestMultiError, or nil if none found.
+func (m *UnsubscribeReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaRequest) validate(all bool) error {
+func (m *UnsubscribeReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	// no validation rules for Alert
+	// no validation rules for Organization
 
-	// no validation rules for Annotations
+	// no validation rules for Project
 
-	switch v := m.QueryFor.(type) {
-	case *GetAlertMetaRequest_QueryForUserId:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for QueryForUserId
-	case *GetAlertMetaRequest_QueryForUserEmail:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for QueryForUserEmail
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GetAlertMetaRequestMultiError(errors)
+		return UnsubscribeReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
+// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAlertMetaRequestMultiError []error
+type UnsubscribeReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaRequestMultiError) Error() string {
+func (m UnsubscribeReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16431,11 +23000,11 @@ func (m GetAlertMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaRequestValidationError is the validation error returned by
-// GetAlertMetaRequest.Validate if the designated constraints aren't met.
-type GetAlertMetaRequestValidationError struct {
+// UnsubscribeReportRequestValidationError is the validation error returned by
+// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
+type UnsubscribeReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16443,24 +23012,24 @@ type GetAlertMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaRequestValidationError) ErrorName() string {
-	return "GetAlertMetaRequestValidationError"
+func (e UnsubscribeReportRequestValidationError) ErrorName() string {
+	return "UnsubscribeReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaRequestValidationError) Error() string {
+func (e UnsubscribeReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16472,14 +23041,14 @@ func (e GetAlertMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		"invalid %sUnsubscribeReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaRequestValidationError{}
+var _ error = UnsubscribeReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16487,77 +23056,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaRequestValidationError{}
+} = UnsubscribeReportRequestValidationError{}
 
-// Validate checks the field values on GetAlertMetaResponse with the rules
+// Validate checks the field values on UnsubscribeReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaResponse) Validate() error {
+func (m *UnsubscribeReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnsubscribeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertMetaResponseMultiError, or nil if none found.
-func (m *GetAlertMetaResponse) ValidateAll() error {
+// UnsubscribeReportResponseMultiError, or nil if none found.
+func (m *UnsubscribeReportResponse) ValidateAll() error {
 	return m.validate(true)
-}
-
-func (m *GetAlertMetaResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for OpenUrl
-
-	// no validation rules for EditUrl
-
-	if all {
-		switch v := interface{}(m.GetQueryForAttributes()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAlertMetaResponseValidationError{
-				field:  "QueryForAttributes",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+}
+
+func (m *UnsubscribeReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return GetAlertMetaResponseMultiError(errors)
+		return UnsubscribeReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertMetaResponseMultiError []error
+// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UnsubscribeReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaResponseMultiError) Error() string {
+func (m UnsubscribeReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16566,11 +23102,11 @@ func (m GetAlertMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaResponseValidationError is the validation error returned by
-// GetAlertMetaResponse.Validate if the designated constraints aren't met.
-type GetAlertMetaResponseValidationError struct {
+// UnsubscribeReportResponseValidationError is the validation error returned by
+// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
+type UnsubscribeReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16578,24 +23114,24 @@ type GetAlertMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaResponseValidationError) ErrorName() string {
-	return "GetAlertMetaResponseValidationError"
+func (e UnsubscribeReportResponseValidationError) ErrorName() string {
+	return "UnsubscribeReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaResponseValidationError) Error() string {
+func (e UnsubscribeReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16607,14 +23143,14 @@ func (e GetAlertMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		"invalid %sUnsubscribeReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaResponseValidationError{}
+var _ error = UnsubscribeReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16622,24 +23158,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaResponseValidationError{}
+} = UnsubscribeReportResponseValidationError{}
 
-// Validate checks the field values on CreateReportRequest with the rules
+// Validate checks the field values on DeleteReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportRequest) Validate() error {
+func (m *DeleteReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportRequest with the rules
+// ValidateAll checks the field values on DeleteReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportRequestMultiError, or nil if none found.
-func (m *CreateReportRequest) ValidateAll() error {
+// DeleteReportRequestMultiError, or nil if none found.
+func (m *DeleteReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportRequest) validate(all bool) error {
+func (m *DeleteReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -16650,60 +23186,22 @@ func (m *CreateReportRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	if m.GetOptions() == nil {
-		err := CreateReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateReportRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return CreateReportRequestMultiError(errors)
+		return DeleteReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReportRequest.ValidateAll() if the designated
+// DeleteReportRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReportRequestMultiError []error
+type DeleteReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportRequestMultiError) Error() string {
+func (m DeleteReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16712,11 +23210,11 @@ func (m CreateReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
 
-// CreateReportRequestValidationError is the validation error returned by
-// CreateReportRequest.Validate if the designated constraints aren't met.
-type CreateReportRequestValidationError struct {
+// DeleteReportRequestValidationError is the validation error returned by
+// DeleteReportRequest.Validate if the designated constraints aren't met.
+type DeleteReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16724,24 +23222,24 @@ type CreateReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportRequestValidationError) Field() string { return e.field }
+func (e DeleteReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportRequestValidationError) Key() bool { return e.key }
+func (e DeleteReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportRequestValidationError) ErrorName() string {
-	return "CreateReportRequestValidationError"
+func (e DeleteReportRequestValidationError) ErrorName() string {
+	return "DeleteReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportRequestValidationError) Error() string {
+func (e DeleteReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16753,14 +23251,14 @@ func (e CreateReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportRequest.%s: %s%s",
+		"invalid %sDeleteReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportRequestValidationError{}
+var _ error = DeleteReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16768,46 +23266,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportRequestValidationError{}
+} = DeleteReportRequestValidationError{}
 
-// Validate checks the field values on CreateReportResponse with the rules
+// Validate checks the field values on DeleteReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportResponse) Validate() error {
+func (m *DeleteReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportResponse with the rules
+// ValidateAll checks the field values on DeleteReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportResponseMultiError, or nil if none found.
-func (m *CreateReportResponse) ValidateAll() error {
+// DeleteReportResponseMultiError, or nil if none found.
+func (m *DeleteReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportResponse) validate(all bool) error {
+func (m *DeleteReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return CreateReportResponseMultiError(errors)
+		return DeleteReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateReportResponse.ValidateAll() if the designated
+// DeleteReportResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReportResponseMultiError []error
+type DeleteReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportResponseMultiError) Error() string {
+func (m DeleteReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16816,11 +23312,11 @@ func (m CreateReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
 
-// CreateReportResponseValidationError is the validation error returned by
-// CreateReportResponse.Validate if the designated constraints aren't met.
-type CreateReportResponseValidationError struct {
+// DeleteReportResponseValidationError is the validation error returned by
+// DeleteReportResponse.Validate if the designated constraints aren't met.
+type DeleteReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16828,24 +23324,24 @@ type CreateReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportResponseValidationError) Field() string { return e.field }
+func (e DeleteReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportResponseValidationError) Key() bool { return e.key }
+func (e DeleteReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportResponseValidationError) ErrorName() string {
-	return "CreateReportResponseValidationError"
+func (e DeleteReportResponseValidationError) ErrorName() string {
+	return "DeleteReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportResponseValidationError) Error() string {
+func (e DeleteReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16857,14 +23353,14 @@ func (e CreateReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportResponse.%s: %s%s",
+		"invalid %sDeleteReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportResponseValidationError{}
+var _ error = DeleteReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16872,90 +23368,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportResponseValidationError{}
-
-// Validate checks the field values on EditReportRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditReportRequest) Validate() error {
-	return m.validate(false)
-}
+} = DeleteReportResponseValidationError{}
 
-// ValidateAll checks the field values on EditReportRequest with the rules
+// Validate checks the field values on TriggerReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditReportRequestMultiError, or nil if none found.
-func (m *EditReportRequest) ValidateAll() error {
-	return m.validate(true)
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerReportRequest) Validate() error {
+	return m.validate(false)
 }
 
-func (m *EditReportRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
-
-	if m.GetOptions() == nil {
-		err := EditReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EditReportRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ValidateAll checks the field values on TriggerReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerReportRequestMultiError, or nil if none found.
+func (m *TriggerReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
 	if len(errors) > 0 {
-		return EditReportRequestMultiError(errors)
+		return TriggerReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportRequestMultiError is an error wrapping multiple validation errors
-// returned by EditReportRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportRequestMultiError []error
+// TriggerReportRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportRequestMultiError) Error() string {
+func (m TriggerReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16964,11 +23420,11 @@ func (m EditReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportRequestMultiError) AllErrors() []error { return m }
+func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
 
-// EditReportRequestValidationError is the validation error returned by
-// EditReportRequest.Validate if the designated constraints aren't met.
-type EditReportRequestValidationError struct {
+// TriggerReportRequestValidationError is the validation error returned by
+// TriggerReportRequest.Validate if the designated constraints aren't met.
+type TriggerReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16976,24 +23432,24 @@ type EditReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportRequestValidationError) Field() string { return e.field }
+func (e TriggerReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportRequestValidationError) Reason() string { return e.reason }
+func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportRequestValidationError) Cause() error { return e.cause }
+func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportRequestValidationError) Key() bool { return e.key }
+func (e TriggerReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportRequestValidationError) ErrorName() string {
-	return "EditReportRequestValidationError"
+func (e TriggerReportRequestValidationError) ErrorName() string {
+	return "TriggerReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportRequestValidationError) Error() string {
+func (e TriggerReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17005,14 +23461,14 @@ func (e EditReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportRequest.%s: %s%s",
+		"invalid %sTriggerReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportRequestValidationError{}
+var _ error = TriggerReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17020,24 +23476,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportRequestValidationError{}
+} = TriggerReportRequestValidationError{}
 
-// Validate checks the field values on EditReportResponse with the rules
+// Validate checks the field values on TriggerReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EditReportResponse) Validate() error {
+func (m *TriggerReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditReportResponse with the rules
+// ValidateAll checks the field values on TriggerReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditReportResponseMultiError, or nil if none found.
-func (m *EditReportResponse) ValidateAll() error {
+// TriggerReportResponseMultiError, or nil if none found.
+func (m *TriggerReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditReportResponse) validate(all bool) error {
+func (m *TriggerReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17045,19 +23501,19 @@ func (m *EditReportResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return EditReportResponseMultiError(errors)
+		return TriggerReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportResponseMultiError is an error wrapping multiple validation errors
-// returned by EditReportResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportResponseMultiError []error
+// TriggerReportResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportResponseMultiError) Error() string {
+func (m TriggerReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17066,11 +23522,11 @@ func (m EditReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportResponseMultiError) AllErrors() []error { return m }
+func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
 
-// EditReportResponseValidationError is the validation error returned by
-// EditReportResponse.Validate if the designated constraints aren't met.
-type EditReportResponseValidationError struct {
+// TriggerReportResponseValidationError is the validation error returned by
+// TriggerReportResponse.Validate if the designated constraints aren't met.
+type TriggerReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17078,24 +23534,24 @@ type EditReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportResponseValidationError) Field() string { return e.field }
+func (e TriggerReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportResponseValidationError) Reason() string { return e.reason }
+func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportResponseValidationError) Cause() error { return e.cause }
+func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportResponseValidationError) Key() bool { return e.key }
+func (e TriggerReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportResponseValidationError) ErrorName() string {
-	return "EditReportResponseValidationError"
+func (e TriggerReportResponseValidationError) ErrorName() string {
+	return "TriggerReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportResponseValidationError) Error() string {
+func (e TriggerReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17107,14 +23563,14 @@ func (e EditReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportResponse.%s: %s%s",
+		"invalid %sTriggerReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportResponseValidationError{}
+var _ error = TriggerReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17122,24 +23578,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportResponseValidationError{}
+} = TriggerReportResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeReportRequest with the rules
+// Validate checks the field values on GenerateReportYAMLRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportRequest) Validate() error {
+func (m *GenerateReportYAMLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportRequest with the
+// ValidateAll checks the field values on GenerateReportYAMLRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportRequestMultiError, or nil if none found.
-func (m *UnsubscribeReportRequest) ValidateAll() error {
+// GenerateReportYAMLRequestMultiError, or nil if none found.
+func (m *GenerateReportYAMLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportRequest) validate(all bool) error {
+func (m *GenerateReportYAMLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17150,22 +23606,60 @@ func (m *UnsubscribeReportRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	// no validation rules for Name
+	if m.GetOptions() == nil {
+		err := GenerateReportYAMLRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateReportYAMLRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UnsubscribeReportRequestMultiError(errors)
+		return GenerateReportYAMLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UnsubscribeReportRequestMultiError []error
+// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateReportYAMLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportRequestMultiError) Error() string {
+func (m GenerateReportYAMLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17174,11 +23668,11 @@ func (m UnsubscribeReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
+func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportRequestValidationError is the validation error returned by
-// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
-type UnsubscribeReportRequestValidationError struct {
+// GenerateReportYAMLRequestValidationError is the validation error returned by
+// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateReportYAMLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17186,24 +23680,24 @@ type UnsubscribeReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
+func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
+func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
+func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
+func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportRequestValidationError) ErrorName() string {
-	return "UnsubscribeReportRequestValidationError"
+func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
+	return "GenerateReportYAMLRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportRequestValidationError) Error() string {
+func (e GenerateReportYAMLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17215,14 +23709,14 @@ func (e UnsubscribeReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportRequest.%s: %s%s",
+		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportRequestValidationError{}
+var _ error = GenerateReportYAMLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17230,44 +23724,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportRequestValidationError{}
+} = GenerateReportYAMLRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GenerateReportYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportResponse) Validate() error {
+func (m *GenerateReportYAMLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportResponse with the
+// ValidateAll checks the field values on GenerateReportYAMLResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportResponseMultiError, or nil if none found.
-func (m *UnsubscribeReportResponse) ValidateAll() error {
+// GenerateReportYAMLResponseMultiError, or nil if none found.
+func (m *GenerateReportYAMLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportResponse) validate(all bool) error {
+func (m *GenerateReportYAMLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Yaml
+
 	if len(errors) > 0 {
-		return UnsubscribeReportResponseMultiError(errors)
+		return GenerateReportYAMLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UnsubscribeReportResponseMultiError []error
+// GenerateReportYAMLResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateReportYAMLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportResponseMultiError) Error() string {
+func (m GenerateReportYAMLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17276,11 +23772,11 @@ func (m UnsubscribeReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
+func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportResponseValidationError is the validation error returned by
-// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
-type UnsubscribeReportResponseValidationError struct {
+// GenerateReportYAMLResponseValidationError is the validation error returned
+// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateReportYAMLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17288,24 +23784,24 @@ type UnsubscribeReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
+func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
+func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
+func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
+func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportResponseValidationError) ErrorName() string {
-	return "UnsubscribeReportResponseValidationError"
+func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
+	return "GenerateReportYAMLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportResponseValidationError) Error() string {
+func (e GenerateReportYAMLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17317,14 +23813,14 @@ func (e UnsubscribeReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportResponse.%s: %s%s",
+		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportResponseValidationError{}
+var _ error = GenerateReportYAMLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17332,24 +23828,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportResponseValidationError{}
+} = GenerateReportYAMLResponseValidationError{}
 
-// Validate checks the field values on DeleteReportRequest with the rules
+// Validate checks the field values on CreateAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportRequest) Validate() error {
+func (m *CreateAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportRequest with the rules
+// ValidateAll checks the field values on CreateAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReportRequestMultiError, or nil if none found.
-func (m *DeleteReportRequest) ValidateAll() error {
+// CreateAlertRequestMultiError, or nil if none found.
+func (m *CreateAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportRequest) validate(all bool) error {
+func (m *CreateAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17360,22 +23856,60 @@ func (m *DeleteReportRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	// no validation rules for Name
+	if m.GetOptions() == nil {
+		err := CreateAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteReportRequestMultiError(errors)
+		return CreateAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReportRequestMultiError []error
+// CreateAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportRequestMultiError) Error() string {
+func (m CreateAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17384,11 +23918,11 @@ func (m DeleteReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
+func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReportRequestValidationError is the validation error returned by
-// DeleteReportRequest.Validate if the designated constraints aren't met.
-type DeleteReportRequestValidationError struct {
+// CreateAlertRequestValidationError is the validation error returned by
+// CreateAlertRequest.Validate if the designated constraints aren't met.
+type CreateAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17396,24 +23930,24 @@ type DeleteReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportRequestValidationError) Field() string { return e.field }
+func (e CreateAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
+func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
+func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportRequestValidationError) Key() bool { return e.key }
+func (e CreateAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportRequestValidationError) ErrorName() string {
-	return "DeleteReportRequestValidationError"
+func (e CreateAlertRequestValidationError) ErrorName() string {
+	return "CreateAlertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportRequestValidationError) Error() string {
+func (e CreateAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17425,14 +23959,14 @@ func (e DeleteReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportRequest.%s: %s%s",
+		"invalid %sCreateAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportRequestValidationError{}
+var _ error = CreateAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17440,44 +23974,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportRequestValidationError{}
+} = CreateAlertRequestValidationError{}
 
-// Validate checks the field values on DeleteReportResponse with the rules
+// Validate checks the field values on CreateAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportResponse) Validate() error {
+func (m *CreateAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportResponse with the rules
+// ValidateAll checks the field values on CreateAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReportResponseMultiError, or nil if none found.
-func (m *DeleteReportResponse) ValidateAll() error {
+// CreateAlertResponseMultiError, or nil if none found.
+func (m *CreateAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportResponse) validate(all bool) error {
+func (m *CreateAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
 	if len(errors) > 0 {
-		return DeleteReportResponseMultiError(errors)
+		return CreateAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportResponse.ValidateAll() if the designated
+// CreateAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAlertResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteReportResponseMultiError []error
+type CreateAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportResponseMultiError) Error() string {
+func (m CreateAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17486,11 +24022,11 @@ func (m DeleteReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
+func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
 
-// DeleteReportResponseValidationError is the validation error returned by
-// DeleteReportResponse.Validate if the designated constraints aren't met.
-type DeleteReportResponseValidationError struct {
+// CreateAlertResponseValidationError is the validation error returned by
+// CreateAlertResponse.Validate if the designated constraints aren't met.
+type CreateAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17498,24 +24034,24 @@ type DeleteReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportResponseValidationError) Field() string { return e.field }
+func (e CreateAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
+func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
+func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportResponseValidationError) Key() bool { return e.key }
+func (e CreateAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportResponseValidationError) ErrorName() string {
-	return "DeleteReportResponseValidationError"
+func (e CreateAlertResponseValidationError) ErrorName() string {
+	return "CreateAlertResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportResponseValidationError) Error() string {
+func (e CreateAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17527,14 +24063,14 @@ func (e DeleteReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportResponse.%s: %s%s",
+		"invalid %sCreateAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportResponseValidationError{}
+var _ error = CreateAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17542,24 +24078,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportResponseValidationError{}
+} = CreateAlertResponseValidationError{}
 
-// Validate checks the field values on TriggerReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportRequest) Validate() error {
+// Validate checks the field values on EditAlertRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportRequest with the rules
+// ValidateAll checks the field values on EditAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReportRequestMultiError, or nil if none found.
-func (m *TriggerReportRequest) ValidateAll() error {
+// EditAlertRequestMultiError, or nil if none found.
+func (m *EditAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportRequest) validate(all bool) error {
+func (m *EditAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17572,20 +24108,60 @@ func (m *TriggerReportRequest) validate(all bool) error {
 
 	// no validation rules for Name
 
+	if m.GetOptions() == nil {
+		err := EditAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TriggerReportRequestMultiError(errors)
+		return EditAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerReportRequestMultiError []error
+// EditAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by EditAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportRequestMultiError) Error() string {
+func (m EditAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17594,11 +24170,11 @@ func (m TriggerReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
+func (m EditAlertRequestMultiError) AllErrors() []error { return m }
 
-// TriggerReportRequestValidationError is the validation error returned by
-// TriggerReportRequest.Validate if the designated constraints aren't met.
-type TriggerReportRequestValidationError struct {
+// EditAlertRequestValidationError is the validation error returned by
+// EditAlertRequest.Validate if the designated constraints aren't met.
+type EditAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17606,24 +24182,22 @@ type TriggerReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportRequestValidationError) Field() string { return e.field }
+func (e EditAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
+func (e EditAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
+func (e EditAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportRequestValidationError) Key() bool { return e.key }
+func (e EditAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportRequestValidationError) ErrorName() string {
-	return "TriggerReportRequestValidationError"
-}
+func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportRequestValidationError) Error() string {
+func (e EditAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17635,14 +24209,14 @@ func (e TriggerReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportRequest.%s: %s%s",
+		"invalid %sEditAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportRequestValidationError{}
+var _ error = EditAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17650,24 +24224,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportRequestValidationError{}
+} = EditAlertRequestValidationError{}
 
-// Validate checks the field values on TriggerReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportResponse) Validate() error {
+// Validate checks the field values on EditAlertResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportResponse with the rules
+// ValidateAll checks the field values on EditAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TriggerReportResponseMultiError, or nil if none found.
-func (m *TriggerReportResponse) ValidateAll() error {
+// violated, the result is a list of violation errors wrapped in
+// EditAlertResponseMultiError, or nil if none found.
+func (m *EditAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportResponse) validate(all bool) error {
+func (m *EditAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17675,19 +24249,19 @@ func (m *TriggerReportResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return TriggerReportResponseMultiError(errors)
+		return EditAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerReportResponseMultiError []error
+// EditAlertResponseMultiError is an error wrapping multiple validation errors
+// returned by EditAlertResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportResponseMultiError) Error() string {
+func (m EditAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17696,11 +24270,11 @@ func (m TriggerReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
+func (m EditAlertResponseMultiError) AllErrors() []error { return m }
 
-// TriggerReportResponseValidationError is the validation error returned by
-// TriggerReportResponse.Validate if the designated constraints aren't met.
-type TriggerReportResponseValidationError struct {
+// EditAlertResponseValidationError is the validation error returned by
+// EditAlertResponse.Validate if the designated constraints aren't met.
+type EditAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17708,24 +24282,24 @@ type TriggerReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportResponseValidationError) Field() string { return e.field }
+func (e EditAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
+func (e EditAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
+func (e EditAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportResponseValidationError) Key() bool { return e.key }
+func (e EditAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportResponseValidationError) ErrorName() string {
-	return "TriggerReportResponseValidationError"
+func (e EditAlertResponseValidationError) ErrorName() string {
+	return "EditAlertResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportResponseValidationError) Error() string {
+func (e EditAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17737,14 +24311,14 @@ func (e TriggerReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportResponse.%s: %s%s",
+		"invalid %sEditAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportResponseValidationError{}
+var _ error = EditAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17752,24 +24326,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportResponseValidationError{}
+} = EditAlertResponseValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLRequest with the rules
+// Validate checks the field values on UnsubscribeAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLRequest) Validate() error {
+func (m *UnsubscribeAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLRequest with the
+// ValidateAll checks the field values on UnsubscribeAlertRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLRequestMultiError, or nil if none found.
-func (m *GenerateReportYAMLRequest) ValidateAll() error {
+// UnsubscribeAlertRequestMultiError, or nil if none found.
+func (m *UnsubscribeAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLRequest) validate(all bool) error {
+func (m *UnsubscribeAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17780,60 +24354,22 @@ func (m *GenerateReportYAMLRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	if m.GetOptions() == nil {
-		err := GenerateReportYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateReportYAMLRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GenerateReportYAMLRequestMultiError(errors)
+		return UnsubscribeAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateReportYAMLRequestMultiError []error
+// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLRequestMultiError) Error() string {
+func (m UnsubscribeAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17842,11 +24378,11 @@ func (m GenerateReportYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
+func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLRequestValidationError is the validation error returned by
-// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateReportYAMLRequestValidationError struct {
+// UnsubscribeAlertRequestValidationError is the validation error returned by
+// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
+type UnsubscribeAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17854,24 +24390,24 @@ type GenerateReportYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
+func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
+func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
+func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
+func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
-	return "GenerateReportYAMLRequestValidationError"
+func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
+	return "UnsubscribeAlertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLRequestValidationError) Error() string {
+func (e UnsubscribeAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17883,14 +24419,14 @@ func (e GenerateReportYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
+		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLRequestValidationError{}
+var _ error = UnsubscribeAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17898,46 +24434,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLRequestValidationError{}
+} = UnsubscribeAlertRequestValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UnsubscribeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLResponse) Validate() error {
+func (m *UnsubscribeAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLResponse with the
+// ValidateAll checks the field values on UnsubscribeAlertResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLResponseMultiError, or nil if none found.
-func (m *GenerateReportYAMLResponse) ValidateAll() error {
+// UnsubscribeAlertResponseMultiError, or nil if none found.
+func (m *UnsubscribeAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLResponse) validate(all bool) error {
+func (m *UnsubscribeAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
-
 	if len(errors) > 0 {
-		return GenerateReportYAMLResponseMultiError(errors)
+		return UnsubscribeAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GenerateReportYAMLResponseMultiError []error
+// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLResponseMultiError) Error() string {
+func (m UnsubscribeAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17946,11 +24480,11 @@ func (m GenerateReportYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
+func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLResponseValidationError is the validation error returned
-// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateReportYAMLResponseValidationError struct {
+// UnsubscribeAlertResponseValidationError is the validation error returned by
+// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
+type UnsubscribeAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17958,24 +24492,24 @@ type GenerateReportYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
+func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
+func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
+func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
+func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
-	return "GenerateReportYAMLResponseValidationError"
+func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
+	return "UnsubscribeAlertResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLResponseValidationError) Error() string {
+func (e UnsubscribeAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17987,14 +24521,14 @@ func (e GenerateReportYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
+		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLResponseValidationError{}
+var _ error = UnsubscribeAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18002,24 +24536,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLResponseValidationError{}
+} = UnsubscribeAlertResponseValidationError{}
 
-// Validate checks the field values on CreateAlertRequest with the rules
+// Validate checks the field values on DeleteAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertRequest) Validate() error {
+func (m *DeleteAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertRequest with the rules
+// ValidateAll checks the field values on DeleteAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAlertRequestMultiError, or nil if none found.
-func (m *CreateAlertRequest) ValidateAll() error {
+// DeleteAlertRequestMultiError, or nil if none found.
+func (m *DeleteAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertRequest) validate(all bool) error {
+func (m *DeleteAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18030,60 +24564,22 @@ func (m *CreateAlertRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	if m.GetOptions() == nil {
-		err := CreateAlertRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateAlertRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return CreateAlertRequestMultiError(errors)
+		return DeleteAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateAlertRequest.ValidateAll() if the designated constraints
+// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CreateAlertRequestMultiError []error
+type DeleteAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertRequestMultiError) Error() string {
+func (m DeleteAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18092,11 +24588,11 @@ func (m CreateAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
+func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
 
-// CreateAlertRequestValidationError is the validation error returned by
-// CreateAlertRequest.Validate if the designated constraints aren't met.
-type CreateAlertRequestValidationError struct {
+// DeleteAlertRequestValidationError is the validation error returned by
+// DeleteAlertRequest.Validate if the designated constraints aren't met.
+type DeleteAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18104,24 +24600,24 @@ type CreateAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertRequestValidationError) Field() string { return e.field }
+func (e DeleteAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
+func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
+func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertRequestValidationError) Key() bool { return e.key }
+func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertRequestValidationError) ErrorName() string {
-	return "CreateAlertRequestValidationError"
+func (e DeleteAlertRequestValidationError) ErrorName() string {
+	return "DeleteAlertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertRequestValidationError) Error() string {
+func (e DeleteAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18133,14 +24629,14 @@ func (e CreateAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertRequest.%s: %s%s",
+		"invalid %sDeleteAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertRequestValidationError{}
+var _ error = DeleteAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18148,46 +24644,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertRequestValidationError{}
+} = DeleteAlertRequestValidationError{}
 
-// Validate checks the field values on CreateAlertResponse with the rules
+// Validate checks the field values on DeleteAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertResponse) Validate() error {
+func (m *DeleteAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertResponse with the rules
+// ValidateAll checks the field values on DeleteAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAlertResponseMultiError, or nil if none found.
-func (m *CreateAlertResponse) ValidateAll() error {
+// DeleteAlertResponseMultiError, or nil if none found.
+func (m *DeleteAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertResponse) validate(all bool) error {
+func (m *DeleteAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return CreateAlertResponseMultiError(errors)
+		return DeleteAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAlertResponse.ValidateAll() if the designated
+// DeleteAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlertResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAlertResponseMultiError []error
+type DeleteAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertResponseMultiError) Error() string {
+func (m DeleteAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18196,11 +24690,11 @@ func (m CreateAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
+func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
 
-// CreateAlertResponseValidationError is the validation error returned by
-// CreateAlertResponse.Validate if the designated constraints aren't met.
-type CreateAlertResponseValidationError struct {
+// DeleteAlertResponseValidationError is the validation error returned by
+// DeleteAlertResponse.Validate if the designated constraints aren't met.
+type DeleteAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18208,24 +24702,24 @@ type CreateAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertResponseValidationError) Field() string { return e.field }
+func (e DeleteAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
+func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
+func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertResponseValidationError) Key() bool { return e.key }
+func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertResponseValidationError) ErrorName() string {
-	return "CreateAlertResponseValidationError"
+func (e DeleteAlertResponseValidationError) ErrorName() string {
+	return "DeleteAlertResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertResponseValidationError) Error() string {
+func (e DeleteAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18237,14 +24731,14 @@ func (e CreateAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertResponse.%s: %s%s",
+		"invalid %sDeleteAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertResponseValidationError{}
+var _ error = DeleteAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18252,24 +24746,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertResponseValidationError{}
+} = DeleteAlertResponseValidationError{}
 
-// Validate checks the field values on EditAlertRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertRequest) Validate() error {
+// Validate checks the field values on GenerateAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditAlertRequestMultiError, or nil if none found.
-func (m *EditAlertRequest) ValidateAll() error {
+// GenerateAlertYAMLRequestMultiError, or nil if none found.
+func (m *GenerateAlertYAMLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertRequest) validate(all bool) error {
+func (m *GenerateAlertYAMLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18280,10 +24774,8 @@ func (m *EditAlertRequest) validate(all bool) error {
 
 	// no validation rules for Project
 
-	// no validation rules for Name
-
 	if m.GetOptions() == nil {
-		err := EditAlertRequestValidationError{
+		err := GenerateAlertYAMLRequestValidationError{
 			field:  "Options",
 			reason: "value is required",
 		}
@@ -18297,7 +24789,7 @@ func (m *EditAlertRequest) validate(all bool) error {
 		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -18305,7 +24797,7 @@ func (m *EditAlertRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -18314,7 +24806,7 @@ func (m *EditAlertRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return EditAlertRequestValidationError{
+			return GenerateAlertYAMLRequestValidationError{
 				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -18323,19 +24815,19 @@ func (m *EditAlertRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EditAlertRequestMultiError(errors)
+		return GenerateAlertYAMLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by EditAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertRequestMultiError []error
+// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateAlertYAMLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertRequestMultiError) Error() string {
+func (m GenerateAlertYAMLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18344,11 +24836,11 @@ func (m EditAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertRequestMultiError) AllErrors() []error { return m }
+func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
 
-// EditAlertRequestValidationError is the validation error returned by
-// EditAlertRequest.Validate if the designated constraints aren't met.
-type EditAlertRequestValidationError struct {
+// GenerateAlertYAMLRequestValidationError is the validation error returned by
+// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18356,22 +24848,24 @@ type EditAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertRequestValidationError) Field() string { return e.field }
+func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertRequestValidationError) Reason() string { return e.reason }
+func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertRequestValidationError) Cause() error { return e.cause }
+func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertRequestValidationError) Key() bool { return e.key }
+func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
+func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
+	return "GenerateAlertYAMLRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EditAlertRequestValidationError) Error() string {
+func (e GenerateAlertYAMLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18383,14 +24877,14 @@ func (e EditAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertRequest.%s: %s%s",
+		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertRequestValidationError{}
+var _ error = GenerateAlertYAMLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18398,44 +24892,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertRequestValidationError{}
+} = GenerateAlertYAMLRequestValidationError{}
 
-// Validate checks the field values on EditAlertResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertResponse) Validate() error {
+// Validate checks the field values on GenerateAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditAlertResponseMultiError, or nil if none found.
-func (m *EditAlertResponse) ValidateAll() error {
+// GenerateAlertYAMLResponseMultiError, or nil if none found.
+func (m *GenerateAlertYAMLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertResponse) validate(all bool) error {
+func (m *GenerateAlertYAMLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Yaml
+
 	if len(errors) > 0 {
-		return EditAlertResponseMultiError(errors)
+		return GenerateAlertYAMLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertResponseMultiError is an error wrapping multiple validation errors
-// returned by EditAlertResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertResponseMultiError []error
+// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateAlertYAMLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertResponseMultiError) Error() string {
+func (m GenerateAlertYAMLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18444,11 +24940,11 @@ func (m EditAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertResponseMultiError) AllErrors() []error { return m }
+func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
 
-// EditAlertResponseValidationError is the validation error returned by
-// EditAlertResponse.Validate if the designated constraints aren't met.
-type EditAlertResponseValidationError struct {
+// GenerateAlertYAMLResponseValidationError is the validation error returned by
+// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18456,24 +24952,24 @@ type EditAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertResponseValidationError) Field() string { return e.field }
+func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertResponseValidationError) Reason() string { return e.reason }
+func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertResponseValidationError) Cause() error { return e.cause }
+func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertResponseValidationError) Key() bool { return e.key }
+func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertResponseValidationError) ErrorName() string {
-	return "EditAlertResponseValidationError"
+func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
+	return "GenerateAlertYAMLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditAlertResponseValidationError) Error() string {
+func (e GenerateAlertYAMLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18485,14 +24981,14 @@ func (e EditAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertResponse.%s: %s%s",
+		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertResponseValidationError{}
+var _ error = GenerateAlertYAMLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18500,24 +24996,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertResponseValidationError{}
+} = GenerateAlertYAMLResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertRequest with the rules
+// Validate checks the field values on GetAlertYAMLRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertRequest) Validate() error {
+func (m *GetAlertYAMLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertRequestMultiError, or nil if none found.
-func (m *UnsubscribeAlertRequest) ValidateAll() error {
+// GetAlertYAMLRequestMultiError, or nil if none found.
+func (m *GetAlertYAMLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertRequest) validate(all bool) error {
+func (m *GetAlertYAMLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18531,19 +25027,19 @@ func (m *UnsubscribeAlertRequest) validate(all bool) error {
 	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return UnsubscribeAlertRequestMultiError(errors)
+		return GetAlertYAMLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
+// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UnsubscribeAlertRequestMultiError []error
+type GetAlertYAMLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertRequestMultiError) Error() string {
+func (m GetAlertYAMLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18552,11 +25048,11 @@ func (m UnsubscribeAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertRequestValidationError is the validation error returned by
-// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
-type UnsubscribeAlertRequestValidationError struct {
+// GetAlertYAMLRequestValidationError is the validation error returned by
+// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GetAlertYAMLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18564,24 +25060,24 @@ type UnsubscribeAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
+func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
+func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
-	return "UnsubscribeAlertRequestValidationError"
+func (e GetAlertYAMLRequestValidationError) ErrorName() string {
+	return "GetAlertYAMLRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertRequestValidationError) Error() string {
+func (e GetAlertYAMLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18593,14 +25089,14 @@ func (e UnsubscribeAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
+		"invalid %sGetAlertYAMLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertRequestValidationError{}
+var _ error = GetAlertYAMLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18608,44 +25104,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertRequestValidationError{}
+} = GetAlertYAMLRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertResponse with the rules
+// Validate checks the field values on GetAlertYAMLResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertResponse) Validate() error {
+func (m *GetAlertYAMLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertResponseMultiError, or nil if none found.
-func (m *UnsubscribeAlertResponse) ValidateAll() error {
+// GetAlertYAMLResponseMultiError, or nil if none found.
+func (m *GetAlertYAMLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertResponse) validate(all bool) error {
+func (m *GetAlertYAMLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Yaml
+
 	if len(errors) > 0 {
-		return UnsubscribeAlertResponseMultiError(errors)
+		return GetAlertYAMLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
+// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UnsubscribeAlertResponseMultiError []error
+type GetAlertYAMLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertResponseMultiError) Error() string {
+func (m GetAlertYAMLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18654,11 +25152,11 @@ func (m UnsubscribeAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
+func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertResponseValidationError is the validation error returned by
-// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
-type UnsubscribeAlertResponseValidationError struct {
+// GetAlertYAMLResponseValidationError is the validation error returned by
+// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GetAlertYAMLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18666,24 +25164,24 @@ type UnsubscribeAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
+func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
+func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
+func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
+func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
-	return "UnsubscribeAlertResponseValidationError"
+func (e GetAlertYAMLResponseValidationError) ErrorName() string {
+	return "GetAlertYAMLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertResponseValidationError) Error() string {
+func (e GetAlertYAMLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18695,14 +25193,14 @@ func (e UnsubscribeAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
+		"invalid %sGetAlertYAMLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertResponseValidationError{}
+var _ error = GetAlertYAMLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18710,50 +25208,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertResponseValidationError{}
+} = GetAlertYAMLResponseValidationError{}
 
-// Validate checks the field values on DeleteAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertRequest) Validate() error {
+// Validate checks the field values on TelemetryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertRequest with the rules
+// ValidateAll checks the field values on TelemetryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAlertRequestMultiError, or nil if none found.
-func (m *DeleteAlertRequest) ValidateAll() error {
+// TelemetryRequestMultiError, or nil if none found.
+func (m *TelemetryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertRequest) validate(all bool) error {
+func (m *TelemetryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	// no validation rules for Name
 
-	// no validation rules for Project
+	// no validation rules for Value
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TelemetryRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteAlertRequestMultiError(errors)
+		return TelemetryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
+// TelemetryRequestMultiError is an error wrapping multiple validation errors
+// returned by TelemetryRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteAlertRequestMultiError []error
+type TelemetryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertRequestMultiError) Error() string {
+func (m TelemetryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18762,11 +25287,11 @@ func (m DeleteAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+func (m TelemetryRequestMultiError) AllErrors() []error { return m }
 
-// DeleteAlertRequestValidationError is the validation error returned by
-// DeleteAlertRequest.Validate if the designated constraints aren't met.
-type DeleteAlertRequestValidationError struct {
+// TelemetryRequestValidationError is the validation error returned by
+// TelemetryRequest.Validate if the designated constraints aren't met.
+type TelemetryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18774,24 +25299,22 @@ type DeleteAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+func (e TelemetryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+func (e TelemetryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+func (e TelemetryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+func (e TelemetryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertRequestValidationError) ErrorName() string {
-	return "DeleteAlertRequestValidationError"
-}
+func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertRequestValidationError) Error() string {
+func (e TelemetryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18803,14 +25326,14 @@ func (e DeleteAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertRequest.%s: %s%s",
+		"invalid %sTelemetryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertRequestValidationError{}
+var _ error = TelemetryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18818,24 +25341,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertRequestValidationError{}
+} = TelemetryRequestValidationError{}
 
-// Validate checks the field values on DeleteAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertResponse) Validate() error {
+// Validate checks the field values on TelemetryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertResponse with the rules
+// ValidateAll checks the field values on TelemetryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAlertResponseMultiError, or nil if none found.
-func (m *DeleteAlertResponse) ValidateAll() error {
+// TelemetryResponseMultiError, or nil if none found.
+func (m *TelemetryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertResponse) validate(all bool) error {
+func (m *TelemetryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18843,19 +25366,19 @@ func (m *DeleteAlertResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return DeleteAlertResponseMultiError(errors)
+		return TelemetryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteAlertResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteAlertResponseMultiError []error
+// TelemetryResponseMultiError is an error wrapping multiple validation errors
+// returned by TelemetryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TelemetryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertResponseMultiError) Error() string {
+func (m TelemetryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18864,11 +25387,11 @@ func (m DeleteAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+func (m TelemetryResponseMultiError) AllErrors() []error { return m }
 
-// DeleteAlertResponseValidationError is the validation error returned by
-// DeleteAlertResponse.Validate if the designated constraints aren't met.
-type DeleteAlertResponseValidationError struct {
+// TelemetryResponseValidationError is the validation error returned by
+// TelemetryResponse.Validate if the designated constraints aren't met.
+type TelemetryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18876,24 +25399,24 @@ type DeleteAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+func (e TelemetryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+func (e TelemetryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+func (e TelemetryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+func (e TelemetryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertResponseValidationError) ErrorName() string {
-	return "DeleteAlertResponseValidationError"
+func (e TelemetryResponseValidationError) ErrorName() string {
+	return "TelemetryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertResponseValidationError) Error() string {
+func (e TelemetryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18905,14 +25428,14 @@ func (e DeleteAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertResponse.%s: %s%s",
+		"invalid %sTelemetryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertResponseValidationError{}
+var _ error = TelemetryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18920,68 +25443,118 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertResponseValidationError{}
+} = TelemetryResponseValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLRequest) Validate() error {
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLRequestMultiError, or nil if none found.
-func (m *GenerateAlertYAMLRequest) ValidateAll() error {
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLRequest) validate(all bool) error {
+func (m *User) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	// no validation rules for Id
 
-	// no validation rules for Project
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for PhotoUrl
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if m.GetOptions() == nil {
-		err := GenerateAlertYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GenerateAlertYAMLRequestValidationError{
-				field:  "Options",
+			return UserValidationError{
+				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -18989,19 +25562,18 @@ func (m *GenerateAlertYAMLRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLRequestMultiError(errors)
+		return UserMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateAlertYAMLRequestMultiError []error
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLRequestMultiError) Error() string {
+func (m UserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19010,11 +25582,11 @@ func (m GenerateAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m UserMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLRequestValidationError is the validation error returned by
-// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLRequestValidationError struct {
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19022,24 +25594,22 @@ type GenerateAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e UserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e UserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e UserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e UserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
-	return "GenerateAlertYAMLRequestValidationError"
-}
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLRequestValidationError) Error() string {
+func (e UserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19051,14 +25621,14 @@ func (e GenerateAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
+		"invalid %sUser.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLRequestValidationError{}
+var _ error = UserValidationError{}
 
 var _ interface {
 	Field() string
@@ -19066,46 +25636,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLRequestValidationError{}
+} = UserValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLResponse) Validate() error {
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLResponseMultiError, or nil if none found.
-func (m *GenerateAlertYAMLResponse) ValidateAll() error {
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLResponse) validate(all bool) error {
+func (m *Service) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLResponseMultiError(errors)
+		return ServiceMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateAlertYAMLResponseMultiError []error
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLResponseMultiError) Error() string {
+func (m ServiceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19114,11 +25746,11 @@ func (m GenerateAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m ServiceMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLResponseValidationError is the validation error returned by
-// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLResponseValidationError struct {
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19126,24 +25758,22 @@ type GenerateAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e ServiceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e ServiceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e ServiceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e ServiceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
-	return "GenerateAlertYAMLResponseValidationError"
-}
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLResponseValidationError) Error() string {
+func (e ServiceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19155,14 +25785,14 @@ func (e GenerateAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
+		"invalid %sService.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLResponseValidationError{}
+var _ error = ServiceValidationError{}
 
 var _ interface {
 	Field() string
@@ -19170,50 +25800,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLResponseValidationError{}
+} = ServiceValidationError{}
 
-// Validate checks the field values on GetAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLRequest) Validate() error {
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLRequestMultiError, or nil if none found.
-func (m *GetAlertYAMLRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLRequest) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	// no validation rules for Id
 
-	// no validation rules for Project
+	// no validation rules for OrgId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for ProjectName
+
+	// no validation rules for ActorType
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorName
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetAlertYAMLRequestMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertYAMLRequestMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLRequestMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19222,11 +25919,11 @@ func (m GetAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLRequestValidationError is the validation error returned by
-// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GetAlertYAMLRequestValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19234,24 +25931,22 @@ type GetAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLRequestValidationError) ErrorName() string {
-	return "GetAlertYAMLRequestValidationError"
-}
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLRequestValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19263,14 +25958,14 @@ func (e GetAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLRequest.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLRequestValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -19278,46 +25973,136 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLRequestValidationError{}
+} = AuditEventValidationError{}
 
-// Validate checks the field values on GetAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLResponse) Validate() error {
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLResponseMultiError, or nil if none found.
-func (m *GetAlertYAMLResponse) ValidateAll() error {
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLResponse) validate(all bool) error {
+func (m *Organization) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetAlertYAMLResponseMultiError(errors)
+		return OrganizationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertYAMLResponseMultiError []error
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLResponseMultiError) Error() string {
+func (m OrganizationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19326,11 +26111,11 @@ func (m GetAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLResponseValidationError is the validation error returned by
-// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GetAlertYAMLResponseValidationError struct {
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19338,24 +26123,22 @@ type GetAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e OrganizationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e OrganizationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLResponseValidationError) ErrorName() string {
-	return "GetAlertYAMLResponseValidationError"
-}
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLResponseValidationError) Error() string {
+func (e OrganizationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19367,14 +26150,14 @@ func (e GetAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLResponse.%s: %s%s",
+		"invalid %sOrganization.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLResponseValidationError{}
+var _ error = OrganizationValidationError{}
 
 var _ interface {
 	Field() string
@@ -19382,77 +26165,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLResponseValidationError{}
+} = OrganizationValidationError{}
 
-// Validate checks the field values on TelemetryRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryRequest) Validate() error {
+// Validate checks the field values on UserQuotas with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserQuotas) Validate() error {
 	return m.validate(false)
-}
-
-// ValidateAll checks the field values on TelemetryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TelemetryRequestMultiError, or nil if none found.
-func (m *TelemetryRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *TelemetryRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Name
-
-	// no validation rules for Value
-
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TelemetryRequestValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+}
+
+// ValidateAll checks the field values on UserQuotas with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserQuotasMultiError, or
+// nil if none found.
+func (m *UserQuotas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserQuotas) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for SingleuserOrgs
+
 	if len(errors) > 0 {
-		return TelemetryRequestMultiError(errors)
+		return UserQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryRequestMultiError is an error wrapping multiple validation errors
-// returned by TelemetryRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TelemetryRequestMultiError []error
+// UserQuotasMultiError is an error wrapping multiple validation errors
+// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
+type UserQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryRequestMultiError) Error() string {
+func (m UserQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19461,11 +26212,11 @@ func (m TelemetryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryRequestMultiError) AllErrors() []error { return m }
+func (m UserQuotasMultiError) AllErrors() []error { return m }
 
-// TelemetryRequestValidationError is the validation error returned by
-// TelemetryRequest.Validate if the designated constraints aren't met.
-type TelemetryRequestValidationError struct {
+// UserQuotasValidationError is the validation error returned by
+// UserQuotas.Validate if the designated constraints aren't met.
+type UserQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19473,22 +26224,22 @@ type TelemetryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryRequestValidationError) Field() string { return e.field }
+func (e UserQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryRequestValidationError) Reason() string { return e.reason }
+func (e UserQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryRequestValidationError) Cause() error { return e.cause }
+func (e UserQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryRequestValidationError) Key() bool { return e.key }
+func (e UserQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
+func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TelemetryRequestValidationError) Error() string {
+func (e UserQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19500,14 +26251,14 @@ func (e TelemetryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryRequest.%s: %s%s",
+		"invalid %sUserQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryRequestValidationError{}
+var _ error = UserQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -19515,44 +26266,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryRequestValidationError{}
+} = UserQuotasValidationError{}
 
-// Validate checks the field values on TelemetryResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryResponse) Validate() error {
+// Validate checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TelemetryResponse with the rules
+// ValidateAll checks the field values on OrganizationQuotas with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TelemetryResponseMultiError, or nil if none found.
-func (m *TelemetryResponse) ValidateAll() error {
+// OrganizationQuotasMultiError, or nil if none found.
+func (m *OrganizationQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TelemetryResponse) validate(all bool) error {
+func (m *OrganizationQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Projects
+
+	// no validation rules for Deployments
+
+	// no validation rules for SlotsTotal
+
+	// no validation rules for SlotsPerDeployment
+
+	// no validation rules for OutstandingInvites
+
 	if len(errors) > 0 {
-		return TelemetryResponseMultiError(errors)
+		return OrganizationQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryResponseMultiError is an error wrapping multiple validation errors
-// returned by TelemetryResponse.ValidateAll() if the designated constraints
+// OrganizationQuotasMultiError is an error wrapping multiple validation errors
+// returned by OrganizationQuotas.ValidateAll() if the designated constraints
 // aren't met.
-type TelemetryResponseMultiError []error
+type OrganizationQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryResponseMultiError) Error() string {
+func (m OrganizationQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19561,11 +26322,11 @@ func (m TelemetryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
 
-// TelemetryResponseValidationError is the validation error returned by
-// TelemetryResponse.Validate if the designated constraints aren't met.
-type TelemetryResponseValidationError struct {
+// OrganizationQuotasValidationError is the validation error returned by
+// OrganizationQuotas.Validate if the designated constraints aren't met.
+type OrganizationQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19573,24 +26334,24 @@ type TelemetryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryResponseValidationError) Field() string { return e.field }
+func (e OrganizationQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryResponseValidationError) Key() bool { return e.key }
+func (e OrganizationQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryResponseValidationError) ErrorName() string {
-	return "TelemetryResponseValidationError"
+func (e OrganizationQuotasValidationError) ErrorName() string {
+	return "OrganizationQuotasValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TelemetryResponseValidationError) Error() string {
+func (e OrganizationQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19602,14 +26363,14 @@ func (e TelemetryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryResponse.%s: %s%s",
+		"invalid %sOrganizationQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryResponseValidationError{}
+var _ error = OrganizationQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -19617,23 +26378,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryResponseValidationError{}
+} = OrganizationQuotasValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
+// ValidateAll checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -19642,46 +26403,45 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Email
+	// no validation rules for Name
 
-	// no validation rules for DisplayName
+	// no validation rules for OrgId
 
-	// no validation rules for PhotoUrl
+	// no validation rules for OrgName
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Description
+
+	// no validation rules for Public
+
+	// no validation rules for Provisioner
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for Annotations
+
+	// no validation rules for ProdVersion
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19689,7 +26449,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19698,7 +26458,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -19710,7 +26470,7 @@ func (m *User) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19718,7 +26478,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19727,7 +26487,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -19736,18 +26496,18 @@ func (m *User) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19756,11 +26516,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19768,22 +26528,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19795,14 +26555,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -19810,23 +26570,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = ProjectValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
+// Validate checks the field values on Deployment with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
+// nil if none found.
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -19835,17 +26596,25 @@ func (m *Service) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for ProjectId
 
-	// no validation rules for OrgId
+	// no validation rules for Slots
 
-	// no validation rules for OrgName
+	// no validation rules for Branch
+
+	// no validation rules for RuntimeHost
+
+	// no validation rules for RuntimeInstanceId
+
+	// no validation rules for Status
+
+	// no validation rules for StatusMessage
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19853,7 +26622,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19862,7 +26631,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return DeploymentValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -19874,7 +26643,7 @@ func (m *Service) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19882,7 +26651,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -19891,7 +26660,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return DeploymentValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -19899,19 +26668,21 @@ func (m *Service) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Preview
+
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19920,11 +26691,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m DeploymentMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
-// if the designated constraints aren't met.
-type ServiceValidationError struct {
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19932,22 +26703,22 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19959,14 +26730,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -19974,136 +26745,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on Organization with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Organization) Validate() error {
+// Validate checks the field values on OrganizationPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Organization with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrganizationMultiError, or
-// nil if none found.
-func (m *Organization) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationPermissions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationPermissionsMultiError, or nil if none found.
+func (m *OrganizationPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Organization) validate(all bool) error {
+func (m *OrganizationPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ReadOrg
 
-	// no validation rules for Name
+	// no validation rules for ManageOrg
 
-	// no validation rules for Description
+	// no validation rules for ReadProjects
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreateProjects
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ManageProjects
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ReadOrgMembers
+
+	// no validation rules for ManageOrgMembers
 
 	if len(errors) > 0 {
-		return OrganizationMultiError(errors)
+		return OrganizationPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationMultiError is an error wrapping multiple validation errors
-// returned by Organization.ValidateAll() if the designated constraints aren't met.
-type OrganizationMultiError []error
+// OrganizationPermissionsMultiError is an error wrapping multiple validation
+// errors returned by OrganizationPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationMultiError) Error() string {
+func (m OrganizationPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20112,11 +26805,11 @@ func (m OrganizationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationMultiError) AllErrors() []error { return m }
+func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
 
-// OrganizationValidationError is the validation error returned by
-// Organization.Validate if the designated constraints aren't met.
-type OrganizationValidationError struct {
+// OrganizationPermissionsValidationError is the validation error returned by
+// OrganizationPermissions.Validate if the designated constraints aren't met.
+type OrganizationPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20124,22 +26817,24 @@ type OrganizationValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationValidationError) Field() string { return e.field }
+func (e OrganizationPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationValidationError) Reason() string { return e.reason }
+func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationValidationError) Cause() error { return e.cause }
+func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationValidationError) Key() bool { return e.key }
+func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+func (e OrganizationPermissionsValidationError) ErrorName() string {
+	return "OrganizationPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OrganizationValidationError) Error() string {
+func (e OrganizationPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20151,14 +26846,14 @@ func (e OrganizationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganization.%s: %s%s",
+		"invalid %sOrganizationPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationValidationError{}
+var _ error = OrganizationPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -20166,45 +26861,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationValidationError{}
+} = OrganizationPermissionsValidationError{}
+
+// Validate checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectPermissions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectPermissionsMultiError, or nil if none found.
+func (m *ProjectPermissions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectPermissions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReadProject
+
+	// no validation rules for ManageProject
+
+	// no validation rules for ReadProd
+
+	// no validation rules for ReadProdStatus
+
+	// no validation rules for ManageProd
+
+	// no validation rules for ReadDev
+
+	// no validation rules for ReadDevStatus
+
+	// no validation rules for ManageDev
 
-// Validate checks the field values on UserQuotas with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserQuotas) Validate() error {
-	return m.validate(false)
-}
+	// no validation rules for ReadProjectMembers
 
-// ValidateAll checks the field values on UserQuotas with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserQuotasMultiError, or
-// nil if none found.
-func (m *UserQuotas) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for ManageProjectMembers
 
-func (m *UserQuotas) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for CreateReports
 
-	var errors []error
+	// no validation rules for ManageReports
 
-	// no validation rules for SingleuserOrgs
+	// no validation rules for CreateAlerts
+
+	// no validation rules for ManageAlerts
 
 	if len(errors) > 0 {
-		return UserQuotasMultiError(errors)
+		return ProjectPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserQuotasMultiError is an error wrapping multiple validation errors
-// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
-type UserQuotasMultiError []error
+// ProjectPermissionsMultiError is an error wrapping multiple validation errors
+// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserQuotasMultiError) Error() string {
+func (m ProjectPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20213,11 +26935,11 @@ func (m UserQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserQuotasMultiError) AllErrors() []error { return m }
+func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
 
-// UserQuotasValidationError is the validation error returned by
-// UserQuotas.Validate if the designated constraints aren't met.
-type UserQuotasValidationError struct {
+// ProjectPermissionsValidationError is the validation error returned by
+// ProjectPermissions.Validate if the designated constraints aren't met.
+type ProjectPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20225,22 +26947,24 @@ type UserQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserQuotasValidationError) Field() string { return e.field }
+func (e ProjectPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserQuotasValidationError) Reason() string { return e.reason }
+func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserQuotasValidationError) Cause() error { return e.cause }
+func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserQuotasValidationError) Key() bool { return e.key }
+func (e ProjectPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
+func (e ProjectPermissionsValidationError) ErrorName() string {
+	return "ProjectPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserQuotasValidationError) Error() string {
+func (e ProjectPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20252,14 +26976,14 @@ func (e UserQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserQuotas.%s: %s%s",
+		"invalid %sProjectPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserQuotasValidationError{}
+var _ error = ProjectPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -20267,54 +26991,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserQuotasValidationError{}
+} = ProjectPermissionsValidationError{}
 
-// Validate checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationQuotas) Validate() error {
+// Validate checks the field values on OrganizationRole with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationQuotas with the rules
+// ValidateAll checks the field values on OrganizationRole with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrganizationQuotasMultiError, or nil if none found.
-func (m *OrganizationQuotas) ValidateAll() error {
+// OrganizationRoleMultiError, or nil if none found.
+func (m *OrganizationRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationQuotas) validate(all bool) error {
+func (m *OrganizationRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Projects
-
-	// no validation rules for Deployments
+	// no validation rules for Id
 
-	// no validation rules for SlotsTotal
+	// no validation rules for Name
 
-	// no validation rules for SlotsPerDeployment
+	// no validation rules for Builtin
 
-	// no validation rules for OutstandingInvites
+	if all {
+		switch v := interface{}(m.GetPermissions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationRoleValidationError{
+					field:  "Permissions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationRoleValidationError{
+				field:  "Permissions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OrganizationQuotasMultiError(errors)
+		return OrganizationRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationQuotasMultiError is an error wrapping multiple validation errors
-// returned by OrganizationQuotas.ValidateAll() if the designated constraints
+// OrganizationRoleMultiError is an error wrapping multiple validation errors
+// returned by OrganizationRole.ValidateAll() if the designated constraints
 // aren't met.
-type OrganizationQuotasMultiError []error
+type OrganizationRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationQuotasMultiError) Error() string {
+func (m OrganizationRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20323,11 +27072,11 @@ func (m OrganizationQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
+func (m OrganizationRoleMultiError) AllErrors() []error { return m }
 
-// OrganizationQuotasValidationError is the validation error returned by
-// OrganizationQuotas.Validate if the designated constraints aren't met.
-type OrganizationQuotasValidationError struct {
+// OrganizationRoleValidationError is the validation error returned by
+// OrganizationRole.Validate if the designated constraints aren't met.
+type OrganizationRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20335,24 +27084,22 @@ type OrganizationQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationQuotasValidationError) Field() string { return e.field }
+func (e OrganizationRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
+func (e OrganizationRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
+func (e OrganizationRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationQuotasValidationError) Key() bool { return e.key }
+func (e OrganizationRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationQuotasValidationError) ErrorName() string {
-	return "OrganizationQuotasValidationError"
-}
+func (e OrganizationRoleValidationError) ErrorName() string { return "OrganizationRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationQuotasValidationError) Error() string {
+func (e OrganizationRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20364,14 +27111,14 @@ func (e OrganizationQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationQuotas.%s: %s%s",
+		"invalid %sOrganizationRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationQuotasValidationError{}
+var _ error = OrganizationRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -20379,23 +27126,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationQuotasValidationError{}
+} = OrganizationRoleValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ProjectRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+func (m *ProjectRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on ProjectRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectRoleMultiError, or
+// nil if none found.
+func (m *ProjectRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *ProjectRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20406,90 +27154,31 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
-
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for Provisioner
-
-	// no validation rules for GithubUrl
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
-
-	// no validation rules for ProdOlapDriver
-
-	// no validation rules for ProdOlapDsn
-
-	// no validation rules for ProdSlots
-
-	// no validation rules for ProdDeploymentId
-
-	// no validation rules for FrontendUrl
-
-	// no validation rules for ProdTtlSeconds
-
-	// no validation rules for Annotations
-
-	// no validation rules for ProdVersion
-
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Builtin
 
 	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		switch v := interface{}(m.GetPermissions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, ProjectRoleValidationError{
+					field:  "Permissions",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, ProjectRoleValidationError{
+					field:  "Permissions",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPermissions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedOn",
+			return ProjectRoleValidationError{
+				field:  "Permissions",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -20497,18 +27186,18 @@ func (m *Project) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return ProjectRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// ProjectRoleMultiError is an error wrapping multiple validation errors
+// returned by ProjectRole.ValidateAll() if the designated constraints aren't met.
+type ProjectRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m ProjectRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20517,11 +27206,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m ProjectRoleMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// ProjectRoleValidationError is the validation error returned by
+// ProjectRole.Validate if the designated constraints aren't met.
+type ProjectRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20529,22 +27218,22 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e ProjectRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e ProjectRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e ProjectRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e ProjectRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e ProjectRoleValidationError) ErrorName() string { return "ProjectRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e ProjectRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20556,14 +27245,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sProjectRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = ProjectRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -20571,51 +27260,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = ProjectRoleValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
+// Validate checks the field values on Member with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+func (m *Member) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// ValidateAll checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MemberMultiError, or nil if none found.
+func (m *Member) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *Member) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Slots
-
-	// no validation rules for Branch
-
-	// no validation rules for RuntimeHost
+	// no validation rules for UserId
 
-	// no validation rules for RuntimeInstanceId
+	// no validation rules for UserEmail
 
-	// no validation rules for Status
+	// no validation rules for UserName
 
-	// no validation rules for StatusMessage
+	// no validation rules for RoleName
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20623,7 +27303,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20632,7 +27312,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20644,7 +27324,7 @@ func (m *Deployment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20652,7 +27332,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20661,7 +27341,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20670,18 +27350,18 @@ func (m *Deployment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return MemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// MemberMultiError is an error wrapping multiple validation errors returned by
+// Member.ValidateAll() if the designated constraints aren't met.
+type MemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m MemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20690,11 +27370,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m MemberMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// MemberValidationError is the validation error returned by Member.Validate if
+// the designated constraints aren't met.
+type MemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20702,22 +27382,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e MemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e MemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e MemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e MemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e MemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20729,14 +27409,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = MemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -20744,58 +27424,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = MemberValidationError{}
 
-// Validate checks the field values on OrganizationPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationPermissions) Validate() error {
+// Validate checks the field values on Usergroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Usergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationPermissions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationPermissionsMultiError, or nil if none found.
-func (m *OrganizationPermissions) ValidateAll() error {
+// ValidateAll checks the field values on Usergroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsergroupMultiError, or nil
+// if none found.
+func (m *Usergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationPermissions) validate(all bool) error {
+func (m *Usergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadOrg
-
-	// no validation rules for ManageOrg
-
-	// no validation rules for ReadProjects
-
-	// no validation rules for CreateProjects
-
-	// no validation rules for ManageProjects
-
-	// no validation rules for ReadOrgMembers
+	// no validation rules for Id
 
-	// no validation rules for ManageOrgMembers
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return OrganizationPermissionsMultiError(errors)
+		return UsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationPermissionsMultiError is an error wrapping multiple validation
-// errors returned by OrganizationPermissions.ValidateAll() if the designated
-// constraints aren't met.
-type OrganizationPermissionsMultiError []error
+// UsergroupMultiError is an error wrapping multiple validation errors returned
+// by Usergroup.ValidateAll() if the designated constraints aren't met.
+type UsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationPermissionsMultiError) Error() string {
+func (m UsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20804,11 +27473,11 @@ func (m OrganizationPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
+func (m UsergroupMultiError) AllErrors() []error { return m }
 
-// OrganizationPermissionsValidationError is the validation error returned by
-// OrganizationPermissions.Validate if the designated constraints aren't met.
-type OrganizationPermissionsValidationError struct {
+// UsergroupValidationError is the validation error returned by
+// Usergroup.Validate if the designated constraints aren't met.
+type UsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20816,24 +27485,22 @@ type OrganizationPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+func (e UsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+func (e UsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+func (e UsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+func (e UsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationPermissionsValidationError) ErrorName() string {
-	return "OrganizationPermissionsValidationError"
-}
+func (e UsergroupValidationError) ErrorName() string { return "UsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationPermissionsValidationError) Error() string {
+func (e UsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20845,14 +27512,14 @@ func (e OrganizationPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationPermissions.%s: %s%s",
+		"invalid %sUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationPermissionsValidationError{}
+var _ error = UsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -20860,72 +27527,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationPermissionsValidationError{}
+} = UsergroupValidationError{}
 
-// Validate checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectPermissions) Validate() error {
+// Validate checks the field values on UsergroupMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UsergroupMember) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectPermissions with the rules
+// ValidateAll checks the field values on UsergroupMember with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProjectPermissionsMultiError, or nil if none found.
-func (m *ProjectPermissions) ValidateAll() error {
+// UsergroupMemberMultiError, or nil if none found.
+func (m *UsergroupMember) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectPermissions) validate(all bool) error {
+func (m *UsergroupMember) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadProject
-
-	// no validation rules for ManageProject
-
-	// no validation rules for ReadProd
-
-	// no validation rules for ReadProdStatus
-
-	// no validation rules for ManageProd
-
-	// no validation rules for ReadDev
-
-	// no validation rules for ReadDevStatus
-
-	// no validation rules for ManageDev
-
-	// no validation rules for ReadProjectMembers
-
-	// no validation rules for ManageProjectMembers
-
-	// no validation rules for CreateReports
-
-	// no validation rules for ManageReports
+	// no validation rules for UserId
 
-	// no validation rules for CreateAlerts
+	// no validation rules for UserEmail
 
-	// no validation rules for ManageAlerts
+	// no validation rules for UserName
 
 	if len(errors) > 0 {
-		return ProjectPermissionsMultiError(errors)
+		return UsergroupMemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectPermissionsMultiError is an error wrapping multiple validation errors
-// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// UsergroupMemberMultiError is an error wrapping multiple validation errors
+// returned by UsergroupMember.ValidateAll() if the designated constraints
 // aren't met.
-type ProjectPermissionsMultiError []error
+type UsergroupMemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectPermissionsMultiError) Error() string {
+func (m UsergroupMemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20934,11 +27579,11 @@ func (m ProjectPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
+func (m UsergroupMemberMultiError) AllErrors() []error { return m }
 
-// ProjectPermissionsValidationError is the validation error returned by
-// ProjectPermissions.Validate if the designated constraints aren't met.
-type ProjectPermissionsValidationError struct {
+// UsergroupMemberValidationError is the validation error returned by
+// UsergroupMember.Validate if the designated constraints aren't met.
+type UsergroupMemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20946,24 +27591,22 @@ type ProjectPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectPermissionsValidationError) Field() string { return e.field }
+func (e UsergroupMemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
+func (e UsergroupMemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
+func (e UsergroupMemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectPermissionsValidationError) Key() bool { return e.key }
+func (e UsergroupMemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectPermissionsValidationError) ErrorName() string {
-	return "ProjectPermissionsValidationError"
-}
+func (e UsergroupMemberValidationError) ErrorName() string { return "UsergroupMemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectPermissionsValidationError) Error() string {
+func (e UsergroupMemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20975,14 +27618,14 @@ func (e ProjectPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectPermissions.%s: %s%s",
+		"invalid %sUsergroupMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectPermissionsValidationError{}
+var _ error = UsergroupMemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -20990,108 +27633,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectPermissionsValidationError{}
+} = UsergroupMemberValidationError{}
 
-// Validate checks the field values on Member with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Member) Validate() error {
+// Validate checks the field values on MemberUsergroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberUsergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Member with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MemberMultiError, or nil if none found.
-func (m *Member) ValidateAll() error {
+// ValidateAll checks the field values on MemberUsergroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberUsergroupMultiError, or nil if none found.
+func (m *MemberUsergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Member) validate(all bool) error {
+func (m *MemberUsergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for UserEmail
+	// no validation rules for UsergroupId
 
-	// no validation rules for UserName
+	// no validation rules for UsergroupName
 
 	// no validation rules for RoleName
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return MemberMultiError(errors)
+		return MemberUsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberMultiError is an error wrapping multiple validation errors returned by
-// Member.ValidateAll() if the designated constraints aren't met.
-type MemberMultiError []error
+// MemberUsergroupMultiError is an error wrapping multiple validation errors
+// returned by MemberUsergroup.ValidateAll() if the designated constraints
+// aren't met.
+type MemberUsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberMultiError) Error() string {
+func (m MemberUsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21100,11 +27685,11 @@ func (m MemberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberMultiError) AllErrors() []error { return m }
+func (m MemberUsergroupMultiError) AllErrors() []error { return m }
 
-// MemberValidationError is the validation error returned by Member.Validate if
-// the designated constraints aren't met.
-type MemberValidationError struct {
+// MemberUsergroupValidationError is the validation error returned by
+// MemberUsergroup.Validate if the designated constraints aren't met.
+type MemberUsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21112,22 +27697,22 @@ type MemberValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberValidationError) Field() string { return e.field }
+func (e MemberUsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberValidationError) Reason() string { return e.reason }
+func (e MemberUsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberValidationError) Cause() error { return e.cause }
+func (e MemberUsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberValidationError) Key() bool { return e.key }
+func (e MemberUsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
+func (e MemberUsergroupValidationError) ErrorName() string { return "MemberUsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberValidationError) Error() string {
+func (e MemberUsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21139,14 +27724,14 @@ func (e MemberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMember.%s: %s%s",
+		"invalid %sMemberUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberValidationError{}
+var _ error = MemberUsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -21154,7 +27739,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberValidationError{}
+} = MemberUsergroupValidationError{}
 
 // Validate checks the field values on UserInvite with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -21626,6 +28211,8 @@ func (m *ServiceToken) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Scim
+
 	if len(errors) > 0 {
 		return ServiceTokenMultiError(errors)
 	}
@@ -36,6 +36,9 @@ const (
 	AdminService_TriggerReconcile_FullMethodName             = "/rill.admin.v1.AdminService/TriggerReconcile"
 	AdminService_TriggerRefreshSources_FullMethodName        = "/rill.admin.v1.AdminService/TriggerRefreshSources"
 	AdminService_TriggerRedeploy_FullMethodName              = "/rill.admin.v1.AdminService/TriggerRedeploy"
+	AdminService_ListDeployments_FullMethodName              = "/rill.admin.v1.AdminService/ListDeployments"
+	AdminService_CreateDeployment_FullMethodName             = "/rill.admin.v1.AdminService/CreateDeployment"
+	AdminService_DeleteDeployment_FullMethodName             = "/rill.admin.v1.AdminService/DeleteDeployment"
 	AdminService_ListOrganizationMembers_FullMethodName      = "/rill.admin.v1.AdminService/ListOrganizationMembers"
 	AdminService_ListOrganizationInvites_FullMethodName      = "/rill.admin.v1.AdminService/ListOrganizationInvites"
 	AdminService_AddOrganizationMember_FullMethodName        = "/rill.admin.v1.AdminService/AddOrganizationMember"
@@ -47,6 +50,24 @@ const (
 	AdminService_AddProjectMember_FullMethodName             = "/rill.admin.v1.AdminService/AddProjectMember"
 	AdminService_RemoveProjectMember_FullMethodName          = "/rill.admin.v1.AdminService/RemoveProjectMember"
 	AdminService_SetProjectMemberRole_FullMethodName         = "/rill.admin.v1.AdminService/SetProjectMemberRole"
+	AdminService_ListOrganizationUsergroups_FullMethodName   = "/rill.admin.v1.AdminService/ListOrganizationUsergroups"
+	AdminService_CreateUsergroup_FullMethodName              = "/rill.admin.v1.AdminService/CreateUsergroup"
+	AdminService_RenameUsergroup_FullMethodName              = "/rill.admin.v1.AdminService/RenameUsergroup"
+	AdminService_DeleteUsergroup_FullMethodName              = "/rill.admin.v1.AdminService/DeleteUsergroup"
+	AdminService_ListUsergroupMembers_FullMethodName         = "/rill.admin.v1.AdminService/ListUsergroupMembers"
+	AdminService_AddUsergroupMember_FullMethodName           = "/rill.admin.v1.AdminService/AddUsergroupMember"
+	AdminService_RemoveUsergroupMember_FullMethodName        = "/rill.admin.v1.AdminService/RemoveUsergroupMember"
+	AdminService_ListProjectMemberUsergroups_FullMethodName  = "/rill.admin.v1.AdminService/ListProjectMemberUsergroups"
+	AdminService_SetUsergroupProjectRole_FullMethodName      = "/rill.admin.v1.AdminService/SetUsergroupProjectRole"
+	AdminService_RemoveUsergroupProjectRole_FullMethodName   = "/rill.admin.v1.AdminService/RemoveUsergroupProjectRole"
+	AdminService_ListOrganizationRoles_FullMethodName        = "/rill.admin.v1.AdminService/ListOrganizationRoles"
+	AdminService_CreateOrganizationRole_FullMethodName       = "/rill.admin.v1.AdminService/CreateOrganizationRole"
+	AdminService_UpdateOrganizationRole_FullMethodName       = "/rill.admin.v1.AdminService/UpdateOrganizationRole"
+	AdminService_DeleteOrganizationRole_FullMethodName       = "/rill.admin.v1.AdminService/DeleteOrganizationRole"
+	AdminService_ListProjectRoles_FullMethodName             = "/rill.admin.v1.AdminService/ListProjectRoles"
+	AdminService_CreateProjectRole_FullMethodName            = "/rill.admin.v1.AdminService/CreateProjectRole"
+	AdminService_UpdateProjectRole_FullMethodName            = "/rill.admin.v1.AdminService/UpdateProjectRole"
+	AdminService_DeleteProjectRole_FullMethodName            = "/rill.admin.v1.AdminService/DeleteProjectRole"
 	AdminService_GetCurrentUser_FullMethodName               = "/rill.admin.v1.AdminService/GetCurrentUser"
 	AdminService_IssueRepresentativeAuthToken_FullMethodName = "/rill.admin.v1.AdminService/IssueRepresentativeAuthToken"
 	AdminService_RevokeCurrentAuthToken_FullMethodName       = "/rill.admin.v1.AdminService/RevokeCurrentAuthToken"
@@ -55,6 +76,7 @@ const (
 	AdminService_CreateWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
 	AdminService_RemoveWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/RemoveWhitelistedDomain"
 	AdminService_ListWhitelistedDomains_FullMethodName       = "/rill.admin.v1.AdminService/ListWhitelistedDomains"
+	AdminService_ListAuditEvents_FullMethodName              = "/rill.admin.v1.AdminService/ListAuditEvents"
 	AdminService_GetUser_FullMethodName                      = "/rill.admin.v1.AdminService/GetUser"
 	AdminService_SearchUsers_FullMethodName                  = "/rill.admin.v1.AdminService/SearchUsers"
 	AdminService_SearchProjectUsers_FullMethodName           = "/rill.admin.v1.AdminService/SearchProjectUsers"
@@ -135,6 +157,12 @@ type AdminServiceClient interface {
 	TriggerRefreshSources(ctx context.Context, in *TriggerRefreshSourcesRequest, opts ...grpc.CallOption) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
 	TriggerRedeploy(ctx context.Context, in *TriggerRedeployRequest, opts ...grpc.CallOption) (*TriggerRedeployResponse, error)
+	// ListDeployments lists the prod and preview deployments of a project
+	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	// CreateDeployment creates a preview deployment of a non-prod branch of a project
+	CreateDeployment(ctx context.Context, in *CreateDeploymentRequest, opts ...grpc.CallOption) (*CreateDeploymentResponse, error)
+	// DeleteDeployment tears down a preview deployment
+	DeleteDeployment(ctx context.Context, in *DeleteDeploymentRequest, opts ...grpc.CallOption) (*DeleteDeploymentResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
 	// ListOrganizationInvites lists all the org invites
@@ -157,6 +185,42 @@ type AdminServiceClient interface {
 	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(ctx context.Context, in *SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberRoleResponse, error)
+	// ListOrganizationUsergroups lists the user groups in the organization
+	ListOrganizationUsergroups(ctx context.Context, in *ListOrganizationUsergroupsRequest, opts ...grpc.CallOption) (*ListOrganizationUsergroupsResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error)
+	// RenameUsergroup renames a user group
+	RenameUsergroup(ctx context.Context, in *RenameUsergroupRequest, opts ...grpc.CallOption) (*RenameUsergroupResponse, error)
+	// DeleteUsergroup deletes a user group
+	DeleteUsergroup(ctx context.Context, in *DeleteUsergroupRequest, opts ...grpc.CallOption) (*DeleteUsergroupResponse, error)
+	// ListUsergroupMembers lists the members of a user group
+	ListUsergroupMembers(ctx context.Context, in *ListUsergroupMembersRequest, opts ...grpc.CallOption) (*ListUsergroupMembersResponse, error)
+	// AddUsergroupMember adds a member of the organization to a user group
+	AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a member from a user group
+	RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error)
+	// ListProjectMemberUsergroups lists the user groups that have a role in the project
+	ListProjectMemberUsergroups(ctx context.Context, in *ListProjectMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListProjectMemberUsergroupsResponse, error)
+	// SetUsergroupProjectRole sets the role of a user group in the project
+	SetUsergroupProjectRole(ctx context.Context, in *SetUsergroupProjectRoleRequest, opts ...grpc.CallOption) (*SetUsergroupProjectRoleResponse, error)
+	// RemoveUsergroupProjectRole removes the role of a user group in the project
+	RemoveUsergroupProjectRole(ctx context.Context, in *RemoveUsergroupProjectRoleRequest, opts ...grpc.CallOption) (*RemoveUsergroupProjectRoleResponse, error)
+	// ListOrganizationRoles lists the built-in and custom organization roles available in an organization
+	ListOrganizationRoles(ctx context.Context, in *ListOrganizationRolesRequest, opts ...grpc.CallOption) (*ListOrganizationRolesResponse, error)
+	// CreateOrganizationRole creates a custom organization role
+	CreateOrganizationRole(ctx context.Context, in *CreateOrganizationRoleRequest, opts ...grpc.CallOption) (*CreateOrganizationRoleResponse, error)
+	// UpdateOrganizationRole updates the permissions of a custom organization role
+	UpdateOrganizationRole(ctx context.Context, in *UpdateOrganizationRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationRoleResponse, error)
+	// DeleteOrganizationRole deletes a custom organization role that is not in use
+	DeleteOrganizationRole(ctx context.Context, in *DeleteOrganizationRoleRequest, opts ...grpc.CallOption) (*DeleteOrganizationRoleResponse, error)
+	// ListProjectRoles lists the built-in and custom project roles available in an organization
+	ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error)
+	// CreateProjectRole creates a custom project role
+	CreateProjectRole(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*CreateProjectRoleResponse, error)
+	// UpdateProjectRole updates the permissions of a custom project role
+	UpdateProjectRole(ctx context.Context, in *UpdateProjectRoleRequest, opts ...grpc.CallOption) (*UpdateProjectRoleResponse, error)
+	// DeleteProjectRole deletes a custom project role that is not in use
+	DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRoleResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 	// IssueRepresentativeAuthToken returns the temporary token for given email
@@ -174,6 +238,8 @@ type AdminServiceClient interface {
 	RemoveWhitelistedDomain(ctx context.Context, in *RemoveWhitelistedDomainRequest, opts ...grpc.CallOption) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(ctx context.Context, in *ListWhitelistedDomainsRequest, opts ...grpc.CallOption) (*ListWhitelistedDomainsResponse, error)
+	// ListAuditEvents lists the audit events for an organization, most recent first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	// GetUser returns user by email
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -417,6 +483,33 @@ func (c *adminServiceClient) TriggerRedeploy(ctx context.Context, in *TriggerRed
 	return out, nil
 }
 
+func (c *adminServiceClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error) {
+	out := new(ListDeploymentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDeployments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateDeployment(ctx context.Context, in *CreateDeploymentRequest, opts ...grpc.CallOption) (*CreateDeploymentResponse, error) {
+	out := new(CreateDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDeployment(ctx context.Context, in *DeleteDeploymentRequest, opts ...grpc.CallOption) (*DeleteDeploymentResponse, error) {
+	out := new(DeleteDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
 	out := new(ListOrganizationMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListOrganizationMembers_FullMethodName, in, out, opts...)
@@ -516,6 +609,168 @@ func (c *adminServiceClient) SetProjectMemberRole(ctx context.Context, in *SetPr
 	return out, nil
 }
 
+func (c *adminServiceClient) ListOrganizationUsergroups(ctx context.Context, in *ListOrganizationUsergroupsRequest, opts ...grpc.CallOption) (*ListOrganizationUsergroupsResponse, error) {
+	out := new(ListOrganizationUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOrganizationUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error) {
+	out := new(CreateUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameUsergroup(ctx context.Context, in *RenameUsergroupRequest, opts ...grpc.CallOption) (*RenameUsergroupResponse, error) {
+	out := new(RenameUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_RenameUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteUsergroup(ctx context.Context, in *DeleteUsergroupRequest, opts ...grpc.CallOption) (*DeleteUsergroupResponse, error) {
+	out := new(DeleteUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUsergroupMembers(ctx context.Context, in *ListUsergroupMembersRequest, opts ...grpc.CallOption) (*ListUsergroupMembersResponse, error) {
+	out := new(ListUsergroupMembersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsergroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error) {
+	out := new(AddUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error) {
+	out := new(RemoveUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectMemberUsergroups(ctx context.Context, in *ListProjectMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListProjectMemberUsergroupsResponse, error) {
+	out := new(ListProjectMemberUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectMemberUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUsergroupProjectRole(ctx context.Context, in *SetUsergroupProjectRoleRequest, opts ...grpc.CallOption) (*SetUsergroupProjectRoleResponse, error) {
+	out := new(SetUsergroupProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetUsergroupProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveUsergroupProjectRole(ctx context.Context, in *RemoveUsergroupProjectRoleRequest, opts ...grpc.CallOption) (*RemoveUsergroupProjectRoleResponse, error) {
+	out := new(RemoveUsergroupProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveUsergroupProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListOrganizationRoles(ctx context.Context, in *ListOrganizationRolesRequest, opts ...grpc.CallOption) (*ListOrganizationRolesResponse, error) {
+	out := new(ListOrganizationRolesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOrganizationRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateOrganizationRole(ctx context.Context, in *CreateOrganizationRoleRequest, opts ...grpc.CallOption) (*CreateOrganizationRoleResponse, error) {
+	out := new(CreateOrganizationRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateOrganizationRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOrganizationRole(ctx context.Context, in *UpdateOrganizationRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationRoleResponse, error) {
+	out := new(UpdateOrganizationRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOrganizationRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrganizationRole(ctx context.Context, in *DeleteOrganizationRoleRequest, opts ...grpc.CallOption) (*DeleteOrganizationRoleResponse, error) {
+	out := new(DeleteOrganizationRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrganizationRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error) {
+	out := new(ListProjectRolesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateProjectRole(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*CreateProjectRoleResponse, error) {
+	out := new(CreateProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateProjectRole(ctx context.Context, in *UpdateProjectRoleRequest, opts ...grpc.CallOption) (*UpdateProjectRoleResponse, error) {
+	out := new(UpdateProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRoleResponse, error) {
+	out := new(DeleteProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
 	out := new(GetCurrentUserResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetCurrentUser_FullMethodName, in, out, opts...)
@@ -588,6 +843,15 @@ func (c *adminServiceClient) ListWhitelistedDomains(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	out := new(GetUserResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetUser_FullMethodName, in, out, opts...)
@@ -986,6 +1250,12 @@ type AdminServiceServer interface {
 	TriggerRefreshSources(context.Context, *TriggerRefreshSourcesRequest) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
 	TriggerRedeploy(context.Context, *TriggerRedeployRequest) (*TriggerRedeployResponse, error)
+	// ListDeployments lists the prod and preview deployments of a project
+	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	// CreateDeployment creates a preview deployment of a non-prod branch of a project
+	CreateDeployment(context.Context, *CreateDeploymentRequest) (*CreateDeploymentResponse, error)
+	// DeleteDeployment tears down a preview deployment
+	DeleteDeployment(context.Context, *DeleteDeploymentRequest) (*DeleteDeploymentResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
 	// ListOrganizationInvites lists all the org invites
@@ -1008,6 +1278,42 @@ type AdminServiceServer interface {
 	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error)
+	// ListOrganizationUsergroups lists the user groups in the organization
+	ListOrganizationUsergroups(context.Context, *ListOrganizationUsergroupsRequest) (*ListOrganizationUsergroupsResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error)
+	// RenameUsergroup renames a user group
+	RenameUsergroup(context.Context, *RenameUsergroupRequest) (*RenameUsergroupResponse, error)
+	// DeleteUsergroup deletes a user group
+	DeleteUsergroup(context.Context, *DeleteUsergroupRequest) (*DeleteUsergroupResponse, error)
+	// ListUsergroupMembers lists the members of a user group
+	ListUsergroupMembers(context.Context, *ListUsergroupMembersRequest) (*ListUsergroupMembersResponse, error)
+	// AddUsergroupMember adds a member of the organization to a user group
+	AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a member from a user group
+	RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error)
+	// ListProjectMemberUsergroups lists the user groups that have a role in the project
+	ListProjectMemberUsergroups(context.Context, *ListProjectMemberUsergroupsRequest) (*ListProjectMemberUsergroupsResponse, error)
+	// SetUsergroupProjectRole sets the role of a user group in the project
+	SetUsergroupProjectRole(context.Context, *SetUsergroupProjectRoleRequest) (*SetUsergroupProjectRoleResponse, error)
+	// RemoveUsergroupProjectRole removes the role of a user group in the project
+	RemoveUsergroupProjectRole(context.Context, *RemoveUsergroupProjectRoleRequest) (*RemoveUsergroupProjectRoleResponse, error)
+	// ListOrganizationRoles lists the built-in and custom organization roles available in an organization
+	ListOrganizationRoles(context.Context, *ListOrganizationRolesRequest) (*ListOrganizationRolesResponse, error)
+	// CreateOrganizationRole creates a custom organization role
+	CreateOrganizationRole(context.Context, *CreateOrganizationRoleRequest) (*CreateOrganizationRoleResponse, error)
+	// UpdateOrganizationRole updates the permissions of a custom organization role
+	UpdateOrganizationRole(context.Context, *UpdateOrganizationRoleRequest) (*UpdateOrganizationRoleResponse, error)
+	// DeleteOrganizationRole deletes a custom organization role that is not in use
+	DeleteOrganizationRole(context.Context, *DeleteOrganizationRoleRequest) (*DeleteOrganizationRoleResponse, error)
+	// ListProjectRoles lists the built-in and custom project roles available in an organization
+	ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error)
+	// CreateProjectRole creates a custom project role
+	CreateProjectRole(context.Context, *CreateProjectRoleRequest) (*CreateProjectRoleResponse, error)
+	// UpdateProjectRole updates the permissions of a custom project role
+	UpdateProjectRole(context.Context, *UpdateProjectRoleRequest) (*UpdateProjectRoleResponse, error)
+	// DeleteProjectRole deletes a custom project role that is not in use
+	DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*DeleteProjectRoleResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	// IssueRepresentativeAuthToken returns the temporary token for given email
@@ -1025,6 +1331,8 @@ type AdminServiceServer interface {
 	RemoveWhitelistedDomain(context.Context, *RemoveWhitelistedDomainRequest) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error)
+	// ListAuditEvents lists the audit events for an organization, most recent first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	// GetUser returns user by email
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -1163,6 +1471,15 @@ func (UnimplementedAdminServiceServer) TriggerRefreshSources(context.Context, *T
 func (UnimplementedAdminServiceServer) TriggerRedeploy(context.Context, *TriggerRedeployRequest) (*TriggerRedeployResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRedeploy not implemented")
 }
+func (UnimplementedAdminServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateDeployment(context.Context, *CreateDeploymentRequest) (*CreateDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeployment not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDeployment(context.Context, *DeleteDeploymentRequest) (*DeleteDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeployment not implemented")
+}
 func (UnimplementedAdminServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
@@ -1196,6 +1513,60 @@ func (UnimplementedAdminServiceServer) RemoveProjectMember(context.Context, *Rem
 func (UnimplementedAdminServiceServer) SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) ListOrganizationUsergroups(context.Context, *ListOrganizationUsergroupsRequest) (*ListOrganizationUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) RenameUsergroup(context.Context, *RenameUsergroupRequest) (*RenameUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteUsergroup(context.Context, *DeleteUsergroupRequest) (*DeleteUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUsergroupMembers(context.Context, *ListUsergroupMembersRequest) (*ListUsergroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsergroupMembers not implemented")
+}
+func (UnimplementedAdminServiceServer) AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectMemberUsergroups(context.Context, *ListProjectMemberUsergroupsRequest) (*ListProjectMemberUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMemberUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUsergroupProjectRole(context.Context, *SetUsergroupProjectRoleRequest) (*SetUsergroupProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsergroupProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveUsergroupProjectRole(context.Context, *RemoveUsergroupProjectRoleRequest) (*RemoveUsergroupProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsergroupProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) ListOrganizationRoles(context.Context, *ListOrganizationRolesRequest) (*ListOrganizationRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationRoles not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateOrganizationRole(context.Context, *CreateOrganizationRoleRequest) (*CreateOrganizationRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationRole not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOrganizationRole(context.Context, *UpdateOrganizationRoleRequest) (*UpdateOrganizationRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationRole not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrganizationRole(context.Context, *DeleteOrganizationRoleRequest) (*DeleteOrganizationRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationRole not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectRoles not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateProjectRole(context.Context, *CreateProjectRoleRequest) (*CreateProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateProjectRole(context.Context, *UpdateProjectRoleRequest) (*UpdateProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*DeleteProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectRole not implemented")
+}
 func (UnimplementedAdminServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
 }
@@ -1220,6 +1591,9 @@ func (UnimplementedAdminServiceServer) RemoveWhitelistedDomain(context.Context,
 func (UnimplementedAdminServiceServer) ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWhitelistedDomains not implemented")
 }
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -1659,6 +2033,60 @@ func _AdminService_TriggerRedeploy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeployments(ctx, req.(*ListDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateDeployment(ctx, req.(*CreateDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDeployment(ctx, req.(*DeleteDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationMembersRequest)
 	if err := dec(in); err != nil {
@@ -1857,6 +2285,330 @@ func _AdminService_SetProjectMemberRole_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListOrganizationUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOrganizationUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOrganizationUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOrganizationUsergroups(ctx, req.(*ListOrganizationUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, req.(*CreateUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RenameUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameUsergroup(ctx, req.(*RenameUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteUsergroup(ctx, req.(*DeleteUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUsergroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsergroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsergroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsergroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsergroupMembers(ctx, req.(*ListUsergroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, req.(*AddUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, req.(*RemoveUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectMemberUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMemberUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectMemberUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectMemberUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectMemberUsergroups(ctx, req.(*ListProjectMemberUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUsergroupProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUsergroupProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUsergroupProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUsergroupProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUsergroupProjectRole(ctx, req.(*SetUsergroupProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveUsergroupProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUsergroupProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveUsergroupProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveUsergroupProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveUsergroupProjectRole(ctx, req.(*RemoveUsergroupProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListOrganizationRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOrganizationRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOrganizationRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOrganizationRoles(ctx, req.(*ListOrganizationRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateOrganizationRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateOrganizationRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateOrganizationRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateOrganizationRole(ctx, req.(*CreateOrganizationRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateOrganizationRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateOrganizationRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateOrganizationRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateOrganizationRole(ctx, req.(*UpdateOrganizationRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteOrganizationRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteOrganizationRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteOrganizationRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteOrganizationRole(ctx, req.(*DeleteOrganizationRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectRoles(ctx, req.(*ListProjectRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProjectRole(ctx, req.(*CreateProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProjectRole(ctx, req.(*UpdateProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProjectRole(ctx, req.(*DeleteProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentUserRequest)
 	if err := dec(in); err != nil {
@@ -2001,6 +2753,24 @@ func _AdminService_ListWhitelistedDomains_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -2796,6 +3566,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerRedeploy",
 			Handler:    _AdminService_TriggerRedeploy_Handler,
 		},
+		{
+			MethodName: "ListDeployments",
+			Handler:    _AdminService_ListDeployments_Handler,
+		},
+		{
+			MethodName: "CreateDeployment",
+			Handler:    _AdminService_CreateDeployment_Handler,
+		},
+		{
+			MethodName: "DeleteDeployment",
+			Handler:    _AdminService_DeleteDeployment_Handler,
+		},
 		{
 			MethodName: "ListOrganizationMembers",
 			Handler:    _AdminService_ListOrganizationMembers_Handler,
@@ -2840,6 +3622,78 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProjectMemberRole",
 			Handler:    _AdminService_SetProjectMemberRole_Handler,
 		},
+		{
+			MethodName: "ListOrganizationUsergroups",
+			Handler:    _AdminService_ListOrganizationUsergroups_Handler,
+		},
+		{
+			MethodName: "CreateUsergroup",
+			Handler:    _AdminService_CreateUsergroup_Handler,
+		},
+		{
+			MethodName: "RenameUsergroup",
+			Handler:    _AdminService_RenameUsergroup_Handler,
+		},
+		{
+			MethodName: "DeleteUsergroup",
+			Handler:    _AdminService_DeleteUsergroup_Handler,
+		},
+		{
+			MethodName: "ListUsergroupMembers",
+			Handler:    _AdminService_ListUsergroupMembers_Handler,
+		},
+		{
+			MethodName: "AddUsergroupMember",
+			Handler:    _AdminService_AddUsergroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveUsergroupMember",
+			Handler:    _AdminService_RemoveUsergroupMember_Handler,
+		},
+		{
+			MethodName: "ListProjectMemberUsergroups",
+			Handler:    _AdminService_ListProjectMemberUsergroups_Handler,
+		},
+		{
+			MethodName: "SetUsergroupProjectRole",
+			Handler:    _AdminService_SetUsergroupProjectRole_Handler,
+		},
+		{
+			MethodName: "RemoveUsergroupProjectRole",
+			Handler:    _AdminService_RemoveUsergroupProjectRole_Handler,
+		},
+		{
+			MethodName: "ListOrganizationRoles",
+			Handler:    _AdminService_ListOrganizationRoles_Handler,
+		},
+		{
+			MethodName: "CreateOrganizationRole",
+			Handler:    _AdminService_CreateOrganizationRole_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationRole",
+			Handler:    _AdminService_UpdateOrganizationRole_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationRole",
+			Handler:    _AdminService_DeleteOrganizationRole_Handler,
+		},
+		{
+			MethodName: "ListProjectRoles",
+			Handler:    _AdminService_ListProjectRoles_Handler,
+		},
+		{
+			MethodName: "CreateProjectRole",
+			Handler:    _AdminService_CreateProjectRole_Handler,
+		},
+		{
+			MethodName: "UpdateProjectRole",
+			Handler:    _AdminService_UpdateProjectRole_Handler,
+		},
+		{
+			MethodName: "DeleteProjectRole",
+			Handler:    _AdminService_DeleteProjectRole_Handler,
+		},
 		{
 			MethodName: "GetCurrentUser",
 			Handler:    _AdminService_GetCurrentUser_Handler,
@@ -2872,6 +3726,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWhitelistedDomains",
 			Handler:    _AdminService_ListWhitelistedDomains_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _AdminService_GetUser_Handler,
@@ -6310,6 +6310,565 @@ var _ interface {
 	ErrorName() string
 } = GetResourceResponseValidationError{}
 
+// Validate checks the field values on GetLineageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetLineageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLineageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLineageRequestMultiError, or nil if none found.
+func (m *GetLineageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLineageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLineageRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return GetLineageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLineageRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLineageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLineageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLineageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLineageRequestMultiError) AllErrors() []error { return m }
+
+// GetLineageRequestValidationError is the validation error returned by
+// GetLineageRequest.Validate if the designated constraints aren't met.
+type GetLineageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLineageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLineageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLineageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLineageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLineageRequestValidationError) ErrorName() string {
+	return "GetLineageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLineageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLineageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLineageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLineageRequestValidationError{}
+
+// Validate checks the field values on GetLineageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLineageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLineageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLineageResponseMultiError, or nil if none found.
+func (m *GetLineageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLineageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLineageResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetLineageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLineageResponseMultiError is an error wrapping multiple validation errors
+// returned by GetLineageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetLineageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLineageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLineageResponseMultiError) AllErrors() []error { return m }
+
+// GetLineageResponseValidationError is the validation error returned by
+// GetLineageResponse.Validate if the designated constraints aren't met.
+type GetLineageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLineageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLineageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLineageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLineageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLineageResponseValidationError) ErrorName() string {
+	return "GetLineageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLineageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLineageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLineageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLineageResponseValidationError{}
+
+// Validate checks the field values on LineageColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageColumnMultiError, or
+// nil if none found.
+func (m *LineageColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageColumnValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageColumnValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageColumnValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return LineageColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageColumnMultiError is an error wrapping multiple validation errors
+// returned by LineageColumn.ValidateAll() if the designated constraints
+// aren't met.
+type LineageColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageColumnMultiError) AllErrors() []error { return m }
+
+// LineageColumnValidationError is the validation error returned by
+// LineageColumn.Validate if the designated constraints aren't met.
+type LineageColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageColumnValidationError) ErrorName() string { return "LineageColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageColumnValidationError{}
+
+// Validate checks the field values on LineageEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageEdgeMultiError, or
+// nil if none found.
+func (m *LineageEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageEdgeValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageEdgeValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LineageEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageEdgeMultiError is an error wrapping multiple validation errors
+// returned by LineageEdge.ValidateAll() if the designated constraints aren't met.
+type LineageEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageEdgeMultiError) AllErrors() []error { return m }
+
+// LineageEdgeValidationError is the validation error returned by
+// LineageEdge.Validate if the designated constraints aren't met.
+type LineageEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageEdgeValidationError) ErrorName() string { return "LineageEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageEdgeValidationError{}
+
 // Validate checks the field values on CreateTriggerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -42,6 +42,7 @@ const (
 	RuntimeService_ListResources_FullMethodName           = "/rill.runtime.v1.RuntimeService/ListResources"
 	RuntimeService_WatchResources_FullMethodName          = "/rill.runtime.v1.RuntimeService/WatchResources"
 	RuntimeService_GetResource_FullMethodName             = "/rill.runtime.v1.RuntimeService/GetResource"
+	RuntimeService_GetLineage_FullMethodName              = "/rill.runtime.v1.RuntimeService/GetLineage"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
 	RuntimeService_ListCatalogEntries_FullMethodName      = "/rill.runtime.v1.RuntimeService/ListCatalogEntries"
 	RuntimeService_GetCatalogEntry_FullMethodName         = "/rill.runtime.v1.RuntimeService/GetCatalogEntry"
@@ -106,6 +107,8 @@ type RuntimeServiceClient interface {
 	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (RuntimeService_WatchResourcesClient, error)
 	// GetResource looks up a specific catalog resource
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
+	// GetLineage returns column-level lineage between sources, models and metrics views
+	GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*GetLineageResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
@@ -420,6 +423,15 @@ func (c *runtimeServiceClient) GetResource(ctx context.Context, in *GetResourceR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*GetLineageResponse, error) {
+	out := new(GetLineageResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error) {
 	out := new(CreateTriggerResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_CreateTrigger_FullMethodName, in, out, opts...)
@@ -570,6 +582,8 @@ type RuntimeServiceServer interface {
 	WatchResources(*WatchResourcesRequest, RuntimeService_WatchResourcesServer) error
 	// GetResource looks up a specific catalog resource
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	// GetLineage returns column-level lineage between sources, models and metrics views
+	GetLineage(context.Context, *GetLineageRequest) (*GetLineageResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
@@ -674,6 +688,9 @@ func (UnimplementedRuntimeServiceServer) WatchResources(*WatchResourcesRequest,
 func (UnimplementedRuntimeServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetLineage(context.Context, *GetLineageRequest) (*GetLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineage not implemented")
+}
 func (UnimplementedRuntimeServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
 }
@@ -1143,6 +1160,24 @@ func _RuntimeService_GetResource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetLineage(ctx, req.(*GetLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTriggerRequest)
 	if err := dec(in); err != nil {
@@ -1428,6 +1463,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResource",
 			Handler:    _RuntimeService_GetResource_Handler,
 		},
+		{
+			MethodName: "GetLineage",
+			Handler:    _RuntimeService_GetLineage_Handler,
+		},
 		{
 			MethodName: "CreateTrigger",
 			Handler:    _RuntimeService_CreateTrigger_Handler,
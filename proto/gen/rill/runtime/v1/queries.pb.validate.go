@@ -363,6 +363,108 @@ var _ interface {
 	ErrorName() string
 } = QueryResponseValidationError{}
 
+// Validate checks the field values on QueryArrowResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryArrowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryArrowResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// QueryArrowResponseMultiError, or nil if none found.
+func (m *QueryArrowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryArrowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArrowIpc
+
+	if len(errors) > 0 {
+		return QueryArrowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryArrowResponseMultiError is an error wrapping multiple validation errors
+// returned by QueryArrowResponse.ValidateAll() if the designated constraints
+// aren't met.
+type QueryArrowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryArrowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryArrowResponseMultiError) AllErrors() []error { return m }
+
+// QueryArrowResponseValidationError is the validation error returned by
+// QueryArrowResponse.Validate if the designated constraints aren't met.
+type QueryArrowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryArrowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryArrowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryArrowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryArrowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryArrowResponseValidationError) ErrorName() string { return "QueryArrowResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryArrowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryArrowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryArrowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryArrowResponseValidationError{}
+
 // Validate checks the field values on QueryBatchRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2975,6 +3077,8 @@ func (m *MetricsViewAggregationRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewAggregationRequestMultiError(errors)
 	}
@@ -4501,6 +4605,8 @@ func (m *MetricsViewComparisonRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewComparisonRequestMultiError(errors)
 	}
@@ -5739,6 +5845,8 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewTimeSeriesRequestMultiError(errors)
 	}
@@ -7451,6 +7559,8 @@ func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewTimeRangeRequestMultiError(errors)
 	}
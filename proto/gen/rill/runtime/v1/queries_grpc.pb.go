@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	QueryService_Query_FullMethodName                       = "/rill.runtime.v1.QueryService/Query"
+	QueryService_QueryArrow_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryArrow"
 	QueryService_QueryBatch_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryBatch"
 	QueryService_Export_FullMethodName                      = "/rill.runtime.v1.QueryService/Export"
 	QueryService_MetricsViewAggregation_FullMethodName      = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
@@ -51,6 +52,8 @@ const (
 type QueryServiceClient interface {
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// QueryArrow runs a SQL query against the instance's OLAP datastore and streams the result in the Arrow IPC streaming format.
+	QueryArrow(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryService_QueryArrowClient, error)
 	// Batch request with different queries
 	QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (QueryService_QueryBatchClient, error)
 	// Export builds a URL to download the results of a query as a file.
@@ -150,8 +153,40 @@ func (c *queryServiceClient) Query(ctx context.Context, in *QueryRequest, opts .
 	return out, nil
 }
 
+func (c *queryServiceClient) QueryArrow(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryService_QueryArrowClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QueryService_ServiceDesc.Streams[0], QueryService_QueryArrow_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryServiceQueryArrowClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueryService_QueryArrowClient interface {
+	Recv() (*QueryArrowResponse, error)
+	grpc.ClientStream
+}
+
+type queryServiceQueryArrowClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryServiceQueryArrowClient) Recv() (*QueryArrowResponse, error) {
+	m := new(QueryArrowResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryServiceClient) QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (QueryService_QueryBatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &QueryService_ServiceDesc.Streams[0], QueryService_QueryBatch_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &QueryService_ServiceDesc.Streams[1], QueryService_QueryBatch_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +421,8 @@ func (c *queryServiceClient) TableRows(ctx context.Context, in *TableRowsRequest
 type QueryServiceServer interface {
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// QueryArrow runs a SQL query against the instance's OLAP datastore and streams the result in the Arrow IPC streaming format.
+	QueryArrow(*QueryRequest, QueryService_QueryArrowServer) error
 	// Batch request with different queries
 	QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error
 	// Export builds a URL to download the results of a query as a file.
@@ -476,6 +513,9 @@ type UnimplementedQueryServiceServer struct {
 func (UnimplementedQueryServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedQueryServiceServer) QueryArrow(*QueryRequest, QueryService_QueryArrowServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryArrow not implemented")
+}
 func (UnimplementedQueryServiceServer) QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method QueryBatch not implemented")
 }
@@ -576,6 +616,27 @@ func _QueryService_Query_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_QueryArrow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServiceServer).QueryArrow(m, &queryServiceQueryArrowServer{stream})
+}
+
+type QueryService_QueryArrowServer interface {
+	Send(*QueryArrowResponse) error
+	grpc.ServerStream
+}
+
+type queryServiceQueryArrowServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryServiceQueryArrowServer) Send(m *QueryArrowResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _QueryService_QueryBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(QueryBatchRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1094,6 +1155,11 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryArrow",
+			Handler:       _QueryService_QueryArrow_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "QueryBatch",
 			Handler:       _QueryService_QueryBatch_Handler,
@@ -661,6 +661,47 @@ func (m *Resource) validate(all bool) error {
 			}
 		}
 
+	case *Resource_Test:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Test",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1877,10 +1918,12 @@ func (m *SourceSpec) validate(all bool) error {
 
 	// no validation rules for TimeoutSeconds
 
-	// no validation rules for StageChanges
-
 	// no validation rules for StreamIngestion
 
+	// no validation rules for OnSchemaChange
+
+	// no validation rules for StageChanges
+
 	// no validation rules for Trigger
 
 	if len(errors) > 0 {
@@ -2017,6 +2060,42 @@ func (m *SourceState) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for StreamOffsets
+
+	for idx, item := range m.GetSchemaChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SourceStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SourceStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SourceStateValidationError{
+					field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SourceStateMultiError(errors)
 	}
@@ -2094,6 +2173,111 @@ var _ interface {
 	ErrorName() string
 } = SourceStateValidationError{}
 
+// Validate checks the field values on SchemaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaChangeMultiError, or
+// nil if none found.
+func (m *SchemaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for PreviousType
+
+	// no validation rules for NewType
+
+	if len(errors) > 0 {
+		return SchemaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaChange.ValidateAll() if the designated constraints aren't met.
+type SchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaChangeMultiError) AllErrors() []error { return m }
+
+// SchemaChangeValidationError is the validation error returned by
+// SchemaChange.Validate if the designated constraints aren't met.
+type SchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaChangeValidationError) ErrorName() string { return "SchemaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaChangeValidationError{}
+
 // Validate checks the field values on ModelV2 with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2309,6 +2493,74 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for UsesTemplating
 
+	// no validation rules for Incremental
+
+	// no validation rules for IncrementalStateResolver
+
+	if all {
+		switch v := interface{}(m.GetIncrementalStateResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "IncrementalStateResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "IncrementalStateResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalStateResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "IncrementalStateResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncrementalStrategy
+
+	// no validation rules for PartitionsResolver
+
+	if all {
+		switch v := interface{}(m.GetPartitionsResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "PartitionsResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "PartitionsResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartitionsResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "PartitionsResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OnSchemaChange
+
 	// no validation rules for StageChanges
 
 	// no validation rules for MaterializeDelaySeconds
@@ -2453,21 +2705,118 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return ModelStateMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetIncrementalState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "IncrementalState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
-}
+	for idx, item := range m.GetPartitions() {
+		_, _ = idx, item
 
-// ModelStateMultiError is an error wrapping multiple validation errors
-// returned by ModelState.ValidateAll() if the designated constraints aren't met.
-type ModelStateMultiError []error
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("Partitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ModelStateMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
+	}
+
+	for idx, item := range m.GetSchemaChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModelStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelStateMultiError is an error wrapping multiple validation errors
+// returned by ModelState.ValidateAll() if the designated constraints aren't met.
+type ModelStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
@@ -2530,6 +2879,170 @@ var _ interface {
 	ErrorName() string
 } = ModelStateValidationError{}
 
+// Validate checks the field values on ModelPartition with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelPartition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelPartition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelPartitionMultiError,
+// or nil if none found.
+func (m *ModelPartition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelPartition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExecutedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "ExecutedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for ElapsedMs
+
+	if len(errors) > 0 {
+		return ModelPartitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelPartitionMultiError is an error wrapping multiple validation errors
+// returned by ModelPartition.ValidateAll() if the designated constraints
+// aren't met.
+type ModelPartitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelPartitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelPartitionMultiError) AllErrors() []error { return m }
+
+// ModelPartitionValidationError is the validation error returned by
+// ModelPartition.Validate if the designated constraints aren't met.
+type ModelPartitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelPartitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelPartitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelPartitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelPartitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelPartitionValidationError) ErrorName() string { return "ModelPartitionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelPartitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelPartition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelPartitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelPartitionValidationError{}
+
 // Validate checks the field values on MetricsViewV2 with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2722,6 +3235,40 @@ func (m *MetricsViewSpec) validate(all bool) error {
 
 	// no validation rules for WatermarkExpression
 
+	for idx, item := range m.GetJoins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Joins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Joins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Joins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	for idx, item := range m.GetDimensions() {
 		_, _ = idx, item
 
@@ -3664,6 +4211,40 @@ func (m *ReportSpec) validate(all bool) error {
 
 	// no validation rules for Annotations
 
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ReportSpecMultiError(errors)
 	}
@@ -4047,6 +4628,40 @@ func (m *ReportExecution) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportExecutionValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportExecutionValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportExecutionValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ReportExecutionMultiError(errors)
 	}
@@ -4362,13 +4977,76 @@ func (m *AlertSpec) validate(all bool) error {
 
 	// no validation rules for Annotations
 
-	switch v := m.QueryFor.(type) {
-	case *AlertSpec_QueryForUserId:
-		if v == nil {
-			err := AlertSpecValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnomaly()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Anomaly",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Anomaly",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnomaly()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "Anomaly",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.QueryFor.(type) {
+	case *AlertSpec_QueryForUserId:
+		if v == nil {
+			err := AlertSpecValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
 			if !all {
 				return err
 			}
@@ -4509,6 +5187,122 @@ var _ interface {
 	ErrorName() string
 } = AlertSpecValidationError{}
 
+// Validate checks the field values on AlertAnomalySpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertAnomalySpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertAnomalySpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertAnomalySpecMultiError, or nil if none found.
+func (m *AlertAnomalySpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertAnomalySpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MetricsView
+
+	// no validation rules for Dimension
+
+	// no validation rules for DimensionLimit
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for TimeZone
+
+	// no validation rules for BaselineIsoDuration
+
+	// no validation rules for BaselineMethod
+
+	// no validation rules for ThresholdSigma
+
+	if len(errors) > 0 {
+		return AlertAnomalySpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertAnomalySpecMultiError is an error wrapping multiple validation errors
+// returned by AlertAnomalySpec.ValidateAll() if the designated constraints
+// aren't met.
+type AlertAnomalySpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertAnomalySpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertAnomalySpecMultiError) AllErrors() []error { return m }
+
+// AlertAnomalySpecValidationError is the validation error returned by
+// AlertAnomalySpec.Validate if the designated constraints aren't met.
+type AlertAnomalySpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertAnomalySpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertAnomalySpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertAnomalySpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertAnomalySpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertAnomalySpecValidationError) ErrorName() string { return "AlertAnomalySpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertAnomalySpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertAnomalySpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertAnomalySpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertAnomalySpecValidationError{}
+
 // Validate checks the field values on AlertState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -4848,6 +5642,40 @@ func (m *AlertExecution) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertExecutionValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertExecutionValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertExecutionValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AlertExecutionMultiError(errors)
 	}
@@ -5059,80 +5887,53 @@ var _ interface {
 	ErrorName() string
 } = AssertionResultValidationError{}
 
-// Validate checks the field values on PullTrigger with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Notifier with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *PullTrigger) Validate() error {
+func (m *Notifier) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullTrigger with the rules defined in
+// ValidateAll checks the field values on Notifier with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PullTriggerMultiError, or
-// nil if none found.
-func (m *PullTrigger) ValidateAll() error {
+// result is a list of violation errors wrapped in NotifierMultiError, or nil
+// if none found.
+func (m *Notifier) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullTrigger) validate(all bool) error {
+func (m *Notifier) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PullTriggerValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Connector
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetProperties()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
-					field:  "State",
+				errors = append(errors, NotifierValidationError{
+					field:  "Properties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
-					field:  "State",
+				errors = append(errors, NotifierValidationError{
+					field:  "Properties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PullTriggerValidationError{
-				field:  "State",
+			return NotifierValidationError{
+				field:  "Properties",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5140,18 +5941,18 @@ func (m *PullTrigger) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PullTriggerMultiError(errors)
+		return NotifierMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullTriggerMultiError is an error wrapping multiple validation errors
-// returned by PullTrigger.ValidateAll() if the designated constraints aren't met.
-type PullTriggerMultiError []error
+// NotifierMultiError is an error wrapping multiple validation errors returned
+// by Notifier.ValidateAll() if the designated constraints aren't met.
+type NotifierMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullTriggerMultiError) Error() string {
+func (m NotifierMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5160,11 +5961,11 @@ func (m PullTriggerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullTriggerMultiError) AllErrors() []error { return m }
+func (m NotifierMultiError) AllErrors() []error { return m }
 
-// PullTriggerValidationError is the validation error returned by
-// PullTrigger.Validate if the designated constraints aren't met.
-type PullTriggerValidationError struct {
+// NotifierValidationError is the validation error returned by
+// Notifier.Validate if the designated constraints aren't met.
+type NotifierValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5172,22 +5973,22 @@ type PullTriggerValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullTriggerValidationError) Field() string { return e.field }
+func (e NotifierValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullTriggerValidationError) Reason() string { return e.reason }
+func (e NotifierValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullTriggerValidationError) Cause() error { return e.cause }
+func (e NotifierValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullTriggerValidationError) Key() bool { return e.key }
+func (e NotifierValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullTriggerValidationError) ErrorName() string { return "PullTriggerValidationError" }
+func (e NotifierValidationError) ErrorName() string { return "NotifierValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PullTriggerValidationError) Error() string {
+func (e NotifierValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5199,14 +6000,14 @@ func (e PullTriggerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullTrigger.%s: %s%s",
+		"invalid %sNotifier.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullTriggerValidationError{}
+var _ error = NotifierValidationError{}
 
 var _ interface {
 	Field() string
@@ -5214,33 +6015,300 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullTriggerValidationError{}
+} = NotifierValidationError{}
 
-// Validate checks the field values on PullTriggerSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PullTriggerSpec) Validate() error {
+// Validate checks the field values on NotificationStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationStatus) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullTriggerSpec with the rules
+// ValidateAll checks the field values on NotificationStatus with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullTriggerSpecMultiError, or nil if none found.
-func (m *PullTriggerSpec) ValidateAll() error {
+// NotificationStatusMultiError, or nil if none found.
+func (m *NotificationStatus) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullTriggerSpec) validate(all bool) error {
+func (m *NotificationStatus) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return PullTriggerSpecMultiError(errors)
-	}
+	// no validation rules for Connector
+
+	// no validation rules for Delivered
+
+	// no validation rules for Attempts
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return NotificationStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationStatusMultiError is an error wrapping multiple validation errors
+// returned by NotificationStatus.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationStatusMultiError) AllErrors() []error { return m }
+
+// NotificationStatusValidationError is the validation error returned by
+// NotificationStatus.Validate if the designated constraints aren't met.
+type NotificationStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationStatusValidationError) ErrorName() string {
+	return "NotificationStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationStatusValidationError{}
+
+// Validate checks the field values on PullTrigger with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PullTrigger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PullTrigger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PullTriggerMultiError, or
+// nil if none found.
+func (m *PullTrigger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PullTrigger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PullTriggerValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PullTriggerValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PullTriggerValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PullTriggerValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PullTriggerValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PullTriggerValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PullTriggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// PullTriggerMultiError is an error wrapping multiple validation errors
+// returned by PullTrigger.ValidateAll() if the designated constraints aren't met.
+type PullTriggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PullTriggerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PullTriggerMultiError) AllErrors() []error { return m }
+
+// PullTriggerValidationError is the validation error returned by
+// PullTrigger.Validate if the designated constraints aren't met.
+type PullTriggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullTriggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullTriggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullTriggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullTriggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullTriggerValidationError) ErrorName() string { return "PullTriggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PullTriggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullTrigger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullTriggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullTriggerValidationError{}
+
+// Validate checks the field values on PullTriggerSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PullTriggerSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PullTriggerSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullTriggerSpecMultiError, or nil if none found.
+func (m *PullTriggerSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PullTriggerSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PullTriggerSpecMultiError(errors)
+	}
 
 	return nil
 }
@@ -7837,12 +8905,52 @@ func (m *APISpec) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return APISpecMultiError(errors)
-	}
+	// no validation rules for Description
 
-	return nil
-}
+	for idx, item := range m.GetArgs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APISpecValidationError{
+						field:  fmt.Sprintf("Args[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APISpecValidationError{
+						field:  fmt.Sprintf("Args[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return APISpecValidationError{
+					field:  fmt.Sprintf("Args[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RateLimitRequests
+
+	// no validation rules for RateLimitPeriodSeconds
+
+	if len(errors) > 0 {
+		return APISpecMultiError(errors)
+	}
+
+	return nil
+}
 
 // APISpecMultiError is an error wrapping multiple validation errors returned
 // by APISpec.ValidateAll() if the designated constraints aren't met.
@@ -7914,6 +9022,176 @@ var _ interface {
 	ErrorName() string
 } = APISpecValidationError{}
 
+// Validate checks the field values on APIArgument with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIArgument) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIArgument with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIArgumentMultiError, or
+// nil if none found.
+func (m *APIArgument) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIArgument) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for Required
+
+	if all {
+		switch v := interface{}(m.GetDefaultValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIArgumentValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIArgumentValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIArgumentValidationError{
+				field:  "DefaultValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEnumValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APIArgumentValidationError{
+						field:  fmt.Sprintf("EnumValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APIArgumentValidationError{
+						field:  fmt.Sprintf("EnumValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return APIArgumentValidationError{
+					field:  fmt.Sprintf("EnumValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return APIArgumentMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIArgumentMultiError is an error wrapping multiple validation errors
+// returned by APIArgument.ValidateAll() if the designated constraints aren't met.
+type APIArgumentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIArgumentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIArgumentMultiError) AllErrors() []error { return m }
+
+// APIArgumentValidationError is the validation error returned by
+// APIArgument.Validate if the designated constraints aren't met.
+type APIArgumentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIArgumentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIArgumentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIArgumentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIArgumentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIArgumentValidationError) ErrorName() string { return "APIArgumentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIArgumentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIArgument.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIArgumentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIArgumentValidationError{}
+
 // Validate checks the field values on APIState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -7921,34 +9199,739 @@ func (m *APIState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on APIState with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in APIStateMultiError, or nil
-// if none found.
-func (m *APIState) ValidateAll() error {
+// ValidateAll checks the field values on APIState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIStateMultiError, or nil
+// if none found.
+func (m *APIState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return APIStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIStateMultiError is an error wrapping multiple validation errors returned
+// by APIState.ValidateAll() if the designated constraints aren't met.
+type APIStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIStateMultiError) AllErrors() []error { return m }
+
+// APIStateValidationError is the validation error returned by
+// APIState.Validate if the designated constraints aren't met.
+type APIStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIStateValidationError) ErrorName() string { return "APIStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIStateValidationError{}
+
+// Validate checks the field values on Test with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Test) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Test with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TestMultiError, or nil if none found.
+func (m *Test) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Test) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestMultiError is an error wrapping multiple validation errors returned by
+// Test.ValidateAll() if the designated constraints aren't met.
+type TestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestMultiError) AllErrors() []error { return m }
+
+// TestValidationError is the validation error returned by Test.Validate if the
+// designated constraints aren't met.
+type TestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestValidationError) ErrorName() string { return "TestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestValidationError{}
+
+// Validate checks the field values on TestSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestSpecMultiError, or nil
+// if none found.
+func (m *TestSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Table
+
+	for idx, item := range m.GetAssertions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestSpecValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestSpecValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestSpecValidationError{
+					field:  fmt.Sprintf("Assertions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BlockDownstream
+
+	if len(errors) > 0 {
+		return TestSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestSpecMultiError is an error wrapping multiple validation errors returned
+// by TestSpec.ValidateAll() if the designated constraints aren't met.
+type TestSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestSpecMultiError) AllErrors() []error { return m }
+
+// TestSpecValidationError is the validation error returned by
+// TestSpec.Validate if the designated constraints aren't met.
+type TestSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestSpecValidationError) ErrorName() string { return "TestSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestSpecValidationError{}
+
+// Validate checks the field values on TestAssertion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestAssertion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestAssertion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestAssertionMultiError, or
+// nil if none found.
+func (m *TestAssertion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestAssertion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Sql
+
+	if len(errors) > 0 {
+		return TestAssertionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestAssertionMultiError is an error wrapping multiple validation errors
+// returned by TestAssertion.ValidateAll() if the designated constraints
+// aren't met.
+type TestAssertionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestAssertionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestAssertionMultiError) AllErrors() []error { return m }
+
+// TestAssertionValidationError is the validation error returned by
+// TestAssertion.Validate if the designated constraints aren't met.
+type TestAssertionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestAssertionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestAssertionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestAssertionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestAssertionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestAssertionValidationError) ErrorName() string { return "TestAssertionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestAssertionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestAssertion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestAssertionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestAssertionValidationError{}
+
+// Validate checks the field values on TestState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestStateMultiError, or nil
+// if none found.
+func (m *TestState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvaluatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestStateValidationError{
+					field:  "EvaluatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestStateValidationError{
+					field:  "EvaluatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestStateValidationError{
+				field:  "EvaluatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Passed
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestStateValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestStateValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestStateValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestStateMultiError is an error wrapping multiple validation errors returned
+// by TestState.ValidateAll() if the designated constraints aren't met.
+type TestStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestStateMultiError) AllErrors() []error { return m }
+
+// TestStateValidationError is the validation error returned by
+// TestState.Validate if the designated constraints aren't met.
+type TestStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestStateValidationError) ErrorName() string { return "TestStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestStateValidationError{}
+
+// Validate checks the field values on TestAssertionResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestAssertionResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestAssertionResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestAssertionResultMultiError, or nil if none found.
+func (m *TestAssertionResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *APIState) validate(all bool) error {
+func (m *TestAssertionResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Passed
+
+	// no validation rules for FailingRowsCount
+
+	for idx, item := range m.GetFailingRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestAssertionResultValidationError{
+						field:  fmt.Sprintf("FailingRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestAssertionResultValidationError{
+						field:  fmt.Sprintf("FailingRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestAssertionResultValidationError{
+					field:  fmt.Sprintf("FailingRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return APIStateMultiError(errors)
+		return TestAssertionResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// APIStateMultiError is an error wrapping multiple validation errors returned
-// by APIState.ValidateAll() if the designated constraints aren't met.
-type APIStateMultiError []error
+// TestAssertionResultMultiError is an error wrapping multiple validation
+// errors returned by TestAssertionResult.ValidateAll() if the designated
+// constraints aren't met.
+type TestAssertionResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m APIStateMultiError) Error() string {
+func (m TestAssertionResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7957,11 +9940,11 @@ func (m APIStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m APIStateMultiError) AllErrors() []error { return m }
+func (m TestAssertionResultMultiError) AllErrors() []error { return m }
 
-// APIStateValidationError is the validation error returned by
-// APIState.Validate if the designated constraints aren't met.
-type APIStateValidationError struct {
+// TestAssertionResultValidationError is the validation error returned by
+// TestAssertionResult.Validate if the designated constraints aren't met.
+type TestAssertionResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7969,22 +9952,24 @@ type APIStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e APIStateValidationError) Field() string { return e.field }
+func (e TestAssertionResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e APIStateValidationError) Reason() string { return e.reason }
+func (e TestAssertionResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e APIStateValidationError) Cause() error { return e.cause }
+func (e TestAssertionResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e APIStateValidationError) Key() bool { return e.key }
+func (e TestAssertionResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e APIStateValidationError) ErrorName() string { return "APIStateValidationError" }
+func (e TestAssertionResultValidationError) ErrorName() string {
+	return "TestAssertionResultValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e APIStateValidationError) Error() string {
+func (e TestAssertionResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7996,14 +9981,14 @@ func (e APIStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAPIState.%s: %s%s",
+		"invalid %sTestAssertionResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = APIStateValidationError{}
+var _ error = TestAssertionResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -8011,7 +9996,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = APIStateValidationError{}
+} = TestAssertionResultValidationError{}
 
 // Validate checks the field values on Schedule with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -8699,6 +10684,8 @@ func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
 
 	// no validation rules for Unnest
 
+	// no validation rules for Join
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_DimensionV2MultiError(errors)
 	}
@@ -8780,6 +10767,148 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpec_DimensionV2ValidationError{}
 
+// Validate checks the field values on MetricsViewSpec_JoinV2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_JoinV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_JoinV2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_JoinV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_JoinV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_JoinV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Table
+
+	// no validation rules for Type
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpec_JoinV2ValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpec_JoinV2ValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpec_JoinV2ValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_JoinV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_JoinV2MultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSpec_JoinV2.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_JoinV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_JoinV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_JoinV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_JoinV2ValidationError is the validation error returned by
+// MetricsViewSpec_JoinV2.Validate if the designated constraints aren't met.
+type MetricsViewSpec_JoinV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_JoinV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_JoinV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_JoinV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_JoinV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_JoinV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_JoinV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_JoinV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_JoinV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_JoinV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_JoinV2ValidationError{}
+
 // Validate checks the field values on MetricsViewSpec_MeasureV2 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -8816,6 +10945,8 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	// no validation rules for Type
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_MeasureV2MultiError(errors)
 	}
@@ -9321,6 +11452,112 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpec_AvailableTimeRangeValidationError{}
 
+// Validate checks the field values on MetricsViewSpec_JoinV2_Key with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_JoinV2_Key) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_JoinV2_Key with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_JoinV2_KeyMultiError, or nil if none found.
+func (m *MetricsViewSpec_JoinV2_Key) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_JoinV2_Key) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for JoinColumn
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_JoinV2_KeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_JoinV2_KeyMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_JoinV2_Key.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSpec_JoinV2_KeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_JoinV2_KeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_JoinV2_KeyMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_JoinV2_KeyValidationError is the validation error returned
+// by MetricsViewSpec_JoinV2_Key.Validate if the designated constraints aren't met.
+type MetricsViewSpec_JoinV2_KeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_JoinV2_KeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_JoinV2_KeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_JoinV2_KeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_JoinV2_KeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_JoinV2_KeyValidationError) ErrorName() string {
+	return "MetricsViewSpec_JoinV2_KeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_JoinV2_KeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_JoinV2_Key.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_JoinV2_KeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_JoinV2_KeyValidationError{}
+
 // Validate checks the field values on
 // MetricsViewSpec_SecurityV2_FieldConditionV2 with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -2,11 +2,24 @@ package runtime
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// Names of the args used to paginate the results of an API.
+// They are prefixed with an underscore so they don't clash with the arguments used in existing APIs' templates (such as "limit").
+// They are passed through to the resolver and can't be declared as arguments of an API.
+const (
+	APIArgLimit     = "_limit"
+	APIArgPageToken = "_page_token"
+)
+
 // BuiltinAPIs is a map of built-in APIs (i.e. predefined APIs that are not created dynamically from a project's YAML files.)
 var BuiltinAPIs = map[string]*runtimev1.API{}
 
@@ -47,3 +60,154 @@ func (r *Runtime) APIForName(ctx context.Context, instanceID, name string) (*run
 
 	return resource.GetApi(), nil
 }
+
+// ResolveAPIArgs validates the args of a request to an API against the arguments declared in its spec.
+// It converts the args to the declared types, applies defaults and returns an error if an arg is missing, unknown or invalid.
+// Args provided as strings (such as from a URL query) are parsed. If the API doesn't declare any arguments, the other args are returned unchanged.
+// The pagination args (APIArgLimit and APIArgPageToken) are accepted by all APIs.
+func ResolveAPIArgs(spec *runtimev1.APISpec, args map[string]any) (map[string]any, error) {
+	if len(spec.Args) == 0 {
+		return resolveAPIPaginationArgs(args, maps.Clone(args))
+	}
+
+	declared := make(map[string]bool, len(spec.Args))
+	res := make(map[string]any, len(spec.Args))
+	for _, a := range spec.Args {
+		declared[a.Name] = true
+
+		v, ok := args[a.Name]
+		if !ok || v == nil {
+			if a.Required {
+				return nil, fmt.Errorf("missing required argument %q", a.Name)
+			}
+			if a.DefaultValue == nil {
+				continue
+			}
+			v = a.DefaultValue.AsInterface()
+		}
+
+		v, err := coerceAPIArg(a.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for argument %q: %w", a.Name, err)
+		}
+
+		if len(a.EnumValues) > 0 {
+			found := false
+			for _, e := range a.EnumValues {
+				ev, err := coerceAPIArg(a.Type, e.AsInterface())
+				if err == nil && ev == v {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("invalid value for argument %q: %v is not one of the allowed values", a.Name, v)
+			}
+		}
+
+		res[a.Name] = v
+	}
+
+	for k := range args {
+		if k == APIArgLimit || k == APIArgPageToken {
+			continue
+		}
+		if !declared[k] {
+			return nil, fmt.Errorf("unknown argument %q", k)
+		}
+	}
+
+	return resolveAPIPaginationArgs(args, res)
+}
+
+// resolveAPIPaginationArgs converts the pagination args found in args to an integer limit and a string page token and sets them in res.
+func resolveAPIPaginationArgs(args, res map[string]any) (map[string]any, error) {
+	paginationArgs := []struct {
+		name string
+		typ  runtimev1.APIArgumentType
+	}{
+		{APIArgLimit, runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER},
+		{APIArgPageToken, runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING},
+	}
+
+	for _, a := range paginationArgs {
+		v, ok := args[a.name]
+		if !ok || v == nil {
+			continue
+		}
+
+		v, err := coerceAPIArg(a.typ, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for argument %q: %w", a.name, err)
+		}
+		res[a.name] = v
+	}
+
+	return res, nil
+}
+
+// coerceAPIArg converts a JSON or string value to the Go type for an API argument type.
+// Integers are returned as int64, numbers as float64 and timestamps as strings in RFC 3339 format.
+func coerceAPIArg(t runtimev1.APIArgumentType, v any) (any, error) {
+	switch t {
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("expected a string, got %v", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER:
+		switch v := v.(type) {
+		case string:
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected an integer, got %q", v)
+			}
+			return i, nil
+		case float64:
+			if v == math.Trunc(v) && !math.IsInf(v, 0) {
+				return int64(v), nil
+			}
+		case int64:
+			return v, nil
+		}
+		return nil, fmt.Errorf("expected an integer, got %v", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_NUMBER:
+		switch v := v.(type) {
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected a number, got %q", v)
+			}
+			return f, nil
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		}
+		return nil, fmt.Errorf("expected a number, got %v", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_BOOLEAN:
+		switch v := v.(type) {
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("expected a boolean, got %q", v)
+			}
+			return b, nil
+		case bool:
+			return v, nil
+		}
+		return nil, fmt.Errorf("expected a boolean, got %v", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_TIMESTAMP:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a timestamp, got %v", v)
+		}
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, fmt.Errorf("expected an RFC 3339 timestamp, got %q", s)
+		}
+		return ts.Format(time.RFC3339Nano), nil
+	default:
+		return v, nil
+	}
+}
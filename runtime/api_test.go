@@ -0,0 +1,62 @@
+package runtime_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestResolveAPIArgs(t *testing.T) {
+	spec := &runtimev1.APISpec{
+		Args: []*runtimev1.APIArgument{
+			{Name: "domain", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING, Required: true},
+			{Name: "limit", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER, DefaultValue: structpb.NewNumberValue(10)},
+			{Name: "min_price", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_NUMBER},
+			{Name: "exact", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_BOOLEAN},
+			{Name: "since", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_TIMESTAMP},
+			{Name: "grain", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING, EnumValues: []*structpb.Value{structpb.NewStringValue("day"), structpb.NewStringValue("week")}},
+		},
+	}
+
+	// Args from a URL query are parsed and defaults are applied
+	args, err := runtime.ResolveAPIArgs(spec, map[string]any{"domain": "google.com", "min_price": "1.5", "exact": "true", "since": "2024-01-01T00:00:00Z", "grain": "day"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"domain": "google.com", "limit": int64(10), "min_price": 1.5, "exact": true, "since": "2024-01-01T00:00:00Z", "grain": "day"}, args)
+
+	// Args from a JSON body
+	args, err = runtime.ResolveAPIArgs(spec, map[string]any{"domain": "google.com", "limit": float64(5), "exact": false})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"domain": "google.com", "limit": int64(5), "exact": false}, args)
+
+	tests := []struct {
+		args map[string]any
+		err  string
+	}{
+		{map[string]any{}, `missing required argument "domain"`},
+		{map[string]any{"domain": "a", "limit": "ten"}, `invalid value for argument "limit": expected an integer, got "ten"`},
+		{map[string]any{"domain": "a", "limit": 1.5}, `invalid value for argument "limit": expected an integer, got 1.5`},
+		{map[string]any{"domain": 1.0}, `invalid value for argument "domain": expected a string, got 1`},
+		{map[string]any{"domain": "a", "since": "yesterday"}, `invalid value for argument "since": expected an RFC 3339 timestamp, got "yesterday"`},
+		{map[string]any{"domain": "a", "grain": "month"}, `invalid value for argument "grain": month is not one of the allowed values`},
+		{map[string]any{"domain": "a", "other": "b"}, `unknown argument "other"`},
+	}
+	for _, tt := range tests {
+		_, err := runtime.ResolveAPIArgs(spec, tt.args)
+		require.EqualError(t, err, tt.err)
+	}
+
+	// Pagination args are accepted and parsed
+	args, err = runtime.ResolveAPIArgs(spec, map[string]any{"domain": "a", "_limit": "100", "_page_token": "abc"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"domain": "a", "limit": int64(10), "_limit": int64(100), "_page_token": "abc"}, args)
+	_, err = runtime.ResolveAPIArgs(spec, map[string]any{"domain": "a", "_limit": "ten"})
+	require.EqualError(t, err, `invalid value for argument "_limit": expected an integer, got "ten"`)
+
+	// APIs without declared args accept any args
+	args, err = runtime.ResolveAPIArgs(&runtimev1.APISpec{}, map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"foo": "bar"}, args)
+}
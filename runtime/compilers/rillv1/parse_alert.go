@@ -34,7 +34,9 @@ type AlertYAML struct {
 			Attributes map[string]any `yaml:"attributes"`
 		} `yaml:"for"`
 	} `yaml:"query"`
-	Email struct {
+	Condition *AlertConditionYAML `yaml:"condition"`
+	Anomaly   *AlertAnomalyYAML   `yaml:"anomaly"`
+	Email     struct {
 		Recipients    []string `yaml:"recipients"`
 		OnRecover     *bool    `yaml:"on_recover"`
 		OnFail        *bool    `yaml:"on_fail"`
@@ -42,9 +44,35 @@ type AlertYAML struct {
 		Renotify      *bool    `yaml:"renotify"`
 		RenotifyAfter string   `yaml:"renotify_after"`
 	} `yaml:"email"`
+	Notify      []map[string]any  `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
 
+// AlertConditionYAML is a declarative alternative to setting an alert's query.
+// It is compiled into a metrics view query that returns the rows that match the condition.
+type AlertConditionYAML struct {
+	MetricsView string   `yaml:"metrics_view"`
+	Measure     string   `yaml:"measure"`
+	Dimension   string   `yaml:"dimension"`
+	TimeRange   string   `yaml:"time_range"`
+	CompareTo   string   `yaml:"compare_to"` // If set, Threshold is compared to the percentage change from the time range offset by CompareTo
+	Operator    string   `yaml:"operator"`
+	Threshold   *float64 `yaml:"threshold"` // Required, so it's a pointer to distinguish a missing threshold from zero
+}
+
+// AlertAnomalyYAML configures an alert that detects anomalies compared to a historical baseline instead of running a query.
+type AlertAnomalyYAML struct {
+	MetricsView    string   `yaml:"metrics_view"`
+	Measures       []string `yaml:"measures"`
+	Dimension      string   `yaml:"dimension"`
+	DimensionLimit uint     `yaml:"dimension_limit"`
+	TimeGrain      string   `yaml:"time_grain"`
+	TimeZone       string   `yaml:"time_zone"`
+	Baseline       string   `yaml:"baseline"`  // Duration of the trailing window to compute the baseline from
+	Method         string   `yaml:"method"`    // options: "rolling", "seasonal"
+	Threshold      float64  `yaml:"threshold"` // In standard deviations
+}
+
 // parseAlert parses an alert definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseAlert(node *Node) error {
 	// Parse YAML
@@ -98,27 +126,59 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
-	// Query name
-	if tmp.Query.Name == "" {
-		return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
+	// Parse anomaly detection. Anomaly alerts don't have a query.
+	var anomaly *runtimev1.AlertAnomalySpec
+	if tmp.Anomaly != nil {
+		if tmp.Condition != nil || tmp.Query.Name != "" || tmp.Query.Args != nil || tmp.Query.ArgsJSON != "" {
+			return errors.New(`cannot set "anomaly" together with "condition" or "query"`)
+		}
+		anomaly, err = parseAlertAnomaly(tmp.Anomaly)
+		if err != nil {
+			return err
+		}
+		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: anomaly.MetricsView})
+
+		// By default, check every interval of the time grain exactly once
+		if tmp.Intervals.Duration == "" {
+			tmp.Intervals.Duration = timeGrainISODuration(anomaly.TimeGrain)
+		}
 	}
 
-	// Query args
-	if tmp.Query.ArgsJSON != "" {
-		// Validate JSON
-		if !json.Valid([]byte(tmp.Query.ArgsJSON)) {
-			return errors.New(`failed to parse "query.args_json" as JSON`)
+	// Compile the condition into a query
+	if tmp.Condition != nil {
+		if tmp.Query.Name != "" || tmp.Query.Args != nil || tmp.Query.ArgsJSON != "" {
+			return errors.New(`cannot set "condition" together with "query.name", "query.args" or "query.args_json"`)
 		}
-	} else {
-		// Fall back to query.args if query.args_json is not set
-		data, err := json.Marshal(tmp.Query.Args)
+		tmp.Query.Name, tmp.Query.ArgsJSON, err = compileAlertCondition(tmp.Condition)
 		if err != nil {
-			return fmt.Errorf(`failed to serialize "query.args" to JSON: %w`, err)
+			return err
 		}
-		tmp.Query.ArgsJSON = string(data)
+		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: tmp.Condition.MetricsView})
 	}
-	if tmp.Query.ArgsJSON == "" {
-		return errors.New(`missing query args (must set either "query.args" or "query.args_json")`)
+
+	if anomaly == nil {
+		// Query name
+		if tmp.Query.Name == "" {
+			return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
+		}
+
+		// Query args
+		if tmp.Query.ArgsJSON != "" {
+			// Validate JSON
+			if !json.Valid([]byte(tmp.Query.ArgsJSON)) {
+				return errors.New(`failed to parse "query.args_json" as JSON`)
+			}
+		} else {
+			// Fall back to query.args if query.args_json is not set
+			data, err := json.Marshal(tmp.Query.Args)
+			if err != nil {
+				return fmt.Errorf(`failed to serialize "query.args" to JSON: %w`, err)
+			}
+			tmp.Query.ArgsJSON = string(data)
+		}
+		if tmp.Query.ArgsJSON == "" {
+			return errors.New(`missing query args (must set either "query.args" or "query.args_json")`)
+		}
 	}
 
 	// Query for: validate only one of user_id, user_email, or attributes is set
@@ -165,6 +225,12 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Parse notifiers
+	notifiers, err := p.parseNotifiers(tmp.Notify)
+	if err != nil {
+		return err
+	}
+
 	// Track alert
 	r, err := p.insertResource(ResourceKindAlert, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -185,6 +251,7 @@ func (p *Parser) parseAlert(node *Node) error {
 	}
 	r.AlertSpec.QueryName = tmp.Query.Name
 	r.AlertSpec.QueryArgsJson = tmp.Query.ArgsJSON
+	r.AlertSpec.Anomaly = anomaly
 
 	// Note: have already validated that at most one of the cases match
 	if queryForUserID != "" {
@@ -218,7 +285,248 @@ func (p *Parser) parseAlert(node *Node) error {
 		r.AlertSpec.EmailRenotifyAfterSeconds = uint32(emailRenotifyAfter.Seconds())
 	}
 
+	r.AlertSpec.Notifiers = notifiers
 	r.AlertSpec.Annotations = tmp.Annotations
 
 	return nil
 }
+
+// parseAlertAnomaly parses and validates the anomaly detection settings of an alert.
+func parseAlertAnomaly(a *AlertAnomalyYAML) (*runtimev1.AlertAnomalySpec, error) {
+	if a.MetricsView == "" {
+		return nil, errors.New(`missing required property "anomaly.metrics_view"`)
+	}
+	if len(a.Measures) == 0 {
+		return nil, errors.New(`missing required property "anomaly.measures"`)
+	}
+
+	grain, err := parseTimeGrain(a.TimeGrain)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_grain"`, a.TimeGrain)
+	}
+	if grain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		return nil, errors.New(`missing required property "anomaly.time_grain"`)
+	}
+	if grain == runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND || grain == runtimev1.TimeGrain_TIME_GRAIN_SECOND {
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_grain": must be a minute or longer`, a.TimeGrain)
+	}
+
+	if a.TimeZone != "" {
+		_, err := time.LoadLocation(a.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_zone": %w`, a.TimeZone, err)
+		}
+	}
+
+	method := runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_ROLLING
+	switch strings.ToLower(a.Method) {
+	case "", "rolling":
+	case "seasonal":
+		if grain != runtimev1.TimeGrain_TIME_GRAIN_MINUTE && grain != runtimev1.TimeGrain_TIME_GRAIN_HOUR && grain != runtimev1.TimeGrain_TIME_GRAIN_DAY {
+			return nil, errors.New(`the "seasonal" method requires an "anomaly.time_grain" of a day or less`)
+		}
+		method = runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_SEASONAL
+	default:
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.method"`, a.Method)
+	}
+
+	baseline := a.Baseline
+	if baseline == "" {
+		baseline = "P4W"
+	}
+	if err := validateISO8601(baseline, true, false); err != nil {
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.baseline": %w`, baseline, err)
+	}
+
+	threshold := a.Threshold
+	if threshold == 0 {
+		threshold = 3
+	}
+	if threshold < 0 {
+		return nil, fmt.Errorf(`invalid value %v for property "anomaly.threshold": must be positive`, a.Threshold)
+	}
+
+	return &runtimev1.AlertAnomalySpec{
+		MetricsView:         a.MetricsView,
+		Measures:            a.Measures,
+		Dimension:           a.Dimension,
+		DimensionLimit:      uint32(a.DimensionLimit),
+		TimeGrain:           grain,
+		TimeZone:            a.TimeZone,
+		BaselineIsoDuration: baseline,
+		BaselineMethod:      method,
+		ThresholdSigma:      threshold,
+	}, nil
+}
+
+// timeGrainISODuration returns the ISO 8601 duration of a single interval of the time grain.
+func timeGrainISODuration(tg runtimev1.TimeGrain) string {
+	switch tg {
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return "PT1M"
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return "PT1H"
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return "P1D"
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return "P1W"
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return "P1M"
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return "P3M"
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return "P1Y"
+	default:
+		return ""
+	}
+}
+
+// compileAlertCondition compiles an alert condition into a query name and JSON query args.
+// The query returns the rows that match the condition, sorted by how far they exceed the threshold.
+// Without "compare_to", it compiles to a MetricsViewAggregation query with a HAVING expression on the measure.
+// With "compare_to", it compiles to a MetricsViewComparison query with a HAVING expression on the measure's relative change.
+func compileAlertCondition(c *AlertConditionYAML) (string, string, error) {
+	if c.MetricsView == "" {
+		return "", "", errors.New(`missing required property "condition.metrics_view"`)
+	}
+	if c.Measure == "" {
+		return "", "", errors.New(`missing required property "condition.measure"`)
+	}
+	op, err := parseConditionOperator(c.Operator)
+	if err != nil {
+		return "", "", err
+	}
+	if c.Threshold == nil {
+		return "", "", errors.New(`missing required property "condition.threshold"`)
+	}
+	threshold := *c.Threshold
+	if c.TimeRange != "" {
+		if err := validateISO8601(c.TimeRange, false, false); err != nil {
+			return "", "", fmt.Errorf(`invalid value %q for property "condition.time_range": %w`, c.TimeRange, err)
+		}
+	}
+
+	// Sort the rows that exceed the threshold the most first, so they're the ones reported in notifications
+	desc := op == runtimev1.Operation_OPERATION_GT || op == runtimev1.Operation_OPERATION_GTE
+
+	var name string
+	var args map[string]any
+	if c.CompareTo == "" {
+		name = "MetricsViewAggregation"
+		args = map[string]any{
+			"metrics_view": c.MetricsView,
+			"measures":     []any{map[string]any{"name": c.Measure}},
+			"having":       conditionHavingExpression(c.Measure, op, threshold),
+			"sort":         []any{map[string]any{"name": c.Measure, "desc": desc}},
+		}
+		if c.Dimension != "" {
+			args["dimensions"] = []any{map[string]any{"name": c.Dimension}}
+		}
+		if c.TimeRange != "" {
+			args["time_range"] = map[string]any{"iso_duration": c.TimeRange}
+		}
+	} else {
+		if err := validateISO8601(c.CompareTo, false, false); err != nil {
+			return "", "", fmt.Errorf(`invalid value %q for property "condition.compare_to": %w`, c.CompareTo, err)
+		}
+		if c.TimeRange == "" {
+			return "", "", errors.New(`property "condition.time_range" is required when "condition.compare_to" is set`)
+		}
+		if c.Dimension == "" {
+			return "", "", errors.New(`property "condition.dimension" is required when "condition.compare_to" is set`)
+		}
+
+		alias := c.Measure + "__delta_rel"
+		relDelta := runtimev1.MetricsViewComparisonMeasureType_METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA.String()
+		name = "MetricsViewComparison"
+		args = map[string]any{
+			"metrics_view_name":     c.MetricsView,
+			"dimension":             map[string]any{"name": c.Dimension},
+			"measures":              []any{map[string]any{"name": c.Measure}},
+			"time_range":            map[string]any{"iso_duration": c.TimeRange},
+			"comparison_time_range": map[string]any{"iso_duration": c.TimeRange, "iso_offset": c.CompareTo},
+			"aliases":               []any{map[string]any{"name": c.Measure, "type": relDelta, "alias": alias}},
+			"having":                conditionHavingExpression(alias, op, threshold/100),
+			"sort":                  []any{map[string]any{"name": c.Measure, "sort_type": relDelta, "desc": desc}},
+			"exact":                 true,
+		}
+	}
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", "", fmt.Errorf(`failed to serialize "condition": %w`, err)
+	}
+	return name, string(data), nil
+}
+
+// conditionHavingExpression returns the JSON representation of a runtimev1.Expression that compares ident to threshold.
+func conditionHavingExpression(ident string, op runtimev1.Operation, threshold float64) map[string]any {
+	return map[string]any{
+		"cond": map[string]any{
+			"op": op.String(),
+			"exprs": []any{
+				map[string]any{"ident": ident},
+				map[string]any{"val": threshold},
+			},
+		},
+	}
+}
+
+// parseConditionOperator parses the comparison operator of an alert condition.
+func parseConditionOperator(s string) (runtimev1.Operation, error) {
+	switch strings.ToLower(s) {
+	case "<", "lt":
+		return runtimev1.Operation_OPERATION_LT, nil
+	case "<=", "lte":
+		return runtimev1.Operation_OPERATION_LTE, nil
+	case ">", "gt":
+		return runtimev1.Operation_OPERATION_GT, nil
+	case ">=", "gte":
+		return runtimev1.Operation_OPERATION_GTE, nil
+	case "=", "==", "eq":
+		return runtimev1.Operation_OPERATION_EQ, nil
+	case "!=", "<>", "neq":
+		return runtimev1.Operation_OPERATION_NEQ, nil
+	case "":
+		return runtimev1.Operation_OPERATION_UNSPECIFIED, errors.New(`missing required property "condition.operator"`)
+	default:
+		return runtimev1.Operation_OPERATION_UNSPECIFIED, fmt.Errorf(`invalid value %q for property "condition.operator"`, s)
+	}
+}
+
+// parseNotifiers parses the "notify" property of an alert or report.
+// Each entry must set a "connector" whose driver supports notifications. The remaining keys are passed to the notifier as properties.
+func (p *Parser) parseNotifiers(entries []map[string]any) ([]*runtimev1.Notifier, error) {
+	var res []*runtimev1.Notifier
+	for i, entry := range entries {
+		connector, ok := entry["connector"].(string)
+		if !ok || connector == "" {
+			return nil, fmt.Errorf(`missing required property "connector" for "notify[%d]"`, i)
+		}
+
+		_, driver, err := p.driverForConnector(connector)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid connector for "notify[%d]": %w`, i, err)
+		}
+		if !driver.Spec().ImplementsNotifier {
+			return nil, fmt.Errorf(`connector %q for "notify[%d]" does not support notifications`, connector, i)
+		}
+
+		props := make(map[string]any, len(entry)-1)
+		for k, v := range entry {
+			if k != "connector" {
+				props[k] = v
+			}
+		}
+		propsPB, err := structpb.NewStruct(props)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to serialize properties for "notify[%d]": %w`, i, err)
+		}
+
+		res = append(res, &runtimev1.Notifier{
+			Connector:  connector,
+			Properties: propsPB,
+		})
+	}
+	return res, nil
+}
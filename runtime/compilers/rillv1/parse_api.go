@@ -1,14 +1,32 @@
 package rillv1
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // APIYAML is the raw structure of a API resource defined in YAML (does not include common fields)
 type APIYAML struct {
-	DataYAML `yaml:",inline" mapstructure:",squash"`
+	DataYAML    `yaml:",inline" mapstructure:",squash"`
+	Description string `yaml:"description"`
+	RateLimit   string `yaml:"rate_limit"`
+}
+
+// APIArgYAML is the raw YAML structure of an argument declared in the "args" list of an API.
+type APIArgYAML struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Type        string `yaml:"type"` // options: "string", "integer", "number", "boolean", "timestamp"
+	Required    bool   `yaml:"required"`
+	Default     any    `yaml:"default"`
+	Enum        []any  `yaml:"enum"`
 }
 
 // parseAPI parses an API definition and adds the resulting resource to p.Resources.
@@ -20,6 +38,11 @@ func (p *Parser) parseAPI(node *Node) error {
 		return err
 	}
 
+	// The name "openapi.json" is reserved for the route that serves the OpenAPI spec of the project's APIs
+	if strings.EqualFold(node.Name, "openapi.json") {
+		return fmt.Errorf("the API name %q is reserved for the OpenAPI spec", node.Name)
+	}
+
 	// Map common node properties to DataYAML
 	if !node.ConnectorInferred && node.Connector != "" {
 		tmp.DataYAML.Connector = node.Connector
@@ -28,6 +51,32 @@ func (p *Parser) parseAPI(node *Node) error {
 		tmp.DataYAML.SQL = node.SQL
 	}
 
+	// Parse the declared arguments.
+	// A list of "args" declares the arguments accepted by the API. A map of "args" sets static args for the "api" resolver.
+	var args []*runtimev1.APIArgument
+	if _, ok := tmp.Args.([]any); ok {
+		argsYAML := &struct {
+			Args []*APIArgYAML `yaml:"args"`
+		}{}
+		if err := node.YAML.Decode(argsYAML); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+		args, err = parseAPIArgs(argsYAML.Args)
+		if err != nil {
+			return err
+		}
+		tmp.Args = nil
+	}
+
+	// Parse the rate limit
+	var rateLimitRequests, rateLimitPeriod uint32
+	if tmp.RateLimit != "" {
+		rateLimitRequests, rateLimitPeriod, err = parseAPIRateLimit(tmp.RateLimit)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Parse the resolver and its properties from the DataYAML
 	resolver, resolverProps, resolverRefs, err := p.parseDataYAML(&tmp.DataYAML)
 	if err != nil {
@@ -43,18 +92,169 @@ func (p *Parser) parseAPI(node *Node) error {
 
 	r.APISpec.Resolver = resolver
 	r.APISpec.ResolverProperties = resolverProps
+	r.APISpec.Description = tmp.Description
+	r.APISpec.Args = args
+	r.APISpec.RateLimitRequests = rateLimitRequests
+	r.APISpec.RateLimitPeriodSeconds = rateLimitPeriod
 
 	return nil
 }
 
+// parseAPIRateLimit parses a rate limit of the form "<requests>/<period>", such as "100/min" or "1000/6h".
+// It returns the number of requests and the length of the period in seconds.
+func parseAPIRateLimit(s string) (uint32, uint32, error) {
+	requestsStr, periodStr, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf(`invalid rate_limit %q: expected a value of the form "<requests>/<period>", such as "100/min"`, s)
+	}
+
+	requests, err := strconv.ParseUint(strings.TrimSpace(requestsStr), 10, 32)
+	if err != nil || requests == 0 {
+		return 0, 0, fmt.Errorf("invalid rate_limit %q: the number of requests must be a positive integer", s)
+	}
+
+	var period time.Duration
+	switch strings.TrimSpace(periodStr) {
+	case "s", "sec", "second":
+		period = time.Second
+	case "m", "min", "minute":
+		period = time.Minute
+	case "h", "hour":
+		period = time.Hour
+	case "d", "day":
+		period = 24 * time.Hour
+	default:
+		period, err = time.ParseDuration(strings.TrimSpace(periodStr))
+		if err != nil || period < time.Second || period%time.Second != 0 {
+			return 0, 0, fmt.Errorf("invalid rate_limit %q: the period must be one of \"sec\", \"min\", \"hour\", \"day\" or a duration in whole seconds", s)
+		}
+	}
+
+	return uint32(requests), uint32(period / time.Second), nil
+}
+
+// parseAPIArgs parses and validates the arguments declared for an API.
+func parseAPIArgs(raw []*APIArgYAML) ([]*runtimev1.APIArgument, error) {
+	res := make([]*runtimev1.APIArgument, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for i, a := range raw {
+		if a == nil || a.Name == "" {
+			return nil, fmt.Errorf(`missing required property "name" for args[%d]`, i)
+		}
+		// NOTE: Keep in sync with the pagination args in runtime/api.go
+		if a.Name == "_limit" || a.Name == "_page_token" {
+			return nil, fmt.Errorf("arg name %q is reserved for pagination", a.Name)
+		}
+		if seen[a.Name] {
+			return nil, fmt.Errorf("found duplicate arg %q", a.Name)
+		}
+		seen[a.Name] = true
+
+		var typ runtimev1.APIArgumentType
+		switch strings.ToLower(a.Type) {
+		case "", "string":
+			typ = runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING
+		case "integer", "int":
+			typ = runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER
+		case "number", "float":
+			typ = runtimev1.APIArgumentType_API_ARGUMENT_TYPE_NUMBER
+		case "boolean", "bool":
+			typ = runtimev1.APIArgumentType_API_ARGUMENT_TYPE_BOOLEAN
+		case "timestamp":
+			typ = runtimev1.APIArgumentType_API_ARGUMENT_TYPE_TIMESTAMP
+		default:
+			return nil, fmt.Errorf(`invalid value %q for property "type" of arg %q`, a.Type, a.Name)
+		}
+
+		arg := &runtimev1.APIArgument{
+			Name:        a.Name,
+			Description: a.Description,
+			Type:        typ,
+			Required:    a.Required,
+		}
+
+		if a.Default != nil {
+			if a.Required {
+				return nil, fmt.Errorf("arg %q can't both be required and have a default", a.Name)
+			}
+			if err := validateAPIArgValue(typ, a.Default); err != nil {
+				return nil, fmt.Errorf(`invalid default for arg %q: %w`, a.Name, err)
+			}
+			pv, err := structpb.NewValue(a.Default)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid default for arg %q: %w`, a.Name, err)
+			}
+			arg.DefaultValue = pv
+		}
+
+		for _, v := range a.Enum {
+			if err := validateAPIArgValue(typ, v); err != nil {
+				return nil, fmt.Errorf(`invalid enum value for arg %q: %w`, a.Name, err)
+			}
+			pv, err := structpb.NewValue(v)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid enum value for arg %q: %w`, a.Name, err)
+			}
+			arg.EnumValues = append(arg.EnumValues, pv)
+		}
+
+		res = append(res, arg)
+	}
+	return res, nil
+}
+
+// validateAPIArgValue validates that a value parsed from YAML matches the type of an API argument.
+func validateAPIArgValue(t runtimev1.APIArgumentType, v any) error {
+	switch t {
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING:
+		if _, ok := v.(string); ok {
+			return nil
+		}
+		return fmt.Errorf("%v is not a string", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER:
+		switch v := v.(type) {
+		case int, int64, uint64:
+			return nil
+		case float64:
+			if v == math.Trunc(v) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%v is not an integer", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_NUMBER:
+		switch v.(type) {
+		case int, int64, uint64, float64:
+			return nil
+		}
+		return fmt.Errorf("%v is not a number", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_BOOLEAN:
+		if _, ok := v.(bool); ok {
+			return nil
+		}
+		return fmt.Errorf("%v is not a boolean", v)
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_TIMESTAMP:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a timestamp", v)
+		}
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			return fmt.Errorf("%q is not an RFC 3339 timestamp", s)
+		}
+		return nil
+	default:
+		return errors.New("unsupported type")
+	}
+}
+
 // DataYAML is the raw YAML structure of a sub-property for defining a data resolver and properties.
 // It is used across multiple resources, usually under "data:", but inlined for APIs.
 type DataYAML struct {
-	Connector  string         `yaml:"connector"`
-	SQL        string         `yaml:"sql"`
-	MetricsSQL string         `yaml:"metrics_sql"`
-	API        string         `yaml:"api"`
-	Args       map[string]any `yaml:"args"`
+	Connector  string `yaml:"connector"`
+	SQL        string `yaml:"sql"`
+	MetricsSQL string `yaml:"metrics_sql"`
+	API        string `yaml:"api"`
+	Args       any    `yaml:"args"`
+	Glob       string `yaml:"glob"`
 }
 
 // parseDataYAML parses a data resolver and its properties from a DataYAML.
@@ -88,7 +288,21 @@ func (p *Parser) parseDataYAML(raw *DataYAML) (string, *structpb.Struct, []Resou
 		resolverProps["api"] = raw.API
 		refs = append(refs, ResourceName{Kind: ResourceKindAPI, Name: raw.API})
 		if raw.Args != nil {
-			resolverProps["args"] = raw.Args
+			args, ok := raw.Args.(map[string]any)
+			if !ok {
+				return "", nil, nil, errors.New(`property "args" must be a map`)
+			}
+			resolverProps["args"] = args
+		}
+	}
+
+	// Handle glob resolver
+	if raw.Glob != "" {
+		count++
+		resolver = "glob"
+		resolverProps["path"] = raw.Glob
+		if raw.Connector != "" {
+			resolverProps["connector"] = raw.Connector
 		}
 	}
 
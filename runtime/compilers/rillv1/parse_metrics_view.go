@@ -3,6 +3,7 @@ package rillv1
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,7 +31,14 @@ type MetricsViewYAML struct {
 	FirstDayOfWeek     uint32           `yaml:"first_day_of_week"`
 	FirstMonthOfYear   uint32           `yaml:"first_month_of_year"`
 	DefaultTheme       string           `yaml:"default_theme"`
-	Dimensions         []*struct {
+	Joins              []*struct {
+		Name  string            `yaml:"name"`
+		Model string            `yaml:"model"`
+		Table string            `yaml:"table"`
+		Type  string            `yaml:"type"`
+		On    map[string]string `yaml:"on"`
+	}
+	Dimensions []*struct {
 		Name        string
 		Label       string
 		Column      string
@@ -39,6 +47,7 @@ type MetricsViewYAML struct {
 		Description string
 		Ignore      bool `yaml:"ignore"`
 		Unnest      bool
+		Join        string
 	}
 	DefaultDimensions []string `yaml:"default_dimensions"`
 	Measures          []*struct {
@@ -46,10 +55,12 @@ type MetricsViewYAML struct {
 		Label               string
 		Expression          string
 		Description         string
-		FormatPreset        string `yaml:"format_preset"`
-		FormatD3            string `yaml:"format_d3"`
-		Ignore              bool   `yaml:"ignore"`
-		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
+		FormatPreset        string   `yaml:"format_preset"`
+		FormatD3            string   `yaml:"format_d3"`
+		Ignore              bool     `yaml:"ignore"`
+		ValidPercentOfTotal bool     `yaml:"valid_percent_of_total"`
+		Type                string   `yaml:"type"`
+		Requires            []string `yaml:"requires"`
 	}
 	DefaultMeasures []string `yaml:"default_measures"`
 	Security        *struct {
@@ -161,6 +172,21 @@ var comparisonModesMap = map[string]runtimev1.MetricsViewSpec_ComparisonMode{
 }
 var validComparisonModes = []string{"none", "time", "dimension"}
 
+var measureTypesMap = map[string]runtimev1.MetricsViewSpec_MeasureType{
+	"simple":          runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+	"derived":         runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+	"running_sum":     runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM,
+	"share_of_total":  runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL,
+	"previous_period": runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD,
+}
+var validMeasureTypes = []string{"simple", "derived", "running_sum", "share_of_total", "previous_period"}
+
+var joinTypesMap = map[string]runtimev1.MetricsViewSpec_JoinType{
+	"":      runtimev1.MetricsViewSpec_JOIN_TYPE_LEFT,
+	"left":  runtimev1.MetricsViewSpec_JOIN_TYPE_LEFT,
+	"inner": runtimev1.MetricsViewSpec_JOIN_TYPE_INNER,
+}
+
 const (
 	nameIsMeasure   uint8 = 1
 	nameIsDimension uint8 = 2
@@ -211,6 +237,49 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		}
 	}
 
+	joins := make(map[string]bool)
+	var joinTables []string
+	for i, join := range tmp.Joins {
+		if join == nil {
+			continue
+		}
+
+		var joinTable string
+		if join.Table == "" {
+			joinTable = join.Model
+		} else if join.Model == "" {
+			joinTable = join.Table
+		} else {
+			return fmt.Errorf(`cannot set both the "model" field and the "table" field for join %d`, i)
+		}
+		if joinTable == "" {
+			return fmt.Errorf(`must set a value for either the "model" field or the "table" field for join %d`, i)
+		}
+		join.Table = joinTable
+		joinTables = append(joinTables, joinTable)
+
+		if join.Name == "" {
+			join.Name = joinTable
+		}
+
+		lower := strings.ToLower(join.Name)
+		if lower == strings.ToLower(table) {
+			return fmt.Errorf("join name %q conflicts with the metrics view's table", join.Name)
+		}
+		if joins[lower] {
+			return fmt.Errorf("found duplicate join name %q", join.Name)
+		}
+		joins[lower] = true
+
+		if _, ok := joinTypesMap[strings.ToLower(join.Type)]; !ok {
+			return fmt.Errorf("invalid type %q for join %q: must be one of %q", join.Type, join.Name, []string{"left", "inner"})
+		}
+
+		if len(join.On) == 0 {
+			return fmt.Errorf(`must set "on" for join %q`, join.Name)
+		}
+	}
+
 	names := make(map[string]uint8)
 
 	for i, dim := range tmp.Dimensions {
@@ -236,6 +305,10 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			return fmt.Errorf("exactly one of column or expression should be set for dimension: %q", dim.Name)
 		}
 
+		if dim.Join != "" && !joins[strings.ToLower(dim.Join)] {
+			return fmt.Errorf("join %q referenced by dimension %q not found", dim.Join, dim.Name)
+		}
+
 		lower := strings.ToLower(dim.Name)
 		if _, ok := names[lower]; ok {
 			return fmt.Errorf("found duplicate dimension or measure name %q", dim.Name)
@@ -250,6 +323,9 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	}
 
 	measureCount := 0
+	measureTypes := make(map[string]runtimev1.MetricsViewSpec_MeasureType)
+	measureRequires := make(map[string][]string)
+	measureNames := make(map[string]string)
 	for i, measure := range tmp.Measures {
 		if measure == nil || measure.Ignore {
 			continue
@@ -271,11 +347,58 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		if measure.FormatPreset != "" && measure.FormatD3 != "" {
 			return fmt.Errorf(`cannot set both "format_preset" and "format_d3" for a measure`)
 		}
+
+		// Measures without a type are simple measures unless they reference other measures
+		typ := runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED
+		if measure.Type != "" {
+			var ok bool
+			typ, ok = measureTypesMap[strings.ToLower(measure.Type)]
+			if !ok {
+				return fmt.Errorf("invalid type %q for measure %q: must be one of %q", measure.Type, measure.Name, validMeasureTypes)
+			}
+		} else if len(measure.Requires) > 0 {
+			typ = runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED
+		}
+
+		switch typ {
+		case runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED, runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE:
+			if len(measure.Requires) > 0 {
+				return fmt.Errorf(`simple measure %q cannot set "requires"`, measure.Name)
+			}
+		case runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED:
+			if measure.Expression == "" || len(measure.Requires) == 0 {
+				return fmt.Errorf(`derived measure %q must set "expression" and "requires"`, measure.Name)
+			}
+		default:
+			if measure.Expression != "" || len(measure.Requires) != 1 {
+				return fmt.Errorf(`measure %q of type %q must set exactly one measure in "requires" and no "expression"`, measure.Name, measure.Type)
+			}
+		}
+
+		measureTypes[lower] = typ
+		measureRequires[lower] = measure.Requires
+		measureNames[lower] = measure.Name
 	}
 	if measureCount == 0 {
 		return fmt.Errorf("must define at least one measure")
 	}
 
+	// Check the measures referenced in "requires" exist and don't form cycles
+	for lower, requires := range measureRequires {
+		for _, req := range requires {
+			typ, ok := measureTypes[strings.ToLower(req)]
+			if !ok {
+				return fmt.Errorf("measure %q referenced in \"requires\" of measure %q not found", req, measureNames[lower])
+			}
+			if isWindowMeasureType(typ) {
+				return fmt.Errorf("measure %q cannot require measure %q, which is computed with a window function", measureNames[lower], req)
+			}
+		}
+		if measureRequiresCycle(lower, measureRequires, nil) {
+			return fmt.Errorf("measure %q references itself through \"requires\"", measureNames[lower])
+		}
+	}
+
 	for _, measure := range tmp.DefaultMeasures {
 		if v, ok := names[strings.ToLower(measure)]; !ok || v != nameIsMeasure {
 			return fmt.Errorf(`measure %q referenced in "default_dimensions" not found`, measure)
@@ -403,6 +526,9 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	}
 
 	node.Refs = append(node.Refs, ResourceName{Name: table})
+	for _, joinTable := range joinTables {
+		node.Refs = append(node.Refs, ResourceName{Name: joinTable})
+	}
 	if tmp.DefaultTheme != "" {
 		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindTheme, Name: tmp.DefaultTheme})
 	}
@@ -427,6 +553,28 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	spec.FirstMonthOfYear = tmp.FirstMonthOfYear
 	spec.DefaultTheme = tmp.DefaultTheme
 
+	for _, join := range tmp.Joins {
+		if join == nil {
+			continue
+		}
+
+		keys := make([]*runtimev1.MetricsViewSpec_JoinV2_Key, 0, len(join.On))
+		for column, joinColumn := range join.On {
+			keys = append(keys, &runtimev1.MetricsViewSpec_JoinV2_Key{Column: column, JoinColumn: joinColumn})
+		}
+		// Sort for a deterministic spec
+		slices.SortFunc(keys, func(a, b *runtimev1.MetricsViewSpec_JoinV2_Key) int {
+			return strings.Compare(a.Column, b.Column)
+		})
+
+		spec.Joins = append(spec.Joins, &runtimev1.MetricsViewSpec_JoinV2{
+			Name:  join.Name,
+			Table: join.Table,
+			Type:  joinTypesMap[strings.ToLower(join.Type)],
+			Keys:  keys,
+		})
+	}
+
 	for _, dim := range tmp.Dimensions {
 		if dim == nil || dim.Ignore {
 			continue
@@ -439,6 +587,7 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			Label:       dim.Label,
 			Description: dim.Description,
 			Unnest:      dim.Unnest,
+			Join:        dim.Join,
 		})
 	}
 	spec.DefaultDimensions = tmp.DefaultDimensions
@@ -456,6 +605,8 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			FormatPreset:        measure.FormatPreset,
 			FormatD3:            measure.FormatD3,
 			ValidPercentOfTotal: measure.ValidPercentOfTotal,
+			Type:                measureTypes[strings.ToLower(measure.Name)],
+			Requires:            measure.Requires,
 		})
 	}
 	spec.DefaultMeasures = tmp.DefaultMeasures
@@ -591,3 +742,29 @@ func validateISO8601(isoDuration string, onlyStandard, onlyOneComponent bool) er
 
 	return nil
 }
+
+// isWindowMeasureType returns true for measure types that are computed with a window function over the query result.
+func isWindowMeasureType(typ runtimev1.MetricsViewSpec_MeasureType) bool {
+	switch typ {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM,
+		runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL,
+		runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD:
+		return true
+	}
+	return false
+}
+
+// measureRequiresCycle returns true if the measure with the given name transitively requires itself.
+// The keys of requires must be lower case measure names.
+func measureRequiresCycle(name string, requires map[string][]string, visiting []string) bool {
+	if slices.Contains(visiting, name) {
+		return true
+	}
+	visiting = append(visiting, name)
+	for _, req := range requires[name] {
+		if measureRequiresCycle(strings.ToLower(req), requires, visiting) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,19 +2,27 @@ package rillv1
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // ModelYAML is the raw structure of a Model resource defined in YAML (does not include common fields)
 type ModelYAML struct {
-	Materialize  *bool         `yaml:"materialize" mapstructure:"materialize"`
-	Timeout      string        `yaml:"timeout" mapstructure:"timeout"`
-	Refresh      *ScheduleYAML `yaml:"refresh" mapstructure:"refresh"`
-	ParserConfig struct {
+	Materialize         *bool         `yaml:"materialize" mapstructure:"materialize"`
+	Timeout             string        `yaml:"timeout" mapstructure:"timeout"`
+	Refresh             *ScheduleYAML `yaml:"refresh" mapstructure:"refresh"`
+	Incremental         bool          `yaml:"incremental" mapstructure:"incremental"`
+	State               *DataYAML     `yaml:"state" mapstructure:"state"`
+	IncrementalStrategy string        `yaml:"incremental_strategy" mapstructure:"incremental_strategy"`
+	UniqueKey           []string      `yaml:"unique_key" mapstructure:"unique_key"`
+	Partitions          *DataYAML     `yaml:"partitions" mapstructure:"partitions"`
+	OnSchemaChange      string        `yaml:"on_schema_change" mapstructure:"on_schema_change"`
+	ParserConfig        struct {
 		DuckDB struct {
 			InferRefs *bool `yaml:"infer_refs" mapstructure:"infer_refs"`
 		} `yaml:"duckdb" mapstructure:"duckdb"`
@@ -50,6 +58,69 @@ func (p *Parser) parseModel(node *Node) error {
 		return errors.New("no SQL provided")
 	}
 
+	// Partitioned models are always incremental since each partition is inserted into the existing table
+	if tmp.Partitions != nil {
+		tmp.Incremental = true
+	}
+
+	// Parse incremental config
+	if !tmp.Incremental {
+		if tmp.State != nil {
+			return errors.New(`the "state" property is only supported for incremental models`)
+		}
+		if tmp.IncrementalStrategy != "" || len(tmp.UniqueKey) > 0 {
+			return errors.New(`the "incremental_strategy" and "unique_key" properties are only supported for incremental models`)
+		}
+	}
+	if tmp.Incremental && tmp.Materialize != nil && !*tmp.Materialize {
+		return errors.New("incremental models must be materialized")
+	}
+	incrementalStrategy, err := parseIncrementalStrategy(tmp.IncrementalStrategy, tmp.UniqueKey)
+	if err != nil {
+		return err
+	}
+
+	// Parse schema change policy
+	onSchemaChange, err := parseSchemaChangePolicy(tmp.OnSchemaChange)
+	if err != nil {
+		return err
+	}
+	if onSchemaChange == runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS && tmp.Materialize != nil && !*tmp.Materialize {
+		return errors.New(`the "append_new_columns" schema change policy is only supported for materialized models`)
+	}
+	var stateResolver string
+	var stateResolverProps *structpb.Struct
+	if tmp.State != nil {
+		// The state query runs against the model's connector unless otherwise specified
+		if tmp.State.SQL != "" && tmp.State.Connector == "" {
+			tmp.State.Connector = node.Connector
+		}
+
+		var refs []ResourceName
+		stateResolver, stateResolverProps, refs, err = p.parseDataYAML(tmp.State)
+		if err != nil {
+			return fmt.Errorf(`failed to parse "state": %w`, err)
+		}
+		node.Refs = append(node.Refs, refs...)
+	}
+
+	// Parse partitions
+	var partitionsResolver string
+	var partitionsResolverProps *structpb.Struct
+	if tmp.Partitions != nil {
+		// The partitions query runs against the model's connector unless otherwise specified
+		if tmp.Partitions.SQL != "" && tmp.Partitions.Connector == "" {
+			tmp.Partitions.Connector = node.Connector
+		}
+
+		var refs []ResourceName
+		partitionsResolver, partitionsResolverProps, refs, err = p.parseDataYAML(tmp.Partitions)
+		if err != nil {
+			return fmt.Errorf(`failed to parse "partitions": %w`, err)
+		}
+		node.Refs = append(node.Refs, refs...)
+	}
+
 	// If the connector is a DuckDB connector, extract info using DuckDB SQL parsing.
 	// (If templating was used, we skip DuckDB inference because the DuckDB parser may not be able to parse the templated code.)
 	driver, _, _ := p.driverForConnector(node.Connector)
@@ -109,6 +180,18 @@ func (p *Parser) parseModel(node *Node) error {
 	if schedule != nil {
 		r.ModelSpec.RefreshSchedule = schedule
 	}
+	r.ModelSpec.OnSchemaChange = onSchemaChange
+	if tmp.Incremental {
+		b := true
+		r.ModelSpec.Materialize = &b
+		r.ModelSpec.Incremental = true
+		r.ModelSpec.IncrementalStateResolver = stateResolver
+		r.ModelSpec.IncrementalStateResolverProperties = stateResolverProps
+		r.ModelSpec.IncrementalStrategy = incrementalStrategy
+		r.ModelSpec.UniqueKey = tmp.UniqueKey
+		r.ModelSpec.PartitionsResolver = partitionsResolver
+		r.ModelSpec.PartitionsResolverProperties = partitionsResolverProps
+	}
 
 	// parseSource calls parseModel for SQL sources without a connector. Materialize such models.
 	if node.Kind == ResourceKindSource && r.ModelSpec.Materialize == nil {
@@ -119,6 +202,46 @@ func (p *Parser) parseModel(node *Node) error {
 	return nil
 }
 
+// parseIncrementalStrategy parses the incremental strategy of a model.
+// If no strategy is specified, it defaults to merge if a unique key is provided and append otherwise.
+func parseIncrementalStrategy(s string, uniqueKey []string) (runtimev1.ModelIncrementalStrategy, error) {
+	switch strings.ToLower(s) {
+	case "":
+		if len(uniqueKey) > 0 {
+			return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_MERGE, nil
+		}
+		return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_APPEND, nil
+	case "append":
+		if len(uniqueKey) > 0 {
+			return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED, errors.New(`the "unique_key" property is not supported for the "append" incremental strategy`)
+		}
+		return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_APPEND, nil
+	case "merge":
+		if len(uniqueKey) == 0 {
+			return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED, errors.New(`the "merge" incremental strategy requires a "unique_key"`)
+		}
+		return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_MERGE, nil
+	default:
+		return runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED, fmt.Errorf("invalid incremental strategy %q", s)
+	}
+}
+
+// parseSchemaChangePolicy parses the policy for handling schema changes between refreshes of a source or model.
+func parseSchemaChangePolicy(s string) (runtimev1.SchemaChangePolicy, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_UNSPECIFIED, nil
+	case "fail":
+		return runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_FAIL, nil
+	case "append_new_columns":
+		return runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS, nil
+	case "sync_all_columns":
+		return runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_SYNC_ALL_COLUMNS, nil
+	default:
+		return runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_UNSPECIFIED, fmt.Errorf("invalid schema change policy %q", s)
+	}
+}
+
 // findLineNumber returns the line number of the pos in the given text.
 // Lines are counted starting from 1, and positions start from 0.
 func findLineNumber(text string, pos int) int {
@@ -52,6 +52,8 @@ func (p *Parser) parseNode(node *Node) error {
 		return p.parseDashboard(node)
 	case ResourceKindAPI:
 		return p.parseAPI(node)
+	case ResourceKindTest:
+		return p.parseTest(node)
 	default:
 		panic(fmt.Errorf("unexpected resource kind: %s", node.Kind.String()))
 	}
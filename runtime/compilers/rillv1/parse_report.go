@@ -29,6 +29,7 @@ type ReportYAML struct {
 	Email struct {
 		Recipients []string `yaml:"recipients"`
 	} `yaml:"email"`
+	Notify      []map[string]any  `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
 
@@ -96,8 +97,14 @@ func (p *Parser) parseReport(node *Node) error {
 		return fmt.Errorf(`missing required property "export.format"`)
 	}
 
+	// Parse notifiers
+	notifiers, err := p.parseNotifiers(tmp.Notify)
+	if err != nil {
+		return err
+	}
+
 	// Validate recipients
-	if len(tmp.Email.Recipients) == 0 {
+	if len(tmp.Email.Recipients) == 0 && len(notifiers) == 0 {
 		return fmt.Errorf(`missing required property "recipients"`)
 	}
 	for _, email := range tmp.Email.Recipients {
@@ -126,6 +133,7 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
 	r.ReportSpec.EmailRecipients = tmp.Email.Recipients
+	r.ReportSpec.Notifiers = notifiers
 	r.ReportSpec.Annotations = tmp.Annotations
 
 	return nil
@@ -141,6 +149,12 @@ func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 		return runtimev1.ExportFormat_EXPORT_FORMAT_XLSX, nil
 	case "parquet":
 		return runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
+	case "arrow":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_ARROW, nil
+	case "jsonl", "ndjson":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "avro":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_AVRO, nil
 	default:
 		if val, ok := runtimev1.ExportFormat_value[s]; ok {
 			return runtimev1.ExportFormat(val), nil
@@ -1,12 +1,14 @@
 package rillv1
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/robfig/cron/v3"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -17,11 +19,12 @@ import (
 
 // SourceYAML is the raw structure of a Source resource defined in YAML (does not include common fields)
 type SourceYAML struct {
-	commonYAML `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
-	Type       string                                  `yaml:"type"` // Backwards compatibility
-	Timeout    string                                  `yaml:"timeout"`
-	Refresh    *ScheduleYAML                           `yaml:"refresh"`
-	Properties map[string]any                          `yaml:",inline" mapstructure:",remain"`
+	commonYAML     `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
+	Type           string                                  `yaml:"type"` // Backwards compatibility
+	Timeout        string                                  `yaml:"timeout"`
+	Refresh        *ScheduleYAML                           `yaml:"refresh"`
+	OnSchemaChange string                                  `yaml:"on_schema_change" mapstructure:"on_schema_change"`
+	Properties     map[string]any                          `yaml:",inline" mapstructure:",remain"`
 }
 
 // parseSource parses a source definition and adds the resulting resource to p.Resources.
@@ -77,6 +80,34 @@ func (p *Parser) parseSource(node *Node) error {
 		return fmt.Errorf("must explicitly specify a connector for sources")
 	}
 
+	// Change data capture is only supported for databases with a logical replication log
+	cdc := false
+	if v, ok := tmp.Properties["cdc"]; ok {
+		cdc, ok = v.(bool)
+		if !ok {
+			return fmt.Errorf(`property "cdc" must be a boolean`)
+		}
+	}
+	var spec drivers.Spec
+	if _, connector, err := p.driverForConnector(node.Connector); err == nil {
+		spec = connector.Spec()
+	}
+	if cdc && !spec.ImplementsChangeCapture {
+		return fmt.Errorf(`property "cdc" is not supported for sources with connector %q`, node.Connector)
+	}
+
+	// Sources from streaming connectors and sources with change data capture are ingested continuously instead of on a schedule
+	streamIngestion := spec.ImplementsStreaming || cdc
+
+	// Parse schema change policy
+	onSchemaChange, err := parseSchemaChangePolicy(tmp.OnSchemaChange)
+	if err != nil {
+		return err
+	}
+	if streamIngestion && tmp.OnSchemaChange != "" {
+		return errors.New(`the "on_schema_change" property is not supported for sources with stream ingestion`)
+	}
+
 	props, err := structpb.NewStruct(tmp.Properties)
 	if err != nil {
 		return fmt.Errorf("encountered invalid property type: %w", err)
@@ -100,6 +131,8 @@ func (p *Parser) parseSource(node *Node) error {
 	if schedule != nil {
 		r.SourceSpec.RefreshSchedule = schedule
 	}
+	r.SourceSpec.StreamIngestion = streamIngestion
+	r.SourceSpec.OnSchemaChange = onSchemaChange
 
 	return nil
 }
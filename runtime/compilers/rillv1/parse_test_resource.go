@@ -0,0 +1,232 @@
+package rillv1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// TestYAML is the raw structure of a data quality Test resource defined in YAML (does not include common fields)
+type TestYAML struct {
+	commonYAML      `yaml:",inline"`     // Not accessed here, only setting it so we can use KnownFields for YAML parsing
+	Model           string               `yaml:"model"`
+	Table           string               `yaml:"table"`
+	Assertions      []*TestAssertionYAML `yaml:"assertions"`
+	BlockDownstream bool                 `yaml:"block_downstream"`
+}
+
+// TestAssertionYAML is the raw structure of an assertion in a Test resource.
+// Exactly one of the assertion types must be set.
+type TestAssertionYAML struct {
+	Name           string `yaml:"name"`
+	NotNull        string `yaml:"not_null"`
+	Unique         string `yaml:"unique"`
+	AcceptedValues *struct {
+		Column string `yaml:"column"`
+		Values []any  `yaml:"values"`
+	} `yaml:"accepted_values"`
+	RowCount *struct {
+		Min *int64 `yaml:"min"`
+		Max *int64 `yaml:"max"`
+	} `yaml:"row_count"`
+	SQL string `yaml:"sql"`
+}
+
+// parseTest parses a data quality test definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseTest(node *Node) error {
+	// Parse YAML
+	tmp := &TestYAML{}
+	err := p.decodeNodeYAML(node, true, tmp)
+	if err != nil {
+		return err
+	}
+
+	// Parse the tested table
+	var table string
+	if tmp.Table == "" {
+		table = tmp.Model
+	} else if tmp.Model == "" {
+		table = tmp.Table
+	} else {
+		return fmt.Errorf(`cannot set both the "model" field and the "table" field`)
+	}
+	if table == "" {
+		return fmt.Errorf(`must set a value for either the "model" field or the "table" field`)
+	}
+
+	// Compile the assertions to SQL queries that return the failing rows.
+	// A SQL query provided at the top level (or in a companion SQL file) is treated as a custom assertion.
+	// The generated SQL is escaped using the dialect of the connector the test runs against.
+	driver, _, _ := p.driverForConnector(node.Connector)
+	dialect := dialectForDriver(driver)
+	var assertions []*runtimev1.TestAssertion
+	if node.SQL != "" {
+		assertions = append(assertions, &runtimev1.TestAssertion{
+			Name: "sql",
+			Sql:  strings.TrimSpace(node.SQL),
+		})
+	}
+	for i, a := range tmp.Assertions {
+		res, err := compileTestAssertion(dialect, table, a)
+		if err != nil {
+			return fmt.Errorf("invalid assertion at index %d: %w", i, err)
+		}
+		assertions = append(assertions, res)
+	}
+	if len(assertions) == 0 {
+		return errors.New("the test does not declare any assertions")
+	}
+
+	// Track the tested table
+	node.Refs = append(node.Refs, ResourceName{Name: table})
+
+	r, err := p.insertResource(ResourceKindTest, node.Name, node.Paths, node.Refs...)
+	if err != nil {
+		return err
+	}
+	// NOTE: After calling insertResource, an error must not be returned. Any validation should be done before calling it.
+
+	r.TestSpec.Connector = node.Connector
+	r.TestSpec.Table = table
+	r.TestSpec.Assertions = assertions
+	r.TestSpec.BlockDownstream = tmp.BlockDownstream
+
+	return nil
+}
+
+// compileTestAssertion compiles an assertion to a SQL query that returns the rows that violate it.
+func compileTestAssertion(dialect drivers.Dialect, table string, a *TestAssertionYAML) (*runtimev1.TestAssertion, error) {
+	if a == nil {
+		return nil, errors.New("assertion is empty")
+	}
+
+	var count int
+	var name, sql string
+	from := dialect.EscapeIdentifier(table)
+
+	if a.NotNull != "" {
+		count++
+		name = fmt.Sprintf("not_null(%s)", a.NotNull)
+		sql = fmt.Sprintf("SELECT * FROM %s WHERE %s IS NULL", from, dialect.EscapeIdentifier(a.NotNull))
+	}
+
+	if a.Unique != "" {
+		count++
+		col := dialect.EscapeIdentifier(a.Unique)
+		name = fmt.Sprintf("unique(%s)", a.Unique)
+		sql = fmt.Sprintf("SELECT %s, COUNT(*) AS count FROM %s GROUP BY %s HAVING COUNT(*) > 1", col, from, col)
+	}
+
+	if a.AcceptedValues != nil {
+		count++
+		if a.AcceptedValues.Column == "" {
+			return nil, errors.New(`the "accepted_values" assertion requires a "column"`)
+		}
+		if len(a.AcceptedValues.Values) == 0 {
+			return nil, errors.New(`the "accepted_values" assertion requires at least one value in "values"`)
+		}
+		// NULL is only accepted if it's explicitly listed in the values.
+		// (The check is explicit because "col NOT IN (...)" is never true for NULL values.)
+		var vals []string
+		var acceptNull bool
+		for _, v := range a.AcceptedValues.Values {
+			if v == nil {
+				acceptNull = true
+				continue
+			}
+			lit, err := sqlLiteral(v)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, lit)
+		}
+		col := dialect.EscapeIdentifier(a.AcceptedValues.Column)
+		var where string
+		switch {
+		case len(vals) == 0:
+			where = fmt.Sprintf("%s IS NOT NULL", col)
+		case acceptNull:
+			where = fmt.Sprintf("%s IS NOT NULL AND %s NOT IN (%s)", col, col, strings.Join(vals, ", "))
+		default:
+			where = fmt.Sprintf("%s IS NULL OR %s NOT IN (%s)", col, col, strings.Join(vals, ", "))
+		}
+		name = fmt.Sprintf("accepted_values(%s)", a.AcceptedValues.Column)
+		sql = fmt.Sprintf("SELECT * FROM %s WHERE %s", from, where)
+	}
+
+	if a.RowCount != nil {
+		count++
+		var conds []string
+		if a.RowCount.Min != nil {
+			conds = append(conds, fmt.Sprintf("COUNT(*) < %d", *a.RowCount.Min))
+		}
+		if a.RowCount.Max != nil {
+			conds = append(conds, fmt.Sprintf("COUNT(*) > %d", *a.RowCount.Max))
+		}
+		if len(conds) == 0 {
+			return nil, errors.New(`the "row_count" assertion requires a "min" or "max"`)
+		}
+		name = "row_count"
+		sql = fmt.Sprintf("SELECT COUNT(*) AS count FROM %s HAVING %s", from, strings.Join(conds, " OR "))
+	}
+
+	if a.SQL != "" {
+		count++
+		name = "sql"
+		sql = strings.TrimSpace(a.SQL)
+	}
+
+	if count == 0 {
+		return nil, errors.New(`the assertion does not specify a type (for example, "not_null:", "unique:", "sql:", ...)`)
+	}
+	if count > 1 {
+		return nil, errors.New("the assertion specifies more than one type")
+	}
+
+	if a.Name != "" {
+		name = a.Name
+	}
+
+	return &runtimev1.TestAssertion{
+		Name: name,
+		Sql:  sql,
+	}, nil
+}
+
+// dialectForDriver returns the SQL dialect of an OLAP driver.
+// It defaults to DuckDB for drivers that are not OLAP databases.
+func dialectForDriver(driver string) drivers.Dialect {
+	switch driver {
+	case "druid":
+		return drivers.DialectDruid
+	case "clickhouse":
+		return drivers.DialectClickHouse
+	default:
+		return drivers.DialectDuckDB
+	}
+}
+
+// sqlLiteral formats a YAML scalar value as a SQL literal.
+func sqlLiteral(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(v, "'", "''")), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
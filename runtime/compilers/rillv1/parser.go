@@ -40,6 +40,7 @@ type Resource struct {
 	ChartSpec       *runtimev1.ChartSpec
 	DashboardSpec   *runtimev1.DashboardSpec
 	APISpec         *runtimev1.APISpec
+	TestSpec        *runtimev1.TestSpec
 }
 
 // ResourceName is a unique identifier for a resource
@@ -74,6 +75,7 @@ const (
 	ResourceKindChart
 	ResourceKindDashboard
 	ResourceKindAPI
+	ResourceKindTest
 )
 
 // ParseResourceKind maps a string to a ResourceKind.
@@ -102,6 +104,8 @@ func ParseResourceKind(kind string) (ResourceKind, error) {
 		return ResourceKindDashboard, nil
 	case "api":
 		return ResourceKindAPI, nil
+	case "test":
+		return ResourceKindTest, nil
 	default:
 		return ResourceKindUnspecified, fmt.Errorf("invalid resource kind %q", kind)
 	}
@@ -131,6 +135,8 @@ func (k ResourceKind) String() string {
 		return "Dashboard"
 	case ResourceKindAPI:
 		return "API"
+	case ResourceKindTest:
+		return "Test"
 	default:
 		panic(fmt.Sprintf("unexpected resource kind: %d", k))
 	}
@@ -645,8 +651,8 @@ func (p *Parser) inferUnspecifiedRefs(r *Resource) {
 			}
 		}
 
-		// Rule 2: If it's a metrics view and there's a model or source with that name, use it
-		if r.Name.Kind == ResourceKindMetricsView {
+		// Rule 2: If it's a metrics view or test and there's a model or source with that name, use it
+		if r.Name.Kind == ResourceKindMetricsView || r.Name.Kind == ResourceKindTest {
 			n := ResourceName{Kind: ResourceKindModel, Name: ref.Name}
 			if _, ok := p.Resources[n.Normalized()]; ok {
 				refs = append(refs, n)
@@ -739,6 +745,8 @@ func (p *Parser) insertResource(kind ResourceKind, name string, paths []string,
 		r.DashboardSpec = &runtimev1.DashboardSpec{}
 	case ResourceKindAPI:
 		r.APISpec = &runtimev1.APISpec{}
+	case ResourceKindTest:
+		r.TestSpec = &runtimev1.TestSpec{}
 	default:
 		panic(fmt.Errorf("unexpected resource kind: %s", kind.String()))
 	}
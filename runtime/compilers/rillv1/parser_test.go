@@ -16,6 +16,10 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	_ "github.com/rilldata/rill/runtime/drivers/file"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
+	_ "github.com/rilldata/rill/runtime/drivers/postgres"
+	_ "github.com/rilldata/rill/runtime/drivers/slack"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 )
 
 func TestRillYAML(t *testing.T) {
@@ -1153,6 +1157,294 @@ annotations:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAlertCondition(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  dimension: domain
+  time_range: P1D
+  operator: '<'
+  threshold: 10
+`,
+		`alerts/a2.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  dimension: domain
+  time_range: P1D
+  compare_to: P1W
+  operator: lte
+  threshold: -20
+`,
+		`alerts/a3.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  time_range: P1D
+  compare_to: P1W
+  operator: '<'
+  threshold: -20
+`,
+		`alerts/a4.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  operator: '~'
+  threshold: 1
+`,
+		`alerts/a5.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  operator: '>'
+  threshold: 1
+query:
+  name: MetricsViewAggregation
+`,
+		`alerts/a6.yaml`: `
+kind: alert
+condition:
+  metrics_view: mv1
+  measure: total_bids
+  operator: '>'
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a2"},
+			Paths: []string{"/alerts/a2.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+		},
+	}, []*runtimev1.ParseError{
+		{Message: `property "condition.dimension" is required when "condition.compare_to" is set`, FilePath: "/alerts/a3.yaml"},
+		{Message: `invalid value "~" for property "condition.operator"`, FilePath: "/alerts/a4.yaml"},
+		{Message: `cannot set "condition" together with "query.name"`, FilePath: "/alerts/a5.yaml"},
+		{Message: `missing required property "condition.threshold"`, FilePath: "/alerts/a6.yaml"},
+	})
+
+	a1 := p.Resources[ResourceName{Kind: ResourceKindAlert, Name: "a1"}]
+	require.Equal(t, "MetricsViewAggregation", a1.AlertSpec.QueryName)
+	require.JSONEq(t, `{
+		"metrics_view": "mv1",
+		"dimensions": [{"name": "domain"}],
+		"measures": [{"name": "total_bids"}],
+		"time_range": {"iso_duration": "P1D"},
+		"having": {"cond": {"op": "OPERATION_LT", "exprs": [{"ident": "total_bids"}, {"val": 10}]}},
+		"sort": [{"name": "total_bids", "desc": false}]
+	}`, a1.AlertSpec.QueryArgsJson)
+
+	a2 := p.Resources[ResourceName{Kind: ResourceKindAlert, Name: "a2"}]
+	require.Equal(t, "MetricsViewComparison", a2.AlertSpec.QueryName)
+	require.JSONEq(t, `{
+		"metrics_view_name": "mv1",
+		"dimension": {"name": "domain"},
+		"measures": [{"name": "total_bids"}],
+		"time_range": {"iso_duration": "P1D"},
+		"comparison_time_range": {"iso_duration": "P1D", "iso_offset": "P1W"},
+		"aliases": [{"name": "total_bids", "type": "METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA", "alias": "total_bids__delta_rel"}],
+		"having": {"cond": {"op": "OPERATION_LTE", "exprs": [{"ident": "total_bids__delta_rel"}, {"val": -0.2}]}},
+		"sort": [{"name": "total_bids", "sort_type": "METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA", "desc": false}],
+		"exact": true
+	}`, a2.AlertSpec.QueryArgsJson)
+}
+
+func TestAlertAnomaly(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+kind: alert
+anomaly:
+  metrics_view: mv1
+  measures: [total_bids]
+  dimension: domain
+  time_grain: hour
+  method: seasonal
+`,
+		`alerts/a2.yaml`: `
+kind: alert
+intervals:
+  duration: P1D
+anomaly:
+  metrics_view: mv1
+  measures: [total_bids, avg_bid]
+  time_grain: day
+  baseline: P8W
+  threshold: 2.5
+`,
+		`alerts/a3.yaml`: `
+kind: alert
+anomaly:
+  metrics_view: mv1
+  measures: [total_bids]
+  time_grain: month
+  method: seasonal
+`,
+		`alerts/a4.yaml`: `
+kind: alert
+anomaly:
+  metrics_view: mv1
+  measures: [total_bids]
+  time_grain: day
+query:
+  name: MetricsViewAggregation
+`,
+		`alerts/a5.yaml`: `
+kind: alert
+anomaly:
+  metrics_view: mv1
+  time_grain: day
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a2"},
+			Paths: []string{"/alerts/a2.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+		},
+	}, []*runtimev1.ParseError{
+		{Message: `the "seasonal" method requires an "anomaly.time_grain" of a day or less`, FilePath: "/alerts/a3.yaml"},
+		{Message: `cannot set "anomaly" together with "condition" or "query"`, FilePath: "/alerts/a4.yaml"},
+		{Message: `missing required property "anomaly.measures"`, FilePath: "/alerts/a5.yaml"},
+	})
+
+	a1 := p.Resources[ResourceName{Kind: ResourceKindAlert, Name: "a1"}]
+	require.Empty(t, a1.AlertSpec.QueryName)
+	require.Equal(t, "PT1H", a1.AlertSpec.IntervalsIsoDuration)
+	require.Equal(t, &runtimev1.AlertAnomalySpec{
+		MetricsView:         "mv1",
+		Measures:            []string{"total_bids"},
+		Dimension:           "domain",
+		TimeGrain:           runtimev1.TimeGrain_TIME_GRAIN_HOUR,
+		BaselineIsoDuration: "P4W",
+		BaselineMethod:      runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_SEASONAL,
+		ThresholdSigma:      3,
+	}, a1.AlertSpec.Anomaly)
+
+	a2 := p.Resources[ResourceName{Kind: ResourceKindAlert, Name: "a2"}]
+	require.Equal(t, "P1D", a2.AlertSpec.IntervalsIsoDuration)
+	require.Equal(t, []string{"total_bids", "avg_bid"}, a2.AlertSpec.Anomaly.Measures)
+	require.Equal(t, "P8W", a2.AlertSpec.Anomaly.BaselineIsoDuration)
+	require.Equal(t, runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_ROLLING, a2.AlertSpec.Anomaly.BaselineMethod)
+	require.Equal(t, 2.5, a2.AlertSpec.Anomaly.ThresholdSigma)
+}
+
+func TestNotifiers(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: `
+connectors:
+- name: oncall
+  type: slack
+`,
+		`reports/r1.yaml`: `
+kind: report
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+notify:
+- connector: oncall
+- connector: webhook
+  url: https://example.com/hook
+  headers:
+    X-Team: data
+`,
+		`alerts/a1.yaml`: `
+kind: alert
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+notify:
+- connector: slack
+  webhook_url: https://hooks.slack.com/services/xxx
+`,
+		`alerts/a2.yaml`: `
+kind: alert
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+notify:
+- url: https://example.com/hook
+`,
+		`alerts/a3.yaml`: `
+kind: alert
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+notify:
+- connector: kafka
+`,
+		`reports/r2.yaml`: `
+kind: report
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{Name: ResourceName{Kind: ResourceKindReport, Name: "r1"}, Paths: []string{"/reports/r1.yaml"}},
+		{Name: ResourceName{Kind: ResourceKindAlert, Name: "a1"}, Paths: []string{"/alerts/a1.yaml"}},
+	}, []*runtimev1.ParseError{
+		{Message: `missing required property "connector" for "notify[0]"`, FilePath: "/alerts/a2.yaml"},
+		{Message: `connector "kafka" for "notify[0]" does not support notifications`, FilePath: "/alerts/a3.yaml"},
+		{Message: `missing required property "recipients"`, FilePath: "/reports/r2.yaml"},
+	})
+
+	r1 := p.Resources[ResourceName{Kind: ResourceKindReport, Name: "r1"}]
+	require.Len(t, r1.ReportSpec.Notifiers, 2)
+	require.Equal(t, "oncall", r1.ReportSpec.Notifiers[0].Connector)
+	require.Empty(t, r1.ReportSpec.Notifiers[0].Properties.AsMap())
+	require.Equal(t, "webhook", r1.ReportSpec.Notifiers[1].Connector)
+	require.Equal(t, map[string]any{
+		"url":     "https://example.com/hook",
+		"headers": map[string]any{"X-Team": "data"},
+	}, r1.ReportSpec.Notifiers[1].Properties.AsMap())
+
+	a1 := p.Resources[ResourceName{Kind: ResourceKindAlert, Name: "a1"}]
+	require.Len(t, a1.AlertSpec.Notifiers, 1)
+	require.Equal(t, "slack", a1.AlertSpec.Notifiers[0].Connector)
+	require.Equal(t, map[string]any{"webhook_url": "https://hooks.slack.com/services/xxx"}, a1.AlertSpec.Notifiers[0].Properties.AsMap())
+}
+
 func TestMetricsViewAvoidSelfCyclicRef(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1192,6 +1484,204 @@ measures:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewJoins(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`sources/orders.yaml`: `
+connector: local_file
+path: data/orders.csv
+`,
+		`sources/customers.yaml`: `
+connector: local_file
+path: data/customers.csv
+`,
+		`sources/regions.yaml`: `
+connector: local_file
+path: data/regions.csv
+`,
+		// dashboard d1
+		`dashboards/d1.yaml`: `
+model: orders
+joins:
+  - model: customers
+    on:
+      customer_id: id
+  - name: r
+    model: regions
+    type: inner
+    on:
+      region_id: id
+      country_id: country_id
+dimensions:
+  - name: customer
+    column: name
+    join: customers
+  - name: region
+    expression: upper(r.name)
+    join: r
+measures:
+  - name: amount
+    expression: sum(amount)
+`,
+		// dashboard d2 (unknown join)
+		`dashboards/d2.yaml`: `
+model: orders
+dimensions:
+  - name: customer
+    column: name
+    join: customers
+measures:
+  - name: amount
+    expression: sum(amount)
+`,
+		// dashboard d3 (invalid join type)
+		`dashboards/d3.yaml`: `
+model: orders
+joins:
+  - model: customers
+    type: outer
+    on:
+      customer_id: id
+measures:
+  - name: amount
+    expression: sum(amount)
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+
+	r := p.Resources[ResourceName{Kind: ResourceKindMetricsView, Name: "d1"}.Normalized()]
+	require.NotNil(t, r)
+	require.ElementsMatch(t, []ResourceName{
+		{Kind: ResourceKindSource, Name: "orders"},
+		{Kind: ResourceKindSource, Name: "customers"},
+		{Kind: ResourceKindSource, Name: "regions"},
+	}, r.Refs)
+	require.Equal(t, []*runtimev1.MetricsViewSpec_JoinV2{
+		{
+			Name:  "customers",
+			Table: "customers",
+			Type:  runtimev1.MetricsViewSpec_JOIN_TYPE_LEFT,
+			Keys:  []*runtimev1.MetricsViewSpec_JoinV2_Key{{Column: "customer_id", JoinColumn: "id"}},
+		},
+		{
+			Name:  "r",
+			Table: "regions",
+			Type:  runtimev1.MetricsViewSpec_JOIN_TYPE_INNER,
+			Keys: []*runtimev1.MetricsViewSpec_JoinV2_Key{
+				{Column: "country_id", JoinColumn: "country_id"},
+				{Column: "region_id", JoinColumn: "id"},
+			},
+		},
+	}, r.MetricsViewSpec.Joins)
+	require.Equal(t, []*runtimev1.MetricsViewSpec_DimensionV2{
+		{Name: "customer", Column: "name", Join: "customers"},
+		{Name: "region", Expression: "upper(r.name)", Join: "r"},
+	}, r.MetricsViewSpec.Dimensions)
+
+	require.Len(t, p.Errors, 2)
+	require.Equal(t, "/dashboards/d2.yaml", p.Errors[0].FilePath)
+	require.Contains(t, p.Errors[0].Message, `join "customers" referenced by dimension "customer" not found`)
+	require.Equal(t, "/dashboards/d3.yaml", p.Errors[1].FilePath)
+	require.Contains(t, p.Errors[1].Message, `invalid type "outer" for join "customers"`)
+}
+
+func TestMetricsViewDerivedMeasures(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// dashboard d1
+		`dashboards/d1.yaml`: `
+table: orders
+measures:
+  - name: revenue
+    expression: sum(amount)
+  - name: orders
+    expression: count(*)
+  - name: aov
+    expression: revenue / orders
+    requires: [revenue, orders]
+  - name: revenue_running
+    type: running_sum
+    requires: [revenue]
+  - name: revenue_share
+    type: share_of_total
+    requires: [revenue]
+`,
+		// dashboard d2 (cycle)
+		`dashboards/d2.yaml`: `
+table: orders
+measures:
+  - name: a
+    expression: b + 1
+    requires: [b]
+  - name: b
+    expression: a + 1
+    requires: [a]
+`,
+		// dashboard d3 (window measure required by another measure)
+		`dashboards/d3.yaml`: `
+table: orders
+measures:
+  - name: revenue
+    expression: sum(amount)
+  - name: revenue_previous
+    type: previous_period
+    requires: [revenue]
+  - name: revenue_change
+    expression: revenue - revenue_previous
+    requires: [revenue, revenue_previous]
+`,
+		// dashboard d4 (missing required measure)
+		`dashboards/d4.yaml`: `
+table: orders
+measures:
+  - name: revenue_running
+    type: running_sum
+    requires: [revenue]
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector: "duckdb",
+				Table:     "orders",
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(amount)"},
+					{Name: "orders", Expression: "count(*)"},
+					{Name: "aov", Expression: "revenue / orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, Requires: []string{"revenue", "orders"}},
+					{Name: "revenue_running", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM, Requires: []string{"revenue"}},
+					{Name: "revenue_share", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL, Requires: []string{"revenue"}},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `references itself through "requires"`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `measure "revenue_change" cannot require measure "revenue_previous", which is computed with a window function`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+		{
+			Message:  `measure "revenue" referenced in "requires" of measure "revenue_running" not found`,
+			FilePath: "/dashboards/d4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestTheme(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1372,6 +1862,610 @@ metrics_sql: select * from m1
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAPIArgs(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`apis/a1.yaml`: `
+kind: api
+description: Bids by domain
+metrics_sql: select domain, total_bids from mv1 where domain = '{{ .args.domain }}' limit {{ .args.limit }}
+args:
+  - name: domain
+    description: The domain to filter by
+    required: true
+  - name: limit
+    type: integer
+    default: 10
+  - name: grain
+    enum: [day, week]
+`,
+		`apis/a2.yaml`: `
+kind: api
+api: a1
+args:
+  domain: google.com
+`,
+		`apis/a3.yaml`: `
+kind: api
+sql: select 1
+args:
+  - name: limit
+    type: integer
+    default: ten
+`,
+		`apis/a4.yaml`: `
+kind: api
+sql: select 1
+args:
+  - name: since
+    type: date
+`,
+		`apis/a5.yaml`: `
+kind: api
+sql: select 1
+args:
+  - name: _page_token
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a1"},
+			Paths: []string{"/apis/a1.yaml"},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a2"},
+			Paths: []string{"/apis/a2.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindAPI, Name: "a1"}},
+		},
+	}, []*runtimev1.ParseError{
+		{Message: `invalid default for arg "limit": ten is not an integer`, FilePath: "/apis/a3.yaml"},
+		{Message: `invalid value "date" for property "type" of arg "since"`, FilePath: "/apis/a4.yaml"},
+		{Message: `arg name "_page_token" is reserved for pagination`, FilePath: "/apis/a5.yaml"},
+	})
+
+	a1 := p.Resources[ResourceName{Kind: ResourceKindAPI, Name: "a1"}]
+	require.Equal(t, "metrics_sql", a1.APISpec.Resolver)
+	require.Equal(t, "Bids by domain", a1.APISpec.Description)
+	require.Equal(t, []*runtimev1.APIArgument{
+		{Name: "domain", Description: "The domain to filter by", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING, Required: true},
+		{Name: "limit", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER, DefaultValue: structpb.NewNumberValue(10)},
+		{Name: "grain", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING, EnumValues: []*structpb.Value{structpb.NewStringValue("day"), structpb.NewStringValue("week")}},
+	}, a1.APISpec.Args)
+	require.NotContains(t, a1.APISpec.ResolverProperties.AsMap(), "args")
+
+	// A map of args keeps setting static args for the "api" resolver
+	a2 := p.Resources[ResourceName{Kind: ResourceKindAPI, Name: "a2"}]
+	require.Empty(t, a2.APISpec.Args)
+	require.Equal(t, map[string]any{"domain": "google.com"}, a2.APISpec.ResolverProperties.AsMap()["args"])
+}
+
+func TestAPIRateLimit(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`apis/a1.yaml`: `
+kind: api
+sql: select 1
+rate_limit: 100/min
+`,
+		`apis/a2.yaml`: `
+kind: api
+sql: select 1
+rate_limit: 5000 / 6h
+`,
+		`apis/a3.yaml`: `
+kind: api
+sql: select 1
+rate_limit: 100
+`,
+		`apis/a4.yaml`: `
+kind: api
+sql: select 1
+rate_limit: 100/week
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a1"},
+			Paths: []string{"/apis/a1.yaml"},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a2"},
+			Paths: []string{"/apis/a2.yaml"},
+		},
+	}, []*runtimev1.ParseError{
+		{Message: `invalid rate_limit "100": expected a value of the form "<requests>/<period>", such as "100/min"`, FilePath: "/apis/a3.yaml"},
+		{Message: `invalid rate_limit "100/week": the period must be one of "sec", "min", "hour", "day" or a duration in whole seconds`, FilePath: "/apis/a4.yaml"},
+	})
+
+	a1 := p.Resources[ResourceName{Kind: ResourceKindAPI, Name: "a1"}]
+	require.Equal(t, uint32(100), a1.APISpec.RateLimitRequests)
+	require.Equal(t, uint32(60), a1.APISpec.RateLimitPeriodSeconds)
+
+	a2 := p.Resources[ResourceName{Kind: ResourceKindAPI, Name: "a2"}]
+	require.Equal(t, uint32(5000), a2.APISpec.RateLimitRequests)
+	require.Equal(t, uint32(6*60*60), a2.APISpec.RateLimitPeriodSeconds)
+}
+
+func TestAPIReservedName(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`apis/a1.yaml`: `
+kind: api
+sql: select 1
+`,
+		`apis/spec.yaml`: `
+kind: api
+name: OpenAPI.json
+sql: select 1
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a1"},
+			Paths: []string{"/apis/a1.yaml"},
+		},
+	}, []*runtimev1.ParseError{
+		{Message: `the API name "OpenAPI.json" is reserved for the OpenAPI spec`, FilePath: "/apis/spec.yaml"},
+	})
+}
+
+func TestIncrementalModel(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// model m1 (append)
+		`models/m1.yaml`: `
+kind: model
+sql: SELECT * FROM events {{ if .incremental }} WHERE ts > '{{ .incremental_state.max_ts }}' {{ end }}
+incremental: true
+state:
+  sql: SELECT MAX(ts) AS max_ts FROM m1
+`,
+		// model m2 (merge)
+		`models/m2.yaml`: `
+kind: model
+sql: SELECT * FROM events {{ if .incremental }} WHERE ts > now() - INTERVAL 1 DAY {{ end }}
+incremental: true
+unique_key: [id]
+`,
+		// model m3 (not materialized)
+		`models/m3.yaml`: `
+kind: model
+sql: SELECT * FROM events
+incremental: true
+materialize: false
+`,
+		// model m4 (merge without unique key)
+		`models/m4.yaml`: `
+kind: model
+sql: SELECT * FROM events
+incremental: true
+incremental_strategy: merge
+`,
+		// model m5 (state without incremental)
+		`models/m5.yaml`: `
+kind: model
+sql: SELECT * FROM events
+state:
+  sql: SELECT 1
+`,
+	})
+
+	materialize := true
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Connector:                          "duckdb",
+				Sql:                                `SELECT * FROM events {{ if .incremental }} WHERE ts > '{{ .incremental_state.max_ts }}' {{ end }}`,
+				UsesTemplating:                     true,
+				Materialize:                        &materialize,
+				RefreshSchedule:                    &runtimev1.Schedule{RefUpdate: true},
+				Incremental:                        true,
+				IncrementalStateResolver:           "sql",
+				IncrementalStateResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT MAX(ts) AS max_ts FROM m1", "connector": "duckdb"})),
+				IncrementalStrategy:                runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_APPEND,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/models/m2.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Connector:           "duckdb",
+				Sql:                 `SELECT * FROM events {{ if .incremental }} WHERE ts > now() - INTERVAL 1 DAY {{ end }}`,
+				UsesTemplating:      true,
+				Materialize:         &materialize,
+				RefreshSchedule:     &runtimev1.Schedule{RefUpdate: true},
+				Incremental:         true,
+				IncrementalStrategy: runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_MERGE,
+				UniqueKey:           []string{"id"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  "incremental models must be materialized",
+			FilePath: "/models/m3.yaml",
+		},
+		{
+			Message:  `requires a "unique_key"`,
+			FilePath: "/models/m4.yaml",
+		},
+		{
+			Message:  `only supported for incremental models`,
+			FilePath: "/models/m5.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestPartitionedModel(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// model m1 (glob partitions)
+		`models/m1.yaml`: `
+kind: model
+sql: SELECT * FROM read_parquet('{{ .partition.uri }}')
+partitions:
+  glob: gs://bucket/data/*.parquet
+`,
+		// model m2 (sql partitions)
+		`models/m2.yaml`: `
+kind: model
+sql: SELECT * FROM events WHERE day = '{{ .partition.day }}'
+partitions:
+  sql: SELECT DISTINCT day FROM events
+unique_key: [id]
+`,
+		// model m3 (multiple resolvers)
+		`models/m3.yaml`: `
+kind: model
+sql: SELECT 1
+partitions:
+  sql: SELECT 1
+  glob: s3://bucket/*.csv
+`,
+	})
+
+	materialize := true
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Connector:                    "duckdb",
+				Sql:                          `SELECT * FROM read_parquet('{{ .partition.uri }}')`,
+				UsesTemplating:               true,
+				Materialize:                  &materialize,
+				RefreshSchedule:              &runtimev1.Schedule{RefUpdate: true},
+				Incremental:                  true,
+				IncrementalStrategy:          runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_APPEND,
+				PartitionsResolver:           "glob",
+				PartitionsResolverProperties: must(structpb.NewStruct(map[string]any{"path": "gs://bucket/data/*.parquet"})),
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/models/m2.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Connector:                    "duckdb",
+				Sql:                          `SELECT * FROM events WHERE day = '{{ .partition.day }}'`,
+				UsesTemplating:               true,
+				Materialize:                  &materialize,
+				RefreshSchedule:              &runtimev1.Schedule{RefUpdate: true},
+				Incremental:                  true,
+				IncrementalStrategy:          runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_MERGE,
+				UniqueKey:                    []string{"id"},
+				PartitionsResolver:           "sql",
+				PartitionsResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT DISTINCT day FROM events", "connector": "duckdb"})),
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `specifies more than one resolver`,
+			FilePath: "/models/m3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestDataQualityTest(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// source s1
+		`sources/s1.yaml`: `
+connector: s3
+path: hello
+`,
+		// test t1 (built-in assertions)
+		`tests/t1.yaml`: `
+kind: test
+model: s1
+block_downstream: true
+assertions:
+  - not_null: id
+  - unique: id
+  - name: valid_status
+    accepted_values:
+      column: status
+      values: [a, "b'c", 10]
+  - row_count:
+      min: 1
+      max: 100
+  - sql: SELECT * FROM s1 WHERE id < 0
+`,
+		// test t2 (top-level SQL)
+		`tests/t2.yaml`: `
+kind: test
+table: external_table
+sql: SELECT * FROM external_table WHERE 1=0
+`,
+		// test t3 (no assertions)
+		`tests/t3.yaml`: `
+kind: test
+model: m1
+`,
+		// test t4 (multiple assertion types)
+		`tests/t4.yaml`: `
+kind: test
+model: m1
+assertions:
+  - not_null: id
+    unique: id
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": "hello"})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindTest, Name: "t1"},
+			Paths: []string{"/tests/t1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindSource, Name: "s1"}},
+			TestSpec: &runtimev1.TestSpec{
+				Connector: "duckdb",
+				Table:     "s1",
+				Assertions: []*runtimev1.TestAssertion{
+					{Name: "not_null(id)", Sql: `SELECT * FROM "s1" WHERE "id" IS NULL`},
+					{Name: "unique(id)", Sql: `SELECT "id", COUNT(*) AS count FROM "s1" GROUP BY "id" HAVING COUNT(*) > 1`},
+					{Name: "valid_status", Sql: `SELECT * FROM "s1" WHERE "status" IS NULL OR "status" NOT IN ('a', 'b''c', 10)`},
+					{Name: "row_count", Sql: `SELECT COUNT(*) AS count FROM "s1" HAVING COUNT(*) < 1 OR COUNT(*) > 100`},
+					{Name: "sql", Sql: `SELECT * FROM s1 WHERE id < 0`},
+				},
+				BlockDownstream: true,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindTest, Name: "t2"},
+			Paths: []string{"/tests/t2.yaml"},
+			TestSpec: &runtimev1.TestSpec{
+				Connector: "duckdb",
+				Table:     "external_table",
+				Assertions: []*runtimev1.TestAssertion{
+					{Name: "sql", Sql: `SELECT * FROM external_table WHERE 1=0`},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `does not declare any assertions`,
+			FilePath: "/tests/t3.yaml",
+		},
+		{
+			Message:  `more than one type`,
+			FilePath: "/tests/t4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestSchemaChangePolicy(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// source s1
+		`sources/s1.yaml`: `
+connector: s3
+path: s3://bucket/*.parquet
+on_schema_change: append_new_columns
+`,
+		// source s2 (stream ingestion)
+		`sources/s2.yaml`: `
+connector: kafka
+topic: events
+on_schema_change: fail
+`,
+		// model m1
+		`models/m1.yaml`: `
+kind: model
+sql: SELECT * FROM events {{ if .incremental }} WHERE ts > now() - INTERVAL 1 DAY {{ end }}
+incremental: true
+on_schema_change: sync_all_columns
+`,
+		// model m2 (not materialized)
+		`models/m2.yaml`: `
+kind: model
+sql: SELECT * FROM events
+materialize: false
+on_schema_change: append_new_columns
+`,
+		// model m3 (invalid policy)
+		`models/m3.yaml`: `
+kind: model
+sql: SELECT * FROM events
+on_schema_change: ignore
+`,
+	})
+
+	materialize := true
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": "s3://bucket/*.parquet"})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				OnSchemaChange:  runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Connector:           "duckdb",
+				Sql:                 `SELECT * FROM events {{ if .incremental }} WHERE ts > now() - INTERVAL 1 DAY {{ end }}`,
+				UsesTemplating:      true,
+				Materialize:         &materialize,
+				RefreshSchedule:     &runtimev1.Schedule{RefUpdate: true},
+				Incremental:         true,
+				IncrementalStrategy: runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_APPEND,
+				OnSchemaChange:      runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_SYNC_ALL_COLUMNS,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `not supported for sources with stream ingestion`,
+			FilePath: "/sources/s2.yaml",
+		},
+		{
+			Message:  `only supported for materialized models`,
+			FilePath: "/models/m2.yaml",
+		},
+		{
+			Message:  `invalid schema change policy "ignore"`,
+			FilePath: "/models/m3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestStreamingSource(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: `
+connectors:
+- name: events_cluster
+  type: kafka
+`,
+		// source s1
+		`sources/s1.yaml`: `
+connector: kafka
+topic: events
+`,
+		// source s2 (connector with a kafka driver)
+		`sources/s2.yaml`: `
+connector: events_cluster
+topic: clicks
+format: avro
+refresh:
+  every: 10s
+`,
+		// source s3 (change data capture)
+		`sources/s3.yaml`: `
+connector: postgres
+cdc: true
+table: public.orders
+`,
+		// source s4 (change data capture with an unsupported connector)
+		`sources/s4.yaml`: `
+connector: kafka
+cdc: true
+topic: orders
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "kafka",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"topic": "events"})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				StreamIngestion: true,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s2"},
+			Paths: []string{"/sources/s2.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "events_cluster",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"topic": "clicks", "format": "avro"})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true, TickerSeconds: 10},
+				StreamIngestion: true,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s3"},
+			Paths: []string{"/sources/s3.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "postgres",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"cdc": true, "table": "public.orders"})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				StreamIngestion: true,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `property "cdc" is not supported for sources with connector "kafka"`,
+			FilePath: "/sources/s4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check resources
 	gotResources := maps.Clone(p.Resources)
@@ -1387,6 +2481,7 @@ func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resourc
 				require.Equal(t, want.MetricsViewSpec, got.MetricsViewSpec, "for resource %q", want.Name)
 				require.Equal(t, want.MigrationSpec, got.MigrationSpec, "for resource %q", want.Name)
 				require.Equal(t, want.ThemeSpec, got.ThemeSpec, "for resource %q", want.Name)
+				require.Equal(t, want.TestSpec, got.TestSpec, "for resource %q", want.Name)
 
 				delete(gotResources, got.Name)
 				found = true
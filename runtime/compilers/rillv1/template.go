@@ -37,6 +37,9 @@ import (
 //     .meta: access the current resource's metadata (resolve time)
 //     .spec: access the current resource's spec (resolve time)
 //     .state: access the current resource's state (resolve time)
+//     .incremental: true when an incremental model is refreshed incrementally (resolve time, models only)
+//     .incremental_state: access the output of an incremental model's state resolver (resolve time, models only)
+//     .partition: access the data of the partition being processed by a partitioned model (resolve time, models only)
 //     (All functions from Sprig except OS functions. See http://masterminds.github.io/sprig/ for details.)
 //
 
@@ -138,6 +141,10 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 		"meta":  map[string]any{},
 		"spec":  map[string]any{},
 		"state": map[string]any{},
+		// Extra props set by the model reconciler
+		"incremental":       false,
+		"incremental_state": map[string]any{},
+		"partition":         map[string]any{},
 	}
 
 	// Resolve template
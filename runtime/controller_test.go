@@ -6,12 +6,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/kafka/kafkatest"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+
+	_ "github.com/rilldata/rill/runtime/resolvers"
 )
 
 func TestComplete(t *testing.T) {
@@ -539,6 +544,87 @@ path: data/foo.csv
 	testruntime.RequireOLAPTable(t, rt, id, "bar")
 }
 
+func TestModelPartitions(t *testing.T) {
+	// Create a partitioned model where one of the partitions fails
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+kind: model
+sql: SELECT CAST('{{ .partition.v }}' AS INTEGER) AS v
+partitions:
+  sql: SELECT * FROM (VALUES ('1'), ('2'), ('x')) AS t(v)
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+	requirePartitions := func(total, failed int) {
+		res, err := ctrl.Get(context.Background(), name, false)
+		require.NoError(t, err)
+		partitions := res.GetModel().State.Partitions
+		require.Len(t, partitions, total)
+		var n int
+		for _, p := range partitions {
+			require.NotNil(t, p.ExecutedOn)
+			if p.Error != "" {
+				n++
+			}
+		}
+		require.Equal(t, failed, n)
+	}
+	requirePartitions(3, 1)
+
+	// Refreshing only retries the failed partition
+	testruntime.RefreshAndWait(t, rt, id, name)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+	requirePartitions(3, 1)
+
+	// Changing the model rebuilds all partitions
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+kind: model
+sql: SELECT CAST('{{ .partition.v }}' AS INTEGER) AS v
+partitions:
+  sql: SELECT * FROM (VALUES ('1'), ('2'), ('3')) AS t(v)
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+	requirePartitions(3, 0)
+}
+
+func TestModelPartitionsRemoved(t *testing.T) {
+	// Create a partitioned model with partitions listed by another model
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/parts.sql": `SELECT * FROM (VALUES (1), (2), (3)) AS t(v)`,
+		"/models/bar.yaml": `
+kind: model
+sql: SELECT {{ .partition.v }} AS v
+partitions:
+  sql: SELECT v FROM parts
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+
+	// Removing a partition removes its rows on the next refresh
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/parts.sql": `SELECT * FROM (VALUES (1), (3)) AS t(v)`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"})
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+}
+
 func TestRename(t *testing.T) {
 	// Rename model A to B and model B to A, verify success
 	// Rename model A to B and source B to A, verify success
@@ -964,6 +1050,108 @@ measures:
 	testruntime.RequireResource(t, rt, id, metricsRes)
 }
 
+func TestDataQualityTest(t *testing.T) {
+	// Create a model, a metrics view on it, and a failing test that blocks downstream resources
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `SELECT * FROM (VALUES (1, 'a'), (NULL, 'b')) AS t(id, status)`,
+		"/dashboards/dash.yaml": `
+title: dash
+model: bar
+dimensions:
+- column: status
+measures:
+- expression: count(*)
+`,
+		"/tests/bar_not_null.yaml": `
+kind: test
+model: bar
+block_downstream: true
+assertions:
+- not_null: id
+- accepted_values:
+    column: status
+    values: [a, b]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 2, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	res, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindTest, Name: "bar_not_null"}, false)
+	require.NoError(t, err)
+	state := res.GetTest().State
+	require.False(t, state.Passed)
+	require.Len(t, state.Results, 2)
+	require.False(t, state.Results[0].Passed)
+	require.Equal(t, int64(1), state.Results[0].FailingRowsCount)
+	require.Len(t, state.Results[0].FailingRows, 1)
+	require.True(t, state.Results[1].Passed)
+
+	res, err = ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "dash"}, false)
+	require.NoError(t, err)
+	require.Contains(t, res.Meta.ReconcileError, `test "bar_not_null" is failing`)
+	require.Nil(t, res.GetMetricsView().State.ValidSpec)
+
+	// Fix the model, verify the test passes and the metrics view is unblocked
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `SELECT * FROM (VALUES (1, 'a'), (2, 'b')) AS t(id, status)`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	res, err = ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "dash"}, false)
+	require.NoError(t, err)
+	require.NotNil(t, res.GetMetricsView().State.ValidSpec)
+}
+
+func TestStreamingSource(t *testing.T) {
+	// Start a mock Kafka cluster and produce some messages
+	mc, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mc.Close()
+	kafkatest.Produce(t, mc.BootstrapServers(), "events", `{"id":1,"name":"a"}`, `{"id":2,"name":"b"}`)
+
+	// Create a streaming source that consumes the topic
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/sources/events.yaml": fmt.Sprintf(`
+connector: kafka
+topic: events
+brokers: %s
+`, mc.BootstrapServers()),
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTable(t, rt, id, "events")
+	testruntime.RequireOLAPTableCount(t, rt, id, "events", 2)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "events"}
+	res, err := ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	require.True(t, res.GetSource().Spec.StreamIngestion)
+	require.Equal(t, "events", res.GetSource().State.Table)
+	require.Equal(t, int64(2), res.GetSource().State.StreamOffsets["events/0"])
+	require.NotNil(t, res.Meta.ReconcileOn)
+
+	// The next micro-batch appends the new messages
+	kafkatest.Produce(t, mc.BootstrapServers(), "events", `{"id":3,"name":"c"}`)
+	testruntime.ReconcileAndWait(t, rt, id, name)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "events", 3)
+
+	res, err = ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.GetSource().State.StreamOffsets["events/0"])
+
+	// A full refresh starts over from the earliest offset
+	testruntime.RefreshAndWait(t, rt, id, name)
+	testruntime.RequireOLAPTableCount(t, rt, id, "events", 3)
+}
+
 func TestStageChanges(t *testing.T) {
 	// Create source and model
 	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
@@ -1018,6 +1206,184 @@ path: data/bar.csv
 	testruntime.RequireResource(t, rt, id, metricsRes)
 }
 
+func TestSourceSchemaChange(t *testing.T) {
+	// Create a source that fails on schema changes
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/data/foo.csv": `a,b
+1,x
+2,y
+`,
+		"/sources/foo.yaml": `
+type: local_file
+path: data/foo.csv
+on_schema_change: fail
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 2)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "foo"}
+	requireSchemaChanges := func(want []*runtimev1.SchemaChange) {
+		res, err := ctrl.Get(context.Background(), name, false)
+		require.NoError(t, err)
+		got := res.GetSource().State.SchemaChanges
+		require.Len(t, got, len(want))
+		for i := range want {
+			require.True(t, proto.Equal(want[i], got[i]), "expected: %v\nactual: %v", want[i], got[i])
+		}
+	}
+
+	// Adding a column fails the refresh and retains the existing table
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/data/foo.csv": `a,b,c
+1,x,true
+2,y,false
+3,z,true
+`,
+	})
+	testruntime.RefreshAndWait(t, rt, id, name)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 2)
+	requireSchemaChanges([]*runtimev1.SchemaChange{{Column: "c", NewType: "BOOLEAN"}})
+
+	// With append_new_columns, new columns are added and removed columns are retained
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/data/foo.csv": `a,c
+1,true
+`,
+		"/sources/foo.yaml": `
+type: local_file
+path: data/foo.csv
+on_schema_change: append_new_columns
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RefreshAndWait(t, rt, id, name)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 1)
+	requireSchemaChanges([]*runtimev1.SchemaChange{
+		{Column: "c", NewType: "BOOLEAN"},
+		{Column: "b", PreviousType: "VARCHAR"},
+	})
+
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+	tbl, err := olap.InformationSchema().Lookup(context.Background(), "foo")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "BIGINT", "b": "VARCHAR", "c": "BOOLEAN"}, tbl.ColumnTypes)
+}
+
+func TestIncrementalModel(t *testing.T) {
+	// Create an append model that uses its state to select new rows, and a merge model that replaces rows by key
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/foo.yaml": `
+kind: model
+sql: SELECT range AS id FROM range({{ if .incremental }}{{ .incremental_state.max_id }} + 1, {{ .incremental_state.max_id }} + 3{{ else }}0, 3{{ end }})
+incremental: true
+state:
+  sql: SELECT MAX(id) AS max_id FROM foo
+`,
+		"/models/bar.yaml": `
+kind: model
+sql: SELECT * FROM (VALUES {{ if .incremental }}(2, 'b'), (3, 'b'){{ else }}(1, 'a'), (2, 'a'){{ end }}) t(id, v)
+incremental: true
+unique_key: [id]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	fooName := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "foo"}
+	barName := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+
+	res, err := ctrl.Get(context.Background(), fooName, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"max_id": float64(2)}, res.GetModel().State.IncrementalState.AsMap())
+
+	// Refreshing the append model inserts the rows after the previous max ID
+	testruntime.RefreshAndWait(t, rt, id, fooName)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 5)
+	res, err = ctrl.Get(context.Background(), fooName, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"max_id": float64(4)}, res.GetModel().State.IncrementalState.AsMap())
+
+	// Refreshing the merge model replaces the row with ID 2 and inserts the row with ID 3
+	testruntime.RefreshAndWait(t, rt, id, barName)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT id, v FROM bar ORDER BY id"})
+	require.NoError(t, err)
+	defer rows.Close()
+	var got []string
+	for rows.Next() {
+		var id int
+		var v string
+		require.NoError(t, rows.Scan(&id, &v))
+		got = append(got, fmt.Sprintf("%d:%s", id, v))
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []string{"1:a", "2:b", "3:b"}, got)
+
+	// Changing the model's definition rebuilds it from scratch
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/foo.yaml": `
+kind: model
+sql: SELECT range AS id FROM range({{ if .incremental }}{{ .incremental_state.max_id }} + 1, {{ .incremental_state.max_id }} + 2{{ else }}0, 2{{ end }})
+incremental: true
+state:
+  sql: SELECT MAX(id) AS max_id FROM foo
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 2)
+}
+
+func TestIncrementalModelSchemaChange(t *testing.T) {
+	// Create an incremental model that outputs a new column on incremental runs
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+kind: model
+sql: SELECT 1 AS a {{ if .incremental }}, 'x' AS b{{ end }}
+incremental: true
+on_schema_change: sync_all_columns
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 1)
+
+	// The incremental run adds the new column before inserting
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+	testruntime.RefreshAndWait(t, rt, id, name)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	res, err := ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	changes := res.GetModel().State.SchemaChanges
+	require.Len(t, changes, 1)
+	require.True(t, proto.Equal(&runtimev1.SchemaChange{Column: "b", NewType: "VARCHAR"}, changes[0]))
+}
+
 func TestWatch(t *testing.T) {
 	// Create instance with watch
 	// Create source, wait and verify
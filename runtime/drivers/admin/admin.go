@@ -171,6 +171,11 @@ func (h *Handle) AsAI(instanceID string) (drivers.AIService, bool) {
 	return h, true
 }
 
+// AsNotifier implements drivers.Handle.
+func (h *Handle) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP implements drivers.Handle.
 func (h *Handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return nil, false
@@ -182,6 +182,11 @@ func (c *Connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *Connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP implements drivers.Connection.
 func (c *Connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return nil, false
@@ -291,6 +296,35 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 	return iter, nil
 }
 
+// ListObjectsForGlob implements drivers.ObjectStore.
+func (c *Connection) ListObjectsForGlob(ctx context.Context, glob string) ([]drivers.ObjectStoreEntry, error) {
+	conf, err := parseSourceProperties(map[string]any{"path": glob})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	client, err := c.getClient(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketObj, err := azureblob.OpenBucket(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer bucketObj.Close()
+
+	entries, err := rillblob.ListObjects(ctx, bucketObj, conf.url, rillblob.Options{})
+	if err != nil {
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden {
+			return nil, drivers.NewPermissionDeniedError(fmt.Sprintf("failed to list objects: %v", respErr))
+		}
+		return nil, err
+	}
+	return entries, nil
+}
+
 type sourceProperties struct {
 	Path                  string         `mapstructure:"path"`
 	Account               string         `mapstructure:"account"`
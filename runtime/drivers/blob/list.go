@@ -0,0 +1,87 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"gocloud.dev/blob"
+)
+
+// Limits applied by ListObjects when not set in the Options.
+// They are higher than the download limits since listing does not fetch object contents.
+const (
+	_listMaxObjectsMatched = 100 * 1000
+	_listMaxObjectsListed  = 10 * 1000 * 1000
+	_listPageSize          = 1000
+)
+
+// ListObjects lists the objects in a bucket that match the URL's path, which may be a glob pattern.
+// It does not close the bucket.
+func ListObjects(ctx context.Context, bucket *blob.Bucket, url *globutil.URL, opts Options) ([]drivers.ObjectStoreEntry, error) {
+	if opts.GlobMaxObjectsMatched == 0 {
+		opts.GlobMaxObjectsMatched = _listMaxObjectsMatched
+	}
+	if opts.GlobMaxObjectsListed == 0 {
+		opts.GlobMaxObjectsListed = _listMaxObjectsListed
+	}
+	if opts.GlobPageSize == 0 {
+		opts.GlobPageSize = _listPageSize
+	}
+
+	newEntry := func(obj *blob.ListObject) drivers.ObjectStoreEntry {
+		return drivers.ObjectStoreEntry{
+			Path:      fmt.Sprintf("%s://%s/%s", url.Scheme, url.Host, obj.Key),
+			Key:       obj.Key,
+			Size:      obj.Size,
+			UpdatedOn: obj.ModTime,
+		}
+	}
+
+	listOpts := &blob.ListOptions{}
+	prefix, glob := doublestar.SplitPattern(url.Path)
+	if !fileutil.IsGlob(glob) {
+		// Single object
+		attr, err := bucket.Attributes(ctx, url.Path)
+		if err != nil {
+			return nil, err
+		}
+		return []drivers.ObjectStoreEntry{newEntry(&blob.ListObject{Key: url.Path, Size: attr.Size, ModTime: attr.ModTime})}, nil
+	} else if prefix != "." {
+		listOpts.Prefix = prefix
+	}
+
+	var res []drivers.ObjectStoreEntry
+	var fetched int64
+	token := blob.FirstPageToken
+	for token != nil {
+		objs, nextToken, err := bucket.ListPage(ctx, token, opts.GlobPageSize, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		token = nextToken
+
+		fetched += int64(len(objs))
+		if fetched > opts.GlobMaxObjectsListed {
+			return nil, fmt.Errorf("glob pattern exceeds limits: listed more than %d files", opts.GlobMaxObjectsListed)
+		}
+
+		for _, obj := range objs {
+			if obj.IsDir {
+				continue
+			}
+			if matched, _ := doublestar.Match(url.Path, obj.Key); !matched {
+				continue
+			}
+			res = append(res, newEntry(obj))
+			if len(res) > opts.GlobMaxObjectsMatched {
+				return nil, fmt.Errorf("glob pattern exceeds limits: matched more than %d files", opts.GlobMaxObjectsMatched)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -139,6 +139,11 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // OLAP implements drivers.Connection.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return c, true
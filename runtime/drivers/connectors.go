@@ -23,6 +23,12 @@ type Spec struct {
 	SourceProperties   []PropertySchema
 	ConfigProperties   []PropertySchema
 	Help               string
+	// ImplementsNotifier is true if the driver's handles can be used to deliver alert and report notifications.
+	ImplementsNotifier bool
+	// ImplementsStreaming is true if the driver's sources are ingested continuously instead of on a schedule.
+	ImplementsStreaming bool
+	// ImplementsChangeCapture is true if the driver's sources can be ingested with change data capture (see ChangeCaptureStore).
+	ImplementsChangeCapture bool
 }
 
 // PropertySchema provides the schema for a property supported by a connector.
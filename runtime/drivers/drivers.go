@@ -134,6 +134,10 @@ type Handle interface {
 	// AsTransporter returns a Transporter for moving data between two other handles. One of the input handles may be the Handle itself.
 	// Examples: duckdb.AsTransporter(gcs, duckdb), beam.AsTransporter(gcs, s3).
 	AsTransporter(from Handle, to Handle) (Transporter, bool)
+
+	// AsNotifier returns a Notifier if the driver can serve as such, otherwise returns false.
+	// A notifier delivers alert and report notifications to an external channel, such as Slack or a webhook.
+	AsNotifier() (Notifier, bool)
 }
 
 // PermissionDeniedError is returned when a driver cannot access some data due to insufficient permissions.
@@ -2,15 +2,14 @@ package druid
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
+	avatica "github.com/apache/calcite-avatica-go/v5"
 	"github.com/jmoiron/sqlx"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"go.uber.org/zap"
-
-	// Load calcite avatica driver for druid
-	_ "github.com/apache/calcite-avatica-go/v5"
 )
 
 var spec = drivers.Spec{
@@ -43,15 +42,16 @@ func (d driver) Open(config map[string]any, shared bool, client *activity.Client
 		return nil, fmt.Errorf("require dsn to open druid connection")
 	}
 
-	db, err := sqlx.Open("avatica", dsn)
-	if err != nil {
-		return nil, err
-	}
+	// Avatica connection properties are passed to Druid as the query context of all queries on the connection.
+	// Window functions, which are used for some measure types, are only available when enableWindowing is set.
+	connector := avatica.NewConnector(dsn).(*avatica.Connector)
+	connector.Info["enableWindowing"] = "true"
+	db := sqlx.NewDb(sql.OpenDB(connector), "avatica")
 
 	// very roughly approximating num queries required for a typical page load
 	db.SetMaxOpenConns(20)
 
-	err = db.Ping()
+	err := db.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("druid: %w", err)
 	}
@@ -124,6 +124,11 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // OLAP implements drivers.Connection.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return c, true
@@ -29,6 +29,11 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 	return fmt.Errorf("druid: data transformation not yet supported")
 }
 
+// DropTableColumn implements drivers.OLAPStore.
+func (c *connection) DropTableColumn(ctx context.Context, tableName, columnName string) error {
+	return fmt.Errorf("druid: data transformation not yet supported")
+}
+
 // CreateTableAsSelect implements drivers.OLAPStore.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
 	return fmt.Errorf("druid: data transformation not yet supported")
@@ -40,7 +45,7 @@ func (c *connection) DropTable(ctx context.Context, name string, view bool) erro
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error {
+func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
 	return fmt.Errorf("druid: data transformation not yet supported")
 }
 
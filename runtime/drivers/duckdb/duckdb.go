@@ -348,6 +348,11 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP OLAP implements drivers.Connection.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	if c.shared {
@@ -379,6 +384,9 @@ func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter
 			return NewMotherduckToDuckDB(from, olap, c.logger), true
 		}
 		if store, ok := from.AsSQLStore(); ok {
+			if cs, ok := store.(drivers.ChangeCaptureStore); ok {
+				return NewChangeCaptureToDuckDB(cs, olap, c.logger), true
+			}
 			return NewSQLStoreToDuckDB(store, olap, c.logger), true
 		}
 		if store, ok := from.AsObjectStore(); ok { // objectstore to duckdb transfer
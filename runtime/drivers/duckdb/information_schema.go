@@ -119,6 +119,7 @@ func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error)
 			Name:           name,
 			View:           tableType == "VIEW",
 			Schema:         &runtimev1.StructType{},
+			ColumnTypes:    make(map[string]string, len(columnNames)),
 		}
 
 		// should NEVER happen, but just to be safe
@@ -138,6 +139,7 @@ func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error)
 				Name: colName.(string),
 				Type: colType,
 			})
+			t.ColumnTypes[colName.(string)] = databaseType
 		}
 
 		res = append(res, t)
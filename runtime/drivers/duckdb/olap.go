@@ -269,6 +269,37 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 	})
 }
 
+// DropTableColumn implements drivers.OLAPStore.
+func (c *connection) DropTableColumn(ctx context.Context, tableName, columnName string) error {
+	c.logger.Debug("drop table column", zap.String("tableName", tableName), zap.String("columnName", columnName))
+	if !c.config.ExtTableStorage {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", safeSQLName(tableName), safeSQLName(columnName)),
+			Priority:    1,
+			LongRunning: true,
+		})
+	}
+
+	version, exist, err := c.tableVersion(tableName)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return fmt.Errorf("table %q does not exist", tableName)
+	}
+	dbName := dbName(tableName, version)
+	return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, conn *dbsql.Conn) error {
+		err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("ALTER TABLE %s.default DROP COLUMN %s", safeSQLName(dbName), safeSQLName(columnName))})
+		if err != nil {
+			return err
+		}
+
+		// recreate view to propagate schema changes
+		return c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("CREATE OR REPLACE VIEW %s AS SELECT * FROM %s.default", safeSQLName(tableName), safeSQLName(dbName))})
+	})
+}
+
 // CreateTableAsSelect implements drivers.OLAPStore.
 // We add a \n at the end of the any user query to ensure any comment at the end of model doesn't make the query incomplete.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
@@ -404,38 +435,106 @@ func (c *connection) DropTable(ctx context.Context, name string, view bool) erro
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error {
-	c.logger.Debug("insert into table", zap.String("name", name), zap.Bool("byName", byName))
-	var insertByNameClause string
-	if byName {
-		insertByNameClause = "BY NAME"
-	} else {
-		insertByNameClause = ""
+func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+	c.logger.Debug("insert into table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey))
+
+	target, err := c.tableTarget(name)
+	if err != nil {
+		return fmt.Errorf("InsertTableAsSelect: %w", err)
 	}
 
-	if !c.config.ExtTableStorage {
-		// Enforce storage limits
-		return c.execWithLimits(ctx, &drivers.Statement{
-			Query:       fmt.Sprintf("INSERT INTO %s %s (%s)", safeSQLName(name), insertByNameClause, sql),
-			Priority:    1,
-			LongRunning: true,
-		})
+	switch strategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+		return c.insertTableAsSelect(ctx, target, byName, sql)
+	case drivers.IncrementalStrategyMerge:
+		return c.mergeTableAsSelect(ctx, target, byName, sql, uniqueKey)
+	default:
+		return fmt.Errorf("InsertTableAsSelect: unsupported incremental strategy %q", strategy)
 	}
+}
 
+// tableTarget returns an escaped reference to the table with the given name that can be used as the target of DML statements.
+// With external table storage, the table's name refers to a view, so the reference points to the table in the attached database instead.
+func (c *connection) tableTarget(name string) (string, error) {
+	if !c.config.ExtTableStorage {
+		return safeSQLName(name), nil
+	}
 	version, exist, err := c.tableVersion(name)
 	if err != nil {
-		return err
+		return "", err
 	}
 	if !exist {
-		return fmt.Errorf("InsertTableAsSelect: table %q does not exist", name)
+		return "", fmt.Errorf("table %q does not exist", name)
+	}
+	return fmt.Sprintf("%s.default", safeSQLName(dbName(name, version))), nil
+}
+
+// insertTableAsSelect appends the result of sql to the target table.
+// The target must be an escaped table reference.
+func (c *connection) insertTableAsSelect(ctx context.Context, target string, byName bool, sql string) error {
+	var insertByNameClause string
+	if byName {
+		insertByNameClause = "BY NAME"
 	}
+
+	// Enforce storage limits
 	return c.execWithLimits(ctx, &drivers.Statement{
-		Query:       fmt.Sprintf("INSERT INTO %s.default %s (%s)", safeSQLName(dbName(name, version)), insertByNameClause, sql),
+		Query:       fmt.Sprintf("INSERT INTO %s %s (%s\n)", target, insertByNameClause, sql),
 		Priority:    1,
 		LongRunning: true,
 	})
 }
 
+// mergeTableAsSelect inserts the result of sql into the target table, replacing existing rows that have the same values for the uniqueKey columns.
+// The target must be an escaped table reference.
+func (c *connection) mergeTableAsSelect(ctx context.Context, target string, byName bool, sql string, uniqueKey []string) error {
+	if len(uniqueKey) == 0 {
+		return fmt.Errorf("InsertTableAsSelect: the merge strategy requires a unique key")
+	}
+
+	var insertByNameClause string
+	if byName {
+		insertByNameClause = "BY NAME"
+	}
+
+	var where strings.Builder
+	for i, key := range uniqueKey {
+		if i > 0 {
+			where.WriteString(" AND ")
+		}
+		key = safeSQLName(key)
+		fmt.Fprintf(&where, "target.%s IS NOT DISTINCT FROM tmp.%s", key, key)
+	}
+
+	// Stage the new rows in a temporary table, so the SQL only runs once.
+	// The temporary table is bound to the connection, so all statements must run on the same connection.
+	tmp := safeSQLName("__rill_tmp_merge_" + uuid.NewString())
+	return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		err := c.execWithLimits(ctx, &drivers.Statement{Query: fmt.Sprintf("CREATE OR REPLACE TEMPORARY TABLE %s AS (%s\n)", tmp, sql)})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", tmp)})
+		}()
+
+		// Replace the existing rows in a transaction, so the table is never observed (or left) with the old rows deleted but the new rows missing
+		err = c.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION"})
+		if err != nil {
+			return err
+		}
+		err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("DELETE FROM %s AS target WHERE EXISTS (SELECT 1 FROM %s AS tmp WHERE %s)", target, tmp, where.String())})
+		if err == nil {
+			err = c.execWithLimits(ctx, &drivers.Statement{Query: fmt.Sprintf("INSERT INTO %s %s SELECT * FROM %s", target, insertByNameClause, tmp)})
+		}
+		if err != nil {
+			_ = c.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK"})
+			return err
+		}
+		return c.Exec(ctx, &drivers.Statement{Query: "COMMIT"})
+	})
+}
+
 // RenameTable implements drivers.OLAPStore.
 // For drop and replace (when running `RenameTable("__tmp_foo", "foo")`):
 // `DROP VIEW __tmp_foo`
@@ -183,10 +183,10 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test-insert", false, "select 1")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "select 2")
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "select 2", drivers.IncrementalStrategyAppend, nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", true, "select 3")
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", true, "select 3", drivers.IncrementalStrategyAppend, nil)
 	require.Error(t, err)
 
 	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM 'test-insert'"})
@@ -198,6 +198,42 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	require.NoError(t, res.Close())
 }
 
+func Test_connection_InsertTableAsSelectMerge(t *testing.T) {
+	for _, ext := range []bool{false, true} {
+		t.Run(fmt.Sprintf("external_table_storage=%v", ext), func(t *testing.T) {
+			temp := t.TempDir()
+
+			dbPath := filepath.Join(temp, "view.db")
+			handle, err := Driver{}.Open(map[string]any{"dsn": dbPath, "external_table_storage": ext}, false, activity.NewNoopClient(), zap.NewNop())
+			require.NoError(t, err)
+			c := handle.(*connection)
+			require.NoError(t, c.Migrate(context.Background()))
+			c.AsOLAP("default")
+
+			err = c.CreateTableAsSelect(context.Background(), "test-merge", false, "SELECT 1 AS id, 'a' AS val UNION ALL SELECT 2 AS id, 'b' AS val")
+			require.NoError(t, err)
+
+			err = c.InsertTableAsSelect(context.Background(), "test-merge", true, "SELECT 2 AS id, 'c' AS val UNION ALL SELECT 3 AS id, 'd' AS val", drivers.IncrementalStrategyMerge, []string{"id"})
+			require.NoError(t, err)
+
+			err = c.InsertTableAsSelect(context.Background(), "test-merge", true, "SELECT 4 AS id, 'e' AS val", drivers.IncrementalStrategyMerge, nil)
+			require.Error(t, err)
+
+			res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT id, val FROM 'test-merge' ORDER BY id"})
+			require.NoError(t, err)
+			var rows []string
+			for res.Next() {
+				var id int
+				var val string
+				require.NoError(t, res.Scan(&id, &val))
+				rows = append(rows, fmt.Sprintf("%d:%s", id, val))
+			}
+			require.NoError(t, res.Close())
+			require.Equal(t, []string{"1:a", "2:c", "3:d"}, rows)
+		})
+	}
+}
+
 func Test_connection_RenameTable(t *testing.T) {
 	temp := t.TempDir()
 	os.Mkdir(temp, fs.ModePerm)
@@ -286,6 +322,29 @@ func Test_connection_AddTableColumn(t *testing.T) {
 	require.NoError(t, res.Close())
 }
 
+func Test_connection_DropTableColumn(t *testing.T) {
+	temp := t.TempDir()
+
+	dbPath := filepath.Join(temp, "view.db")
+	handle, err := Driver{}.Open(map[string]any{"dsn": dbPath, "external_table_storage": true}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	require.NoError(t, c.Migrate(context.Background()))
+	c.AsOLAP("default")
+
+	err = c.CreateTableAsSelect(context.Background(), "test drop column", false, "select 1 as a, 2 as b")
+	require.NoError(t, err)
+
+	err = c.DropTableColumn(context.Background(), "test drop column", "a")
+	require.NoError(t, err)
+
+	tbl, err := c.InformationSchema().Lookup(context.Background(), "test drop column")
+	require.NoError(t, err)
+	require.Len(t, tbl.Schema.Fields, 1)
+	require.Equal(t, "b", tbl.Schema.Fields[0].Name)
+	require.Equal(t, map[string]string{"b": "INTEGER"}, tbl.ColumnTypes)
+}
+
 func Test_connection_RenameToExistingTableOld(t *testing.T) {
 	handle, err := Driver{}.Open(map[string]any{"dsn": ""}, false, activity.NewNoopClient(), zap.NewNop())
 	require.NoError(t, err)
@@ -375,12 +434,12 @@ func Test_connection_InsertTableAsSelectLimits(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')")
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", drivers.IncrementalStrategyAppend, nil)
 	if err != nil {
 		require.ErrorIs(t, err, drivers.ErrStorageLimitExceeded)
 	}
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')")
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", false, "SELECT * from read_parquet('../../../web-local/tests/data/AdBids.parquet')", drivers.IncrementalStrategyAppend, nil)
 	require.ErrorIs(t, err, drivers.ErrStorageLimitExceeded)
 }
 
@@ -398,16 +457,16 @@ func Test_connection_CastEnum(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test", false, "SELECT 1 AS id, 'bglr' AS city, 'IND' AS country")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 2, 'mUm', 'IND'")
+	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 2, 'mUm', 'IND'", drivers.IncrementalStrategyAppend, nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, 'Perth', 'Aus'")
+	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, 'Perth', 'Aus'", drivers.IncrementalStrategyAppend, nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, null, 'Aus'")
+	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, null, 'Aus'", drivers.IncrementalStrategyAppend, nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, 'bglr', null")
+	err = c.InsertTableAsSelect(context.Background(), "test", false, "SELECT 3, 'bglr', null", drivers.IncrementalStrategyAppend, nil)
 	require.NoError(t, err)
 
 	err = c.convertToEnum(context.Background(), "test", []string{"city", "country"})
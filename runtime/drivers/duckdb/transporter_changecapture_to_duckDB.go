@@ -0,0 +1,331 @@
+package duckdb
+
+import (
+	"bufio"
+	"context"
+	dbsql "database/sql"
+	sqldriver "database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// _cdcDeletedColumn is the name of the column that marks deleted rows in the staged changes.
+const _cdcDeletedColumn = "__rill_cdc_deleted"
+
+// changeCaptureToDuckDB is a streaming transporter for SQL stores with change data capture.
+// The first batch takes a snapshot of the table. Subsequent batches apply the captured inserts, updates and deletes keyed by primary key.
+// Regular (non-streaming) transfers behave like sqlStoreToDuckDB.
+type changeCaptureToDuckDB struct {
+	*sqlStoreToDuckDB
+	from drivers.ChangeCaptureStore
+	to   *connection
+}
+
+var (
+	_ drivers.StreamingTransporter = &changeCaptureToDuckDB{}
+	_ drivers.StreamCloser         = &changeCaptureToDuckDB{}
+)
+
+func NewChangeCaptureToDuckDB(from drivers.ChangeCaptureStore, to *connection, logger *zap.Logger) drivers.Transporter {
+	return &changeCaptureToDuckDB{
+		sqlStoreToDuckDB: &sqlStoreToDuckDB{
+			to:     to,
+			from:   from,
+			logger: logger,
+		},
+		from: from,
+		to:   to,
+	}
+}
+
+// Transfer implements drivers.Transporter.
+func (t *changeCaptureToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	return t.sqlStoreToDuckDB.Transfer(ctx, srcProps, sinkProps, opts)
+}
+
+// TransferBatch implements drivers.StreamingTransporter.
+// If opts.Offsets is empty, it starts capturing changes and replaces the sink table with a snapshot of the source table.
+// Otherwise, it reads the changes committed since opts.Offsets and applies them to the sink table.
+func (t *changeCaptureToDuckDB) TransferBatch(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.StreamTransferOptions) (*drivers.StreamTransferResult, error) {
+	sinkCfg, err := parseSinkProperties(sinkProps)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Offsets) == 0 {
+		return t.snapshot(ctx, srcProps, sinkCfg.Table, opts)
+	}
+
+	batch, err := t.from.ReadChanges(ctx, opts.StreamID, srcProps, opts.Offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(batch.Changes) > 0 {
+		opts.Progress.Target(int64(len(batch.Changes)), drivers.ProgressUnitRecord)
+		err = t.applyChanges(ctx, sinkCfg.Table, batch, opts.TempDir)
+		if err != nil {
+			return nil, err
+		}
+		opts.Progress.Observe(int64(len(batch.Changes)), drivers.ProgressUnitRecord)
+	}
+
+	t.logger.Debug("applied change batch", zap.String("table", sinkCfg.Table), zap.Int("changes", len(batch.Changes)))
+	return &drivers.StreamTransferResult{
+		Offsets: batch.Offsets,
+		Rows:    int64(len(batch.Changes)),
+	}, nil
+}
+
+// CloseStream implements drivers.StreamCloser.
+func (t *changeCaptureToDuckDB) CloseStream(ctx context.Context, srcProps map[string]any, streamID string) error {
+	return t.from.StopChangeCapture(ctx, streamID, srcProps)
+}
+
+// snapshot starts capturing changes and then copies the current rows of the source table into the sink table.
+func (t *changeCaptureToDuckDB) snapshot(ctx context.Context, srcProps map[string]any, table string, opts *drivers.StreamTransferOptions) (*drivers.StreamTransferResult, error) {
+	start, err := t.from.StartChangeCapture(ctx, opts.StreamID, srcProps)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := t.from.Query(ctx, start.SnapshotProps)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	counter := &countingRowIterator{RowIterator: iter}
+	err = t.transferFromRowIterator(ctx, counter, table, opts.Progress)
+	if err != nil {
+		return nil, err
+	}
+
+	t.logger.Debug("ingested snapshot for change data capture", zap.String("table", table), zap.Int64("rows", counter.rows))
+	return &drivers.StreamTransferResult{
+		Offsets: start.Offsets,
+		Rows:    counter.rows,
+	}, nil
+}
+
+// applyChanges applies a batch of changes to the table.
+// The changes are collapsed to the final state of each affected row, written to a file in tempDir, staged in a temporary table with the sink table's column types,
+// and then the affected rows are deleted and re-inserted in a single transaction.
+func (t *changeCaptureToDuckDB) applyChanges(ctx context.Context, table string, batch *drivers.ChangeBatch, tempDir string) error {
+	if len(batch.PrimaryKey) == 0 {
+		return fmt.Errorf("cannot apply changes to %q: the source table has no primary key", table)
+	}
+
+	truncate, rows, err := collapseChanges(batch)
+	if err != nil {
+		return err
+	}
+
+	target, err := t.to.tableTarget(table)
+	if err != nil {
+		return err
+	}
+
+	cols, err := t.columnTypes(ctx, table)
+	if err != nil {
+		return err
+	}
+	for _, key := range batch.PrimaryKey {
+		if _, ok := cols[key]; !ok {
+			return fmt.Errorf("primary key column %q not found in table %q", key, table)
+		}
+	}
+
+	var staged string
+	if len(rows) > 0 {
+		path, err := writeChangesFile(tempDir, rows, cols)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(path)
+
+		staged = stagedChangesQuery(path, cols)
+	}
+
+	var where strings.Builder
+	for i, key := range batch.PrimaryKey {
+		if i > 0 {
+			where.WriteString(" AND ")
+		}
+		key = safeSQLName(key)
+		fmt.Fprintf(&where, "target.%s IS NOT DISTINCT FROM tmp.%s", key, key)
+	}
+
+	// The temporary table is bound to the connection, so all statements must run on the same connection.
+	tmp := safeSQLName("__rill_tmp_cdc_" + uuid.NewString())
+	return t.to.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		if staged != "" {
+			err := t.to.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("CREATE OR REPLACE TEMPORARY TABLE %s AS (%s\n)", tmp, staged)})
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = t.to.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", tmp)})
+			}()
+		}
+
+		err := t.to.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION"})
+		if err != nil {
+			return err
+		}
+		if truncate {
+			err = t.to.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("DELETE FROM %s", target)})
+		}
+		if err == nil && staged != "" {
+			err = t.to.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("DELETE FROM %s AS target WHERE EXISTS (SELECT 1 FROM %s AS tmp WHERE %s)", target, tmp, where.String())})
+			if err == nil {
+				err = t.to.execWithLimits(ctx, &drivers.Statement{Query: fmt.Sprintf("INSERT INTO %s BY NAME SELECT * EXCLUDE (%s) FROM %s WHERE NOT %s", target, safeSQLName(_cdcDeletedColumn), tmp, safeSQLName(_cdcDeletedColumn))})
+			}
+		}
+		if err != nil {
+			_ = t.to.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK"})
+			return err
+		}
+		return t.to.Exec(ctx, &drivers.Statement{Query: "COMMIT"})
+	})
+}
+
+// columnTypes returns the DuckDB types of the table's columns.
+func (t *changeCaptureToDuckDB) columnTypes(ctx context.Context, table string) (map[string]string, error) {
+	res, err := t.to.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT column_name, column_type FROM (DESCRIBE SELECT * FROM %s)", safeSQLName(table)),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	cols := make(map[string]string)
+	for res.Next() {
+		var name, typ string
+		if err := res.Scan(&name, &typ); err != nil {
+			return nil, err
+		}
+		cols[name] = typ
+	}
+	return cols, res.Err()
+}
+
+// collapseChanges reduces the changes to the final state of each affected row, in order of first change.
+// Deleted rows are returned with the _cdcDeletedColumn set. It also returns true if the table was truncated before the returned rows.
+func collapseChanges(batch *drivers.ChangeBatch) (bool, []map[string]any, error) {
+	truncate := false
+	var keys []string
+	rows := make(map[string]map[string]any)
+	for _, change := range batch.Changes {
+		if change.Truncate {
+			truncate = true
+			keys = nil
+			rows = make(map[string]map[string]any)
+			continue
+		}
+
+		keyVals := make([]any, len(batch.PrimaryKey))
+		for i, col := range batch.PrimaryKey {
+			v, ok := change.Row[col]
+			if !ok {
+				return false, nil, fmt.Errorf("change is missing primary key column %q", col)
+			}
+			keyVals[i] = v
+		}
+		b, err := json.Marshal(keyVals)
+		if err != nil {
+			return false, nil, err
+		}
+		key := string(b)
+
+		if _, ok := rows[key]; !ok {
+			keys = append(keys, key)
+		}
+		row := change.Row
+		if change.Delete {
+			row = make(map[string]any, len(batch.PrimaryKey)+1)
+			for _, col := range batch.PrimaryKey {
+				row[col] = change.Row[col]
+			}
+			row[_cdcDeletedColumn] = true
+		}
+		rows[key] = row
+	}
+
+	res := make([]map[string]any, len(keys))
+	for i, key := range keys {
+		res[i] = rows[key]
+	}
+	return truncate, res, nil
+}
+
+// writeChangesFile writes rows to a temporary newline-delimited JSON file in dir, keeping only the sink table's columns.
+func writeChangesFile(dir string, rows []map[string]any, cols map[string]string) (string, error) {
+	f, err := os.CreateTemp(dir, "cdc-*.ndjson")
+	if err != nil {
+		return "", err
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		obj := make(map[string]any, len(row))
+		for k, v := range row {
+			if _, ok := cols[k]; ok || k == _cdcDeletedColumn {
+				obj[k] = v
+			}
+		}
+		if obj[_cdcDeletedColumn] == nil {
+			obj[_cdcDeletedColumn] = false
+		}
+		err = enc.Encode(obj)
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// stagedChangesQuery returns a query that reads the changes file written by writeChangesFile and casts the values to the sink table's column types.
+func stagedChangesQuery(path string, cols map[string]string) string {
+	var selects, columns []string
+	for name, typ := range cols {
+		selects = append(selects, fmt.Sprintf("CAST(%s AS %s) AS %s", safeSQLName(name), typ, safeSQLName(name)))
+		columns = append(columns, fmt.Sprintf("%s: 'VARCHAR'", safeSQLString(name)))
+	}
+	selects = append(selects, safeSQLName(_cdcDeletedColumn))
+	columns = append(columns, fmt.Sprintf("%s: 'BOOLEAN'", safeSQLString(_cdcDeletedColumn)))
+	return fmt.Sprintf("SELECT %s FROM read_json(%s, format='newline_delimited', columns={%s})", strings.Join(selects, ", "), safeSQLString(path), strings.Join(columns, ", "))
+}
+
+// countingRowIterator counts the rows returned by a drivers.RowIterator.
+type countingRowIterator struct {
+	drivers.RowIterator
+	rows int64
+}
+
+func (i *countingRowIterator) Next(ctx context.Context) ([]sqldriver.Value, error) {
+	row, err := i.RowIterator.Next(ctx)
+	if err == nil {
+		i.rows++
+	}
+	return row, err
+}
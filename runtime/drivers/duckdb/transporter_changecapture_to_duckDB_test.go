@@ -0,0 +1,257 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCollapseChanges(t *testing.T) {
+	batch := &drivers.ChangeBatch{
+		PrimaryKey: []string{"id"},
+		Changes: []*drivers.RowChange{
+			{Row: map[string]any{"id": "1", "name": "a"}},
+			{Row: map[string]any{"id": "2", "name": "b"}},
+			{Row: map[string]any{"id": "1", "name": "a2"}},
+			{Delete: true, Row: map[string]any{"id": "2", "name": "b"}},
+			{Row: map[string]any{"id": "3", "name": nil}},
+		},
+	}
+	truncate, rows, err := collapseChanges(batch)
+	require.NoError(t, err)
+	require.False(t, truncate)
+	require.Equal(t, []map[string]any{
+		{"id": "1", "name": "a2"},
+		{"id": "2", _cdcDeletedColumn: true},
+		{"id": "3", "name": nil},
+	}, rows)
+
+	batch.Changes = append(batch.Changes, &drivers.RowChange{Truncate: true}, &drivers.RowChange{Row: map[string]any{"id": "4", "name": "d"}})
+	truncate, rows, err = collapseChanges(batch)
+	require.NoError(t, err)
+	require.True(t, truncate)
+	require.Equal(t, []map[string]any{{"id": "4", "name": "d"}}, rows)
+
+	batch.Changes = []*drivers.RowChange{{Row: map[string]any{"name": "x"}}}
+	_, _, err = collapseChanges(batch)
+	require.ErrorContains(t, err, `missing primary key column "id"`)
+}
+
+func TestChangeCaptureToDuckDB(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeChangeCaptureStore{
+		schema: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32}},
+			{Name: "name", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+			{Name: "ts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+		}},
+		snapshot: [][]sqldriver.Value{
+			{int32(1), "a", nil},
+			{int32(2), "b", nil},
+		},
+		batches: [][]*drivers.RowChange{
+			{
+				{Row: map[string]any{"id": "1", "name": "a2", "ts": "2024-01-01 10:00:00", "dropped": "x"}},
+				{Delete: true, Row: map[string]any{"id": "2"}},
+				{Row: map[string]any{"id": "3", "name": nil, "ts": nil}},
+			},
+			{
+				{Truncate: true},
+				{Row: map[string]any{"id": "4", "name": "d", "ts": nil}},
+			},
+		},
+	}
+
+	conn, err := Driver{}.Open(map[string]any{}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	olap, _ := conn.AsOLAP("")
+	st := NewChangeCaptureToDuckDB(store, conn.(*connection), zap.NewNop()).(drivers.StreamingTransporter)
+
+	requireSink := func(want string) {
+		res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT string_agg(concat_ws(':', id, name, ts), ',' ORDER BY id) FROM sink"})
+		require.NoError(t, err)
+		defer res.Close()
+		var got sql.NullString
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&got))
+		require.Equal(t, want, got.String)
+	}
+
+	sinkProps := map[string]any{"table": "sink"}
+	opts := &drivers.StreamTransferOptions{TransferOptions: drivers.TransferOptions{Progress: drivers.NoOpProgress{}}}
+	res, err := st.TransferBatch(ctx, nil, sinkProps, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Rows)
+	requireSink("1:a,2:b")
+
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, nil, sinkProps, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Rows)
+	requireSink("1:a2:2024-01-01 10:00:00,3")
+
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, nil, sinkProps, opts)
+	require.NoError(t, err)
+	requireSink("4:d")
+
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, nil, sinkProps, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Rows)
+	requireSink("4:d")
+
+	// Closing the stream stops change capture for the stream
+	err = st.(drivers.StreamCloser).CloseStream(ctx, nil, "instance_source")
+	require.NoError(t, err)
+	require.Equal(t, []string{"instance_source"}, store.stopped)
+}
+
+// fakeChangeCaptureStore serves a fixed snapshot followed by a fixed sequence of change batches.
+type fakeChangeCaptureStore struct {
+	schema   *runtimev1.StructType
+	snapshot [][]sqldriver.Value
+	batches  [][]*drivers.RowChange
+	stopped  []string
+}
+
+var _ drivers.ChangeCaptureStore = &fakeChangeCaptureStore{}
+
+func (s *fakeChangeCaptureStore) Query(ctx context.Context, props map[string]any) (drivers.RowIterator, error) {
+	return &fakeRowIterator{schema: s.schema, rows: s.snapshot}, nil
+}
+
+func (s *fakeChangeCaptureStore) QueryAsFiles(ctx context.Context, props map[string]any, opt *drivers.QueryOption, p drivers.Progress) (drivers.FileIterator, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (s *fakeChangeCaptureStore) StartChangeCapture(ctx context.Context, streamID string, props map[string]any) (*drivers.ChangeCaptureStart, error) {
+	return &drivers.ChangeCaptureStart{Offsets: map[string]int64{"pos": 0}}, nil
+}
+
+func (s *fakeChangeCaptureStore) StopChangeCapture(ctx context.Context, streamID string, props map[string]any) error {
+	s.stopped = append(s.stopped, streamID)
+	return nil
+}
+
+func (s *fakeChangeCaptureStore) ReadChanges(ctx context.Context, streamID string, props map[string]any, offsets map[string]int64) (*drivers.ChangeBatch, error) {
+	pos := offsets["pos"]
+	batch := &drivers.ChangeBatch{PrimaryKey: []string{"id"}, Offsets: map[string]int64{"pos": pos}}
+	if int(pos) < len(s.batches) {
+		batch.Changes = s.batches[pos]
+		batch.Offsets["pos"] = pos + 1
+	}
+	return batch, nil
+}
+
+type fakeRowIterator struct {
+	schema *runtimev1.StructType
+	rows   [][]sqldriver.Value
+}
+
+func (i *fakeRowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	return i.schema, nil
+}
+
+func (i *fakeRowIterator) Next(ctx context.Context) ([]sqldriver.Value, error) {
+	if len(i.rows) == 0 {
+		return nil, drivers.ErrIteratorDone
+	}
+	row := i.rows[0]
+	i.rows = i.rows[1:]
+	return row, nil
+}
+
+func (i *fakeRowIterator) Close() error {
+	return nil
+}
+
+func (i *fakeRowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
+
+// changeCaptureTest takes a snapshot of a table with change data capture and then applies inserts, updates, deletes and truncates to the sink.
+func changeCaptureTest(t *testing.T, db *sql.DB, driver string, config, srcProps map[string]any) {
+	ctx := context.Background()
+	exec := func(query string) {
+		_, err := db.ExecContext(ctx, query)
+		require.NoError(t, err)
+	}
+	exec("CREATE TABLE cdc_items (id INT PRIMARY KEY, name VARCHAR(100))")
+	exec("INSERT INTO cdc_items VALUES (1, 'a'), (2, 'b')")
+
+	from, err := drivers.Open(driver, config, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	to, err := drivers.Open("duckdb", map[string]any{"dsn": ""}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer to.Close()
+	olap, _ := to.AsOLAP("")
+
+	tr, ok := to.AsTransporter(from, to)
+	require.True(t, ok)
+	st, ok := tr.(drivers.StreamingTransporter)
+	require.True(t, ok)
+
+	requireSink := func(want [][]any) {
+		res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT CAST(id AS INTEGER), name FROM sink ORDER BY id"})
+		require.NoError(t, err)
+		defer res.Close()
+		var got [][]any
+		for res.Next() {
+			var id int
+			var name string
+			require.NoError(t, res.Scan(&id, &name))
+			got = append(got, []any{id, name})
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, want, got)
+	}
+
+	sinkProps := map[string]any{"table": "sink"}
+	opts := &drivers.StreamTransferOptions{TransferOptions: drivers.TransferOptions{Progress: drivers.NoOpProgress{}}, StreamID: "instance_cdc_items"}
+	res, err := st.TransferBatch(ctx, srcProps, sinkProps, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Rows)
+	require.NotEmpty(t, res.Offsets)
+	requireSink([][]any{{1, "a"}, {2, "b"}})
+
+	exec("INSERT INTO cdc_items VALUES (3, 'c')")
+	exec("UPDATE cdc_items SET name = 'a2' WHERE id = 1")
+	exec("UPDATE cdc_items SET id = 4 WHERE id = 2")
+	exec("DELETE FROM cdc_items WHERE id = 3")
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, srcProps, sinkProps, opts)
+	require.NoError(t, err)
+	require.Greater(t, res.Rows, int64(0))
+	requireSink([][]any{{1, "a2"}, {4, "b"}})
+
+	// Reading again from the same offsets is idempotent
+	res, err = st.TransferBatch(ctx, srcProps, sinkProps, opts)
+	require.NoError(t, err)
+	requireSink([][]any{{1, "a2"}, {4, "b"}})
+
+	exec("TRUNCATE TABLE cdc_items")
+	exec("INSERT INTO cdc_items VALUES (5, 'e')")
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, srcProps, sinkProps, opts)
+	require.NoError(t, err)
+	requireSink([][]any{{5, "e"}})
+
+	// No changes
+	opts.Offsets = res.Offsets
+	res, err = st.TransferBatch(ctx, srcProps, sinkProps, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Rows)
+	requireSink([][]any{{5, "e"}})
+
+	err = st.(drivers.StreamCloser).CloseStream(ctx, srcProps, opts.StreamID)
+	require.NoError(t, err)
+}
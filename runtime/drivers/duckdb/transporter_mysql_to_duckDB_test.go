@@ -94,6 +94,11 @@ func TestMySQLToDuckDBTransfer(t *testing.T) {
 	defer db.Close()
 
 	t.Run("AllDataTypes", func(t *testing.T) { allMySQLDataTypesTest(t, db, dsn) })
+	t.Run("ChangeCapture", func(t *testing.T) {
+		// Reading the binary log requires replication privileges
+		rootDSN := fmt.Sprintf("root:mypassword@tcp(%s:%d)/mydb", host, port.Int())
+		changeCaptureTest(t, db, "mysql", map[string]any{"dsn": rootDSN}, map[string]any{"cdc": true, "table": "cdc_items"})
+	})
 }
 
 func allMySQLDataTypesTest(t *testing.T, db *sql.DB, dsn string) {
@@ -59,6 +59,16 @@ func TestTransfer(t *testing.T) {
 	defer db.Close()
 
 	t.Run("AllDataTypes", func(t *testing.T) { allDataTypesTest(t, db, pg.DatabaseURL) })
+	t.Run("ChangeCapture", func(t *testing.T) {
+		changeCaptureTest(t, db, "postgres", map[string]any{"database_url": pg.DatabaseURL}, map[string]any{"cdc": true, "table": "cdc_items"})
+
+		// Closing the stream drops the replication slot and publication
+		var slots, publications int
+		require.NoError(t, db.QueryRow("SELECT count(*) FROM pg_replication_slots").Scan(&slots))
+		require.NoError(t, db.QueryRow("SELECT count(*) FROM pg_publication").Scan(&publications))
+		require.Equal(t, 0, slots)
+		require.Equal(t, 0, publications)
+	})
 }
 
 func allDataTypesTest(t *testing.T, db *sql.DB, dbURL string) {
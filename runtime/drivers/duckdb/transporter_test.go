@@ -23,6 +23,10 @@ func (m *mockObjectStore) DownloadFiles(ctx context.Context, srcProps map[string
 	return m.mockIterator, nil
 }
 
+func (m *mockObjectStore) ListObjectsForGlob(ctx context.Context, glob string) ([]drivers.ObjectStoreEntry, error) {
+	return nil, nil
+}
+
 type mockIterator struct {
 	batches [][]string
 	index   int
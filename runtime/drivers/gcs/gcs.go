@@ -241,6 +241,11 @@ func (c *Connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *Connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP implements drivers.Connection.
 func (c *Connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return nil, false
@@ -334,6 +339,35 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 	return iter, err
 }
 
+// ListObjectsForGlob implements drivers.ObjectStore.
+func (c *Connection) ListObjectsForGlob(ctx context.Context, glob string) ([]drivers.ObjectStoreEntry, error) {
+	conf, err := parseSourceProperties(map[string]any{"path": glob})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	client, err := c.createClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketObj, err := gcsblob.OpenBucket(ctx, client, conf.url.Host, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %q, %w", conf.url.Host, err)
+	}
+	defer bucketObj.Close()
+
+	entries, err := rillblob.ListObjects(ctx, bucketObj, conf.url, rillblob.Options{})
+	if err != nil {
+		apiError := &googleapi.Error{}
+		if errors.As(err, &apiError) && apiError.Code == http.StatusUnauthorized {
+			return nil, drivers.NewPermissionDeniedError(fmt.Sprintf("can't access remote err: %v", apiError))
+		}
+		return nil, err
+	}
+	return entries, nil
+}
+
 func (c *Connection) createClient(ctx context.Context) (*gcp.HTTPClient, error) {
 	creds, err := gcputil.Credentials(ctx, c.config.SecretJSON, c.config.AllowHostAccess)
 	if err != nil {
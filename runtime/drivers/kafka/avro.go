@@ -0,0 +1,361 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// avroSchema is a parsed Avro schema.
+// It only retains the information needed to decode values in the Avro binary encoding.
+type avroSchema struct {
+	typ      string
+	logical  string
+	fields   []avroField
+	symbols  []string
+	items    *avroSchema
+	values   *avroSchema
+	branches []*avroSchema
+	size     int
+	scale    int
+}
+
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+var avroPrimitives = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"int":     true,
+	"long":    true,
+	"float":   true,
+	"double":  true,
+	"bytes":   true,
+	"string":  true,
+}
+
+// parseAvroSchema parses an Avro schema from its JSON representation.
+func parseAvroSchema(schema string) (*avroSchema, error) {
+	var v any
+	err := json.Unmarshal([]byte(schema), &v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+	return (&avroParser{names: make(map[string]*avroSchema)}).parse(v, "")
+}
+
+// avroParser tracks named types while parsing a schema.
+type avroParser struct {
+	names map[string]*avroSchema
+}
+
+func (p *avroParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch v := v.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroSchema{typ: v}, nil
+		}
+		if s, ok := p.names[v]; ok {
+			return s, nil
+		}
+		if s, ok := p.names[fullName(v, namespace)]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("unknown Avro type %q", v)
+	case []any:
+		s := &avroSchema{typ: "union"}
+		for _, b := range v {
+			bs, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, bs)
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseComplex(v, namespace)
+	default:
+		return nil, fmt.Errorf("invalid Avro schema type %T", v)
+	}
+}
+
+func (p *avroParser) parseComplex(v map[string]any, namespace string) (*avroSchema, error) {
+	// The type may itself be a nested schema, e.g. {"type": {"type": "string"}}
+	typ, ok := v["type"].(string)
+	if !ok {
+		return p.parse(v["type"], namespace)
+	}
+
+	logical, _ := v["logicalType"].(string)
+	scale, _ := v["scale"].(float64)
+	s := &avroSchema{typ: typ, logical: logical, scale: int(scale)}
+
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		name, _ := v["name"].(string)
+		if ns, ok := v["namespace"].(string); ok {
+			namespace = ns
+		}
+		name = fullName(name, namespace)
+		if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
+			namespace = name[:idx]
+		}
+		// Register before parsing fields to support recursive types
+		p.names[name] = s
+	}
+
+	switch typ {
+	case "record", "error":
+		s.typ = "record"
+		fields, _ := v["fields"].([]any)
+		for _, f := range fields {
+			fm, ok := f.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid Avro record field")
+			}
+			name, _ := fm["name"].(string)
+			fs, err := p.parse(fm["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", name, err)
+			}
+			s.fields = append(s.fields, avroField{name: name, schema: fs})
+		}
+	case "enum":
+		symbols, _ := v["symbols"].([]any)
+		for _, sym := range symbols {
+			str, _ := sym.(string)
+			s.symbols = append(s.symbols, str)
+		}
+	case "array":
+		items, err := p.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = items
+	case "map":
+		values, err := p.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.values = values
+	case "fixed":
+		size, _ := v["size"].(float64)
+		s.size = int(size)
+	default:
+		if !avroPrimitives[typ] {
+			return p.parse(typ, namespace)
+		}
+	}
+
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if strings.ContainsRune(name, '.') || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// avroDecoder decodes values in the Avro binary encoding.
+type avroDecoder struct {
+	data []byte
+	pos  int
+}
+
+// decodeAvro decodes a single value with the given schema.
+// Records are decoded to map[string]any and logical timestamp and date types are decoded to time.Time.
+func decodeAvro(data []byte, s *avroSchema) (any, error) {
+	d := &avroDecoder{data: data}
+	return d.decode(s)
+}
+
+func (d *avroDecoder) decode(s *avroSchema) (any, error) {
+	switch s.typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		b, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		return b != 0, nil
+	case "int", "long":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		switch s.logical {
+		case "date":
+			return time.Unix(n*86400, 0).UTC(), nil
+		case "timestamp-millis", "local-timestamp-millis":
+			return time.UnixMilli(n).UTC(), nil
+		case "timestamp-micros", "local-timestamp-micros":
+			return time.UnixMicro(n).UTC(), nil
+		}
+		return n, nil
+	case "float":
+		b, err := d.read(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case "double":
+		b, err := d.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case "bytes", "string":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.read(int(n))
+		if err != nil {
+			return nil, err
+		}
+		if s.typ == "string" {
+			return string(b), nil
+		}
+		if s.logical == "decimal" {
+			return decimalValue(b, s.scale), nil
+		}
+		return b, nil
+	case "fixed":
+		b, err := d.read(s.size)
+		if err != nil {
+			return nil, err
+		}
+		if s.logical == "decimal" {
+			return decimalValue(b, s.scale), nil
+		}
+		return b, nil
+	case "enum":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || int(n) >= len(s.symbols) {
+			return nil, fmt.Errorf("invalid enum index %d", n)
+		}
+		return s.symbols[n], nil
+	case "union":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || int(n) >= len(s.branches) {
+			return nil, fmt.Errorf("invalid union index %d", n)
+		}
+		return d.decode(s.branches[n])
+	case "array":
+		res := []any{}
+		err := d.readBlocks(func() error {
+			v, err := d.decode(s.items)
+			if err != nil {
+				return err
+			}
+			res = append(res, v)
+			return nil
+		})
+		return res, err
+	case "map":
+		res := map[string]any{}
+		err := d.readBlocks(func() error {
+			k, err := d.decode(&avroSchema{typ: "string"})
+			if err != nil {
+				return err
+			}
+			v, err := d.decode(s.values)
+			if err != nil {
+				return err
+			}
+			res[k.(string)] = v
+			return nil
+		})
+		return res, err
+	case "record":
+		res := make(map[string]any, len(s.fields))
+		for _, f := range s.fields {
+			v, err := d.decode(f.schema)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", f.name, err)
+			}
+			res[f.name] = v
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported Avro type %q", s.typ)
+	}
+}
+
+// readBlocks reads the blocks of an array or map, calling fn for each item.
+func (d *avroDecoder) readBlocks(fn func() error) error {
+	for {
+		n, err := d.readLong()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if n < 0 {
+			// A negative count is followed by the block's size in bytes
+			n = -n
+			if _, err := d.readLong(); err != nil {
+				return err
+			}
+		}
+		for i := int64(0); i < n; i++ {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readLong reads a zig-zag encoded variable-length integer.
+func (d *avroDecoder) readLong() (int64, error) {
+	v, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid Avro varint")
+	}
+	d.pos += n
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (d *avroDecoder) readByte() (byte, error) {
+	b, err := d.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *avroDecoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// decimalValue converts a big-endian two's complement unscaled integer to a JSON number with the given scale.
+func decimalValue(b []byte, scale int) json.Number {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	r := new(big.Rat).SetFrac(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	return json.Number(r.FloatString(scale))
+}
@@ -0,0 +1,270 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("kafka", driver{})
+	drivers.RegisterAsConnector("kafka", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName:         "Kafka",
+	Description:         "Continuously ingest messages from a Kafka topic.",
+	ImplementsStreaming: true,
+	SourceProperties: []drivers.PropertySchema{
+		{
+			Key:         "topic",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Topic",
+			Description: "Name of the Kafka topic to consume.",
+			Placeholder: "events",
+		},
+		{
+			Key:         "format",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Format",
+			Description: "Encoding of the message values, either json or avro.",
+			Placeholder: "json",
+			Default:     "json",
+		},
+		{
+			Key:         "start_offset",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Start offset",
+			Description: "Where to start consuming from on the first ingestion, either earliest or latest.",
+			Placeholder: "earliest",
+			Default:     "earliest",
+		},
+		{
+			Key:         "brokers",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Brokers",
+			Description: "Comma-separated list of Kafka brokers.",
+			Placeholder: "localhost:9092",
+			Hint:        "Either set this or pass --var connector.kafka.brokers=... to rill start",
+		},
+	},
+	ConfigProperties: []drivers.PropertySchema{
+		{
+			Key: "brokers",
+		},
+		{
+			Key: "security_protocol",
+		},
+		{
+			Key: "sasl_mechanism",
+		},
+		{
+			Key: "sasl_username",
+		},
+		{
+			Key:    "sasl_password",
+			Secret: true,
+		},
+		{
+			Key: "schema_registry_url",
+		},
+		{
+			Key: "schema_registry_username",
+		},
+		{
+			Key:    "schema_registry_password",
+			Secret: true,
+		},
+	},
+}
+
+type driver struct{}
+
+type configProperties struct {
+	Brokers                string `mapstructure:"brokers"`
+	SecurityProtocol       string `mapstructure:"security_protocol"`
+	SASLMechanism          string `mapstructure:"sasl_mechanism"`
+	SASLUsername           string `mapstructure:"sasl_username"`
+	SASLPassword           string `mapstructure:"sasl_password"`
+	SchemaRegistryURL      string `mapstructure:"schema_registry_url"`
+	SchemaRegistryUsername string `mapstructure:"schema_registry_username"`
+	SchemaRegistryPassword string `mapstructure:"schema_registry_password"`
+}
+
+func (d driver) Open(config map[string]any, shared bool, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if shared {
+		return nil, fmt.Errorf("kafka driver can't be shared")
+	}
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{
+		driverConfig: config,
+		config:       conf,
+		logger:       logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return drivers.ErrDropNotSupported
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type connection struct {
+	driverConfig map[string]any
+	config       *configProperties
+	logger       *zap.Logger
+}
+
+var _ drivers.Handle = &connection{}
+
+// Driver implements drivers.Connection.
+func (c *connection) Driver() string {
+	return "kafka"
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.driverConfig
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+// Messages can be streamed into DuckDB and ClickHouse.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	if from != c {
+		return nil, false
+	}
+	olap, ok := to.AsOLAP("")
+	if !ok {
+		return nil, false
+	}
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB, drivers.DialectClickHouse:
+		return newTransporter(c, olap, c.logger), true
+	}
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// consumerConfig returns the librdkafka configuration for a consumer of the connection's brokers.
+// Offsets are tracked by the caller, so auto commits are disabled.
+func (c *connection) consumerConfig(brokers string) *kafka.ConfigMap {
+	if brokers == "" {
+		brokers = c.config.Brokers
+	}
+	cfg := &kafka.ConfigMap{
+		"bootstrap.servers":    brokers,
+		"group.id":             "rill",
+		"enable.auto.commit":   false,
+		"enable.partition.eof": false,
+	}
+	if c.config.SecurityProtocol != "" {
+		_ = cfg.SetKey("security.protocol", c.config.SecurityProtocol)
+	}
+	if c.config.SASLMechanism != "" {
+		_ = cfg.SetKey("sasl.mechanism", c.config.SASLMechanism)
+	}
+	if c.config.SASLUsername != "" {
+		_ = cfg.SetKey("sasl.username", c.config.SASLUsername)
+		_ = cfg.SetKey("sasl.password", c.config.SASLPassword)
+	}
+	return cfg
+}
+
+// schemaRegistryClient returns a client for the configured schema registry.
+func (c *connection) schemaRegistryClient() (schemaregistry.Client, error) {
+	if c.config.SchemaRegistryURL == "" {
+		return nil, fmt.Errorf(`the "schema_registry_url" connector property is required to decode Avro messages`)
+	}
+	cfg := schemaregistry.NewConfig(c.config.SchemaRegistryURL)
+	if c.config.SchemaRegistryUsername != "" {
+		cfg = schemaregistry.NewConfigWithAuthentication(c.config.SchemaRegistryURL, c.config.SchemaRegistryUsername, c.config.SchemaRegistryPassword)
+	}
+	return schemaregistry.NewClient(cfg)
+}
@@ -0,0 +1,173 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/kafka/kafkatest"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestConsume(t *testing.T) {
+	mc, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mc.Close()
+
+	kafkatest.Produce(t, mc.BootstrapServers(), "events", `{"id":1,"name":"a"}`, `{"id":2,"name":"b"}`, `3`)
+
+	handle, err := driver{}.Open(map[string]any{"brokers": mc.BootstrapServers()}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	tr := newTransporter(handle.(*connection), nil, zap.NewNop())
+
+	cfg, err := parseSourceProperties(map[string]any{"topic": "events", "poll_timeout": "5s"})
+	require.NoError(t, err)
+
+	// Consume from the earliest offset
+	rows, offsets, err := tr.consume(context.Background(), cfg, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"id":1,"name":"a"}`, `{"id":2,"name":"b"}`, `{"value":3}`}, toStrings(rows))
+	require.Equal(t, map[string]int64{"events/0": 3, "events/1": 0, "events/2": 0, "events/3": 0}, offsets)
+
+	// Nothing new to consume
+	rows, offsets, err = tr.consume(context.Background(), cfg, offsets)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+	require.Equal(t, map[string]int64{"events/0": 3, "events/1": 0, "events/2": 0, "events/3": 0}, offsets)
+
+	// Resume from the previous offsets
+	kafkatest.Produce(t, mc.BootstrapServers(), "events", `{"id":4,"name":"d"}`)
+	rows, offsets, err = tr.consume(context.Background(), cfg, offsets)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"id":4,"name":"d"}`}, toStrings(rows))
+	require.Equal(t, map[string]int64{"events/0": 4, "events/1": 0, "events/2": 0, "events/3": 0}, offsets)
+
+	// Batches are limited to max_batch_size
+	cfg.MaxBatchSize = 2
+	rows, offsets, err = tr.consume(context.Background(), cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, map[string]int64{"events/0": 2, "events/1": 0, "events/2": 0, "events/3": 0}, offsets)
+
+	// Start from the latest offset
+	cfg, err = parseSourceProperties(map[string]any{"topic": "events", "start_offset": "latest"})
+	require.NoError(t, err)
+	rows, offsets, err = tr.consume(context.Background(), cfg, nil)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+	require.Equal(t, map[string]int64{"events/0": 4, "events/1": 0, "events/2": 0, "events/3": 0}, offsets)
+}
+
+func TestAsTransporter(t *testing.T) {
+	handle, err := driver{}.Open(map[string]any{"brokers": "localhost:9092"}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	_, ok := handle.AsTransporter(handle, handle)
+	require.False(t, ok)
+}
+
+func TestParseSourceProperties(t *testing.T) {
+	_, err := parseSourceProperties(map[string]any{})
+	require.ErrorContains(t, err, "topic")
+
+	_, err = parseSourceProperties(map[string]any{"topic": "events", "format": "csv"})
+	require.ErrorContains(t, err, "unsupported format")
+
+	cfg, err := parseSourceProperties(map[string]any{"topic": "events", "max_batch_size": "100"})
+	require.NoError(t, err)
+	require.Equal(t, "json", cfg.Format)
+	require.Equal(t, "earliest", cfg.StartOffset)
+	require.Equal(t, 100, cfg.MaxBatchSize)
+}
+
+func TestDecodeAvro(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "Event",
+		"namespace": "com.example",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "name", "type": ["null", "string"]},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["CLICK", "VIEW"]}},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "attrs", "type": {"type": "map", "values": "double"}},
+			{"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+			{"name": "parent", "type": ["null", "Event"]}
+		]
+	}`
+
+	client, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+	require.NoError(t, err)
+	id, err := client.Register("events-value", schemaregistry.SchemaInfo{Schema: schema}, false)
+	require.NoError(t, err)
+
+	// Encode a record in the Avro binary encoding
+	var value []byte
+	value = appendLong(value, 42)                                        // id
+	value = appendLong(value, 1)                                         // name: union branch 1
+	value = appendString(value, "hello")                                 // name
+	value = appendLong(value, 1)                                         // kind: VIEW
+	value = appendLong(value, 2)                                         // tags: block of 2 items
+	value = appendString(value, "x")                                     //
+	value = appendString(value, "y")                                     //
+	value = appendLong(value, 0)                                         // tags: end
+	value = appendLong(value, 1)                                         // attrs: block of 1 item
+	value = appendString(value, "score")                                 //
+	value = binary.LittleEndian.AppendUint64(value, 4607182418800017408) // 1.0
+	value = appendLong(value, 0)                                         // attrs: end
+	value = appendLong(value, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixMilli())
+	value = appendLong(value, 2)      // amount: 2 bytes
+	value = append(value, 0xfe, 0x0c) // -500
+	value = appendLong(value, 0)      // parent: null
+
+	msg := []byte{0}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(id))
+	msg = append(msg, value...)
+
+	dec := &decoder{format: "avro", topic: "events", registry: client, schemas: map[int]*avroSchema{}}
+	res, err := dec.decode(msg)
+	require.NoError(t, err)
+
+	var row map[string]any
+	require.NoError(t, json.Unmarshal(res, &row))
+	require.Equal(t, map[string]any{
+		"id":     float64(42),
+		"name":   "hello",
+		"kind":   "VIEW",
+		"tags":   []any{"x", "y"},
+		"attrs":  map[string]any{"score": float64(1)},
+		"ts":     "2024-01-02T03:04:05Z",
+		"amount": -5.0,
+		"parent": nil,
+	}, row)
+
+	// Invalid wire format
+	_, err = dec.decode([]byte(`{"id":1}`))
+	require.ErrorContains(t, err, "wire format")
+}
+
+var _ drivers.StreamingTransporter = &transporter{}
+
+func toStrings(rows [][]byte) []string {
+	res := make([]string, len(rows))
+	for i, r := range rows {
+		res[i] = string(r)
+	}
+	return res
+}
+
+func appendLong(b []byte, v int64) []byte {
+	return binary.AppendUvarint(b, uint64((v<<1)^(v>>63)))
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendLong(b, int64(len(s)))
+	return append(b, s...)
+}
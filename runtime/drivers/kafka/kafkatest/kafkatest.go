@@ -0,0 +1,30 @@
+// Package kafkatest contains helpers for tests that consume from Kafka.
+package kafkatest
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/require"
+)
+
+// Produce produces messages to partition 0 of the topic and waits for them to be delivered.
+// Topics are automatically created by mock clusters (see kafka.NewMockCluster) with 4 partitions.
+func Produce(t testing.TB, brokers, topic string, values ...string) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer p.Close()
+
+	delivered := make(chan kafka.Event, len(values))
+	for _, v := range values {
+		err := p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(v),
+		}, delivered)
+		require.NoError(t, err)
+	}
+	for range values {
+		e := <-delivered
+		require.NoError(t, e.(*kafka.Message).TopicPartition.Error)
+	}
+}
@@ -0,0 +1,456 @@
+package kafka
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+const (
+	_defaultMaxBatchSize = 10000
+	_defaultPollTimeout  = time.Second
+	_metadataTimeoutMs   = 10000
+	// _clickhouseMaxInsertBytes limits the size of inline data in a single ClickHouse statement (the default max_query_size is 256 KiB).
+	_clickhouseMaxInsertBytes = 200 << 10
+)
+
+type sourceProperties struct {
+	Topic        string `mapstructure:"topic"`
+	Brokers      string `mapstructure:"brokers"`
+	Format       string `mapstructure:"format"`
+	StartOffset  string `mapstructure:"start_offset"`
+	MaxBatchSize int    `mapstructure:"max_batch_size"`
+	PollTimeout  string `mapstructure:"poll_timeout"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Topic == "" {
+		return nil, fmt.Errorf(`property "topic" is required`)
+	}
+	switch conf.Format {
+	case "":
+		conf.Format = "json"
+	case "json", "avro":
+	default:
+		return nil, fmt.Errorf("unsupported format %q, must be one of json or avro", conf.Format)
+	}
+	switch conf.StartOffset {
+	case "":
+		conf.StartOffset = "earliest"
+	case "earliest", "latest":
+	default:
+		return nil, fmt.Errorf("invalid start_offset %q, must be one of earliest or latest", conf.StartOffset)
+	}
+	if conf.MaxBatchSize <= 0 {
+		conf.MaxBatchSize = _defaultMaxBatchSize
+	}
+	return conf, nil
+}
+
+type sinkProperties struct {
+	Table string `mapstructure:"table"`
+}
+
+type transporter struct {
+	from   *connection
+	to     drivers.OLAPStore
+	logger *zap.Logger
+}
+
+var _ drivers.StreamingTransporter = &transporter{}
+
+func newTransporter(from *connection, to drivers.OLAPStore, logger *zap.Logger) *transporter {
+	return &transporter{
+		from:   from,
+		to:     to,
+		logger: logger,
+	}
+}
+
+// Transfer implements drivers.Transporter.
+// It ingests the messages currently in the topic into a new table.
+func (t *transporter) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	_, err := t.TransferBatch(ctx, srcProps, sinkProps, &drivers.StreamTransferOptions{TransferOptions: *opts})
+	return err
+}
+
+// TransferBatch implements drivers.StreamingTransporter.
+// It consumes the messages produced since opts.Offsets (up to max_batch_size) and appends them to the sink table.
+// The sink table is created from the first non-empty batch.
+func (t *transporter) TransferBatch(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.StreamTransferOptions) (*drivers.StreamTransferResult, error) {
+	srcCfg, err := parseSourceProperties(srcProps)
+	if err != nil {
+		return nil, err
+	}
+	sinkCfg := &sinkProperties{}
+	if err := mapstructure.Decode(sinkProps, sinkCfg); err != nil {
+		return nil, err
+	}
+
+	rows, offsets, err := t.consume(ctx, srcCfg, opts.Offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > 0 {
+		opts.Progress.Target(int64(len(rows)), drivers.ProgressUnitRecord)
+		err = t.append(ctx, sinkCfg.Table, rows, opts.TempDir)
+		if err != nil {
+			return nil, err
+		}
+		opts.Progress.Observe(int64(len(rows)), drivers.ProgressUnitRecord)
+	}
+
+	t.logger.Debug("ingested kafka batch", zap.String("topic", srcCfg.Topic), zap.Int("rows", len(rows)))
+	return &drivers.StreamTransferResult{
+		Offsets: offsets,
+		Rows:    int64(len(rows)),
+	}, nil
+}
+
+// consume reads a micro-batch of messages from the topic, starting at the given offsets.
+// It stops when it has caught up with the high watermarks at the time of the call, after max_batch_size messages,
+// or when no messages have been received for poll_timeout.
+// It returns the decoded rows and the offsets to resume from.
+func (t *transporter) consume(ctx context.Context, cfg *sourceProperties, offsets map[string]int64) ([][]byte, map[string]int64, error) {
+	pollTimeout := _defaultPollTimeout
+	if cfg.PollTimeout != "" {
+		d, err := time.ParseDuration(cfg.PollTimeout)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid poll_timeout: %w", err)
+		}
+		pollTimeout = d
+	}
+
+	dec, err := t.newDecoder(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	consumer, err := kafka.NewConsumer(t.from.consumerConfig(cfg.Brokers))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create kafka consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	md, err := consumer.GetMetadata(&cfg.Topic, false, _metadataTimeoutMs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get metadata for topic %q: %w", cfg.Topic, err)
+	}
+	topic, ok := md.Topics[cfg.Topic]
+	if !ok {
+		return nil, nil, fmt.Errorf("topic %q not found", cfg.Topic)
+	}
+	if topic.Error.Code() != kafka.ErrNoError {
+		return nil, nil, fmt.Errorf("failed to get metadata for topic %q: %w", cfg.Topic, topic.Error)
+	}
+
+	// Resolve the start and end offset of each partition
+	next := make(map[string]int64, len(topic.Partitions))
+	end := make(map[string]int64, len(topic.Partitions))
+	var assignment []kafka.TopicPartition
+	for _, p := range topic.Partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(cfg.Topic, p.ID, _metadataTimeoutMs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get offsets for topic %q: %w", cfg.Topic, err)
+		}
+
+		key := partitionKey(cfg.Topic, p.ID)
+		start, ok := offsets[key]
+		if !ok {
+			if cfg.StartOffset == "latest" {
+				start = high
+			} else {
+				start = low
+			}
+		}
+		if start < low {
+			// The messages were deleted by the retention policy before they were ingested
+			t.logger.Warn("kafka offset out of range, skipping to the earliest available message", zap.String("topic", cfg.Topic), zap.Int32("partition", p.ID), zap.Int64("offset", start), zap.Int64("earliest", low))
+			start = low
+		}
+
+		next[key] = start
+		if start < high {
+			end[key] = high
+			assignment = append(assignment, kafka.TopicPartition{Topic: &cfg.Topic, Partition: p.ID, Offset: kafka.Offset(start)})
+		}
+	}
+
+	if len(assignment) == 0 {
+		return nil, next, nil
+	}
+
+	err = consumer.Assign(assignment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to assign partitions: %w", err)
+	}
+
+	var rows [][]byte
+	lastMessage := time.Now()
+	for len(end) > 0 && len(rows) < cfg.MaxBatchSize {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+
+		ev := consumer.Poll(100)
+		switch e := ev.(type) {
+		case *kafka.Message:
+			if e.TopicPartition.Error != nil {
+				return nil, nil, e.TopicPartition.Error
+			}
+			lastMessage = time.Now()
+
+			offset := int64(e.TopicPartition.Offset)
+			key := partitionKey(cfg.Topic, e.TopicPartition.Partition)
+			next[key] = offset + 1
+			if offset+1 >= end[key] {
+				delete(end, key)
+			}
+
+			// Skip tombstones
+			if e.Value == nil {
+				continue
+			}
+			row, err := dec.decode(e.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to decode message at partition %d offset %d: %w", e.TopicPartition.Partition, offset, err)
+			}
+			rows = append(rows, row)
+		case kafka.Error:
+			// The client recovers from non-fatal errors by itself
+			if e.IsFatal() {
+				return nil, nil, fmt.Errorf("kafka consumer error: %w", e)
+			}
+			t.logger.Warn("kafka consumer error", zap.Error(e))
+		case nil:
+			// Partitions may end with offsets that are not delivered as messages (such as transaction markers),
+			// so we give up waiting for the high watermarks after a while.
+			if time.Since(lastMessage) > pollTimeout {
+				return rows, next, nil
+			}
+		}
+	}
+
+	return rows, next, nil
+}
+
+// append appends JSON encoded rows to the sink table, creating it if it doesn't exist.
+// Temporary files are created in tempDir.
+func (t *transporter) append(ctx context.Context, table string, rows [][]byte, tempDir string) error {
+	_, err := t.to.InformationSchema().Lookup(ctx, table)
+	exists := err == nil
+	if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+		return err
+	}
+
+	switch t.to.Dialect() {
+	case drivers.DialectDuckDB:
+		return t.appendDuckDB(ctx, table, exists, rows, tempDir)
+	case drivers.DialectClickHouse:
+		return t.appendClickHouse(ctx, table, exists, rows)
+	default:
+		return fmt.Errorf("kafka: unsupported sink dialect %q", t.to.Dialect().String())
+	}
+}
+
+// appendDuckDB writes the rows to a temporary newline-delimited JSON file and loads it with read_json_auto.
+func (t *transporter) appendDuckDB(ctx context.Context, table string, exists bool, rows [][]byte, tempDir string) error {
+	f, err := os.CreateTemp(tempDir, "kafka-*.ndjson")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	for _, row := range rows {
+		_, _ = w.Write(row)
+		_ = w.WriteByte('\n')
+	}
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf("SELECT * FROM read_json_auto(%s, format='newline_delimited', sample_size=-1)", duckDBString(f.Name()))
+	if !exists {
+		return t.to.CreateTableAsSelect(ctx, table, false, sql)
+	}
+	return t.to.InsertTableAsSelect(ctx, table, true, sql, drivers.IncrementalStrategyAppend, nil)
+}
+
+// appendClickHouse inserts the rows as inline JSONEachRow data.
+// The rows are split into chunks to stay within ClickHouse's maximum query size.
+func (t *transporter) appendClickHouse(ctx context.Context, table string, exists bool, rows [][]byte) error {
+	for len(rows) > 0 {
+		var buf bytes.Buffer
+		n := 0
+		for n < len(rows) && (n == 0 || buf.Len()+len(rows[n]) < _clickhouseMaxInsertBytes) {
+			buf.Write(rows[n])
+			buf.WriteByte('\n')
+			n++
+		}
+		rows = rows[n:]
+
+		if !exists {
+			err := t.to.CreateTableAsSelect(ctx, table, false, fmt.Sprintf("SELECT * FROM format(JSONEachRow, %s)", clickHouseString(buf.String())))
+			if err != nil {
+				return err
+			}
+			exists = true
+			continue
+		}
+
+		// Use the table's structure to parse the data so columns are matched by name
+		structure, err := t.clickHouseStructure(ctx, table)
+		if err != nil {
+			return err
+		}
+		err = t.to.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("INSERT INTO %s SELECT * FROM format(JSONEachRow, %s, %s)", drivers.DialectClickHouse.EscapeIdentifier(table), clickHouseString(structure), clickHouseString(buf.String())),
+			Priority: 100,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clickHouseStructure returns the structure of a ClickHouse table in the format expected by the format table function.
+func (t *transporter) clickHouseStructure(ctx context.Context, table string) (string, error) {
+	res, err := t.to.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT name, type FROM system.columns WHERE database = currentDatabase() AND table = ? ORDER BY position",
+		Args:     []any{table},
+		Priority: 100,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer res.Close()
+
+	var cols []string
+	for res.Next() {
+		var name, typ string
+		if err := res.Scan(&name, &typ); err != nil {
+			return "", err
+		}
+		cols = append(cols, fmt.Sprintf("`%s` %s", strings.ReplaceAll(name, "`", "\\`"), typ))
+	}
+	if err := res.Err(); err != nil {
+		return "", err
+	}
+	return strings.Join(cols, ", "), nil
+}
+
+// decoder decodes message values to JSON objects.
+type decoder struct {
+	format   string
+	topic    string
+	registry schemaregistry.Client
+	schemas  map[int]*avroSchema
+}
+
+func (t *transporter) newDecoder(cfg *sourceProperties) (*decoder, error) {
+	d := &decoder{format: cfg.Format, topic: cfg.Topic}
+	if cfg.Format == "avro" {
+		client, err := t.from.schemaRegistryClient()
+		if err != nil {
+			return nil, err
+		}
+		d.registry = client
+		d.schemas = make(map[int]*avroSchema)
+	}
+	return d, nil
+}
+
+// decode decodes a message value to a JSON object.
+// Values that don't decode to an object are wrapped in an object with a single "value" field.
+func (d *decoder) decode(data []byte) ([]byte, error) {
+	var v any
+	switch d.format {
+	case "avro":
+		var err error
+		v, err = d.decodeAvro(data)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		err := dec.Decode(&v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := v.(map[string]any); !ok {
+		v = map[string]any{"value": v}
+	}
+	return json.Marshal(v)
+}
+
+// decodeAvro decodes a message in the Confluent Schema Registry wire format:
+// a zero magic byte, a 4-byte big-endian schema ID and the Avro binary encoded value.
+func (d *decoder) decodeAvro(data []byte) (any, error) {
+	if len(data) < 5 || data[0] != 0 {
+		return nil, fmt.Errorf("message is not in the schema registry wire format")
+	}
+	id := int(binary.BigEndian.Uint32(data[1:5]))
+
+	s, ok := d.schemas[id]
+	if !ok {
+		info, err := d.registry.GetBySubjectAndID(d.topic+"-value", id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema %d: %w", id, err)
+		}
+		s, err = parseAvroSchema(info.Schema)
+		if err != nil {
+			return nil, err
+		}
+		d.schemas[id] = s
+	}
+
+	return decodeAvro(data[5:], s)
+}
+
+// partitionKey returns the key of a partition in the offsets map.
+func partitionKey(topic string, partition int32) string {
+	return topic + "/" + strconv.Itoa(int(partition))
+}
+
+// duckDBString returns s as a DuckDB string literal.
+func duckDBString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// clickHouseString returns s as a ClickHouse string literal.
+// Unlike DuckDB, ClickHouse interprets backslash escape sequences in string literals.
+func clickHouseString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", `\'`))
+}
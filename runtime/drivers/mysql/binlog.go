@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+)
+
+// binlogColumn describes a column of the captured table.
+type binlogColumn struct {
+	name string
+	// dataType is the lower case DATA_TYPE of the column in information_schema.
+	dataType string
+	unsigned bool
+	binary   bool
+	// values are the members of an ENUM or SET column.
+	values []string
+}
+
+// binlogValue converts a value decoded from a row image to a value in the same format as values read with Query, so DuckDB can cast it to the column's type.
+// It returns nil for zero dates, which can't be represented.
+// The meta argument is the column's metadata from the table map event.
+func binlogValue(col *binlogColumn, meta uint16, v any) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case int8:
+		return intValue(col, int64(v), uint64(uint8(v))), nil
+	case int16:
+		return intValue(col, int64(v), uint64(uint16(v))), nil
+	case int32:
+		if col.dataType == "mediumint" {
+			return intValue(col, int64(v), uint64(uint32(v)&0xFFFFFF)), nil
+		}
+		return intValue(col, int64(v), uint64(uint32(v))), nil
+	case int64:
+		switch col.dataType {
+		case "bit":
+			nbits := int(meta>>8)*8 + int(meta&0xff)
+			b := make([]byte, (nbits+7)/8)
+			for i := range b {
+				b[len(b)-1-i] = byte(v >> (8 * i))
+			}
+			// Format like values read with Query
+			return bitMapper{}.value(&b)
+		case "enum":
+			if v <= 0 || int(v) > len(col.values) {
+				return "", nil
+			}
+			return col.values[v-1], nil
+		case "set":
+			var members []string
+			for i, m := range col.values {
+				if v&(1<<i) != 0 {
+					members = append(members, m)
+				}
+			}
+			return strings.Join(members, ","), nil
+		default:
+			return intValue(col, v, uint64(v)), nil
+		}
+	case int:
+		// YEAR
+		return strconv.Itoa(v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case string:
+		switch col.dataType {
+		case "date", "datetime", "timestamp":
+			if strings.HasPrefix(v, "0000-00-00") {
+				return nil, nil
+			}
+		}
+		// Strings may point into the event buffer
+		return stringValue([]byte(v), col), nil
+	case []byte:
+		if col.dataType == "json" {
+			// An empty document is stored for invalid JSON values inserted in non-strict mode
+			if len(v) == 0 {
+				return nil, nil
+			}
+			return string(v), nil
+		}
+		return stringValue(v, col), nil
+	case *replication.JsonDiff:
+		return nil, fmt.Errorf("change data capture does not support partial JSON updates: set binlog_row_value_options to an empty value")
+	default:
+		return nil, fmt.Errorf("unsupported binlog value of type %T", v)
+	}
+}
+
+// intValue formats an integer, which is decoded as a signed value regardless of the column's type.
+func intValue(col *binlogColumn, signed int64, unsigned uint64) string {
+	if col.unsigned {
+		return strconv.FormatUint(unsigned, 10)
+	}
+	return strconv.FormatInt(signed, 10)
+}
+
+// stringValue returns a string or binary column value.
+func stringValue(b []byte, col *binlogColumn) string {
+	if col.binary {
+		return blobValue(b)
+	}
+	return string(b)
+}
+
+// blobValue encodes bytes with an escape for each byte, which DuckDB can cast to BLOB.
+func blobValue(b []byte) string {
+	var sb strings.Builder
+	sb.Grow(len(b) * 4)
+	for _, c := range b {
+		fmt.Fprintf(&sb, `\x%02X`, c)
+	}
+	return sb.String()
+}
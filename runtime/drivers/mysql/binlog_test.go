@@ -0,0 +1,163 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-sql-driver/mysql"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinlogValue(t *testing.T) {
+	tests := []struct {
+		name string
+		meta uint16
+		col  *binlogColumn
+		val  any
+		want any
+	}{
+		{name: "null", col: &binlogColumn{}, val: nil, want: nil},
+		{name: "tiny signed", col: &binlogColumn{dataType: "tinyint"}, val: int8(-1), want: "-1"},
+		{name: "tiny unsigned", col: &binlogColumn{dataType: "tinyint", unsigned: true}, val: int8(-1), want: "255"},
+		{name: "int24 signed", col: &binlogColumn{dataType: "mediumint"}, val: int32(-2), want: "-2"},
+		{name: "int24 unsigned", col: &binlogColumn{dataType: "mediumint", unsigned: true}, val: int32(-1), want: "16777215"},
+		{name: "bigint unsigned", col: &binlogColumn{dataType: "bigint", unsigned: true}, val: int64(-1), want: "18446744073709551615"},
+		{name: "double", col: &binlogColumn{dataType: "double"}, val: float64(1.5), want: "1.5"},
+		{name: "float", col: &binlogColumn{dataType: "float"}, val: float32(0.1), want: "0.1"},
+		{name: "decimal", col: &binlogColumn{dataType: "decimal"}, val: "1234.56", want: "1234.56"},
+		{name: "varchar", col: &binlogColumn{dataType: "varchar"}, val: "hi", want: "hi"},
+		{name: "varbinary", col: &binlogColumn{dataType: "varbinary", binary: true}, val: "\x00\xab", want: `\x00\xAB`},
+		{name: "blob", col: &binlogColumn{dataType: "blob", binary: true}, val: []byte{0x01}, want: `\x01`},
+		{name: "text", col: &binlogColumn{dataType: "text"}, val: []byte("abc"), want: "abc"},
+		{name: "enum", col: &binlogColumn{dataType: "enum", values: []string{"a", "b"}}, val: int64(2), want: "b"},
+		{name: "invalid enum", col: &binlogColumn{dataType: "enum", values: []string{"a", "b"}}, val: int64(0), want: ""},
+		{name: "set", col: &binlogColumn{dataType: "set", values: []string{"a", "b", "c"}}, val: int64(5), want: "a,c"},
+		{name: "year", col: &binlogColumn{dataType: "year"}, val: 2024, want: "2024"},
+		{name: "date", col: &binlogColumn{dataType: "date"}, val: "2024-02-29", want: "2024-02-29"},
+		{name: "zero date", col: &binlogColumn{dataType: "date"}, val: "0000-00-00", want: nil},
+		{name: "zero datetime", col: &binlogColumn{dataType: "datetime"}, val: "0000-00-00 00:00:00.000", want: nil},
+		{name: "timestamp", col: &binlogColumn{dataType: "timestamp"}, val: "1970-01-01 00:00:10.000001", want: "1970-01-01 00:00:10.000001"},
+		{name: "json", col: &binlogColumn{dataType: "json"}, val: `{"a":1}`, want: `{"a":1}`},
+		{name: "empty json", col: &binlogColumn{dataType: "json"}, val: []byte{}, want: nil},
+		// Same as bitMapper, which formats values read with Query
+		{name: "bit", meta: 1 << 8, col: &binlogColumn{dataType: "bit"}, val: int64(0xaa), want: "1"},
+	}
+	for _, tt := range tests {
+		got, err := binlogValue(tt.col, tt.meta, tt.val)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, got, tt.name)
+	}
+
+	_, err := binlogValue(&binlogColumn{dataType: "json"}, 0, &replication.JsonDiff{})
+	require.ErrorContains(t, err, "partial JSON")
+}
+
+func TestBinlogStreamChanges(t *testing.T) {
+	tbl := &mysqlTable{
+		schema:     "db",
+		name:       "t",
+		columns:    []*binlogColumn{{name: "id", dataType: "int"}, {name: "name", dataType: "varchar"}},
+		primaryKey: []string{"id"},
+	}
+	s := &binlogStream{table: tbl, conf: &cdcProperties{MaxBatchSize: 10}}
+	tm := &replication.TableMapEvent{Schema: []byte("db"), Table: []byte("t"), ColumnCount: 2}
+
+	rows := func(typ replication.EventType, table *replication.TableMapEvent, rows ...[]any) *replication.BinlogEvent {
+		return &replication.BinlogEvent{
+			Header: &replication.EventHeader{EventType: typ},
+			Event:  &replication.RowsEvent{Table: table, ColumnCount: table.ColumnCount, Rows: rows},
+		}
+	}
+	query := func(schema, q string) *replication.BinlogEvent {
+		return &replication.BinlogEvent{
+			Header: &replication.EventHeader{EventType: replication.QUERY_EVENT},
+			Event:  &replication.QueryEvent{Schema: []byte(schema), Query: []byte(q)},
+		}
+	}
+
+	events := []*replication.BinlogEvent{
+		query("db", "BEGIN"),
+		rows(replication.WRITE_ROWS_EVENTv2, tm, []any{int32(1), "a"}, []any{int32(2), nil}),
+		// Changes to other tables are ignored
+		rows(replication.WRITE_ROWS_EVENTv2, &replication.TableMapEvent{Schema: []byte("db"), Table: []byte("other"), ColumnCount: 1}, []any{int32(3)}),
+	}
+	for _, ev := range events {
+		require.NoError(t, s.handle(ev))
+	}
+	// Changes are only emitted on commit
+	require.True(t, s.inTxn)
+	require.Empty(t, s.changes)
+	require.NoError(t, s.handle(&replication.BinlogEvent{Header: &replication.EventHeader{EventType: replication.XID_EVENT}, Event: &replication.XIDEvent{}}))
+	require.False(t, s.inTxn)
+	require.Equal(t, []*drivers.RowChange{
+		{Row: map[string]any{"id": "1", "name": "a"}},
+		{Row: map[string]any{"id": "2", "name": nil}},
+	}, s.changes)
+
+	// An update that changes the primary key deletes the row with the old key
+	s.changes = nil
+	events = []*replication.BinlogEvent{
+		query("db", "BEGIN"),
+		rows(replication.UPDATE_ROWS_EVENTv2, tm, []any{int32(1), "a"}, []any{int32(1), "b"}, []any{int32(2), nil}, []any{int32(3), nil}),
+		rows(replication.DELETE_ROWS_EVENTv2, tm, []any{int32(3), nil}),
+		query("db", "COMMIT"),
+		query("other", "TRUNCATE TABLE `db`.`t`"),
+	}
+	for _, ev := range events {
+		require.NoError(t, s.handle(ev))
+	}
+	require.Equal(t, []*drivers.RowChange{
+		{Row: map[string]any{"id": "1", "name": "b"}},
+		{Delete: true, Row: map[string]any{"id": "2", "name": nil}},
+		{Row: map[string]any{"id": "3", "name": nil}},
+		{Delete: true, Row: map[string]any{"id": "3", "name": nil}},
+		{Truncate: true},
+	}, s.changes)
+
+	// Mismatched column count
+	err := s.handle(rows(replication.WRITE_ROWS_EVENTv2, &replication.TableMapEvent{Schema: []byte("db"), Table: []byte("t"), ColumnCount: 1}, []any{int32(1)}))
+	require.ErrorContains(t, err, "schema may have changed")
+
+	// Compressed transactions
+	err = s.handle(&replication.BinlogEvent{Header: &replication.EventHeader{EventType: replication.TRANSACTION_PAYLOAD_EVENT}, Event: &replication.TransactionPayloadEvent{}})
+	require.ErrorContains(t, err, "compressed transactions")
+}
+
+func TestIsTruncate(t *testing.T) {
+	s := &binlogStream{table: &mysqlTable{schema: "db", name: "t"}}
+	require.True(t, s.isTruncate("other", "TRUNCATE TABLE `db`.`t`"))
+	require.True(t, s.isTruncate("db", "truncate t"))
+	require.False(t, s.isTruncate("other", "truncate t"))
+	require.False(t, s.isTruncate("db", "TRUNCATE TABLE db.t2"))
+	require.False(t, s.isTruncate("db", "DROP TABLE t"))
+}
+
+func TestParseEnumValues(t *testing.T) {
+	require.Equal(t, []string{"a", "it's", "b,c"}, parseEnumValues("enum('a','it''s','b,c')"))
+	require.Equal(t, []string{"x"}, parseEnumValues("set('x')"))
+}
+
+func TestDefaultServerID(t *testing.T) {
+	id := defaultServerID("instance1_source")
+	require.GreaterOrEqual(t, id, uint32(1<<31))
+	require.Equal(t, id, defaultServerID("instance1_source"))
+	require.NotEqual(t, id, defaultServerID("instance2_source"))
+}
+
+func TestBinlogSyncerConfig(t *testing.T) {
+	cfg, err := mysql.ParseDSN("user:pass@tcp(example.com:3307)/db")
+	require.NoError(t, err)
+	sc, err := binlogSyncerConfig(cfg, 42)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", sc.Host)
+	require.Equal(t, uint16(3307), sc.Port)
+	require.Equal(t, "user", sc.User)
+	require.Equal(t, "pass", sc.Password)
+	require.Equal(t, uint32(42), sc.ServerID)
+
+	cfg, err = mysql.ParseDSN("user:pass@unix(/tmp/mysql.sock)/db")
+	require.NoError(t, err)
+	_, err = binlogSyncerConfig(cfg, 42)
+	require.ErrorContains(t, err, "TCP")
+}
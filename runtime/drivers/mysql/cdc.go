@@ -0,0 +1,539 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	gomysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-sql-driver/mysql"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/siddontang/go-log/log"
+)
+
+const _defaultCDCMaxBatchSize = 10000
+
+var _ drivers.ChangeCaptureStore = &connection{}
+
+var truncateRegex = regexp.MustCompile("(?i)^\\s*TRUNCATE\\s+(?:TABLE\\s+)?(?:`?([^`.\\s]+)`?\\.)?`?([^`.\\s;]+)`?")
+
+type cdcProperties struct {
+	Table        string   `mapstructure:"table"`
+	DSN          string   `mapstructure:"dsn"`
+	ServerID     uint32   `mapstructure:"server_id"`
+	PrimaryKey   []string `mapstructure:"primary_key"`
+	MaxBatchSize int      `mapstructure:"max_batch_size"`
+}
+
+func parseCDCProperties(props map[string]any) (*cdcProperties, error) {
+	conf := &cdcProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Table == "" {
+		return nil, fmt.Errorf("property 'table' is mandatory for change data capture from MySQL")
+	}
+	if conf.MaxBatchSize <= 0 {
+		conf.MaxBatchSize = _defaultCDCMaxBatchSize
+	}
+	return conf, nil
+}
+
+// mysqlTable describes the table to capture changes from.
+type mysqlTable struct {
+	schema     string
+	name       string
+	columns    []*binlogColumn
+	primaryKey []string
+}
+
+func (t *mysqlTable) sanitizedName() string {
+	return quoteIdentifier(t.schema) + "." + quoteIdentifier(t.name)
+}
+
+// StartChangeCapture implements drivers.ChangeCaptureStore.
+// It checks that the server's binary log is configured for change data capture and returns its current position.
+func (c *connection) StartChangeCapture(ctx context.Context, streamID string, props map[string]any) (*drivers.ChangeCaptureStart, error) {
+	conf, err := parseCDCProperties(props)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := c.dsn(conf.DSN)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	tbl, err := lookupTable(ctx, db, cfg, conf)
+	if err != nil {
+		return nil, err
+	}
+	err = checkBinlogSettings(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	file, pos, err := binlogPosition(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.ChangeCaptureStart{
+		Offsets: map[string]int64{file: pos},
+		SnapshotProps: map[string]any{
+			"sql": fmt.Sprintf("SELECT * FROM %s", tbl.sanitizedName()),
+			"dsn": dsn,
+		},
+	}, nil
+}
+
+// ReadChanges implements drivers.ChangeCaptureStore.
+// It streams the binary log from the given position until it reaches the end of the log or has read max_batch_size changes.
+func (c *connection) ReadChanges(ctx context.Context, streamID string, props map[string]any, offsets map[string]int64) (*drivers.ChangeBatch, error) {
+	conf, err := parseCDCProperties(props)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := c.dsn(conf.DSN)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if len(offsets) != 1 {
+		return nil, fmt.Errorf("expected a single binlog position to resume change data capture from, got %d", len(offsets))
+	}
+	var start gomysql.Position
+	for k, v := range offsets {
+		start = gomysql.Position{Name: k, Pos: uint32(v)}
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err := lookupTable(ctx, db, cfg, conf)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	err = checkBinlogSettings(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	endFile, endPos, err := binlogPosition(ctx, db)
+	db.Close()
+	if err != nil {
+		return nil, err
+	}
+	end := gomysql.Position{Name: endFile, Pos: uint32(endPos)}
+
+	batch := &drivers.ChangeBatch{
+		PrimaryKey: tbl.primaryKey,
+		Offsets:    offsets,
+	}
+	if start.Compare(end) >= 0 {
+		return batch, nil
+	}
+
+	serverID := conf.ServerID
+	if serverID == 0 {
+		serverID = defaultServerID(streamID)
+	}
+	syncerCfg, err := binlogSyncerConfig(cfg, serverID)
+	if err != nil {
+		return nil, err
+	}
+	syncer := replication.NewBinlogSyncer(syncerCfg)
+	defer syncer.Close()
+	streamer, err := syncer.StartSync(start)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &binlogStream{
+		table: tbl,
+		conf:  conf,
+		pos:   start,
+		end:   end,
+	}
+	batch.Changes, err = s.read(ctx, streamer)
+	if err != nil {
+		return nil, err
+	}
+	batch.Offsets = map[string]int64{s.pos.Name: int64(s.pos.Pos)}
+	return batch, nil
+}
+
+// StopChangeCapture implements drivers.ChangeCaptureStore.
+// Reading the binary log doesn't keep any state on the server, so there is nothing to release.
+func (c *connection) StopChangeCapture(ctx context.Context, streamID string, props map[string]any) error {
+	return nil
+}
+
+// lookupTable resolves the configured table, its columns and its primary key.
+func lookupTable(ctx context.Context, db *sql.DB, cfg *mysql.Config, conf *cdcProperties) (*mysqlTable, error) {
+	tbl := &mysqlTable{schema: cfg.DBName, name: strings.ReplaceAll(conf.Table, "`", "")}
+	if schema, name, ok := strings.Cut(tbl.name, "."); ok {
+		tbl.schema, tbl.name = schema, name
+	}
+	if tbl.schema == "" {
+		return nil, fmt.Errorf("table %q is not qualified with a database and the DSN does not specify one", conf.Table)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT COLUMN_NAME, DATA_TYPE, COLUMN_TYPE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION", tbl.schema, tbl.name)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var name, dataType, columnType string
+		if err := rows.Scan(&name, &dataType, &columnType); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		col := &binlogColumn{
+			name:     name,
+			dataType: strings.ToLower(dataType),
+			unsigned: strings.Contains(strings.ToLower(columnType), "unsigned"),
+		}
+		switch col.dataType {
+		case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob",
+			"geometry", "point", "linestring", "polygon", "multipoint", "multilinestring", "multipolygon", "geometrycollection", "geomcollection":
+			col.binary = true
+		case "enum", "set":
+			col.values = parseEnumValues(columnType)
+		}
+		tbl.columns = append(tbl.columns, col)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(tbl.columns) == 0 {
+		return nil, fmt.Errorf("table %q not found", conf.Table)
+	}
+
+	if len(conf.PrimaryKey) > 0 {
+		tbl.primaryKey = conf.PrimaryKey
+		return tbl, nil
+	}
+	rows, err = db.QueryContext(ctx, "SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY' ORDER BY ORDINAL_POSITION", tbl.schema, tbl.name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tbl.primaryKey = append(tbl.primaryKey, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(tbl.primaryKey) == 0 {
+		return nil, fmt.Errorf("table %q has no primary key: set the 'primary_key' property", conf.Table)
+	}
+	return tbl, nil
+}
+
+// checkBinlogSettings checks that the binary log contains full row images.
+func checkBinlogSettings(ctx context.Context, db *sql.DB) error {
+	var format, rowImage string
+	err := db.QueryRowContext(ctx, "SELECT @@global.binlog_format, @@global.binlog_row_image").Scan(&format, &rowImage)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(format, "ROW") {
+		return fmt.Errorf("change data capture requires binlog_format=ROW, but it is %s", format)
+	}
+	if !strings.EqualFold(rowImage, "FULL") {
+		return fmt.Errorf("change data capture requires binlog_row_image=FULL, but it is %s", rowImage)
+	}
+	return nil
+}
+
+// binlogPosition returns the current position of the binary log.
+func binlogPosition(ctx context.Context, db *sql.DB) (string, int64, error) {
+	// SHOW MASTER STATUS was renamed in MySQL 8.2
+	rows, err := db.QueryContext(ctx, "SHOW BINARY LOG STATUS")
+	if err != nil {
+		rows, err = db.QueryContext(ctx, "SHOW MASTER STATUS")
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return "", 0, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", 0, err
+		}
+		return "", 0, fmt.Errorf("binary logging is not enabled on the server")
+	}
+	var file string
+	var pos int64
+	dest := make([]any, len(cols))
+	for i := range dest {
+		dest[i] = new(sql.RawBytes)
+	}
+	dest[0], dest[1] = &file, &pos
+	err = rows.Scan(dest...)
+	if err != nil {
+		return "", 0, err
+	}
+	return file, pos, nil
+}
+
+// binlogSyncerConfig returns the configuration for reading the binary log of the server in the DSN as a replica with the given server ID.
+func binlogSyncerConfig(cfg *mysql.Config, serverID uint32) (replication.BinlogSyncerConfig, error) {
+	if cfg.Net != "tcp" {
+		return replication.BinlogSyncerConfig{}, fmt.Errorf("change data capture requires a TCP connection, got %q", cfg.Net)
+	}
+	host, portStr, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return replication.BinlogSyncerConfig{}, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return replication.BinlogSyncerConfig{}, fmt.Errorf("invalid port in address %q: %w", cfg.Addr, err)
+	}
+	return replication.BinlogSyncerConfig{
+		ServerID:  serverID,
+		Flavor:    gomysql.MySQLFlavor,
+		Host:      host,
+		Port:      uint16(port),
+		User:      cfg.User,
+		Password:  cfg.Passwd,
+		TLSConfig: cfg.TLS,
+		// Format timestamps in UTC like values read with Query
+		TimestampStringLocation: time.UTC,
+		// Fail the read instead of reconnecting in the background, the next read resumes from the last offsets
+		DisableRetrySync: true,
+		Logger:           log.NewDefault(&log.NullHandler{}),
+	}, nil
+}
+
+// binlogStream reads changes to a table from the binary log.
+type binlogStream struct {
+	table *mysqlTable
+	conf  *cdcProperties
+	// pos is the position up to which all changes have been read.
+	pos gomysql.Position
+	// end is the position of the end of the binary log when the read started.
+	end gomysql.Position
+	// inTxn and pending track the changes of the current transaction, which are only emitted on commit.
+	inTxn   bool
+	pending []*drivers.RowChange
+	changes []*drivers.RowChange
+}
+
+// read reads changes until it reaches the end position or has read max_batch_size changes.
+func (s *binlogStream) read(ctx context.Context, streamer *replication.BinlogStreamer) ([]*drivers.RowChange, error) {
+	for {
+		ev, err := streamer.GetEvent(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = s.handle(ev)
+		if err != nil {
+			return nil, err
+		}
+
+		if rotate, ok := ev.Event.(*replication.RotateEvent); ok {
+			s.pos.Name = string(rotate.NextLogName)
+			if !s.inTxn {
+				s.pos.Pos = uint32(rotate.Position)
+			}
+		} else if !s.inTxn && ev.Header.LogPos != 0 && ev.Header.Flags&replication.LOG_EVENT_ARTIFICIAL_F == 0 {
+			// Outside transactions, all changes up to the end of the event have been read
+			s.pos.Pos = ev.Header.LogPos
+		}
+
+		if !s.inTxn && (s.pos.Compare(s.end) >= 0 || len(s.changes) >= s.conf.MaxBatchSize) {
+			return s.changes, nil
+		}
+	}
+}
+
+// handle collects the changes in an event.
+func (s *binlogStream) handle(ev *replication.BinlogEvent) error {
+	switch e := ev.Event.(type) {
+	case *replication.QueryEvent:
+		query := string(e.Query)
+		switch strings.ToUpper(strings.TrimSpace(query)) {
+		case "BEGIN":
+			s.inTxn = true
+			s.pending = s.pending[:0]
+		case "COMMIT":
+			s.commit()
+		default:
+			if s.isTruncate(string(e.Schema), query) {
+				s.pending = append(s.pending, &drivers.RowChange{Truncate: true})
+				if !s.inTxn {
+					s.commit()
+				}
+			}
+		}
+	case *replication.XIDEvent:
+		s.commit()
+	case *replication.RowsEvent:
+		if e.Table == nil || string(e.Table.Schema) != s.table.schema || string(e.Table.Table) != s.table.name {
+			return nil
+		}
+		if ev.Header.EventType == replication.PARTIAL_UPDATE_ROWS_EVENT {
+			return fmt.Errorf("change data capture does not support partial JSON updates: set binlog_row_value_options to an empty value")
+		}
+		cs, err := s.rowChanges(ev.Header.EventType, e)
+		if err != nil {
+			return err
+		}
+		s.pending = append(s.pending, cs...)
+	case *replication.TransactionPayloadEvent:
+		return fmt.Errorf("change data capture does not support compressed transactions: disable binlog_transaction_compression")
+	}
+	return nil
+}
+
+// commit emits the changes of the current transaction.
+func (s *binlogStream) commit() {
+	s.inTxn = false
+	s.changes = append(s.changes, s.pending...)
+	s.pending = s.pending[:0]
+}
+
+// rowChanges converts a rows event for the captured table to row changes.
+func (s *binlogStream) rowChanges(eventType replication.EventType, e *replication.RowsEvent) ([]*drivers.RowChange, error) {
+	if int(e.ColumnCount) != len(s.table.columns) {
+		return nil, fmt.Errorf("table %s has %d columns in the binary log, but %d in the schema: the schema may have changed", s.table.sanitizedName(), e.ColumnCount, len(s.table.columns))
+	}
+	rows := make([]map[string]any, len(e.Rows))
+	for i, row := range e.Rows {
+		m, err := s.rowMap(e.Table, row)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = m
+	}
+
+	var changes []*drivers.RowChange
+	switch eventType {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
+		for _, row := range rows {
+			changes = append(changes, &drivers.RowChange{Row: row})
+		}
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		for _, row := range rows {
+			changes = append(changes, &drivers.RowChange{Delete: true, Row: row})
+		}
+	default:
+		for i := 0; i+1 < len(rows); i += 2 {
+			before, after := rows[i], rows[i+1]
+			// If the primary key changed, the row with the old key must be deleted
+			for _, k := range s.table.primaryKey {
+				if before[k] != after[k] {
+					changes = append(changes, &drivers.RowChange{Delete: true, Row: before})
+					break
+				}
+			}
+			changes = append(changes, &drivers.RowChange{Row: after})
+		}
+	}
+	return changes, nil
+}
+
+func (s *binlogStream) rowMap(t *replication.TableMapEvent, row []any) (map[string]any, error) {
+	m := make(map[string]any, len(row))
+	for i, v := range row {
+		col := s.table.columns[i]
+		var meta uint16
+		if i < len(t.ColumnMeta) {
+			meta = t.ColumnMeta[i]
+		}
+		val, err := binlogValue(col, meta, v)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", col.name, err)
+		}
+		m[col.name] = val
+	}
+	return m, nil
+}
+
+// isTruncate returns true if the query truncates the captured table.
+func (s *binlogStream) isTruncate(schema, query string) bool {
+	m := truncateRegex.FindStringSubmatch(query)
+	if m == nil {
+		return false
+	}
+	if m[1] != "" {
+		schema = m[1]
+	}
+	return schema == s.table.schema && m[2] == s.table.name
+}
+
+// parseEnumValues parses the members of an ENUM or SET column type like "enum('a','b')".
+func parseEnumValues(columnType string) []string {
+	start := strings.IndexByte(columnType, '(')
+	end := strings.LastIndexByte(columnType, ')')
+	if start < 0 || end < start {
+		return nil
+	}
+	s := columnType[start+1 : end]
+
+	var values []string
+	var sb strings.Builder
+	inQuote := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\'' && inQuote && i+1 < len(s) && s[i+1] == '\'':
+			sb.WriteByte('\'')
+			i++
+		case c == '\'':
+			if inQuote {
+				values = append(values, sb.String())
+				sb.Reset()
+			}
+			inQuote = !inQuote
+		case inQuote:
+			sb.WriteByte(c)
+		}
+	}
+	return values
+}
+
+// defaultServerID derives a replica server ID from the stream ID, so sources in different instances don't disconnect each other.
+func defaultServerID(streamID string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(streamID))
+	return h.Sum32() | 1<<31
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
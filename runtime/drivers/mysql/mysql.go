@@ -41,6 +41,7 @@ var spec = drivers.Spec{
 			Secret: true,
 		},
 	},
+	ImplementsChangeCapture: true,
 }
 
 type driver struct{}
@@ -122,6 +123,11 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP implements drivers.Connection.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return nil, false
@@ -20,13 +20,9 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 		return nil, err
 	}
 
-	var dsn string
-	if srcProps.DSN != "" { // get from src properties
-		dsn = srcProps.DSN
-	} else if url, ok := c.config["dsn"].(string); ok && url != "" { // get from driver configs
-		dsn = url
-	} else {
-		return nil, fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--var connector.mysql.dsn=...' to 'rill start'")
+	dsn, err := c.dsn(srcProps.DSN)
+	if err != nil {
+		return nil, err
 	}
 
 	conf, err := mysql.ParseDSN(dsn)
@@ -64,6 +60,17 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 	return iter, nil
 }
 
+// dsn returns the DSN from the source properties if set, and otherwise from the connector config.
+func (c *connection) dsn(srcDSN string) (string, error) {
+	if srcDSN != "" { // get from src properties
+		return srcDSN, nil
+	}
+	if url, ok := c.config["dsn"].(string); ok && url != "" { // get from driver configs
+		return url, nil
+	}
+	return "", fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--var connector.mysql.dsn=...' to 'rill start'")
+}
+
 // QueryAsFiles implements drivers.SQLStore
 func (c *connection) QueryAsFiles(ctx context.Context, props map[string]any, opt *drivers.QueryOption, p drivers.Progress) (drivers.FileIterator, error) {
 	return nil, drivers.ErrNotImplemented
@@ -0,0 +1,39 @@
+package drivers
+
+import (
+	"context"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// Notifier delivers alert and report notifications to an external channel.
+// The props are the notifier properties declared in the alert or report. They take precedence over the handle's config.
+type Notifier interface {
+	SendAlertStatus(ctx context.Context, props map[string]any, s *AlertStatus) error
+	SendScheduledReport(ctx context.Context, props map[string]any, s *ScheduledReport) error
+}
+
+// AlertStatus is a notification about the result of an alert execution.
+type AlertStatus struct {
+	Name           string
+	Title          string
+	ExecutionTime  time.Time
+	Status         runtimev1.AssertionStatus
+	IsRecover      bool
+	FailRow        map[string]any
+	ExecutionError string
+	OpenLink       string
+	EditLink       string
+}
+
+// ScheduledReport is a notification about a report that is ready for download.
+type ScheduledReport struct {
+	Name           string
+	Title          string
+	ReportTime     time.Time
+	DownloadFormat string
+	OpenLink       string
+	DownloadLink   string
+	EditLink       string
+}
@@ -1,10 +1,25 @@
 package drivers
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type ObjectStore interface {
 	// DownloadFiles provides an iterator for downloading and consuming files
 	DownloadFiles(ctx context.Context, src map[string]any) (FileIterator, error)
+	// ListObjectsForGlob lists the objects matching a path or glob pattern (e.g. "s3://bucket/data/*.parquet")
+	ListObjectsForGlob(ctx context.Context, glob string) ([]ObjectStoreEntry, error)
+}
+
+// ObjectStoreEntry represents an object in an object store
+type ObjectStoreEntry struct {
+	// Path is the full path of the object, including the scheme and bucket (e.g. "s3://bucket/data/file.parquet")
+	Path string
+	// Key is the object's key within the bucket
+	Key       string
+	Size      int64
+	UpdatedOn time.Time
 }
 
 // FileIterator provides ways to iteratively download files from external sources
@@ -32,14 +32,24 @@ type OLAPStore interface {
 	EstimateSize() (int64, bool)
 
 	CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error
-	InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error
+	InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string, strategy IncrementalStrategy, uniqueKey []string) error
 	DropTable(ctx context.Context, name string, view bool) error
 	// RenameTable is force rename
 	RenameTable(ctx context.Context, name, newName string, view bool) error
 	AddTableColumn(ctx context.Context, tableName, columnName string, typ string) error
 	AlterTableColumn(ctx context.Context, tableName, columnName string, newType string) error
+	DropTableColumn(ctx context.Context, tableName, columnName string) error
 }
 
+// IncrementalStrategy is a strategy to use for incrementally inserting data into an existing table.
+type IncrementalStrategy string
+
+const (
+	IncrementalStrategyUnspecified IncrementalStrategy = ""
+	IncrementalStrategyAppend      IncrementalStrategy = "append"
+	IncrementalStrategyMerge       IncrementalStrategy = "merge"
+)
+
 // Statement wraps a query to execute against an OLAP driver.
 type Statement struct {
 	Query            string
@@ -97,6 +107,8 @@ type Table struct {
 	View            bool
 	Schema          *runtimev1.StructType
 	UnsupportedCols map[string]string
+	// ColumnTypes maps column names to their types in the OLAP's SQL dialect (if known).
+	ColumnTypes map[string]string
 }
 
 // IngestionSummary is details about ingestion
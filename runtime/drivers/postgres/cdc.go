@@ -0,0 +1,504 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+const (
+	_defaultCDCMaxBatchSize = 10000
+	_defaultCDCPollTimeout  = time.Second
+	// _lsnOffsetKey is the key of the replication slot's confirmed position in the offsets map.
+	_lsnOffsetKey = "lsn"
+)
+
+var _ drivers.ChangeCaptureStore = &connection{}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type cdcProperties struct {
+	Table           string   `mapstructure:"table"`
+	DatabaseURL     string   `mapstructure:"database_url"`
+	ReplicationSlot string   `mapstructure:"replication_slot"`
+	Publication     string   `mapstructure:"publication"`
+	PrimaryKey      []string `mapstructure:"primary_key"`
+	MaxBatchSize    int      `mapstructure:"max_batch_size"`
+	PollTimeout     string   `mapstructure:"poll_timeout"`
+	pollTimeout     time.Duration
+	// ownsPublication is true if the publication was not configured, so Rill creates and drops it.
+	ownsPublication bool
+}
+
+func parseCDCProperties(streamID string, props map[string]any) (*cdcProperties, error) {
+	conf := &cdcProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Table == "" {
+		return nil, fmt.Errorf("property 'table' is mandatory for change data capture from Postgres")
+	}
+	if conf.ReplicationSlot == "" {
+		if streamID == "" {
+			return nil, fmt.Errorf("property 'replication_slot' is mandatory for change data capture from Postgres")
+		}
+		conf.ReplicationSlot = cdcIdentifier(streamID)
+	}
+	if conf.Publication == "" {
+		conf.Publication = conf.ReplicationSlot
+		conf.ownsPublication = true
+	}
+	if conf.MaxBatchSize <= 0 {
+		conf.MaxBatchSize = _defaultCDCMaxBatchSize
+	}
+	conf.pollTimeout = _defaultCDCPollTimeout
+	if conf.PollTimeout != "" {
+		conf.pollTimeout, err = time.ParseDuration(conf.PollTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid poll_timeout: %w", err)
+		}
+	}
+	return conf, nil
+}
+
+// pgTable identifies the table to capture changes from.
+type pgTable struct {
+	oid        uint32
+	namespace  string
+	name       string
+	primaryKey []string
+}
+
+func (t *pgTable) sanitizedName() string {
+	return pgx.Identifier{t.namespace, t.name}.Sanitize()
+}
+
+// StartChangeCapture implements drivers.ChangeCaptureStore.
+// It creates the publication if it doesn't exist and (re)creates the logical replication slot.
+func (c *connection) StartChangeCapture(ctx context.Context, streamID string, props map[string]any) (*drivers.ChangeCaptureStart, error) {
+	conf, err := parseCDCProperties(streamID, props)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := c.databaseURL(conf.DatabaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(context.Background())
+
+	tbl, err := lookupTable(ctx, conn, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_publication WHERE pubname = $1)", conf.Publication).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		_, err = conn.Exec(ctx, fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s", pgx.Identifier{conf.Publication}.Sanitize(), tbl.sanitizedName()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create publication %q: %w", conf.Publication, err)
+		}
+	}
+
+	// Start over from a new slot, since an existing slot may have skipped changes that are not reflected in the table
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_replication_slots WHERE slot_name = $1)", conf.ReplicationSlot).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		_, err = conn.Exec(ctx, "SELECT pg_drop_replication_slot($1)", conf.ReplicationSlot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to drop replication slot %q: %w", conf.ReplicationSlot, err)
+		}
+	}
+
+	var lsn string
+	err = conn.QueryRow(ctx, "SELECT lsn::text FROM pg_create_logical_replication_slot($1, 'pgoutput')", conf.ReplicationSlot).Scan(&lsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replication slot %q: %w", conf.ReplicationSlot, err)
+	}
+	pos, err := parseLSN(lsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.ChangeCaptureStart{
+		Offsets: map[string]int64{_lsnOffsetKey: int64(pos)},
+		SnapshotProps: map[string]any{
+			"sql":          fmt.Sprintf("SELECT * FROM %s", tbl.sanitizedName()),
+			"database_url": dsn,
+		},
+	}, nil
+}
+
+// ReadChanges implements drivers.ChangeCaptureStore.
+// It streams changes from the replication slot until it has caught up with the WAL position at the time of the call,
+// max_batch_size changes have been read, or no messages have been received for poll_timeout.
+func (c *connection) ReadChanges(ctx context.Context, streamID string, props map[string]any, offsets map[string]int64) (*drivers.ChangeBatch, error) {
+	conf, err := parseCDCProperties(streamID, props)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := c.databaseURL(conf.DatabaseURL)
+	if err != nil {
+		return nil, err
+	}
+	start, ok := offsets[_lsnOffsetKey]
+	if !ok {
+		return nil, fmt.Errorf("missing %q offset to resume change data capture from", _lsnOffsetKey)
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err := lookupTable(ctx, conn, conf)
+	conn.Close(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := pgconn.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.RuntimeParams["replication"] = "database"
+	cfg.RuntimeParams["timezone"] = "UTC"
+	cfg.RuntimeParams["datestyle"] = "ISO"
+	repl, err := pgconn.ConnectConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer repl.Close(context.Background())
+
+	s := &pgStream{
+		conn:      repl,
+		table:     tbl,
+		conf:      conf,
+		lsn:       uint64(start),
+		confirmed: uint64(start),
+		decoded:   make(map[uint32]*pgRelation),
+	}
+	changes, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.ChangeBatch{
+		Changes:    changes,
+		PrimaryKey: tbl.primaryKey,
+		Offsets:    map[string]int64{_lsnOffsetKey: int64(s.lsn)},
+	}, nil
+}
+
+// StopChangeCapture implements drivers.ChangeCaptureStore.
+// It drops the replication slot, which otherwise retains WAL on the server, and the publication if it was created by Rill.
+func (c *connection) StopChangeCapture(ctx context.Context, streamID string, props map[string]any) error {
+	conf, err := parseCDCProperties(streamID, props)
+	if err != nil {
+		return err
+	}
+	dsn, err := c.databaseURL(conf.DatabaseURL)
+	if err != nil {
+		return err
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "SELECT pg_drop_replication_slot(slot_name) FROM pg_replication_slots WHERE slot_name = $1", conf.ReplicationSlot)
+	if err != nil {
+		return fmt.Errorf("failed to drop replication slot %q: %w", conf.ReplicationSlot, err)
+	}
+
+	if conf.ownsPublication {
+		_, err = conn.Exec(ctx, fmt.Sprintf("DROP PUBLICATION IF EXISTS %s", pgx.Identifier{conf.Publication}.Sanitize()))
+		if err != nil {
+			return fmt.Errorf("failed to drop publication %q: %w", conf.Publication, err)
+		}
+	}
+
+	return nil
+}
+
+// cdcIdentifier derives the name of a replication slot from a stream ID.
+// Slot names may only contain lower case letters, numbers and underscores, and can be at most 63 characters long.
+// Longer names are truncated and suffixed with a hash of the stream ID to keep them unique.
+func cdcIdentifier(streamID string) string {
+	name := "rill_" + nonIdentifierChars.ReplaceAllString(strings.ToLower(streamID), "_")
+	if len(name) <= 63 {
+		return name
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(streamID))
+	return fmt.Sprintf("%s_%08x", name[:54], h.Sum32())
+}
+
+// lookupTable resolves the configured table and its primary key.
+func lookupTable(ctx context.Context, conn *pgx.Conn, conf *cdcProperties) (*pgTable, error) {
+	tbl := &pgTable{}
+	err := conn.QueryRow(ctx, "SELECT c.oid, n.nspname, c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace WHERE c.oid = $1::regclass", conf.Table).Scan(&tbl.oid, &tbl.namespace, &tbl.name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find table %q: %w", conf.Table, err)
+	}
+
+	if len(conf.PrimaryKey) > 0 {
+		tbl.primaryKey = conf.PrimaryKey
+		return tbl, nil
+	}
+
+	rows, err := conn.Query(ctx, `
+		SELECT a.attname
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1 AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)
+	`, tbl.oid)
+	if err != nil {
+		return nil, err
+	}
+	tbl.primaryKey, err = pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	if len(tbl.primaryKey) == 0 {
+		return nil, fmt.Errorf("table %q has no primary key: set the 'primary_key' property", conf.Table)
+	}
+	return tbl, nil
+}
+
+// pgStream reads changes from a logical replication slot.
+type pgStream struct {
+	conn    *pgconn.PgConn
+	table   *pgTable
+	conf    *cdcProperties
+	decoded map[uint32]*pgRelation
+	// lsn is the position up to which all changes have been read.
+	lsn uint64
+	// confirmed is the position persisted by the caller. Only this position is reported to the server,
+	// so changes after it are sent again if the current batch is not persisted.
+	confirmed uint64
+}
+
+func (s *pgStream) read(ctx context.Context) ([]*drivers.RowChange, error) {
+	// Find the current end of the WAL, which is where we stop reading
+	res, err := s.conn.Exec(ctx, "IDENTIFY_SYSTEM").ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 || len(res[0].Rows) != 1 || len(res[0].Rows[0]) < 3 {
+		return nil, fmt.Errorf("unexpected result of IDENTIFY_SYSTEM")
+	}
+	end, err := parseLSN(string(res[0].Rows[0][2]))
+	if err != nil {
+		return nil, err
+	}
+	if end <= s.lsn {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf("START_REPLICATION SLOT %s LOGICAL %s (proto_version '1', publication_names '%s')",
+		pgx.Identifier{s.conf.ReplicationSlot}.Sanitize(),
+		formatLSN(s.lsn),
+		strings.ReplaceAll(pgx.Identifier{s.conf.Publication}.Sanitize(), "'", "''"),
+	)
+	s.conn.Frontend().Send(&pgproto3.Query{String: query})
+	err = s.conn.Frontend().Flush()
+	if err != nil {
+		return nil, err
+	}
+	for started := false; !started; {
+		msg, err := s.conn.ReceiveMessage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.CopyBothResponse:
+			started = true
+		case *pgproto3.ErrorResponse:
+			return nil, pgconn.ErrorResponseToPgError(msg)
+		}
+	}
+
+	// Confirm the position that was persisted by the caller, so the server can release older WAL
+	err = s.sendStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes, pending []*drivers.RowChange
+	inTxn := false
+	for {
+		rctx, cancel := context.WithTimeout(ctx, s.conf.pollTimeout)
+		msg, err := s.conn.ReceiveMessage(rctx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if pgconn.Timeout(err) || errors.Is(err, context.DeadlineExceeded) {
+				return changes, nil
+			}
+			return nil, err
+		}
+
+		var data []byte
+		switch msg := msg.(type) {
+		case *pgproto3.CopyData:
+			data = msg.Data
+		case *pgproto3.ErrorResponse:
+			return nil, pgconn.ErrorResponseToPgError(msg)
+		default:
+			continue
+		}
+
+		rmsg, err := parseReplicationMessage(data)
+		if err != nil {
+			return nil, err
+		}
+		switch rmsg := rmsg.(type) {
+		case *primaryKeepalive:
+			// The server has sent all WAL up to serverWALEnd, so outside a transaction we have caught up with it
+			if !inTxn && rmsg.serverWALEnd > s.lsn {
+				s.lsn = rmsg.serverWALEnd
+			}
+			if !inTxn && s.lsn >= end {
+				return changes, nil
+			}
+			if rmsg.replyRequested {
+				err = s.sendStatus()
+				if err != nil {
+					return nil, err
+				}
+			}
+		case *xLogData:
+			msg, err := parsePgoutput(rmsg.data)
+			if err != nil {
+				return nil, err
+			}
+			switch msg := msg.(type) {
+			case *pgRelation:
+				s.decoded[msg.id] = msg
+			case *pgBegin:
+				inTxn = true
+				pending = pending[:0]
+			case *pgCommit:
+				inTxn = false
+				changes = append(changes, pending...)
+				pending = pending[:0]
+				s.lsn = msg.endLSN
+				if len(changes) >= s.conf.MaxBatchSize || s.lsn >= end {
+					return changes, nil
+				}
+			default:
+				cs, err := s.changes(msg)
+				if err != nil {
+					return nil, err
+				}
+				pending = append(pending, cs...)
+			}
+		}
+	}
+}
+
+// changes converts a pgoutput data message to row changes. It ignores messages for other tables.
+func (s *pgStream) changes(msg any) ([]*drivers.RowChange, error) {
+	var relID uint32
+	switch msg := msg.(type) {
+	case *pgInsert:
+		relID = msg.relationID
+	case *pgUpdate:
+		relID = msg.relationID
+	case *pgDelete:
+		relID = msg.relationID
+	case *pgTruncate:
+		for _, id := range msg.relationIDs {
+			if id == s.table.oid {
+				return []*drivers.RowChange{{Truncate: true}}, nil
+			}
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+	if relID != s.table.oid {
+		return nil, nil
+	}
+	rel, ok := s.decoded[relID]
+	if !ok {
+		return nil, fmt.Errorf("pgoutput: received change for unknown relation %d", relID)
+	}
+
+	switch msg := msg.(type) {
+	case *pgInsert:
+		row, err := rel.row(msg.newTuple, false, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []*drivers.RowChange{{Row: row}}, nil
+	case *pgUpdate:
+		var old map[string]any
+		if msg.oldTuple != nil {
+			var err error
+			old, err = rel.row(msg.oldTuple, msg.oldKeyOnly, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		row, err := rel.row(msg.newTuple, false, old)
+		if err != nil {
+			return nil, err
+		}
+		// If the primary key changed, the row with the old key must be deleted
+		var res []*drivers.RowChange
+		if old != nil && keyChanged(s.table.primaryKey, old, row) {
+			res = append(res, &drivers.RowChange{Delete: true, Row: old})
+		}
+		return append(res, &drivers.RowChange{Row: row}), nil
+	case *pgDelete:
+		row, err := rel.row(msg.oldTuple, msg.oldKeyOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []*drivers.RowChange{{Delete: true, Row: row}}, nil
+	}
+	return nil, nil
+}
+
+func (s *pgStream) sendStatus() error {
+	s.conn.Frontend().Send(&pgproto3.CopyData{Data: standbyStatusUpdate(s.confirmed, time.Now())})
+	return s.conn.Frontend().Flush()
+}
+
+// keyChanged returns true if any of the key columns in old have a different value in row.
+func keyChanged(key []string, old, row map[string]any) bool {
+	for _, k := range key {
+		ov, ok := old[k]
+		if ok && ov != row[k] {
+			return true
+		}
+	}
+	return false
+}
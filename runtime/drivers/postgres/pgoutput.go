@@ -0,0 +1,352 @@
+package postgres
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// This file implements decoding of the streaming replication protocol and the pgoutput logical decoding plugin (protocol version 1).
+// See https://www.postgresql.org/docs/current/protocol-replication.html and https://www.postgresql.org/docs/current/protocol-logicalrep-message-formats.html.
+
+const (
+	_byteaOID = 17
+	// _pgEpochMicros is the number of microseconds between the Unix epoch and the Postgres epoch (2000-01-01).
+	_pgEpochMicros = 946684800000000
+)
+
+var errShortMessage = errors.New("pgoutput: message too short")
+
+// parseLSN parses a log sequence number in the "XXX/XXX" text format.
+func parseLSN(s string) (uint64, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	return h<<32 | l, nil
+}
+
+// formatLSN formats a log sequence number in the "XXX/XXX" text format.
+func formatLSN(lsn uint64) string {
+	return fmt.Sprintf("%X/%X", uint32(lsn>>32), uint32(lsn))
+}
+
+// xLogData is the header of a WAL data message sent in the replication stream.
+type xLogData struct {
+	walStart     uint64
+	serverWALEnd uint64
+	data         []byte
+}
+
+// primaryKeepalive is a keepalive message sent in the replication stream.
+type primaryKeepalive struct {
+	serverWALEnd   uint64
+	replyRequested bool
+}
+
+// parseReplicationMessage parses the payload of a CopyData message received in the replication stream.
+// It returns a *xLogData or a *primaryKeepalive.
+func parseReplicationMessage(b []byte) (any, error) {
+	if len(b) == 0 {
+		return nil, errShortMessage
+	}
+	switch b[0] {
+	case 'w':
+		if len(b) < 25 {
+			return nil, errShortMessage
+		}
+		return &xLogData{
+			walStart:     binary.BigEndian.Uint64(b[1:]),
+			serverWALEnd: binary.BigEndian.Uint64(b[9:]),
+			data:         b[25:],
+		}, nil
+	case 'k':
+		if len(b) < 18 {
+			return nil, errShortMessage
+		}
+		return &primaryKeepalive{
+			serverWALEnd:   binary.BigEndian.Uint64(b[1:]),
+			replyRequested: b[17] == 1,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown replication message type %q", b[0])
+	}
+}
+
+// standbyStatusUpdate encodes a status update reporting that WAL up to lsn has been written, flushed and applied.
+func standbyStatusUpdate(lsn uint64, now time.Time) []byte {
+	b := make([]byte, 34)
+	b[0] = 'r'
+	binary.BigEndian.PutUint64(b[1:], lsn)
+	binary.BigEndian.PutUint64(b[9:], lsn)
+	binary.BigEndian.PutUint64(b[17:], lsn)
+	binary.BigEndian.PutUint64(b[25:], uint64(now.UnixMicro()-_pgEpochMicros))
+	return b
+}
+
+// pgRelation describes a table in the pgoutput stream.
+type pgRelation struct {
+	id        uint32
+	namespace string
+	name      string
+	columns   []pgColumn
+}
+
+type pgColumn struct {
+	name    string
+	key     bool
+	typeOID uint32
+}
+
+// pgTupleValue is a column value in a pgoutput tuple.
+// The kind is 'n' for null, 'u' for an unchanged TOASTed value and 't' for a value in text format.
+type pgTupleValue struct {
+	kind byte
+	data []byte
+}
+
+type pgBegin struct{}
+
+type pgCommit struct {
+	endLSN uint64
+}
+
+type pgInsert struct {
+	relationID uint32
+	newTuple   []pgTupleValue
+}
+
+type pgUpdate struct {
+	relationID uint32
+	// oldTuple is set if the table's replica identity changed or is FULL. It holds only the key columns if oldKeyOnly is true.
+	oldTuple   []pgTupleValue
+	oldKeyOnly bool
+	newTuple   []pgTupleValue
+}
+
+type pgDelete struct {
+	relationID uint32
+	oldTuple   []pgTupleValue
+	oldKeyOnly bool
+}
+
+type pgTruncate struct {
+	relationIDs []uint32
+}
+
+// parsePgoutput parses a pgoutput message. It returns nil for message types that are not relevant for change capture.
+func parsePgoutput(b []byte) (any, error) {
+	if len(b) == 0 {
+		return nil, errShortMessage
+	}
+	r := &pgReader{b: b[1:]}
+	var msg any
+	switch b[0] {
+	case 'B':
+		msg = &pgBegin{}
+	case 'C':
+		r.uint8()  // flags
+		r.uint64() // commit LSN
+		msg = &pgCommit{endLSN: r.uint64()}
+	case 'R':
+		rel := &pgRelation{id: r.uint32(), namespace: r.string(), name: r.string()}
+		r.uint8() // replica identity setting
+		n := int(r.uint16())
+		for i := 0; i < n && r.err == nil; i++ {
+			flags := r.uint8()
+			name := r.string()
+			oid := r.uint32()
+			r.uint32() // type modifier
+			rel.columns = append(rel.columns, pgColumn{name: name, key: flags&1 == 1, typeOID: oid})
+		}
+		msg = rel
+	case 'I':
+		m := &pgInsert{relationID: r.uint32()}
+		if r.uint8() != 'N' {
+			return nil, fmt.Errorf("pgoutput: malformed insert message")
+		}
+		m.newTuple = r.tuple()
+		msg = m
+	case 'U':
+		m := &pgUpdate{relationID: r.uint32()}
+		kind := r.uint8()
+		if kind == 'K' || kind == 'O' {
+			m.oldKeyOnly = kind == 'K'
+			m.oldTuple = r.tuple()
+			kind = r.uint8()
+		}
+		if kind != 'N' {
+			return nil, fmt.Errorf("pgoutput: malformed update message")
+		}
+		m.newTuple = r.tuple()
+		msg = m
+	case 'D':
+		m := &pgDelete{relationID: r.uint32()}
+		kind := r.uint8()
+		if kind != 'K' && kind != 'O' {
+			return nil, fmt.Errorf("pgoutput: malformed delete message")
+		}
+		m.oldKeyOnly = kind == 'K'
+		m.oldTuple = r.tuple()
+		msg = m
+	case 'T':
+		n := int(r.uint32())
+		r.uint8() // options
+		m := &pgTruncate{}
+		for i := 0; i < n && r.err == nil; i++ {
+			m.relationIDs = append(m.relationIDs, r.uint32())
+		}
+		msg = m
+	default:
+		// Origin, type and logical decoding messages are not relevant
+		return nil, nil
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return msg, nil
+}
+
+// row converts a tuple of the relation to a row.
+// Unchanged TOASTed values are taken from prev; it returns an error if prev doesn't have them.
+// If keyOnly is true, only the relation's key columns are included.
+func (rel *pgRelation) row(tuple []pgTupleValue, keyOnly bool, prev map[string]any) (map[string]any, error) {
+	if len(tuple) != len(rel.columns) {
+		return nil, fmt.Errorf("pgoutput: tuple has %d columns, but relation %q has %d", len(tuple), rel.name, len(rel.columns))
+	}
+	row := make(map[string]any, len(tuple))
+	for i, v := range tuple {
+		col := rel.columns[i]
+		if keyOnly && !col.key {
+			continue
+		}
+		switch v.kind {
+		case 'n':
+			row[col.name] = nil
+		case 'u':
+			pv, ok := prev[col.name]
+			if !ok {
+				return nil, fmt.Errorf("the update of table %q did not include the unchanged TOASTed value of column %q: set REPLICA IDENTITY FULL on the table", rel.name, col.name)
+			}
+			row[col.name] = pv
+		default:
+			row[col.name] = textValue(col.typeOID, v.data)
+		}
+	}
+	return row, nil
+}
+
+// textValue converts a value in Postgres text format to a string that DuckDB can cast to the column's type.
+func textValue(oid uint32, data []byte) string {
+	s := string(data)
+	if oid == _byteaOID && strings.HasPrefix(s, `\x`) {
+		// Postgres encodes bytea as a single \x prefix followed by hex digits, while DuckDB expects a \x escape for each byte
+		raw, err := hex.DecodeString(s[2:])
+		if err != nil {
+			return s
+		}
+		var sb strings.Builder
+		for _, c := range raw {
+			fmt.Fprintf(&sb, `\x%02X`, c)
+		}
+		return sb.String()
+	}
+	return s
+}
+
+// pgReader reads big-endian values from a pgoutput message.
+// After the first error, all reads return zero values and the error is kept in err.
+type pgReader struct {
+	b   []byte
+	err error
+}
+
+func (r *pgReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.b) < n {
+		r.err = errShortMessage
+		return nil
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *pgReader) uint8() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *pgReader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+func (r *pgReader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *pgReader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// string reads a null-terminated string.
+func (r *pgReader) string() string {
+	if r.err != nil {
+		return ""
+	}
+	i := strings.IndexByte(string(r.b), 0)
+	if i < 0 {
+		r.err = errShortMessage
+		return ""
+	}
+	s := string(r.b[:i])
+	r.b = r.b[i+1:]
+	return s
+}
+
+func (r *pgReader) tuple() []pgTupleValue {
+	n := int(r.uint16())
+	var vals []pgTupleValue
+	for i := 0; i < n && r.err == nil; i++ {
+		v := pgTupleValue{kind: r.uint8()}
+		switch v.kind {
+		case 'n', 'u':
+		case 't', 'b':
+			l := int(r.uint32())
+			v.data = r.next(l)
+		default:
+			r.err = fmt.Errorf("pgoutput: unknown tuple value kind %q", v.kind)
+		}
+		vals = append(vals, v)
+	}
+	return vals
+}
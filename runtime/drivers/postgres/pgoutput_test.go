@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLSN(t *testing.T) {
+	lsn, err := parseLSN("16/B374D848")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x16B374D848), lsn)
+	require.Equal(t, "16/B374D848", formatLSN(lsn))
+
+	_, err = parseLSN("16B374D848")
+	require.Error(t, err)
+}
+
+func TestCDCIdentifier(t *testing.T) {
+	require.Equal(t, "rill_abc_def_my_source", cdcIdentifier("abc-def_My Source"))
+
+	long := cdcIdentifier("4f8b1d2e-6c3a-4b5e-9f10-2a3b4c5d6e7f_a_very_long_source_name")
+	require.Len(t, long, 63)
+	require.NotEqual(t, long, cdcIdentifier("4f8b1d2e-6c3a-4b5e-9f10-2a3b4c5d6e7f_a_very_long_source_name_2"))
+
+	conf, err := parseCDCProperties("instance_source", map[string]any{"table": "items"})
+	require.NoError(t, err)
+	require.Equal(t, "rill_instance_source", conf.ReplicationSlot)
+	require.Equal(t, "rill_instance_source", conf.Publication)
+	require.True(t, conf.ownsPublication)
+
+	conf, err = parseCDCProperties("instance_source", map[string]any{"table": "items", "publication": "pub"})
+	require.NoError(t, err)
+	require.False(t, conf.ownsPublication)
+}
+
+func TestParseReplicationMessage(t *testing.T) {
+	b := []byte{'w'}
+	b = binary.BigEndian.AppendUint64(b, 10)
+	b = binary.BigEndian.AppendUint64(b, 20)
+	b = binary.BigEndian.AppendUint64(b, 0)
+	b = append(b, 'B')
+	msg, err := parseReplicationMessage(b)
+	require.NoError(t, err)
+	require.Equal(t, &xLogData{walStart: 10, serverWALEnd: 20, data: []byte{'B'}}, msg)
+
+	b = []byte{'k'}
+	b = binary.BigEndian.AppendUint64(b, 30)
+	b = binary.BigEndian.AppendUint64(b, 0)
+	b = append(b, 1)
+	msg, err = parseReplicationMessage(b)
+	require.NoError(t, err)
+	require.Equal(t, &primaryKeepalive{serverWALEnd: 30, replyRequested: true}, msg)
+
+	_, err = parseReplicationMessage([]byte{'w', 1})
+	require.Error(t, err)
+
+	status := standbyStatusUpdate(40, time.UnixMicro(_pgEpochMicros+5))
+	require.Len(t, status, 34)
+	require.Equal(t, byte('r'), status[0])
+	require.Equal(t, uint64(40), binary.BigEndian.Uint64(status[17:]))
+	require.Equal(t, uint64(5), binary.BigEndian.Uint64(status[25:]))
+}
+
+func TestParsePgoutput(t *testing.T) {
+	// Relation with a key column "id" (int4) and columns "data" (bytea) and "note" (text)
+	rel := []byte{'R'}
+	rel = binary.BigEndian.AppendUint32(rel, 100)
+	rel = append(rel, "public\x00items\x00"...)
+	rel = append(rel, 'd')
+	rel = binary.BigEndian.AppendUint16(rel, 3)
+	rel = appendColumn(rel, 1, "id", 23)
+	rel = appendColumn(rel, 0, "data", _byteaOID)
+	rel = appendColumn(rel, 0, "note", 25)
+	msg, err := parsePgoutput(rel)
+	require.NoError(t, err)
+	r := msg.(*pgRelation)
+	require.Equal(t, "items", r.name)
+	require.Equal(t, []pgColumn{{name: "id", key: true, typeOID: 23}, {name: "data", typeOID: _byteaOID}, {name: "note", typeOID: 25}}, r.columns)
+
+	// Update with the old key and an unchanged TOASTed value
+	upd := []byte{'U'}
+	upd = binary.BigEndian.AppendUint32(upd, 100)
+	upd = append(upd, 'K')
+	upd = appendTuple(upd, text("1"), null(), null())
+	upd = append(upd, 'N')
+	upd = appendTuple(upd, text("2"), text(`\x0aff`), pgTupleValue{kind: 'u'})
+	msg, err = parsePgoutput(upd)
+	require.NoError(t, err)
+	u := msg.(*pgUpdate)
+	require.True(t, u.oldKeyOnly)
+
+	old, err := r.row(u.oldTuple, u.oldKeyOnly, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": "1"}, old)
+
+	_, err = r.row(u.newTuple, false, old)
+	require.ErrorContains(t, err, "REPLICA IDENTITY FULL")
+
+	row, err := r.row(u.newTuple, false, map[string]any{"id": "1", "data": nil, "note": "hello"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": "2", "data": `\x0A\xFF`, "note": "hello"}, row)
+
+	// Commit
+	commit := []byte{'C', 0}
+	commit = binary.BigEndian.AppendUint64(commit, 1)
+	commit = binary.BigEndian.AppendUint64(commit, 2)
+	commit = binary.BigEndian.AppendUint64(commit, 0)
+	msg, err = parsePgoutput(commit)
+	require.NoError(t, err)
+	require.Equal(t, &pgCommit{endLSN: 2}, msg)
+
+	// Truncate
+	trunc := []byte{'T'}
+	trunc = binary.BigEndian.AppendUint32(trunc, 2)
+	trunc = append(trunc, 0)
+	trunc = binary.BigEndian.AppendUint32(trunc, 100)
+	trunc = binary.BigEndian.AppendUint32(trunc, 101)
+	msg, err = parsePgoutput(trunc)
+	require.NoError(t, err)
+	require.Equal(t, &pgTruncate{relationIDs: []uint32{100, 101}}, msg)
+
+	// Unhandled and malformed messages
+	msg, err = parsePgoutput([]byte{'O', 0})
+	require.NoError(t, err)
+	require.Nil(t, msg)
+	_, err = parsePgoutput(upd[:len(upd)-2])
+	require.Error(t, err)
+}
+
+func appendColumn(b []byte, flags byte, name string, oid uint32) []byte {
+	b = append(b, flags)
+	b = append(b, name...)
+	b = append(b, 0)
+	b = binary.BigEndian.AppendUint32(b, oid)
+	return binary.BigEndian.AppendUint32(b, 0xffffffff)
+}
+
+func appendTuple(b []byte, vals ...pgTupleValue) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(vals)))
+	for _, v := range vals {
+		b = append(b, v.kind)
+		if v.kind == 't' {
+			b = binary.BigEndian.AppendUint32(b, uint32(len(v.data)))
+			b = append(b, v.data...)
+		}
+	}
+	return b
+}
+
+func text(s string) pgTupleValue {
+	return pgTupleValue{kind: 't', data: []byte(s)}
+}
+
+func null() pgTupleValue {
+	return pgTupleValue{kind: 'n'}
+}
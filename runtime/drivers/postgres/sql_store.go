@@ -24,13 +24,9 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 		return nil, err
 	}
 
-	var dsn string
-	if srcProps.DatabaseURL != "" { // get from src properties
-		dsn = srcProps.DatabaseURL
-	} else if url, ok := c.config["database_url"].(string); ok && url != "" { // get from driver configs
-		dsn = url
-	} else {
-		return nil, fmt.Errorf("the property 'database_url' is required for Postgres. Provide 'database_url' in the YAML properties or pass '--var connector.postgres.database_url=...' to 'rill start'")
+	dsn, err := c.databaseURL(srcProps.DatabaseURL)
+	if err != nil {
+		return nil, err
 	}
 
 	pool, err := pgxpool.New(ctx, dsn)
@@ -64,6 +60,17 @@ func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.R
 	return iter, nil
 }
 
+// databaseURL returns the database URL from the source properties, falling back to the driver config.
+func (c *connection) databaseURL(srcURL string) (string, error) {
+	if srcURL != "" { // get from src properties
+		return srcURL, nil
+	}
+	if url, ok := c.config["database_url"].(string); ok && url != "" { // get from driver configs
+		return url, nil
+	}
+	return "", fmt.Errorf("the property 'database_url' is required for Postgres. Provide 'database_url' in the YAML properties or pass '--var connector.postgres.database_url=...' to 'rill start'")
+}
+
 // QueryAsFiles implements drivers.SQLStore
 func (c *connection) QueryAsFiles(ctx context.Context, props map[string]any, opt *drivers.QueryOption, p drivers.Progress) (drivers.FileIterator, error) {
 	return nil, drivers.ErrNotImplemented
@@ -0,0 +1,254 @@
+package rest
+
+import (
+	"bufio"
+	"context"
+	sqldriver "database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// rowIterator implements drivers.RowIterator over records fetched from an API.
+// All pages are fetched and staged in a temporary NDJSON file before the first record is returned,
+// so the schema is inferred from the top-level fields of all records instead of just the first page.
+type rowIterator struct {
+	file   *os.File
+	dec    *json.Decoder
+	schema *runtimev1.StructType
+	total  uint64
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+// newRowIterator stages the records, followed by the records of the pages returned by p, and returns an iterator over them.
+// The pager may be nil if there are no further pages.
+func newRowIterator(ctx context.Context, records []map[string]any, p *pager) (*rowIterator, error) {
+	f, err := os.CreateTemp("", "rest-*.ndjson")
+	if err != nil {
+		return nil, err
+	}
+	it := &rowIterator{file: f}
+	ok := false
+	defer func() {
+		if !ok {
+			_ = it.Close()
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	inf := newSchemaInferrer()
+	for {
+		for _, rec := range records {
+			inf.add(rec)
+			if err := enc.Encode(rec); err != nil {
+				return nil, err
+			}
+			it.total++
+		}
+		if p == nil {
+			break
+		}
+		records, err = p.next(ctx)
+		if err != nil {
+			if errors.Is(err, drivers.ErrIteratorDone) {
+				break
+			}
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	it.schema = inf.schema()
+	it.dec = json.NewDecoder(bufio.NewReader(f))
+	it.dec.UseNumber()
+	ok = true
+	return it, nil
+}
+
+// Schema implements drivers.RowIterator.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	if len(r.schema.Fields) == 0 {
+		return nil, drivers.ErrIteratorDone
+	}
+	return r.schema, nil
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]sqldriver.Value, error) {
+	var rec map[string]any
+	err := r.dec.Decode(&rec)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, drivers.ErrIteratorDone
+		}
+		return nil, err
+	}
+
+	row := make([]sqldriver.Value, len(r.schema.Fields))
+	for i, f := range r.schema.Fields {
+		row[i] = convertValue(rec[f.Name], f.Type.Code)
+	}
+	return row, nil
+}
+
+// Close implements drivers.RowIterator.
+// It removes the staged records.
+func (r *rowIterator) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	_ = r.file.Close()
+	err := os.Remove(r.file.Name())
+	r.file = nil
+	return err
+}
+
+// Size implements drivers.RowIterator.
+// It returns the total number of records.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	if unit == drivers.ProgressUnitRecord {
+		return r.total, true
+	}
+	return 0, false
+}
+
+// schemaInferrer infers a struct type with a field for every top-level key in the records added to it.
+// Fields are ordered by first appearance, and alphabetically for keys first seen in the same record.
+// Fields with conflicting types across records are widened to DOUBLE (for mixed numbers) or VARCHAR.
+// Nested objects and arrays are stored as JSON.
+type schemaInferrer struct {
+	names []string
+	codes map[string]runtimev1.Type_Code
+}
+
+func newSchemaInferrer() *schemaInferrer {
+	return &schemaInferrer{codes: make(map[string]runtimev1.Type_Code)}
+}
+
+func (s *schemaInferrer) add(rec map[string]any) {
+	keys := maps.Keys(rec)
+	slices.Sort(keys)
+	for _, k := range keys {
+		code := valueTypeCode(rec[k])
+		prev, seen := s.codes[k]
+		if !seen {
+			s.names = append(s.names, k)
+			s.codes[k] = code
+			continue
+		}
+		s.codes[k] = mergeTypeCodes(prev, code)
+	}
+}
+
+func (s *schemaInferrer) schema() *runtimev1.StructType {
+	schema := &runtimev1.StructType{}
+	for _, name := range s.names {
+		code := s.codes[name]
+		if code == runtimev1.Type_CODE_UNSPECIFIED {
+			// Only nulls were seen
+			code = runtimev1.Type_CODE_STRING
+		}
+		schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+			Name: name,
+			Type: &runtimev1.Type{Code: code, Nullable: true},
+		})
+	}
+	return schema
+}
+
+func valueTypeCode(v any) runtimev1.Type_Code {
+	switch v := v.(type) {
+	case nil:
+		return runtimev1.Type_CODE_UNSPECIFIED
+	case bool:
+		return runtimev1.Type_CODE_BOOL
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return runtimev1.Type_CODE_INT64
+		}
+		return runtimev1.Type_CODE_FLOAT64
+	case string:
+		return runtimev1.Type_CODE_STRING
+	default:
+		return runtimev1.Type_CODE_JSON
+	}
+}
+
+func mergeTypeCodes(a, b runtimev1.Type_Code) runtimev1.Type_Code {
+	switch {
+	case a == b:
+		return a
+	case a == runtimev1.Type_CODE_UNSPECIFIED:
+		return b
+	case b == runtimev1.Type_CODE_UNSPECIFIED:
+		return a
+	case isNumeric(a) && isNumeric(b):
+		return runtimev1.Type_CODE_FLOAT64
+	default:
+		return runtimev1.Type_CODE_STRING
+	}
+}
+
+func isNumeric(c runtimev1.Type_Code) bool {
+	return c == runtimev1.Type_CODE_INT64 || c == runtimev1.Type_CODE_FLOAT64
+}
+
+// convertValue converts a decoded JSON value to a value that can be appended to a column of the given type.
+// Since the type is inferred from all records, it only returns nil for null values.
+func convertValue(v any, code runtimev1.Type_Code) sqldriver.Value {
+	if v == nil {
+		return nil
+	}
+	switch code {
+	case runtimev1.Type_CODE_BOOL:
+		if b, ok := v.(bool); ok {
+			return b
+		}
+		return nil
+	case runtimev1.Type_CODE_INT64:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil
+		}
+		return i
+	case runtimev1.Type_CODE_FLOAT64:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return nil
+		}
+		return f
+	default:
+		if s, ok := v.(string); ok {
+			return s
+		}
+		if n, ok := v.(json.Number); ok {
+			return n.String()
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed JSONPath expression.
+// It supports the subset needed to locate records in API responses:
+// the root ($), child members (.name or ['name']), array indexes ([0], [-1]) and wildcards (.* or [*]).
+type jsonPath []jsonPathStep
+
+type jsonPathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+func parseJSONPath(expr string) (jsonPath, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("JSONPath %q must start with '$'", expr)
+	}
+
+	var path jsonPath
+	rest := expr[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			if name == "" {
+				return nil, fmt.Errorf("JSONPath %q has an empty member name", expr)
+			}
+			if name == "*" {
+				path = append(path, jsonPathStep{wildcard: true})
+			} else {
+				path = append(path, jsonPathStep{key: name})
+			}
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("JSONPath %q has an unterminated '['", expr)
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			switch {
+			case inner == "*":
+				path = append(path, jsonPathStep{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				path = append(path, jsonPathStep{key: inner[1 : len(inner)-1]})
+			default:
+				i, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("JSONPath %q has an unsupported selector [%s]", expr, inner)
+				}
+				path = append(path, jsonPathStep{index: i, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("JSONPath %q has an unexpected character %q", expr, rest[0])
+		}
+	}
+	return path, nil
+}
+
+// eval returns the values matched by the path in v.
+func (p jsonPath) eval(v any) []any {
+	matches := []any{v}
+	for _, step := range p {
+		var next []any
+		for _, m := range matches {
+			switch m := m.(type) {
+			case map[string]any:
+				if step.wildcard {
+					for _, child := range m {
+						next = append(next, child)
+					}
+				} else if child, ok := m[step.key]; ok && !step.isIndex {
+					next = append(next, child)
+				}
+			case []any:
+				if step.wildcard {
+					next = append(next, m...)
+				} else if step.isIndex {
+					i := step.index
+					if i < 0 {
+						i += len(m)
+					}
+					if i >= 0 && i < len(m) {
+						next = append(next, m[i])
+					}
+				}
+			}
+		}
+		matches = next
+	}
+	return matches
+}
@@ -0,0 +1,212 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("rest", driver{})
+	drivers.RegisterAsConnector("rest", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "REST API",
+	Description: "Ingest records from a JSON HTTP API.",
+	SourceProperties: []drivers.PropertySchema{
+		{
+			Key:         "url",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "URL",
+			Description: "URL of the API endpoint to call.",
+			Placeholder: "https://api.example.com/v1/orders",
+		},
+		{
+			Key:         "method",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Method",
+			Description: "HTTP method to use for the requests.",
+			Placeholder: "GET",
+			Default:     "GET",
+		},
+		{
+			Key:         "records_path",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Records path",
+			Description: "JSONPath expression that selects the records in each response.",
+			Placeholder: "$.data",
+			Default:     "$",
+		},
+		{
+			Key:         "token",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Bearer token",
+			Description: "Token to send in the Authorization header.",
+			Secret:      true,
+			Hint:        "Either set this or pass --var connector.rest.token=... to rill start",
+		},
+	},
+	ConfigProperties: []drivers.PropertySchema{
+		{
+			Key:    "token",
+			Secret: true,
+		},
+		{
+			Key: "username",
+		},
+		{
+			Key:    "password",
+			Secret: true,
+		},
+		{
+			Key: "client_id",
+		},
+		{
+			Key:    "client_secret",
+			Secret: true,
+		},
+	},
+}
+
+type driver struct{}
+
+type configProperties struct {
+	Token        string `mapstructure:"token"`
+	Username     string `mapstructure:"username"`
+	Password     string `mapstructure:"password"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+}
+
+func (d driver) Open(config map[string]any, shared bool, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if shared {
+		return nil, fmt.Errorf("rest driver can't be shared")
+	}
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{
+		driverConfig: config,
+		config:       conf,
+		logger:       logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return drivers.ErrDropNotSupported
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	props, err := parseSourceProperties(src)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse config: %w", err)
+	}
+	return props.Auth.Type == "" && props.Token == "", nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type connection struct {
+	driverConfig map[string]any
+	config       *configProperties
+	logger       *zap.Logger
+}
+
+var _ drivers.Handle = &connection{}
+
+// Driver implements drivers.Connection.
+func (c *connection) Driver() string {
+	return "rest"
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.driverConfig
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+// The records are exposed through SQLStore.Query so they can be loaded by the DuckDB SQL store transporter.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return c, true
+}
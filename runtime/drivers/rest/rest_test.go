@@ -0,0 +1,318 @@
+package rest
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestQuery(t *testing.T) {
+	items := make([]map[string]any, 5)
+	for i := range items {
+		items[i] = map[string]any{"id": i, "name": fmt.Sprintf("item%d", i), "tags": []string{"a"}}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cursor", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		end := min(start+2, len(items))
+		res := map[string]any{"data": map[string]any{"items": items[start:end]}}
+		if end < len(items) {
+			res["next"] = strconv.Itoa(end)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "u" || pass != "p" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		end := min(page*2+2, len(items))
+		if end < len(items) {
+			w.Header().Set("Link", fmt.Sprintf(`</link?page=%d>; rel="next", </link?page=0>; rel="first"`, page+1))
+		}
+		_ = json.NewEncoder(w).Encode(items[page*2 : end])
+	})
+	mux.HandleFunc("/offset", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := min(offset+limit, len(items))
+		_ = json.NewEncoder(w).Encode(map[string]any{"results": items[offset:end]})
+	})
+	mux.HandleFunc("/nolimit", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(items)
+	})
+	mux.HandleFunc("/samecursor", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": items[:2], "next": "x"})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.Form.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"oauth","token_type":"bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/oauth", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer oauth" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1,"score":1.5,"ok":true,"meta":{"x":1}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		props  map[string]any
+		rows   int
+		err    string
+	}{
+		{
+			name:   "cursor with bearer token from config",
+			config: map[string]any{"token": "secret"},
+			props: map[string]any{
+				"url":          srv.URL + "/cursor",
+				"records_path": "$.data.items",
+				"pagination":   map[string]any{"type": "cursor", "cursor_path": "$.next", "cursor_param": "after"},
+			},
+			rows: 5,
+		},
+		{
+			name:  "cursor without token",
+			props: map[string]any{"url": srv.URL + "/cursor"},
+			err:   "status 401",
+		},
+		{
+			name: "link header with basic auth",
+			props: map[string]any{
+				"url":        srv.URL + "/link",
+				"auth":       map[string]any{"type": "basic", "username": "u", "password": "p"},
+				"pagination": map[string]any{"type": "link"},
+			},
+			rows: 5,
+		},
+		{
+			name: "offset",
+			props: map[string]any{
+				"url":          srv.URL + "/offset",
+				"records_path": "$['results']",
+				"pagination":   map[string]any{"type": "offset", "page_size": "2"},
+			},
+			rows: 5,
+		},
+		{
+			name: "max pages",
+			props: map[string]any{
+				"url":          srv.URL + "/offset",
+				"records_path": "$.results",
+				"pagination":   map[string]any{"type": "offset", "page_size": 2, "max_pages": 2},
+			},
+			rows: 4,
+		},
+		{
+			name: "offset ignored by the api",
+			props: map[string]any{
+				"url":        srv.URL + "/nolimit",
+				"pagination": map[string]any{"type": "offset", "page_size": 2},
+			},
+			rows: 5,
+		},
+		{
+			name: "repeated cursor",
+			props: map[string]any{
+				"url":          srv.URL + "/samecursor",
+				"records_path": "$.items",
+				"pagination":   map[string]any{"type": "cursor", "cursor_path": "$.next"},
+			},
+			rows: 4,
+		},
+		{
+			name:   "oauth2 client credentials",
+			config: map[string]any{"client_id": "id", "client_secret": "secret"},
+			props: map[string]any{
+				"url":  srv.URL + "/oauth",
+				"auth": map[string]any{"type": "oauth2", "token_url": srv.URL + "/token"},
+			},
+			rows: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handle, err := driver{}.Open(tt.config, false, nil, zap.NewNop())
+			require.NoError(t, err)
+			store, ok := handle.AsSQLStore()
+			require.True(t, ok)
+
+			iter, err := store.Query(context.Background(), tt.props)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			defer iter.Close()
+
+			_, err = iter.Schema(context.Background())
+			require.NoError(t, err)
+			n := 0
+			for {
+				_, err := iter.Next(context.Background())
+				if err == drivers.ErrIteratorDone {
+					break
+				}
+				require.NoError(t, err)
+				n++
+			}
+			require.Equal(t, tt.rows, n)
+		})
+	}
+}
+
+func TestCredentialsOnlySentToSourceHost(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[{"id":2}]`))
+	}))
+	defer other.Close()
+
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		w.Header().Set("Link", fmt.Sprintf(`<%s/next>; rel="next"`, other.URL))
+		_, _ = w.Write([]byte(`[{"id":1}]`))
+	}))
+	defer src.Close()
+
+	handle, err := driver{}.Open(map[string]any{"token": "secret"}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	store, ok := handle.AsSQLStore()
+	require.True(t, ok)
+
+	iter, err := store.Query(context.Background(), map[string]any{
+		"url":        src.URL,
+		"pagination": map[string]any{"type": "link"},
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err = iter.Next(context.Background())
+		require.NoError(t, err)
+	}
+	_, err = iter.Next(context.Background())
+	require.ErrorIs(t, err, drivers.ErrIteratorDone)
+}
+
+func TestInferSchema(t *testing.T) {
+	// The second page has values that conflict with the types of the first page, and fields not in the first page
+	pages := []string{
+		`[{"a":1,"b":"x","c":{"k":1}}]`,
+		`[{"a":2.5,"b":3,"d":null,"e":true}]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page+1 < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`</?page=%d>; rel="next"`, page+1))
+		}
+		_, _ = w.Write([]byte(pages[page]))
+	}))
+	defer srv.Close()
+
+	handle, err := driver{}.Open(nil, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	store, ok := handle.AsSQLStore()
+	require.True(t, ok)
+	iter, err := store.Query(context.Background(), map[string]any{
+		"url":        srv.URL,
+		"pagination": map[string]any{"type": "link"},
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	schema, err := iter.Schema(context.Background())
+	require.NoError(t, err)
+
+	var names []string
+	var codes []runtimev1.Type_Code
+	for _, f := range schema.Fields {
+		names = append(names, f.Name)
+		codes = append(codes, f.Type.Code)
+	}
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+	require.Equal(t, []runtimev1.Type_Code{
+		runtimev1.Type_CODE_FLOAT64,
+		runtimev1.Type_CODE_STRING,
+		runtimev1.Type_CODE_JSON,
+		runtimev1.Type_CODE_STRING,
+		runtimev1.Type_CODE_BOOL,
+	}, codes)
+	n, ok := iter.Size(drivers.ProgressUnitRecord)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), n)
+
+	row, err := iter.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []sqldriver.Value{1.0, "x", `{"k":1}`, nil, nil}, row)
+	row, err = iter.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []sqldriver.Value{2.5, "3", nil, nil, true}, row)
+	_, err = iter.Next(context.Background())
+	require.ErrorIs(t, err, drivers.ErrIteratorDone)
+}
+
+func TestJSONPath(t *testing.T) {
+	doc := map[string]any{"a": map[string]any{"b": []any{"x", "y", map[string]any{"c": "z"}}}}
+
+	tests := []struct {
+		path string
+		want []any
+		err  bool
+	}{
+		{path: "$", want: []any{doc}},
+		{path: "$.a.b[0]", want: []any{"x"}},
+		{path: "$.a.b[-1].c", want: []any{"z"}},
+		{path: "$['a'][\"b\"][*]", want: []any{"x", "y", map[string]any{"c": "z"}}},
+		{path: "$.a.missing", want: nil},
+		{path: "a.b", err: true},
+		{path: "$.a[", err: true},
+		{path: "$.a[?(@.b)]", err: true},
+	}
+	for _, tt := range tests {
+		p, err := parseJSONPath(tt.path)
+		if tt.err {
+			require.Error(t, err, tt.path)
+			continue
+		}
+		require.NoError(t, err, tt.path)
+		require.Equal(t, tt.want, p.eval(doc), tt.path)
+	}
+}
+
+func TestNextLinkURL(t *testing.T) {
+	next, err := nextLinkURL("https://api.example.com/items?page=1", `<https://api.example.com/items?page=1>; rel="prev", </items?page=3>; rel="next"`)
+	require.NoError(t, err)
+	require.Equal(t, "https://api.example.com/items?page=3", next)
+
+	next, err = nextLinkURL("https://api.example.com/items", `<https://api.example.com/items?page=1>; rel="first"`)
+	require.NoError(t, err)
+	require.Equal(t, "", next)
+}
@@ -0,0 +1,497 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// maxResponseBytes caps the size of a single response body.
+	maxResponseBytes = 256 << 20
+	// requestTimeout caps the duration of a single request, including reading the response body.
+	requestTimeout = 2 * time.Minute
+	// defaultMaxPages caps the number of requests when the source doesn't set max_pages.
+	defaultMaxPages = 10000
+)
+
+type sourceProperties struct {
+	URL         string            `mapstructure:"url"`
+	Method      string            `mapstructure:"method"`
+	Headers     map[string]string `mapstructure:"headers"`
+	Params      map[string]string `mapstructure:"params"`
+	Body        string            `mapstructure:"body"`
+	RecordsPath string            `mapstructure:"records_path"`
+	Token       string            `mapstructure:"token"`
+	Auth        authProperties    `mapstructure:"auth"`
+	Pagination  paginationProps   `mapstructure:"pagination"`
+}
+
+type authProperties struct {
+	// Type is one of "bearer", "basic" or "oauth2". It defaults to "bearer" when a token is configured.
+	Type         string   `mapstructure:"type"`
+	Token        string   `mapstructure:"token"`
+	Username     string   `mapstructure:"username"`
+	Password     string   `mapstructure:"password"`
+	TokenURL     string   `mapstructure:"token_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+type paginationProps struct {
+	// Type is one of "cursor", "link" or "offset". Pagination is disabled when empty.
+	Type string `mapstructure:"type"`
+	// CursorPath is a JSONPath selecting the next cursor (or next page URL) in a response.
+	CursorPath string `mapstructure:"cursor_path"`
+	// CursorParam is the query parameter used to pass the cursor to the next request.
+	CursorParam string `mapstructure:"cursor_param"`
+	// OffsetParam and LimitParam are the query parameters used for offset pagination.
+	OffsetParam string `mapstructure:"offset_param"`
+	LimitParam  string `mapstructure:"limit_param"`
+	PageSize    int    `mapstructure:"page_size"`
+	// MaxPages limits the number of requests. It defaults to defaultMaxPages.
+	MaxPages int `mapstructure:"max_pages"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.URL == "" {
+		return nil, fmt.Errorf("property 'url' is mandatory for connector \"rest\"")
+	}
+	if conf.Method == "" {
+		conf.Method = http.MethodGet
+	}
+	conf.Method = strings.ToUpper(conf.Method)
+	if conf.RecordsPath == "" {
+		conf.RecordsPath = "$"
+	}
+	if _, err := parseJSONPath(conf.RecordsPath); err != nil {
+		return nil, fmt.Errorf("invalid 'records_path': %w", err)
+	}
+
+	// A top-level token is shorthand for bearer auth
+	if conf.Token != "" && conf.Auth.Token == "" {
+		conf.Auth.Token = conf.Token
+	}
+	if conf.Auth.Type == "" && conf.Auth.Token != "" {
+		conf.Auth.Type = "bearer"
+	}
+	switch conf.Auth.Type {
+	case "", "bearer", "basic":
+	case "oauth2":
+		if conf.Auth.TokenURL == "" {
+			return nil, fmt.Errorf("property 'auth.token_url' is mandatory for oauth2 authentication")
+		}
+	default:
+		return nil, fmt.Errorf("invalid auth type %q, must be one of bearer, basic or oauth2", conf.Auth.Type)
+	}
+
+	p := &conf.Pagination
+	switch p.Type {
+	case "", "link":
+	case "cursor":
+		if p.CursorPath == "" {
+			return nil, fmt.Errorf("property 'pagination.cursor_path' is mandatory for cursor pagination")
+		}
+		if _, err := parseJSONPath(p.CursorPath); err != nil {
+			return nil, fmt.Errorf("invalid 'pagination.cursor_path': %w", err)
+		}
+		if p.CursorParam == "" {
+			p.CursorParam = "cursor"
+		}
+	case "offset":
+		if p.OffsetParam == "" {
+			p.OffsetParam = "offset"
+		}
+		if p.LimitParam == "" {
+			p.LimitParam = "limit"
+		}
+		if p.PageSize <= 0 {
+			p.PageSize = 100
+		}
+	default:
+		return nil, fmt.Errorf("invalid pagination type %q, must be one of cursor, link or offset", p.Type)
+	}
+	if p.MaxPages <= 0 {
+		p.MaxPages = defaultMaxPages
+	}
+
+	return conf, nil
+}
+
+// Query implements drivers.SQLStore.
+// It fetches all pages from the API before returning, so the schema can be inferred from all records.
+func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.RowIterator, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.httpClient(ctx, srcProps)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := c.newPager(client, srcProps)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRowIterator(ctx, nil, p)
+}
+
+// QueryAsFiles implements drivers.SQLStore
+func (c *connection) QueryAsFiles(ctx context.Context, props map[string]any, opt *drivers.QueryOption, p drivers.Progress) (drivers.FileIterator, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+// httpClient returns a client that authenticates requests according to the source's auth properties.
+// Credentials not set on the source fall back to the connector config.
+// Credentials are only sent to the host of the source URL.
+func (c *connection) httpClient(ctx context.Context, props *sourceProperties) (*http.Client, error) {
+	u, err := url.Parse(props.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %q: %w", props.URL, err)
+	}
+	t := &authTransport{host: u.Host}
+
+	auth := props.Auth
+	switch auth.Type {
+	case "oauth2":
+		cfg := &clientcredentials.Config{
+			ClientID:     firstNonEmpty(auth.ClientID, c.config.ClientID),
+			ClientSecret: firstNonEmpty(auth.ClientSecret, c.config.ClientSecret),
+			TokenURL:     auth.TokenURL,
+			Scopes:       auth.Scopes,
+		}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: requestTimeout})
+		t.tokens = cfg.TokenSource(ctx)
+	case "bearer":
+		token := firstNonEmpty(auth.Token, c.config.Token)
+		if token == "" {
+			return nil, fmt.Errorf("the property 'token' is required for bearer authentication. Provide 'token' in the YAML properties or pass '--var connector.rest.token=...' to 'rill start'")
+		}
+		t.header = "Bearer " + token
+	case "basic":
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(firstNonEmpty(auth.Username, c.config.Username), firstNonEmpty(auth.Password, c.config.Password))
+		t.header = req.Header.Get("Authorization")
+	default:
+		if c.config.Token == "" {
+			return &http.Client{Timeout: requestTimeout}, nil
+		}
+		t.header = "Bearer " + c.config.Token
+	}
+
+	return &http.Client{Transport: t, Timeout: requestTimeout}, nil
+}
+
+// pager fetches the pages of an API one at a time.
+// It stops when pagination is exhausted, a page is empty, a page was already fetched, or after the max number of pages.
+type pager struct {
+	conn        *connection
+	client      *http.Client
+	props       *sourceProperties
+	recordsPath jsonPath
+	cursorPath  jsonPath
+	nextURL     string
+	offset      int
+	page        int
+	seen        map[string]bool
+}
+
+func (c *connection) newPager(client *http.Client, props *sourceProperties) (*pager, error) {
+	recordsPath, err := parseJSONPath(props.RecordsPath)
+	if err != nil {
+		return nil, err
+	}
+	var cursorPath jsonPath
+	if props.Pagination.Type == "cursor" {
+		cursorPath, err = parseJSONPath(props.Pagination.CursorPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nextURL, err := withParams(props.URL, props.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pager{
+		conn:        c,
+		client:      client,
+		props:       props,
+		recordsPath: recordsPath,
+		cursorPath:  cursorPath,
+		nextURL:     nextURL,
+		seen:        make(map[string]bool),
+	}, nil
+}
+
+// next fetches the next page and returns its records. It returns drivers.ErrIteratorDone when there are no more pages.
+func (p *pager) next(ctx context.Context) ([]map[string]any, error) {
+	if p.nextURL == "" {
+		return nil, drivers.ErrIteratorDone
+	}
+	if p.page >= p.props.Pagination.MaxPages {
+		p.conn.logger.Warn("stopped pagination after reaching the max number of pages", zap.Int("max_pages", p.props.Pagination.MaxPages))
+		p.nextURL = ""
+		return nil, drivers.ErrIteratorDone
+	}
+
+	reqURL := p.nextURL
+	if p.props.Pagination.Type == "offset" {
+		var err error
+		reqURL, err = withParams(p.nextURL, map[string]string{
+			p.props.Pagination.OffsetParam: strconv.Itoa(p.offset),
+			p.props.Pagination.LimitParam:  strconv.Itoa(p.props.Pagination.PageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.seen[reqURL] {
+		p.conn.logger.Warn("stopped pagination because the next page was already fetched", zap.Int("page", p.page))
+		p.nextURL = ""
+		return nil, drivers.ErrIteratorDone
+	}
+	p.seen[reqURL] = true
+
+	body, header, err := p.conn.do(ctx, p.client, p.props, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := extractRecords(body, p.recordsPath)
+	if err != nil {
+		return nil, err
+	}
+	p.conn.logger.Debug("fetched page", zap.Int("page", p.page), zap.Int("records", len(records)))
+	p.page++
+
+	if len(records) == 0 {
+		p.nextURL = ""
+		return nil, drivers.ErrIteratorDone
+	}
+
+	switch p.props.Pagination.Type {
+	case "cursor":
+		p.nextURL, err = nextCursorURL(p.nextURL, body, p.cursorPath, p.props.Pagination.CursorParam)
+		if err != nil {
+			return nil, err
+		}
+	case "link":
+		p.nextURL, err = nextLinkURL(reqURL, header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	case "offset":
+		p.offset += len(records)
+		// A page with fewer records is the last one, and a page with more records means the API ignores the limit param.
+		if len(records) != p.props.Pagination.PageSize {
+			p.nextURL = ""
+		}
+	default:
+		p.nextURL = ""
+	}
+
+	return records, nil
+}
+
+// do sends a single request and returns the decoded JSON body and the response headers.
+func (c *connection) do(ctx context.Context, client *http.Client, props *sourceProperties, reqURL string) (any, http.Header, error) {
+	var body io.Reader
+	if props.Body != "" {
+		body = bytes.NewBufferString(props.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, props.Method, reqURL, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if props.Body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range props.Headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, nil, fmt.Errorf("request to %q failed with status %d: %s", req.URL.Redacted(), res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	dec := json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode response from %q: %w", req.URL.Redacted(), err)
+	}
+	return v, res.Header, nil
+}
+
+// extractRecords applies the records path to a response body.
+// If the path selects a single array, its elements are the records. Non-object records are wrapped in a "value" field.
+func extractRecords(body any, path jsonPath) ([]map[string]any, error) {
+	matches := path.eval(body)
+	if len(matches) == 1 {
+		if arr, ok := matches[0].([]any); ok {
+			matches = arr
+		}
+	}
+
+	records := make([]map[string]any, 0, len(matches))
+	for _, m := range matches {
+		switch v := m.(type) {
+		case nil:
+			continue
+		case map[string]any:
+			records = append(records, v)
+		default:
+			records = append(records, map[string]any{"value": v})
+		}
+	}
+	return records, nil
+}
+
+// nextCursorURL returns the URL of the next page for cursor pagination, or an empty string when there are no more pages.
+// A cursor that is itself a URL is used as the next page URL.
+func nextCursorURL(current string, body any, path jsonPath, param string) (string, error) {
+	matches := path.eval(body)
+	if len(matches) == 0 || matches[0] == nil {
+		return "", nil
+	}
+	var cursor string
+	switch v := matches[0].(type) {
+	case string:
+		cursor = v
+	case json.Number:
+		cursor = v.String()
+	case bool:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unexpected cursor value of type %T", v)
+	}
+	if cursor == "" {
+		return "", nil
+	}
+	if strings.HasPrefix(cursor, "http://") || strings.HasPrefix(cursor, "https://") {
+		return cursor, nil
+	}
+	if strings.HasPrefix(cursor, "/") {
+		return resolveURL(current, cursor)
+	}
+	return withParams(current, map[string]string{param: cursor})
+}
+
+// nextLinkURL parses a RFC 8288 Link header and returns the URL with rel="next", or an empty string if there is none.
+func nextLinkURL(current, header string) (string, error) {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for _, param := range parts[1:] {
+			k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(k, "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(v, `"`)) {
+				if strings.EqualFold(rel, "next") {
+					return resolveURL(current, target[1:len(target)-1])
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func resolveURL(base, ref string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return b.ResolveReference(r).String(), nil
+}
+
+// withParams returns the URL with the given query parameters set.
+func withParams(u string, params map[string]string) (string, error) {
+	if len(params) == 0 {
+		return u, nil
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", u, err)
+	}
+	q := parsed.Query()
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	parsed.RawQuery = q.Encode()
+	return parsed.String(), nil
+}
+
+// authTransport sets the Authorization header on requests to host.
+// Requests to other hosts, such as next page URLs pointing elsewhere, are sent without credentials.
+type authTransport struct {
+	host   string
+	header string
+	tokens oauth2.TokenSource
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.EqualFold(req.URL.Host, t.host) {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	if t.tokens != nil {
+		tok, err := t.tokens.Token()
+		if err != nil {
+			return nil, err
+		}
+		tok.SetAuthHeader(req)
+	} else {
+		req.Header.Set("Authorization", t.header)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -200,6 +200,11 @@ func (c *Connection) AsAI(instanceID string) (drivers.AIService, bool) {
 	return nil, false
 }
 
+// AsNotifier implements drivers.Handle.
+func (c *Connection) AsNotifier() (drivers.Notifier, bool) {
+	return nil, false
+}
+
 // AsOLAP implements drivers.Connection.
 func (c *Connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
 	return nil, false
@@ -361,6 +366,35 @@ func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (dri
 	return it, err
 }
 
+// ListObjectsForGlob implements drivers.ObjectStore.
+func (c *Connection) ListObjectsForGlob(ctx context.Context, glob string) ([]drivers.ObjectStoreEntry, error) {
+	conf, err := parseSourceProperties(map[string]any{"path": glob})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	creds, err := c.getCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	bucketObj, err := c.openBucket(ctx, conf, conf.url.Host, creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %q, %w", conf.url.Host, err)
+	}
+	defer bucketObj.Close()
+
+	entries, err := rillblob.ListObjects(ctx, bucketObj, conf.url, rillblob.Options{})
+	if err != nil {
+		var failureErr awserr.RequestFailure
+		if errors.As(err, &failureErr) && (failureErr.StatusCode() == http.StatusForbidden || failureErr.StatusCode() == http.StatusBadRequest) {
+			return nil, drivers.NewPermissionDeniedError(fmt.Sprintf("can't access remote err: %v", failureErr))
+		}
+		return nil, err
+	}
+	return entries, nil
+}
+
 func (c *Connection) openBucket(ctx context.Context, conf *sourceProperties, bucket string, creds *credentials.Credentials) (*blob.Bucket, error) {
 	sess, err := c.getAwsSessionConfig(ctx, conf, bucket, creds)
 	if err != nil {
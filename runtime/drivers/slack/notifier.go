@@ -0,0 +1,150 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+type notifier struct {
+	c *connection
+}
+
+var _ drivers.Notifier = &notifier{}
+
+// message is the payload of a Slack incoming webhook.
+// See: https://api.slack.com/messaging/webhooks
+type message struct {
+	Text string `json:"text"`
+}
+
+// SendAlertStatus implements drivers.Notifier.
+func (n *notifier) SendAlertStatus(ctx context.Context, props map[string]any, s *drivers.AlertStatus) error {
+	var b strings.Builder
+	title := escape(s.Title)
+	if title == "" {
+		title = escape(s.Name)
+	}
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if s.IsRecover {
+			fmt.Fprintf(&b, ":large_green_circle: Alert *%s* recovered", title)
+		} else {
+			fmt.Fprintf(&b, ":large_green_circle: Alert *%s* passed", title)
+		}
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		fmt.Fprintf(&b, ":red_circle: Alert *%s* triggered", title)
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		fmt.Fprintf(&b, ":warning: Alert *%s* failed to run", title)
+	default:
+		return fmt.Errorf("unexpected assertion status: %v", s.Status)
+	}
+	if !s.ExecutionTime.IsZero() {
+		fmt.Fprintf(&b, " for %s", s.ExecutionTime.Format(time.RFC1123))
+	}
+	b.WriteString("\n")
+
+	if len(s.FailRow) > 0 {
+		keys := make([]string, 0, len(s.FailRow))
+		for k := range s.FailRow {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString("```")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s: %v\n", escape(k), escape(fmt.Sprint(s.FailRow[k])))
+		}
+		b.WriteString("```\n")
+	}
+	if s.ExecutionError != "" {
+		fmt.Fprintf(&b, "> %s\n", escape(s.ExecutionError))
+	}
+	writeLinks(&b, link(s.OpenLink, "Open in Rill"), link(s.EditLink, "Edit alert"))
+
+	return n.post(ctx, props, &message{Text: strings.TrimSpace(b.String())})
+}
+
+// SendScheduledReport implements drivers.Notifier.
+func (n *notifier) SendScheduledReport(ctx context.Context, props map[string]any, s *drivers.ScheduledReport) error {
+	var b strings.Builder
+	title := escape(s.Title)
+	if title == "" {
+		title = escape(s.Name)
+	}
+	fmt.Fprintf(&b, ":bar_chart: Report *%s* for %s is ready\n", title, s.ReportTime.Format(time.RFC1123))
+	writeLinks(&b, link(s.DownloadLink, "Download "+s.DownloadFormat), link(s.OpenLink, "Open in Rill"), link(s.EditLink, "Edit report"))
+
+	return n.post(ctx, props, &message{Text: strings.TrimSpace(b.String())})
+}
+
+// post sends the message to the Slack webhook.
+func (n *notifier) post(ctx context.Context, props map[string]any, msg *message) error {
+	cfg := *n.c.config
+	err := mapstructure.WeakDecode(props, &cfg)
+	if err != nil {
+		return fmt.Errorf("invalid slack properties: %w", err)
+	}
+	if cfg.WebhookURL == "" {
+		return errors.New(`slack "webhook_url" is not configured`)
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("slack responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// writeLinks writes the non-empty links separated by bullets.
+func writeLinks(b *strings.Builder, links ...string) {
+	var res []string
+	for _, l := range links {
+		if l != "" {
+			res = append(res, l)
+		}
+	}
+	b.WriteString(strings.Join(res, " • "))
+}
+
+// link formats a link in Slack's mrkdwn syntax. It returns an empty string if the URL is empty.
+func link(url, text string) string {
+	if url == "" {
+		return ""
+	}
+	return fmt.Sprintf("<%s|%s>", url, escape(text))
+}
+
+// escape escapes the control characters of Slack's mrkdwn syntax.
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
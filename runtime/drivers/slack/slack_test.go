@@ -0,0 +1,74 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var msgs []message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg message
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		msgs = append(msgs, msg)
+	}))
+	defer srv.Close()
+
+	handle, err := driver{}.Open(map[string]any{"webhook_url": srv.URL}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, ok := handle.AsNotifier()
+	require.True(t, ok)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	err = n.SendAlertStatus(context.Background(), nil, &drivers.AlertStatus{
+		Title:         "Bids < 10",
+		ExecutionTime: ts,
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"domain": "msn.com", "bids": 3},
+		OpenLink:      "https://example.com/open",
+		EditLink:      "https://example.com/edit",
+	})
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(context.Background(), nil, &drivers.AlertStatus{
+		Name:      "bids_alert",
+		Status:    runtimev1.AssertionStatus_ASSERTION_STATUS_PASS,
+		IsRecover: true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, msgs, 2)
+	require.Equal(t, ":red_circle: Alert *Bids &lt; 10* triggered for Tue, 02 Jan 2024 03:04:05 UTC\n```bids: 3\ndomain: msn.com\n```\n<https://example.com/open|Open in Rill> • <https://example.com/edit|Edit alert>", msgs[0].Text)
+	require.Equal(t, ":large_green_circle: Alert *bids_alert* recovered", msgs[1].Text)
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	var msg message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+	}))
+	defer srv.Close()
+
+	// The webhook URL on the notifier takes precedence over the connector's config
+	handle, err := driver{}.Open(map[string]any{"webhook_url": "http://localhost:1"}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, _ := handle.AsNotifier()
+
+	err = n.SendScheduledReport(context.Background(), map[string]any{"webhook_url": srv.URL}, &drivers.ScheduledReport{
+		Title:          "Weekly bids",
+		ReportTime:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		DownloadLink:   "https://example.com/download",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ":bar_chart: Report *Weekly bids* for Tue, 02 Jan 2024 00:00:00 UTC is ready\n<https://example.com/download|Download CSV>", msg.Text)
+}
@@ -37,3 +37,48 @@ type RowIterator interface {
 	// Returns 0,false if not able to compute size in given unit
 	Size(unit ProgressUnit) (uint64, bool)
 }
+
+// ChangeCaptureStore is implemented by SQL stores that can capture row-level changes to a table from the database's replication log (change data capture).
+// It is an optional extension of SQLStore, so callers should type assert the result of AsSQLStore.
+// Positions in the replication log are represented as offsets, which the caller must persist between calls.
+type ChangeCaptureStore interface {
+	SQLStore
+	// StartChangeCapture prepares to capture changes to the table configured in props.
+	// The streamID uniquely identifies the stream across instances and sources, and is used to name any state kept for it in the database.
+	// It returns the offsets to read changes from and the props to pass to Query to take a snapshot of the table.
+	// The snapshot is taken after the returned offsets, so changes read from the offsets may already be reflected in it.
+	StartChangeCapture(ctx context.Context, streamID string, props map[string]any) (*ChangeCaptureStart, error)
+	// ReadChanges reads a batch of committed changes after the given offsets.
+	ReadChanges(ctx context.Context, streamID string, props map[string]any, offsets map[string]int64) (*ChangeBatch, error)
+	// StopChangeCapture releases any state kept in the database for the stream, such as a replication slot.
+	StopChangeCapture(ctx context.Context, streamID string, props map[string]any) error
+}
+
+// ChangeCaptureStart is the result of ChangeCaptureStore.StartChangeCapture
+type ChangeCaptureStart struct {
+	// Offsets to pass to the first call to ReadChanges.
+	Offsets map[string]int64
+	// SnapshotProps are the props to pass to Query to read the table's current rows.
+	SnapshotProps map[string]any
+}
+
+// ChangeBatch is a batch of changes returned by ChangeCaptureStore.ReadChanges
+type ChangeBatch struct {
+	// Changes in commit order.
+	Changes []*RowChange
+	// PrimaryKey lists the columns that identify a row.
+	PrimaryKey []string
+	// Offsets to read the next batch from.
+	Offsets map[string]int64
+}
+
+// RowChange is an insert, update, delete or truncate of a table captured by a ChangeCaptureStore.
+// Inserts and updates are represented as upserts of the full row.
+type RowChange struct {
+	// Delete is true if the row was deleted. Only the primary key columns of Row are used.
+	Delete bool
+	// Truncate is true if all rows were deleted. Row is nil.
+	Truncate bool
+	// Row maps column names to values. Values are nil or strings that can be cast to the column's type.
+	Row map[string]any
+}
@@ -16,6 +16,45 @@ type TransferOptions struct {
 	RepoRoot         string
 	Progress         Progress
 	AcquireConnector func(string) (Handle, func(), error)
+	// TempDir is a directory for temporary files created during the transfer.
+	// If empty, the system's default temp directory is used.
+	TempDir string
+}
+
+// StreamingTransporter is a Transporter for unbounded sources, such as message queues.
+// Instead of moving all the data in one go, it appends micro-batches of new data to the sink table.
+// The caller is responsible for persisting the returned offsets and passing them to the next call to TransferBatch.
+type StreamingTransporter interface {
+	Transporter
+	TransferBatch(ctx context.Context, srcProps, sinkProps map[string]any, opts *StreamTransferOptions) (*StreamTransferResult, error)
+}
+
+// StreamCloser is implemented by StreamingTransporters that keep state for a stream in the source, such as a replication slot.
+// It is an optional extension of StreamingTransporter, so callers should type assert it.
+type StreamCloser interface {
+	// CloseStream releases the state kept in the source for the stream with the given ID.
+	// It is called when the stream will not be consumed again, such as when its source is deleted.
+	CloseStream(ctx context.Context, srcProps map[string]any, streamID string) error
+}
+
+// StreamTransferOptions provide execution context for StreamingTransporter.TransferBatch
+type StreamTransferOptions struct {
+	TransferOptions
+	// StreamID uniquely identifies the stream across instances and sources.
+	// It is used to name any state kept for the stream in the source, such as a replication slot.
+	StreamID string
+	// Offsets to resume consuming from, as returned by the previous call to TransferBatch.
+	// If empty, consumption starts from the source's configured start position.
+	Offsets map[string]int64
+}
+
+// StreamTransferResult is the result of StreamingTransporter.TransferBatch
+type StreamTransferResult struct {
+	// Offsets to resume consuming from in the next call to TransferBatch.
+	// The keys are driver-specific identifiers of the stream's partitions.
+	Offsets map[string]int64
+	// Rows is the number of rows appended to the sink table.
+	Rows int64
 }
 
 // Progress is an interface for communicating progress info
@@ -38,4 +77,5 @@ const (
 	ProgressUnitByte ProgressUnit = iota
 	ProgressUnitFile
 	ProgressUnitRecord
+	ProgressUnitPartition
 )
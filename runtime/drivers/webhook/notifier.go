@@ -0,0 +1,189 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// SignatureHeader is the header containing the hex encoded HMAC-SHA256 signature of the timestamp and request body.
+// It is only set if a secret is configured. Receivers should verify it with a constant time comparison.
+const SignatureHeader = "X-Rill-Signature"
+
+// TimestampHeader is the header containing the Unix time in seconds at which the request was signed.
+// Receivers should reject requests with a timestamp too far from the current time to prevent replays.
+const TimestampHeader = "X-Rill-Timestamp"
+
+// maxErrorBodyLength is the maximum number of bytes of a failed response's body included in the returned error.
+const maxErrorBodyLength = 100
+
+// EventHeader is the header containing the type of event in the payload.
+const EventHeader = "X-Rill-Event"
+
+const (
+	eventAlertStatus     = "alert_status"
+	eventScheduledReport = "scheduled_report"
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+type notifier struct {
+	c *connection
+}
+
+var _ drivers.Notifier = &notifier{}
+
+type alertStatusPayload struct {
+	Event string `json:"event"`
+	Alert struct {
+		Name           string         `json:"name"`
+		Title          string         `json:"title"`
+		ExecutionTime  *time.Time     `json:"execution_time,omitempty"`
+		Status         string         `json:"status"`
+		IsRecover      bool           `json:"is_recover"`
+		FailRow        map[string]any `json:"fail_row,omitempty"`
+		ExecutionError string         `json:"execution_error,omitempty"`
+		OpenURL        string         `json:"open_url,omitempty"`
+		EditURL        string         `json:"edit_url,omitempty"`
+	} `json:"alert"`
+	SentOn time.Time `json:"sent_on"`
+}
+
+type scheduledReportPayload struct {
+	Event  string `json:"event"`
+	Report struct {
+		Name           string    `json:"name"`
+		Title          string    `json:"title"`
+		ReportTime     time.Time `json:"report_time"`
+		DownloadFormat string    `json:"download_format"`
+		OpenURL        string    `json:"open_url,omitempty"`
+		DownloadURL    string    `json:"download_url,omitempty"`
+		EditURL        string    `json:"edit_url,omitempty"`
+	} `json:"report"`
+	SentOn time.Time `json:"sent_on"`
+}
+
+// SendAlertStatus implements drivers.Notifier.
+func (n *notifier) SendAlertStatus(ctx context.Context, props map[string]any, s *drivers.AlertStatus) error {
+	p := &alertStatusPayload{Event: eventAlertStatus, SentOn: time.Now().UTC()}
+	p.Alert.Name = s.Name
+	p.Alert.Title = s.Title
+	if !s.ExecutionTime.IsZero() {
+		t := s.ExecutionTime.UTC()
+		p.Alert.ExecutionTime = &t
+	}
+	p.Alert.Status = statusString(s.Status)
+	p.Alert.IsRecover = s.IsRecover
+	p.Alert.FailRow = s.FailRow
+	p.Alert.ExecutionError = s.ExecutionError
+	p.Alert.OpenURL = s.OpenLink
+	p.Alert.EditURL = s.EditLink
+	return n.post(ctx, props, eventAlertStatus, p)
+}
+
+// SendScheduledReport implements drivers.Notifier.
+func (n *notifier) SendScheduledReport(ctx context.Context, props map[string]any, s *drivers.ScheduledReport) error {
+	p := &scheduledReportPayload{Event: eventScheduledReport, SentOn: time.Now().UTC()}
+	p.Report.Name = s.Name
+	p.Report.Title = s.Title
+	p.Report.ReportTime = s.ReportTime.UTC()
+	p.Report.DownloadFormat = s.DownloadFormat
+	p.Report.OpenURL = s.OpenLink
+	p.Report.DownloadURL = s.DownloadLink
+	p.Report.EditURL = s.EditLink
+	return n.post(ctx, props, eventScheduledReport, p)
+}
+
+// post sends the payload as JSON to the webhook's URL.
+func (n *notifier) post(ctx context.Context, props map[string]any, event string, payload any) error {
+	cfg, err := n.resolveConfig(props)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	if cfg.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, ts)
+		req.Header.Set(SignatureHeader, "sha256="+Sign(cfg.Secret, ts, body))
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		// The body may echo request data, so only a short, printable prefix is included in the error.
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyLength))
+		snippet := strings.TrimSpace(strings.Map(func(r rune) rune {
+			if !unicode.IsPrint(r) {
+				return ' '
+			}
+			return r
+		}, strings.ToValidUTF8(string(msg), "")))
+		if snippet == "" {
+			return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+		}
+		return fmt.Errorf("webhook responded with status %d: %s", res.StatusCode, snippet)
+	}
+	return nil
+}
+
+// resolveConfig merges the notifier properties into the connector's config.
+func (n *notifier) resolveConfig(props map[string]any) (*configProperties, error) {
+	cfg := *n.c.config
+	cfg.Headers = maps.Clone(cfg.Headers)
+	err := mapstructure.WeakDecode(props, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook properties: %w", err)
+	}
+	if cfg.URL == "" {
+		return nil, errors.New(`webhook "url" is not configured`)
+	}
+	return &cfg, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using the secret.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// statusString returns a lowercase name for an assertion status, e.g. "fail".
+func statusString(s runtimev1.AssertionStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "ASSERTION_STATUS_"))
+}
@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var gotHeaders http.Header
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	handle, err := driver{}.Open(map[string]any{"url": srv.URL, "secret": "s3cret"}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, ok := handle.AsNotifier()
+	require.True(t, ok)
+
+	err = n.SendAlertStatus(context.Background(), map[string]any{"headers": map[string]any{"X-Team": "data"}}, &drivers.AlertStatus{
+		Name:          "a1",
+		Title:         "Bids dropped",
+		ExecutionTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"bids": 1.0},
+		OpenLink:      "https://example.com/open",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "application/json", gotHeaders.Get("Content-Type"))
+	require.Equal(t, "alert_status", gotHeaders.Get(EventHeader))
+	require.Equal(t, "data", gotHeaders.Get("X-Team"))
+	ts, err := strconv.ParseInt(gotHeaders.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(ts, 0), time.Minute)
+	require.Equal(t, "sha256="+Sign("s3cret", gotHeaders.Get(TimestampHeader), gotBody), gotHeaders.Get(SignatureHeader))
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, "alert_status", payload["event"])
+	require.Equal(t, map[string]any{
+		"name":           "a1",
+		"title":          "Bids dropped",
+		"execution_time": "2024-01-02T03:04:05Z",
+		"status":         "fail",
+		"is_recover":     false,
+		"fail_row":       map[string]any{"bids": 1.0},
+		"open_url":       "https://example.com/open",
+	}, payload["alert"])
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	var gotHeaders http.Header
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	// The URL is only set on the notifier and no secret is configured
+	handle, err := driver{}.Open(map[string]any{}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, ok := handle.AsNotifier()
+	require.True(t, ok)
+
+	err = n.SendScheduledReport(context.Background(), map[string]any{"url": srv.URL}, &drivers.ScheduledReport{
+		Name:           "r1",
+		Title:          "Weekly bids",
+		ReportTime:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		DownloadLink:   "https://example.com/download",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "scheduled_report", gotHeaders.Get(EventHeader))
+	require.Empty(t, gotHeaders.Get(SignatureHeader))
+	require.Empty(t, gotHeaders.Get(TimestampHeader))
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, map[string]any{
+		"name":            "r1",
+		"title":           "Weekly bids",
+		"report_time":     "2024-01-02T00:00:00Z",
+		"download_format": "CSV",
+		"download_url":    "https://example.com/download",
+	}, payload["report"])
+}
+
+func TestSendErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/long" {
+			http.Error(w, strings.Repeat("x", 1000), http.StatusBadGateway)
+			return
+		}
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	handle, err := driver{}.Open(map[string]any{}, false, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, _ := handle.AsNotifier()
+
+	err = n.SendScheduledReport(context.Background(), nil, &drivers.ScheduledReport{})
+	require.ErrorContains(t, err, `"url" is not configured`)
+
+	err = n.SendScheduledReport(context.Background(), map[string]any{"url": srv.URL}, &drivers.ScheduledReport{})
+	require.ErrorContains(t, err, "status 502: nope")
+
+	// Long response bodies are truncated
+	err = n.SendScheduledReport(context.Background(), map[string]any{"url": srv.URL + "/long"}, &drivers.ScheduledReport{})
+	require.ErrorContains(t, err, "status 502: "+strings.Repeat("x", maxErrorBodyLength))
+	require.NotContains(t, err.Error(), strings.Repeat("x", maxErrorBodyLength+1))
+}
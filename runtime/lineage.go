@@ -0,0 +1,339 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
+)
+
+// Lineage computes column-level lineage between the sources, models and metrics views of an instance.
+// Lineage is derived from the SQL of DuckDB models and from the time dimension, dimensions, measures and row filter of metrics views.
+// Models that use templating and SQL that can't be parsed are skipped, so the result is best-effort.
+//
+// If name is set, only the edges upstream and downstream of the resource are returned.
+// If column is also set, only the edges upstream and downstream of that column of the resource are returned.
+func (r *Runtime) Lineage(ctx context.Context, instanceID string, name *runtimev1.ResourceName, column string) ([]*runtimev1.LineageEdge, error) {
+	inst, err := r.Instance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &lineageBuilder{
+		ctrl:             ctrl,
+		defaultConnector: inst.ResolveOLAPConnector(),
+		tables:           make(map[string]*runtimev1.ResourceName),
+		olaps:            make(map[string]drivers.OLAPStore),
+		schemas:          make(map[string]map[string][]string),
+	}
+	defer b.close()
+
+	// Index the tables created by sources and models, which are what model SQL and metrics views reference
+	for _, res := range rs {
+		var table string
+		switch res.Meta.Name.Kind {
+		case ResourceKindSource:
+			table = res.GetSource().State.Table
+		case ResourceKindModel:
+			table = res.GetModel().State.Table
+		default:
+			continue
+		}
+		b.tables[strings.ToLower(res.Meta.Name.Name)] = res.Meta.Name
+		if table != "" {
+			b.tables[strings.ToLower(table)] = res.Meta.Name
+		}
+	}
+
+	for _, res := range rs {
+		switch res.Meta.Name.Kind {
+		case ResourceKindModel:
+			err = b.addModel(ctx, res)
+		case ResourceKindMetricsView:
+			err = b.addMetricsView(ctx, res)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if name == nil {
+		return b.edges, nil
+	}
+	return filterLineage(b.edges, name, column), nil
+}
+
+// lineageBuilder accumulates lineage edges. It caches OLAP handles and table schemas across resources.
+type lineageBuilder struct {
+	ctrl             *Controller
+	defaultConnector string
+	tables           map[string]*runtimev1.ResourceName
+	olaps            map[string]drivers.OLAPStore
+	releases         []func()
+	schemas          map[string]map[string][]string
+	edges            []*runtimev1.LineageEdge
+}
+
+func (b *lineageBuilder) close() {
+	for _, release := range b.releases {
+		release()
+	}
+}
+
+// olap returns the OLAP handle for a connector. It returns nil if the connector is not a DuckDB OLAP, since lineage is only computed for DuckDB SQL.
+func (b *lineageBuilder) olap(ctx context.Context, connector string) (drivers.OLAPStore, error) {
+	if connector == "" {
+		connector = b.defaultConnector
+	}
+
+	if olap, ok := b.olaps[connector]; ok {
+		return olap, nil
+	}
+
+	olap, release, err := b.ctrl.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+	b.releases = append(b.releases, release)
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		olap = nil
+	}
+	b.olaps[connector] = olap
+	return olap, nil
+}
+
+// columns returns a func that looks up the columns of the tables in a connector.
+func (b *lineageBuilder) columns(ctx context.Context, connector string, olap drivers.OLAPStore) func(table string) []string {
+	return func(table string) []string {
+		schemas, ok := b.schemas[connector]
+		if !ok {
+			schemas = make(map[string][]string)
+			b.schemas[connector] = schemas
+		}
+
+		key := strings.ToLower(table)
+		if cols, ok := schemas[key]; ok {
+			return cols
+		}
+
+		var cols []string
+		t, err := olap.InformationSchema().Lookup(ctx, table)
+		if err == nil && t.Schema != nil {
+			for _, f := range t.Schema.Fields {
+				cols = append(cols, f.Name)
+			}
+		}
+		schemas[key] = cols
+		return cols
+	}
+}
+
+// addModel adds edges from the columns referenced in a model's SQL to the model's columns.
+func (b *lineageBuilder) addModel(ctx context.Context, res *runtimev1.Resource) error {
+	spec := res.GetModel().Spec
+	if spec.UsesTemplating {
+		return nil
+	}
+
+	olap, err := b.olap(ctx, spec.Connector)
+	if err != nil {
+		return err
+	}
+	if olap == nil {
+		return nil
+	}
+
+	ast, err := duckdbsql.Parse(spec.Sql)
+	if err != nil {
+		return nil
+	}
+
+	lineage, err := ast.ExtractColumnLineage(b.columns(ctx, spec.Connector, olap))
+	if err != nil {
+		return nil
+	}
+
+	used := make(map[duckdbsql.ColumnSource]bool)
+	for _, c := range lineage.Columns {
+		for _, src := range c.Sources {
+			b.addEdge(src.Table, src.Column, res.Meta.Name, c.Name)
+			used[*src] = true
+		}
+	}
+	for _, src := range lineage.References {
+		if !used[*src] {
+			b.addEdge(src.Table, src.Column, res.Meta.Name, "")
+		}
+	}
+
+	return nil
+}
+
+// addMetricsView adds edges from the columns of a metrics view's table to its dimensions and measures.
+func (b *lineageBuilder) addMetricsView(ctx context.Context, res *runtimev1.Resource) error {
+	mv := res.GetMetricsView()
+	spec := mv.State.ValidSpec
+	if spec == nil {
+		spec = mv.Spec
+	}
+	if spec.Table == "" {
+		return nil
+	}
+
+	if spec.TimeDimension != "" {
+		b.addEdge(spec.Table, spec.TimeDimension, res.Meta.Name, spec.TimeDimension)
+	}
+
+	// Expressions are parsed as DuckDB SQL. They're not resolved for other OLAPs, but plain column dimensions are.
+	olap, err := b.olap(ctx, spec.Connector)
+	if err != nil {
+		return err
+	}
+	from := drivers.DialectDuckDB.EscapeIdentifier(spec.Table)
+	addExpression := func(from, expr, column string) {
+		if olap == nil {
+			return
+		}
+		ast, err := duckdbsql.Parse(fmt.Sprintf("SELECT %s FROM %s", expr, from))
+		if err != nil {
+			return
+		}
+		lineage, err := ast.ExtractColumnLineage(b.columns(ctx, spec.Connector, olap))
+		if err != nil {
+			return
+		}
+		for _, src := range lineage.References {
+			b.addEdge(src.Table, src.Column, res.Meta.Name, column)
+		}
+	}
+
+	joins := make(map[string]*runtimev1.MetricsViewSpec_JoinV2, len(spec.Joins))
+	for _, j := range spec.Joins {
+		joins[strings.ToLower(j.Name)] = j
+	}
+
+	for _, d := range spec.Dimensions {
+		table, dimFrom := spec.Table, from
+		if d.Join != "" {
+			j, ok := joins[strings.ToLower(d.Join)]
+			if !ok {
+				continue
+			}
+			// The join condition doesn't affect lineage, so the tables are cross joined
+			table = j.Table
+			dimFrom = fmt.Sprintf("%s, %s AS %s", from, drivers.DialectDuckDB.EscapeIdentifier(j.Table), drivers.DialectDuckDB.EscapeIdentifier(j.Name))
+		}
+
+		if d.Expression == "" {
+			col := d.Column
+			if col == "" {
+				col = d.Name
+			}
+			b.addEdge(table, col, res.Meta.Name, d.Name)
+			continue
+		}
+		addExpression(dimFrom, d.Expression, d.Name)
+	}
+
+	for _, m := range spec.Measures {
+		expr, _, err := metricsview.MeasureExpression(spec, m, drivers.DialectDuckDB, &metricsview.Window{OrderBy: "1"})
+		if err != nil {
+			continue
+		}
+		addExpression(from, expr, m.Name)
+	}
+
+	if spec.Security != nil && spec.Security.RowFilter != "" {
+		addExpression(from, spec.Security.RowFilter, "")
+	}
+
+	return nil
+}
+
+// addEdge adds an edge from a column of a table to a column of a resource.
+// It skips tables that are not created by a source or model, and self-references (e.g. in incremental models).
+func (b *lineageBuilder) addEdge(table, column string, target *runtimev1.ResourceName, targetColumn string) {
+	source, ok := b.tables[strings.ToLower(table)]
+	if !ok || (source.Kind == target.Kind && source.Name == target.Name) {
+		return
+	}
+
+	for _, e := range b.edges {
+		if sameLineageColumn(e.Source, source, column) && sameLineageColumn(e.Target, target, targetColumn) {
+			return
+		}
+	}
+
+	b.edges = append(b.edges, &runtimev1.LineageEdge{
+		Source: &runtimev1.LineageColumn{Resource: source, Column: column},
+		Target: &runtimev1.LineageColumn{Resource: target, Column: targetColumn},
+	})
+}
+
+// filterLineage returns the edges upstream and downstream of a resource or a column of a resource.
+// A target without a column means the whole resource depends on the source column, so it is traversed as if it was every column of the resource.
+func filterLineage(edges []*runtimev1.LineageEdge, name *runtimev1.ResourceName, column string) []*runtimev1.LineageEdge {
+	type node struct {
+		kind, name, column string
+	}
+	matches := func(c *runtimev1.LineageColumn, n node) bool {
+		if !strings.EqualFold(c.Resource.Kind, n.kind) || !strings.EqualFold(c.Resource.Name, n.name) {
+			return false
+		}
+		return n.column == "" || c.Column == "" || strings.EqualFold(c.Column, n.column)
+	}
+
+	included := make(map[*runtimev1.LineageEdge]bool)
+	traverse := func(upstream bool) {
+		queue := []node{{kind: name.Kind, name: name.Name, column: column}}
+		seen := map[node]bool{queue[0]: true}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			for _, e := range edges {
+				from, to := e.Target, e.Source
+				if !upstream {
+					from, to = e.Source, e.Target
+				}
+				if !matches(from, n) {
+					continue
+				}
+				included[e] = true
+				next := node{kind: to.Resource.Kind, name: to.Resource.Name, column: strings.ToLower(to.Column)}
+				if !seen[next] {
+					seen[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+	traverse(true)
+	traverse(false)
+
+	res := make([]*runtimev1.LineageEdge, 0, len(included))
+	for _, e := range edges {
+		if included[e] {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func sameLineageColumn(c *runtimev1.LineageColumn, name *runtimev1.ResourceName, column string) bool {
+	return c.Resource.Kind == name.Kind && c.Resource.Name == name.Name && c.Column == column
+}
@@ -0,0 +1,76 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineage(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/data/foo.csv": `a,b,c,d
+1,2,3,4
+`,
+		"/sources/foo.yaml": `
+type: local_file
+path: data/foo.csv
+`,
+		"/models/bar.sql": `SELECT a, b + c AS bc, * EXCLUDE (a, b, c) FROM foo WHERE d > 0`,
+		"/dashboards/dash.yaml": `
+title: dash
+model: bar
+dimensions:
+- name: a
+  column: a
+measures:
+- name: total
+  expression: sum(bc)
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	foo := &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "foo"}
+	bar := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+	dash := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "dash"}
+	edge := func(src *runtimev1.ResourceName, srcCol string, dst *runtimev1.ResourceName, dstCol string) string {
+		return src.Name + "." + srcCol + " -> " + dst.Name + "." + dstCol
+	}
+	lineage := func(name *runtimev1.ResourceName, column string) []string {
+		edges, err := rt.Lineage(context.Background(), id, name, column)
+		require.NoError(t, err)
+		var res []string
+		for _, e := range edges {
+			res = append(res, edge(e.Source.Resource, e.Source.Column, e.Target.Resource, e.Target.Column))
+		}
+		return res
+	}
+
+	// All edges
+	require.ElementsMatch(t, []string{
+		edge(foo, "a", bar, "a"),
+		edge(foo, "b", bar, "bc"),
+		edge(foo, "c", bar, "bc"),
+		edge(foo, "d", bar, "d"),
+		edge(bar, "a", dash, "a"),
+		edge(bar, "bc", dash, "total"),
+	}, lineage(nil, ""))
+
+	// Where does a measure come from
+	require.ElementsMatch(t, []string{
+		edge(foo, "b", bar, "bc"),
+		edge(foo, "c", bar, "bc"),
+		edge(bar, "bc", dash, "total"),
+	}, lineage(dash, "total"))
+
+	// What depends on a source column
+	require.ElementsMatch(t, []string{
+		edge(foo, "a", bar, "a"),
+		edge(bar, "a", dash, "a"),
+	}, lineage(foo, "a"))
+}
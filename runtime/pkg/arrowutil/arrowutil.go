@@ -0,0 +1,396 @@
+package arrowutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/google/uuid"
+	"github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ContentType is the MIME type of the Arrow IPC streaming format.
+const ContentType = "application/vnd.apache.arrow.stream"
+
+// DefaultBatchSize is the default number of rows in each record batch written by a Writer.
+const DefaultBatchSize = 10000
+
+// Rows is the subset of *sqlx.Rows used to iterate over query results.
+type Rows interface {
+	Next() bool
+	SliceScan() ([]any, error)
+	Err() error
+}
+
+// ToSchema converts a Rill struct type to an Arrow schema.
+// Types that don't have a direct Arrow equivalent are mapped to the closest lossy type:
+// big integers and decimals are mapped to float64, and nested types are JSON encoded as strings.
+func ToSchema(t *runtimev1.StructType) *arrow.Schema {
+	fields := make([]arrow.Field, len(t.Fields))
+	for i, f := range t.Fields {
+		fields[i] = arrow.Field{
+			Name:     f.Name,
+			Type:     ToDataType(f.Type),
+			Nullable: true,
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// ToDataType converts a Rill type to an Arrow data type.
+func ToDataType(t *runtimev1.Type) arrow.DataType {
+	if t == nil {
+		return arrow.BinaryTypes.String
+	}
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return arrow.FixedWidthTypes.Boolean
+	case runtimev1.Type_CODE_INT8:
+		return arrow.PrimitiveTypes.Int8
+	case runtimev1.Type_CODE_INT16:
+		return arrow.PrimitiveTypes.Int16
+	case runtimev1.Type_CODE_INT32:
+		return arrow.PrimitiveTypes.Int32
+	case runtimev1.Type_CODE_INT64:
+		return arrow.PrimitiveTypes.Int64
+	case runtimev1.Type_CODE_UINT8:
+		return arrow.PrimitiveTypes.Uint8
+	case runtimev1.Type_CODE_UINT16:
+		return arrow.PrimitiveTypes.Uint16
+	case runtimev1.Type_CODE_UINT32:
+		return arrow.PrimitiveTypes.Uint32
+	case runtimev1.Type_CODE_UINT64:
+		return arrow.PrimitiveTypes.Uint64
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_FLOAT32:
+		return arrow.PrimitiveTypes.Float32
+	case runtimev1.Type_CODE_FLOAT64:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case runtimev1.Type_CODE_DATE:
+		return arrow.FixedWidthTypes.Date32
+	case runtimev1.Type_CODE_TIME:
+		return arrow.FixedWidthTypes.Time64us
+	case runtimev1.Type_CODE_BYTES:
+		return arrow.BinaryTypes.Binary
+	default:
+		// Strings, UUIDs, JSON and nested types
+		return arrow.BinaryTypes.String
+	}
+}
+
+// Writer writes rows to an io.Writer in the Arrow IPC streaming format.
+// Rows are buffered and flushed as record batches of at most BatchSize rows.
+type Writer struct {
+	BatchSize int
+	schema    *runtimev1.StructType
+	builder   *array.RecordBuilder
+	writer    *ipc.Writer
+	n         int
+}
+
+// NewWriter creates a new Writer for rows matching the given schema.
+// The caller must call Close to flush buffered rows and write the end-of-stream marker.
+func NewWriter(w io.Writer, schema *runtimev1.StructType) *Writer {
+	s := ToSchema(schema)
+	mem := memory.NewGoAllocator()
+	return &Writer{
+		BatchSize: DefaultBatchSize,
+		schema:    schema,
+		builder:   array.NewRecordBuilder(mem, s),
+		writer:    ipc.NewWriter(w, ipc.WithSchema(s), ipc.WithAllocator(mem)),
+	}
+}
+
+// Append adds a row to the current record batch, flushing it if it is full.
+// The row must have one value for each field in the schema.
+func (w *Writer) Append(row []any) error {
+	if len(row) != len(w.schema.Fields) {
+		return fmt.Errorf("arrowutil: expected %d values, got %d", len(w.schema.Fields), len(row))
+	}
+	for i, v := range row {
+		err := appendValue(w.builder.Field(i), v, w.schema.Fields[i].Type)
+		if err != nil {
+			return fmt.Errorf("arrowutil: field %q: %w", w.schema.Fields[i].Name, err)
+		}
+	}
+	w.n++
+	if w.n >= w.BatchSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows as a record batch.
+func (w *Writer) Flush() error {
+	if w.n == 0 {
+		return nil
+	}
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+	w.n = 0
+	return w.writer.Write(rec)
+}
+
+// Close flushes buffered rows and writes the end-of-stream marker.
+// It does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	defer w.builder.Release()
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+	return w.writer.Close()
+}
+
+// WriteRows writes all rows to w in the Arrow IPC streaming format.
+func WriteRows(w io.Writer, schema *runtimev1.StructType, rows Rows) error {
+	aw := NewWriter(w, schema)
+	for rows.Next() {
+		row, err := rows.SliceScan()
+		if err != nil {
+			return err
+		}
+		err = aw.Append(row)
+		if err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+// appendValue appends a value scanned from a database driver to an Arrow array builder.
+// The builder must have been created for the data type returned by ToDataType(t).
+func appendValue(b array.Builder, v any, t *runtimev1.Type) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for bool", v)
+		}
+		b.Append(x)
+	case *array.Int8Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int8(x))
+	case *array.Int16Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int16(x))
+	case *array.Int32Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int32(x))
+	case *array.Int64Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.Uint8Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint8(x))
+	case *array.Uint16Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint16(x))
+	case *array.Uint32Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint32(x))
+	case *array.Uint64Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.Float32Builder:
+		x, err := toFloat64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(float32(x))
+	case *array.Float64Builder:
+		x, err := toFloat64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.TimestampBuilder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for timestamp", v)
+		}
+		b.Append(arrow.Timestamp(x.UnixMicro()))
+	case *array.Date32Builder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for date", v)
+		}
+		b.Append(arrow.Date32FromTime(x))
+	case *array.Time64Builder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for time", v)
+		}
+		y, m, d := x.Date()
+		midnight := time.Date(y, m, d, 0, 0, 0, 0, x.Location())
+		b.Append(arrow.Time64(x.Sub(midnight).Microseconds()))
+	case *array.BinaryBuilder:
+		switch x := v.(type) {
+		case []byte:
+			b.Append(x)
+		case string:
+			b.AppendString(x)
+		default:
+			return fmt.Errorf("unexpected type %T for bytes", v)
+		}
+	case *array.StringBuilder:
+		s, err := toString(v, t)
+		if err != nil {
+			return err
+		}
+		b.Append(s)
+	default:
+		return fmt.Errorf("unsupported builder %T", b)
+	}
+
+	return nil
+}
+
+func toInt64(v any) (int64, error) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for integer", v)
+	}
+}
+
+func toUint64(v any) (uint64, error) {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v), nil
+	case uint16:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case uint:
+		return uint64(v), nil
+	default:
+		x, err := toInt64(v)
+		return uint64(x), err
+	}
+}
+
+func toFloat64(v any) (float64, error) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, nil
+	case big.Int:
+		f, _ := new(big.Float).SetInt(&v).Float64()
+		return f, nil
+	case duckdb.Decimal:
+		denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.Scale)), nil)
+		f, _ := new(big.Rat).SetFrac(v.Value, denom).Float64()
+		return f, nil
+	case string:
+		f, ok := new(big.Float).SetString(v)
+		if !ok {
+			return 0, fmt.Errorf("invalid number %q", v)
+		}
+		x, _ := f.Float64()
+		return x, nil
+	default:
+		x, err := toInt64(v)
+		return float64(x), err
+	}
+}
+
+func toString(v any, t *runtimev1.Type) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		if t != nil && t.Code == runtimev1.Type_CODE_UUID {
+			if id, err := uuid.FromBytes(v); err == nil {
+				return id.String(), nil
+			}
+		}
+		return string(v), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+
+	// JSON encode other types (e.g. nested types)
+	pb, err := pbutil.ToValue(v, t)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := pb.Kind.(*structpb.Value_StringValue); ok {
+		return s.StringValue, nil
+	}
+	// Not using protojson since its output is deliberately unstable
+	data, err := json.Marshal(pb.AsInterface())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,115 @@
+package arrowutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceRows struct {
+	rows [][]any
+	idx  int
+}
+
+func (r *sliceRows) Next() bool {
+	r.idx++
+	return r.idx <= len(r.rows)
+}
+
+func (r *sliceRows) SliceScan() ([]any, error) {
+	return r.rows[r.idx-1], nil
+}
+
+func (r *sliceRows) Err() error {
+	return nil
+}
+
+func TestWriteRows(t *testing.T) {
+	schema := &runtimev1.StructType{
+		Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32}},
+			{Name: "name", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+			{Name: "amount", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+			{Name: "ts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+			{Name: "tags", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_ARRAY}},
+		},
+	}
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := &sliceRows{rows: [][]any{
+		{int32(1), "a", 1.5, ts, []any{"x", "y"}},
+		{int32(2), nil, float32(2.5), ts.Add(time.Hour), nil},
+		{int32(3), "c", int64(3), nil, []any{}},
+	}}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, schema)
+	w.BatchSize = 2
+	for rows.Next() {
+		row, err := rows.SliceScan()
+		require.NoError(t, err)
+		require.NoError(t, w.Append(row))
+	}
+	require.NoError(t, w.Close())
+
+	r, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer r.Release()
+
+	require.Len(t, r.Schema().Fields(), 5)
+	require.Equal(t, arrow.PrimitiveTypes.Int32, r.Schema().Field(0).Type)
+
+	var batches, n int
+	var ids []int32
+	var names []string
+	var tags []string
+	for r.Next() {
+		rec := r.Record()
+		batches++
+		n += int(rec.NumRows())
+		ids = append(ids, rec.Column(0).(*array.Int32).Int32Values()...)
+		nameCol := rec.Column(1).(*array.String)
+		tagCol := rec.Column(4).(*array.String)
+		for i := 0; i < int(rec.NumRows()); i++ {
+			if nameCol.IsNull(i) {
+				names = append(names, "<null>")
+			} else {
+				names = append(names, nameCol.Value(i))
+			}
+			if tagCol.IsNull(i) {
+				tags = append(tags, "<null>")
+			} else {
+				tags = append(tags, tagCol.Value(i))
+			}
+		}
+	}
+	require.NoError(t, r.Err())
+	require.Equal(t, 2, batches)
+	require.Equal(t, 3, n)
+	require.Equal(t, []int32{1, 2, 3}, ids)
+	require.Equal(t, []string{"a", "<null>", "c"}, names)
+	require.Equal(t, []string{`["x","y"]`, "<null>", `[]`}, tags)
+}
+
+func TestWriteRowsEmpty(t *testing.T) {
+	schema := &runtimev1.StructType{
+		Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRows(&buf, schema, &sliceRows{}))
+
+	r, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer r.Release()
+	require.Equal(t, "id", r.Schema().Field(0).Name)
+	require.False(t, r.Next())
+	require.NoError(t, r.Err())
+}
@@ -0,0 +1,441 @@
+package avroutil
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ContentType is the MIME type of Avro object container files.
+const ContentType = "application/avro"
+
+// DefaultBatchSize is the default number of rows in each block written by a Writer.
+const DefaultBatchSize = 10000
+
+// Rows is the subset of *sqlx.Rows used to iterate over query results.
+type Rows interface {
+	Next() bool
+	SliceScan() ([]any, error)
+	Err() error
+}
+
+// ToSchema converts a Rill struct type to an Avro record schema in its JSON representation.
+// All fields are nullable. Types that don't have a direct Avro equivalent are mapped to the closest lossy type:
+// unsigned 64-bit and bigger integers and decimals are mapped to double, and times and nested types are encoded as strings.
+// Field names that are not valid Avro names are sanitized, and the original name is kept in the field's doc.
+func ToSchema(t *runtimev1.StructType) (string, error) {
+	type field struct {
+		Name    string `json:"name"`
+		Doc     string `json:"doc,omitempty"`
+		Type    []any  `json:"type"`
+		Default any    `json:"default"`
+	}
+
+	seen := make(map[string]bool, len(t.Fields))
+	fields := make([]field, len(t.Fields))
+	for i, f := range t.Fields {
+		name := sanitizeName(f.Name)
+		for j := 2; seen[name]; j++ {
+			name = fmt.Sprintf("%s_%d", sanitizeName(f.Name), j)
+		}
+		seen[name] = true
+
+		fields[i] = field{
+			Name: name,
+			Type: []any{"null", toAvroType(f.Type)},
+		}
+		if name != f.Name {
+			fields[i].Doc = f.Name
+		}
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"type":   "record",
+		"name":   "Row",
+		"fields": fields,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// toAvroType converts a Rill type to an Avro type.
+func toAvroType(t *runtimev1.Type) any {
+	if t == nil {
+		return "string"
+	}
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return "boolean"
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16:
+		return "int"
+	case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_UINT32:
+		return "long"
+	case runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL:
+		return "double"
+	case runtimev1.Type_CODE_FLOAT32:
+		return "float"
+	case runtimev1.Type_CODE_FLOAT64:
+		return "double"
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+	case runtimev1.Type_CODE_DATE:
+		return map[string]any{"type": "int", "logicalType": "date"}
+	case runtimev1.Type_CODE_BYTES:
+		return "bytes"
+	default:
+		// Strings, UUIDs, JSON, times and nested types
+		return "string"
+	}
+}
+
+// sanitizeName converts s to a valid Avro name, i.e. one that matches [A-Za-z_][A-Za-z0-9_]*.
+func sanitizeName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			b[i] = '_'
+		}
+	}
+	if len(b) == 0 || b[0] >= '0' && b[0] <= '9' {
+		b = append([]byte{'_'}, b...)
+	}
+	return string(b)
+}
+
+// Writer writes rows to an io.Writer as an Avro object container file.
+// Rows are buffered and flushed as blocks of at most BatchSize rows.
+type Writer struct {
+	BatchSize     int
+	schema        *runtimev1.StructType
+	w             io.Writer
+	sync          [16]byte
+	block         bytes.Buffer
+	n             int
+	headerWritten bool
+}
+
+// NewWriter creates a new Writer for rows matching the given schema.
+// The caller must call Close to flush buffered rows. The file header is written on the first flush.
+func NewWriter(w io.Writer, schema *runtimev1.StructType) *Writer {
+	aw := &Writer{
+		BatchSize: DefaultBatchSize,
+		schema:    schema,
+		w:         w,
+	}
+	_, _ = rand.Read(aw.sync[:])
+	return aw
+}
+
+// Append adds a row to the current block, flushing it if it is full.
+// The row must have one value for each field in the schema.
+func (w *Writer) Append(row []any) error {
+	if len(row) != len(w.schema.Fields) {
+		return fmt.Errorf("avroutil: expected %d values, got %d", len(w.schema.Fields), len(row))
+	}
+	for i, v := range row {
+		err := w.appendValue(v, w.schema.Fields[i].Type)
+		if err != nil {
+			return fmt.Errorf("avroutil: field %q: %w", w.schema.Fields[i].Name, err)
+		}
+	}
+	w.n++
+	if w.n >= w.BatchSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows as a block.
+func (w *Writer) Flush() error {
+	if !w.headerWritten {
+		err := w.writeHeader()
+		if err != nil {
+			return err
+		}
+	}
+	if w.n == 0 {
+		return nil
+	}
+
+	var buf []byte
+	buf = appendLong(buf, int64(w.n))
+	buf = appendLong(buf, int64(w.block.Len()))
+	_, err := w.w.Write(buf)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(w.block.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(w.sync[:])
+	if err != nil {
+		return err
+	}
+
+	w.block.Reset()
+	w.n = 0
+	return nil
+}
+
+// Close flushes buffered rows.
+// It does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	return w.Flush()
+}
+
+// writeHeader writes the magic bytes, the file metadata and the sync marker.
+func (w *Writer) writeHeader() error {
+	schema, err := ToSchema(w.schema)
+	if err != nil {
+		return err
+	}
+
+	buf := []byte{'O', 'b', 'j', 1}
+	buf = appendLong(buf, 2) // Metadata map with two entries
+	buf = appendBytes(buf, []byte("avro.schema"))
+	buf = appendBytes(buf, []byte(schema))
+	buf = appendBytes(buf, []byte("avro.codec"))
+	buf = appendBytes(buf, []byte("null"))
+	buf = appendLong(buf, 0) // End of map
+	buf = append(buf, w.sync[:]...)
+
+	_, err = w.w.Write(buf)
+	if err != nil {
+		return err
+	}
+	w.headerWritten = true
+	return nil
+}
+
+// WriteRows writes all rows to w as an Avro object container file.
+func WriteRows(w io.Writer, schema *runtimev1.StructType, rows Rows) error {
+	aw := NewWriter(w, schema)
+	for rows.Next() {
+		row, err := rows.SliceScan()
+		if err != nil {
+			return err
+		}
+		err = aw.Append(row)
+		if err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+// appendValue encodes a value scanned from a database driver to the current block.
+// Values are encoded as the nullable union of the type returned by toAvroType(t).
+func (w *Writer) appendValue(v any, t *runtimev1.Type) error {
+	if v == nil {
+		w.block.Write(appendLong(nil, 0))
+		return nil
+	}
+
+	var buf []byte
+	buf = appendLong(buf, 1)
+	switch typ := toAvroType(t).(type) {
+	case string:
+		switch typ {
+		case "boolean":
+			x, ok := v.(bool)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for bool", v)
+			}
+			if x {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+		case "int", "long":
+			x, err := toInt64(v)
+			if err != nil {
+				return err
+			}
+			buf = appendLong(buf, x)
+		case "float":
+			x, err := toFloat64(v)
+			if err != nil {
+				return err
+			}
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(x)))
+		case "double":
+			x, err := toFloat64(v)
+			if err != nil {
+				return err
+			}
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(x))
+		case "bytes":
+			switch x := v.(type) {
+			case []byte:
+				buf = appendBytes(buf, x)
+			case string:
+				buf = appendBytes(buf, []byte(x))
+			default:
+				return fmt.Errorf("unexpected type %T for bytes", v)
+			}
+		default:
+			s, err := toString(v, t)
+			if err != nil {
+				return err
+			}
+			buf = appendBytes(buf, []byte(s))
+		}
+	case map[string]any:
+		x, err := toTime(v)
+		if err != nil {
+			return err
+		}
+		if typ["logicalType"] == "date" {
+			y, m, d := x.Date()
+			buf = appendLong(buf, time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400)
+		} else {
+			buf = appendLong(buf, x.UnixMicro())
+		}
+	}
+
+	w.block.Write(buf)
+	return nil
+}
+
+// appendLong appends a zig-zag encoded variable-length integer.
+func appendLong(b []byte, v int64) []byte {
+	return binary.AppendUvarint(b, uint64((v<<1)^(v>>63)))
+}
+
+// appendBytes appends a length-prefixed byte string.
+func appendBytes(b, v []byte) []byte {
+	b = appendLong(b, int64(len(v)))
+	return append(b, v...)
+}
+
+func toInt64(v any) (int64, error) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected type %T for integer", v)
+	}
+}
+
+func toFloat64(v any) (float64, error) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case uint64:
+		return float64(v), nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, nil
+	case big.Int:
+		f, _ := new(big.Float).SetInt(&v).Float64()
+		return f, nil
+	case duckdb.Decimal:
+		denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.Scale)), nil)
+		f, _ := new(big.Rat).SetFrac(v.Value, denom).Float64()
+		return f, nil
+	case string:
+		f, ok := new(big.Float).SetString(v)
+		if !ok {
+			return 0, fmt.Errorf("invalid number %q", v)
+		}
+		x, _ := f.Float64()
+		return x, nil
+	default:
+		x, err := toInt64(v)
+		return float64(x), err
+	}
+}
+
+// toTime converts a time.Time or a timestamp or date string (as found in JSON encoded results) to a time.Time.
+func toTime(v any) (time.Time, error) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, time.DateTime, time.DateOnly} {
+			t, err := time.Parse(layout, v)
+			if err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", v)
+	default:
+		return time.Time{}, fmt.Errorf("unexpected type %T for timestamp", v)
+	}
+}
+
+func toString(v any, t *runtimev1.Type) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		if t != nil && t.Code == runtimev1.Type_CODE_UUID {
+			if id, err := uuid.FromBytes(v); err == nil {
+				return id.String(), nil
+			}
+		}
+		return string(v), nil
+	case time.Time:
+		if t != nil && t.Code == runtimev1.Type_CODE_TIME {
+			return v.Format("15:04:05.999999"), nil
+		}
+		return v.Format(time.RFC3339Nano), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+
+	// JSON encode other types (e.g. nested types)
+	pb, err := pbutil.ToValue(v, t)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := pb.Kind.(*structpb.Value_StringValue); ok {
+		return s.StringValue, nil
+	}
+	data, err := json.Marshal(pb.AsInterface())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,180 @@
+package avroutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceRows struct {
+	rows [][]any
+	idx  int
+}
+
+func (r *sliceRows) Next() bool {
+	r.idx++
+	return r.idx <= len(r.rows)
+}
+
+func (r *sliceRows) SliceScan() ([]any, error) {
+	return r.rows[r.idx-1], nil
+}
+
+func (r *sliceRows) Err() error {
+	return nil
+}
+
+func TestToSchema(t *testing.T) {
+	schema, err := ToSchema(&runtimev1.StructType{
+		Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+			{Name: "count(*)", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_UINT64}},
+			{Name: "count___", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32}},
+			{Name: "1d", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_DATE}},
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "record",
+		"name": "Row",
+		"fields": [
+			{"name": "id", "type": ["null", "long"], "default": null},
+			{"name": "count___", "doc": "count(*)", "type": ["null", "double"], "default": null},
+			{"name": "count____2", "doc": "count___", "type": ["null", "int"], "default": null},
+			{"name": "_1d", "doc": "1d", "type": ["null", {"type": "int", "logicalType": "date"}], "default": null}
+		]
+	}`, schema)
+}
+
+func TestWriteRows(t *testing.T) {
+	schema := &runtimev1.StructType{
+		Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32}},
+			{Name: "name", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+			{Name: "amount", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+			{Name: "ts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+			{Name: "tags", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_ARRAY}},
+		},
+	}
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := &sliceRows{rows: [][]any{
+		{int32(1), "a", 1.5, ts, []any{"x", "y"}},
+		{int32(2), nil, float32(2.5), ts.Add(time.Hour).Format(time.RFC3339), nil},
+		{int32(3), "c", int64(3), nil, []any{}},
+	}}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, schema)
+	w.BatchSize = 2
+	for rows.Next() {
+		row, err := rows.SliceScan()
+		require.NoError(t, err)
+		require.NoError(t, w.Append(row))
+	}
+	require.NoError(t, w.Close())
+
+	r := &reader{data: buf.Bytes()}
+	meta, sync := r.header()
+	require.Equal(t, "null", meta["avro.codec"])
+	var s map[string]any
+	require.NoError(t, json.Unmarshal([]byte(meta["avro.schema"]), &s))
+	require.Equal(t, "Row", s["name"])
+
+	// Expect two blocks since the batch size is 2
+	var res [][]any
+	var blocks int
+	for r.pos < len(r.data) {
+		n := r.long()
+		r.long() // Block size
+		for i := int64(0); i < n; i++ {
+			res = append(res, []any{
+				r.nullable(r.longValue),
+				r.nullable(r.string),
+				r.nullable(r.double),
+				r.nullable(r.longValue),
+				r.nullable(r.string),
+			})
+		}
+		require.Equal(t, sync, r.read(16))
+		blocks++
+	}
+	require.Equal(t, 2, blocks)
+	require.Equal(t, [][]any{
+		{int64(1), "a", 1.5, ts.UnixMicro(), `["x","y"]`},
+		{int64(2), nil, 2.5, ts.Add(time.Hour).UnixMicro(), nil},
+		{int64(3), "c", 3.0, nil, `[]`},
+	}, res)
+}
+
+func TestWriteRowsEmpty(t *testing.T) {
+	schema := &runtimev1.StructType{
+		Fields: []*runtimev1.StructType_Field{
+			{Name: "id", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRows(&buf, schema, &sliceRows{}))
+
+	r := &reader{data: buf.Bytes()}
+	meta, _ := r.header()
+	require.Contains(t, meta["avro.schema"], `"name":"id"`)
+	require.Equal(t, len(r.data), r.pos)
+}
+
+// reader is a minimal decoder for the subset of Avro written by Writer.
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) header() (map[string]string, []byte) {
+	if string(r.read(4)) != "Obj\x01" {
+		panic("invalid magic")
+	}
+	meta := map[string]string{}
+	for n := r.long(); n != 0; n = r.long() {
+		for i := int64(0); i < n; i++ {
+			k := r.string().(string)
+			meta[k] = r.string().(string)
+		}
+	}
+	return meta, r.read(16)
+}
+
+func (r *reader) nullable(fn func() any) any {
+	if r.long() == 0 {
+		return nil
+	}
+	return fn()
+}
+
+func (r *reader) long() int64 {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	r.pos += n
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *reader) longValue() any {
+	return r.long()
+}
+
+func (r *reader) string() any {
+	return string(r.read(int(r.long())))
+}
+
+func (r *reader) double() any {
+	return math.Float64frombits(binary.LittleEndian.Uint64(r.read(8)))
+}
+
+func (r *reader) read(n int) []byte {
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
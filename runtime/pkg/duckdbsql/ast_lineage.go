@@ -0,0 +1,569 @@
+package duckdbsql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lineage describes how the output columns of a DuckDB SQL statement are derived from the tables it queries
+type Lineage struct {
+	// Columns has an entry for each output column of the outermost SELECT
+	Columns []*ColumnLineage
+	// References lists every table column referenced in the statement, including in filters, joins, groupings and orderings
+	References []*ColumnSource
+}
+
+// ColumnLineage lists the table columns that an output column is derived from
+type ColumnLineage struct {
+	Name    string
+	Sources []*ColumnSource
+}
+
+// ColumnSource identifies a column of a table referenced in a DuckDB SQL statement
+type ColumnSource struct {
+	Table  string
+	Column string
+}
+
+// ExtractColumnLineage computes column-level lineage for a DuckDB SQL statement.
+// The columns func is used to expand stars and resolve unqualified column references. It should return the columns of a table or nil if they are not known.
+// Columns of table functions (like read_parquet) and references that can't be resolved don't have any sources.
+func (a *AST) ExtractColumnLineage(columns func(table string) []string) (*Lineage, error) {
+	if a.ast == nil {
+		return nil, fmt.Errorf("calling lineage on failed parse")
+	}
+
+	res := &Lineage{
+		Columns:    make([]*ColumnLineage, 0),
+		References: make([]*ColumnSource, 0),
+	}
+
+	statements := toNodeArray(a.ast, astKeyStatements)
+	if len(statements) == 0 {
+		return res, nil
+	}
+
+	b := &lineageBuilder{
+		columns: columns,
+		seen:    map[ColumnSource]bool{},
+	}
+	out := b.queryNode(toNode(statements[0], astKeyNode), nil)
+
+	for _, c := range out.columns {
+		cl := &ColumnLineage{
+			Name:    c.name,
+			Sources: make([]*ColumnSource, len(c.sources)),
+		}
+		for i := range c.sources {
+			cl.Sources[i] = &c.sources[i]
+		}
+		res.Columns = append(res.Columns, cl)
+	}
+	for i := range b.refs {
+		res.References = append(res.References, &b.refs[i])
+	}
+
+	return res, nil
+}
+
+// lineageBuilder tracks state while computing the lineage of a statement
+type lineageBuilder struct {
+	columns func(table string) []string
+	refs    []ColumnSource
+	seen    map[ColumnSource]bool
+}
+
+// lineageColumn is a column of a relation in the statement
+type lineageColumn struct {
+	name    string
+	sources []ColumnSource
+}
+
+// lineageRelation is a table, CTE, subquery or table function that columns can be resolved against
+type lineageRelation struct {
+	alias   string
+	columns []*lineageColumn
+	// table is set for base tables with unknown columns. Any column name resolves to a column of the table.
+	table string
+	// opaque is set for relations with columns that can't be traced (like table functions). Any column name resolves without sources.
+	opaque bool
+	// stars holds relations with unknown columns that were selected with a star. Names not found in columns are resolved against them.
+	stars []*lineageRelation
+}
+
+// column finds a known column by name
+func (r *lineageRelation) column(name string) *lineageColumn {
+	for _, c := range r.columns {
+		if strings.EqualFold(c.name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// known returns true if columns lists all the columns of the relation
+func (r *lineageRelation) known() bool {
+	return r.table == "" && !r.opaque && len(r.stars) == 0
+}
+
+// resolve returns the sources of the column with the given name. It returns false if the relation doesn't have the column.
+func (r *lineageRelation) resolve(name string) ([]ColumnSource, bool) {
+	if c := r.column(name); c != nil {
+		return c.sources, true
+	}
+	if r.table != "" {
+		return []ColumnSource{{Table: r.table, Column: name}}, true
+	}
+	if r.opaque {
+		return nil, true
+	}
+
+	var res []ColumnSource
+	found := false
+	for _, s := range r.stars {
+		if srcs, ok := s.resolve(name); ok {
+			res = appendSources(res, srcs...)
+			found = true
+		}
+	}
+	return res, found
+}
+
+// sources returns the sources of all the known columns of the relation
+func (r *lineageRelation) sources() []ColumnSource {
+	var res []ColumnSource
+	for _, c := range r.columns {
+		res = appendSources(res, c.sources...)
+	}
+	return res
+}
+
+// lineageScope holds the relations and CTEs visible in a query node
+type lineageScope struct {
+	parent    *lineageScope
+	relations []*lineageRelation
+	ctes      map[string]*lineageRelation
+	// aliases are the select list columns, which DuckDB allows referencing by name in the same query
+	aliases []*lineageColumn
+}
+
+// resolve returns the sources of a column reference. It looks in parent scopes for correlated references.
+func (s *lineageScope) resolve(names []string) []ColumnSource {
+	for ; s != nil; s = s.parent {
+		if srcs, ok := s.resolveLocal(names); ok {
+			return srcs
+		}
+	}
+	return nil
+}
+
+func (s *lineageScope) resolveLocal(names []string) ([]ColumnSource, bool) {
+	// Qualified reference like "t.col" or "schema.t.col"
+	if len(names) > 1 {
+		for _, r := range s.relations {
+			if strings.EqualFold(r.alias, names[0]) {
+				return r.resolve(names[1])
+			}
+		}
+		if len(names) > 2 {
+			for _, r := range s.relations {
+				if strings.EqualFold(r.alias, names[1]) {
+					return r.resolve(names[2])
+				}
+			}
+		}
+	}
+
+	// Unqualified reference (or field access on a struct column).
+	// Columns of the relations take precedence over aliases, which take precedence over relations with unknown columns.
+	name := names[0]
+	for _, r := range s.relations {
+		if c := r.column(name); c != nil {
+			return c.sources, true
+		}
+	}
+	for _, c := range s.aliases {
+		if strings.EqualFold(c.name, name) {
+			return c.sources, true
+		}
+	}
+
+	var res []ColumnSource
+	found := false
+	for _, r := range s.relations {
+		if r.known() {
+			continue
+		}
+		if srcs, ok := r.resolve(name); ok {
+			res = appendSources(res, srcs...)
+			found = true
+		}
+	}
+	return res, found
+}
+
+// newScope creates a scope for a query node and resolves the CTEs declared on it
+func (b *lineageBuilder) newScope(node astNode, parent *lineageScope) *lineageScope {
+	s := &lineageScope{
+		parent: parent,
+		ctes:   map[string]*lineageRelation{},
+	}
+	if parent != nil {
+		for k, v := range parent.ctes {
+			s.ctes[k] = v
+		}
+	}
+
+	for _, entry := range toNodeArray(toNode(node, astKeyCTE), astKeyMap) {
+		name := toString(entry, astKeyKey)
+		key := strings.ToLower(name)
+		cte := toNode(entry, astKeyValue)
+
+		// Register a placeholder first so that recursive CTEs don't resolve to a table with the same name
+		s.ctes[key] = &lineageRelation{alias: name, opaque: true}
+
+		rel := b.queryNode(toNode(toNode(cte, astKeyQuery), astKeyNode), s)
+		rel.alias = name
+		renameColumns(rel, toArray(cte, astKeyAliases))
+		s.ctes[key] = rel
+	}
+
+	return s
+}
+
+// queryNode computes the output relation of a SELECT, set operation or recursive CTE node
+func (b *lineageBuilder) queryNode(node astNode, parent *lineageScope) *lineageRelation {
+	switch toString(node, astKeyType) {
+	case "SELECT_NODE":
+		return b.selectNode(node, parent)
+
+	case "SET_OPERATION_NODE", "RECURSIVE_CTE_NODE":
+		s := b.newScope(node, parent)
+		left := b.queryNode(toNode(node, astKeyLeft), s)
+		right := b.queryNode(toNode(node, astKeyRight), s)
+		b.expression(node[astKeyModifiers], s, nil)
+		return mergeRelations(left, right, toString(node, astKeySetOpType) == "UNION_BY_NAME")
+	}
+
+	return &lineageRelation{opaque: true}
+}
+
+func (b *lineageBuilder) selectNode(node astNode, parent *lineageScope) *lineageRelation {
+	s := b.newScope(node, parent)
+	b.fromTable(toNode(node, astKeyFromTable), s)
+
+	out := &lineageRelation{}
+	for _, expr := range toNodeArray(node, astKeySelectColumnList) {
+		switch toString(expr, astKeyType) {
+		case "STAR":
+			b.expandStar(expr, s, out)
+		case "FUNCTION":
+			if toString(expr, astKeyFunctionName) == "exclude" {
+				continue
+			}
+			fallthrough
+		default:
+			out.columns = append(out.columns, &lineageColumn{
+				name:    expressionName(expr),
+				sources: b.expression(expr, s, nil),
+			})
+		}
+		s.aliases = out.columns
+	}
+
+	b.expression(node[astKeyWhereClause], s, nil)
+	b.expression(node[astKeyGroupExpressions], s, nil)
+	b.expression(node[astKeyHaving], s, nil)
+	b.expression(node[astKeyQualify], s, nil)
+	b.expression(node[astKeyModifiers], s, nil)
+
+	return out
+}
+
+// expandStar adds the columns selected by a star expression to out
+func (b *lineageBuilder) expandStar(expr astNode, s *lineageScope, out *lineageRelation) {
+	// COLUMNS with a regex or lambda can't be expanded statically
+	if toNode(expr, astKeyExpr) != nil {
+		return
+	}
+
+	excluded := map[string]bool{}
+	for _, e := range toArray(expr, astKeyExcludeList) {
+		if name, ok := e.(string); ok {
+			excluded[strings.ToLower(name)] = true
+		}
+	}
+	replaced := map[string]astNode{}
+	for _, e := range toNodeArray(expr, astKeyReplaceList) {
+		replaced[strings.ToLower(toString(e, astKeyKey))] = toNode(e, astKeyValue)
+	}
+
+	relation := toString(expr, astKetRelationName)
+	for _, r := range s.relations {
+		if relation != "" && !strings.EqualFold(r.alias, relation) {
+			continue
+		}
+
+		for _, c := range r.columns {
+			key := strings.ToLower(c.name)
+			if excluded[key] {
+				continue
+			}
+
+			col := &lineageColumn{name: c.name}
+			if e, ok := replaced[key]; ok {
+				col.sources = b.expression(e, s, nil)
+			} else {
+				col.sources = c.sources
+				b.reference(c.sources...)
+			}
+			out.columns = append(out.columns, col)
+		}
+
+		if !r.known() {
+			out.stars = append(out.stars, r)
+		}
+	}
+}
+
+// fromTable adds the relations of a FROM clause to the scope
+func (b *lineageBuilder) fromTable(node astNode, s *lineageScope) {
+	alias := toString(node, astKeyAlias)
+
+	switch toString(node, astKeyType) {
+	case "BASE_TABLE":
+		name := toString(node, astKeyTableName)
+		if alias == "" {
+			alias = name
+		}
+
+		var rel *lineageRelation
+		if cte, ok := s.ctes[strings.ToLower(name)]; ok && toString(node, astKeySchemaName) == "" {
+			cp := *cte
+			rel = &cp
+		} else {
+			rel = &lineageRelation{table: name}
+			if b.columns != nil {
+				if cols := b.columns(name); cols != nil {
+					rel.table = ""
+					rel.columns = make([]*lineageColumn, len(cols))
+					for i, c := range cols {
+						rel.columns[i] = &lineageColumn{
+							name:    c,
+							sources: []ColumnSource{{Table: name, Column: c}},
+						}
+					}
+				}
+			}
+		}
+		rel.alias = alias
+		renameColumns(rel, toArray(node, astKeyColumnNameAlias))
+		s.relations = append(s.relations, rel)
+
+	case "JOIN":
+		b.fromTable(toNode(node, astKeyLeft), s)
+		b.fromTable(toNode(node, astKeyRight), s)
+		b.expression(node[astKeyCondition], s, nil)
+		for _, c := range toArray(node, astKeyUsingColumns) {
+			name, ok := c.(string)
+			if !ok {
+				continue
+			}
+			for _, r := range s.relations {
+				if srcs, ok := r.resolve(name); ok {
+					b.reference(srcs...)
+				}
+			}
+		}
+
+	case "SUBQUERY":
+		rel := b.queryNode(toNode(toNode(node, astKeySubQuery), astKeyNode), s)
+		rel.alias = alias
+		renameColumns(rel, toArray(node, astKeyColumnNameAlias))
+		s.relations = append(s.relations, rel)
+
+	case "TABLE_FUNCTION":
+		if alias == "" {
+			alias = toString(toNode(node, astKeyFunction), astKeyFunctionName)
+		}
+		s.relations = append(s.relations, &lineageRelation{alias: alias, opaque: true})
+
+	case "PIVOT", "EXPRESSION_LIST":
+		s.relations = append(s.relations, &lineageRelation{alias: alias, opaque: true})
+	}
+}
+
+// expression returns the sources of the columns referenced in an expression and records them as references.
+// It accepts any value of the AST, so it can be called directly on lists of expressions and on modifiers.
+func (b *lineageBuilder) expression(v any, s *lineageScope, lambdaParams map[string]bool) []ColumnSource {
+	if n, ok := v.(astNode); ok {
+		v = map[string]any(n)
+	}
+
+	var res []ColumnSource
+	switch vt := v.(type) {
+	case []any:
+		for _, e := range vt {
+			res = appendSources(res, b.expression(e, s, lambdaParams)...)
+		}
+
+	case map[string]any:
+		node := astNode(vt)
+		switch toString(node, astKeyClass) {
+		case "COLUMN_REF":
+			names := toTypedArray[string](node, astKeyColumnNames)
+			if len(names) == 0 || lambdaParams[strings.ToLower(names[0])] {
+				return nil
+			}
+			res = appendSources(res, s.resolve(names)...)
+			b.reference(res...)
+			return res
+
+		case "SUBQUERY":
+			rel := b.queryNode(toNode(toNode(node, astKeySubQuery), astKeyNode), s)
+			res = appendSources(res, b.expression(node[astKeyChild], s, lambdaParams)...)
+			return appendSources(res, rel.sources()...)
+
+		case "LAMBDA":
+			params := make(map[string]bool, len(lambdaParams))
+			for k := range lambdaParams {
+				params[k] = true
+			}
+			collectColumnNames(toNode(node, astKeyLHS), params)
+			return b.expression(node[astKeyExpr], s, params)
+		}
+
+		// Iterate in a stable order so the sources are deterministic
+		keys := make([]string, 0, len(vt))
+		for k := range vt {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			res = appendSources(res, b.expression(vt[k], s, lambdaParams)...)
+		}
+	}
+	return res
+}
+
+// reference records sources as referenced by the statement
+func (b *lineageBuilder) reference(srcs ...ColumnSource) {
+	for _, src := range srcs {
+		if b.seen[src] {
+			continue
+		}
+		b.seen[src] = true
+		b.refs = append(b.refs, src)
+	}
+}
+
+// expressionName returns the name DuckDB gives to the output column of an expression in a select list
+func expressionName(node astNode) string {
+	if alias := toString(node, astKeyAlias); alias != "" {
+		return alias
+	}
+
+	if toString(node, astKeyClass) == "COLUMN_REF" {
+		names := toTypedArray[string](node, astKeyColumnNames)
+		if len(names) == 0 {
+			return ""
+		}
+		return names[len(names)-1]
+	}
+
+	exprStatement, err := createExpressionStatement(node)
+	if err != nil {
+		return ""
+	}
+	exprSQL, err := queryString("SELECT json_deserialize_sql(?::JSON)", exprStatement)
+	if err != nil {
+		return ""
+	}
+	subMatches := selectExpressionIsolation.FindStringSubmatch(string(exprSQL))
+	if len(subMatches) == 0 {
+		return ""
+	}
+	return subMatches[1]
+}
+
+// collectColumnNames adds the names of the column references in a node to names
+func collectColumnNames(node astNode, names map[string]bool) {
+	if toString(node, astKeyClass) == "COLUMN_REF" {
+		for _, n := range toTypedArray[string](node, astKeyColumnNames) {
+			names[strings.ToLower(n)] = true
+		}
+		return
+	}
+	for _, child := range toNodeArray(node, astKeyChildren) {
+		collectColumnNames(child, names)
+	}
+}
+
+// renameColumns applies column aliases (like "t(a, b)") to the columns of a relation
+func renameColumns(r *lineageRelation, aliases []any) {
+	if len(aliases) == 0 {
+		return
+	}
+
+	cols := make([]*lineageColumn, len(r.columns))
+	for i, c := range r.columns {
+		cols[i] = c
+		if i < len(aliases) {
+			if name, ok := aliases[i].(string); ok {
+				cols[i] = &lineageColumn{name: name, sources: c.sources}
+			}
+		}
+	}
+	r.columns = cols
+}
+
+// mergeRelations combines the outputs of the two sides of a set operation.
+// Columns are matched by position, or by name for UNION BY NAME.
+func mergeRelations(left, right *lineageRelation, byName bool) *lineageRelation {
+	out := &lineageRelation{
+		opaque: left.opaque || right.opaque,
+		stars:  append(append([]*lineageRelation{}, left.stars...), right.stars...),
+	}
+
+	for i, c := range left.columns {
+		col := &lineageColumn{name: c.name, sources: appendSources(nil, c.sources...)}
+		var other *lineageColumn
+		if byName {
+			other = right.column(c.name)
+		} else if i < len(right.columns) {
+			other = right.columns[i]
+		}
+		if other != nil {
+			col.sources = appendSources(col.sources, other.sources...)
+		}
+		out.columns = append(out.columns, col)
+	}
+
+	if byName {
+		for _, c := range right.columns {
+			if out.column(c.name) == nil {
+				out.columns = append(out.columns, &lineageColumn{name: c.name, sources: c.sources})
+			}
+		}
+	}
+
+	return out
+}
+
+// appendSources appends sources to dst, skipping duplicates
+func appendSources(dst []ColumnSource, srcs ...ColumnSource) []ColumnSource {
+	for _, src := range srcs {
+		found := false
+		for _, d := range dst {
+			if d == src {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst = append(dst, src)
+		}
+	}
+	return dst
+}
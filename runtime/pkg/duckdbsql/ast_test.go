@@ -708,3 +708,118 @@ func TestAST_RewriteLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestAST_ExtractColumnLineage(t *testing.T) {
+	tables := map[string][]string{
+		"AdBids":        {"id", "publisher", "domain", "bid_price", "timestamp"},
+		"AdImpressions": {"id", "city", "country", "user_id"},
+	}
+	columns := func(table string) []string {
+		return tables[table]
+	}
+
+	sqlVariations := []struct {
+		title      string
+		sql        string
+		columns    []*ColumnLineage
+		references []*ColumnSource
+	}{
+		{
+			"star with exclude and replace",
+			`select * exclude (timestamp) replace (upper(domain) as domain) from AdBids where bid_price > 1`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "AdBids", Column: "id"}}},
+				{Name: "publisher", Sources: []*ColumnSource{{Table: "AdBids", Column: "publisher"}}},
+				{Name: "domain", Sources: []*ColumnSource{{Table: "AdBids", Column: "domain"}}},
+				{Name: "bid_price", Sources: []*ColumnSource{{Table: "AdBids", Column: "bid_price"}}},
+			},
+			[]*ColumnSource{
+				{Table: "AdBids", Column: "id"},
+				{Table: "AdBids", Column: "publisher"},
+				{Table: "AdBids", Column: "domain"},
+				{Table: "AdBids", Column: "bid_price"},
+			},
+		},
+		{
+			"join with aliases",
+			`select b.publisher, i.city as c, avg(b.bid_price) as bid_price from AdBids b join AdImpressions i on b.id = i.id group by 1, 2`,
+			[]*ColumnLineage{
+				{Name: "publisher", Sources: []*ColumnSource{{Table: "AdBids", Column: "publisher"}}},
+				{Name: "c", Sources: []*ColumnSource{{Table: "AdImpressions", Column: "city"}}},
+				{Name: "bid_price", Sources: []*ColumnSource{{Table: "AdBids", Column: "bid_price"}}},
+			},
+			[]*ColumnSource{
+				{Table: "AdBids", Column: "id"},
+				{Table: "AdImpressions", Column: "id"},
+				{Table: "AdBids", Column: "publisher"},
+				{Table: "AdImpressions", Column: "city"},
+				{Table: "AdBids", Column: "bid_price"},
+			},
+		},
+		{
+			"CTEs and subqueries",
+			`
+with b as (select id, bid_price * 2 as price from AdBids)
+select p.id, p.price, p.country from (select b.*, i.country from b join AdImpressions i on b.id = i.id) p
+`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "AdBids", Column: "id"}}},
+				{Name: "price", Sources: []*ColumnSource{{Table: "AdBids", Column: "bid_price"}}},
+				{Name: "country", Sources: []*ColumnSource{{Table: "AdImpressions", Column: "country"}}},
+			},
+			[]*ColumnSource{
+				{Table: "AdBids", Column: "id"},
+				{Table: "AdBids", Column: "bid_price"},
+				{Table: "AdImpressions", Column: "id"},
+				{Table: "AdImpressions", Column: "country"},
+			},
+		},
+		{
+			"union",
+			`select id, publisher as name from AdBids union all select id, city from AdImpressions`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "AdBids", Column: "id"}, {Table: "AdImpressions", Column: "id"}}},
+				{Name: "name", Sources: []*ColumnSource{{Table: "AdBids", Column: "publisher"}, {Table: "AdImpressions", Column: "city"}}},
+			},
+			[]*ColumnSource{
+				{Table: "AdBids", Column: "id"},
+				{Table: "AdBids", Column: "publisher"},
+				{Table: "AdImpressions", Column: "id"},
+				{Table: "AdImpressions", Column: "city"},
+			},
+		},
+		{
+			"unknown table",
+			`select a, b + 1 as c from other where d = 1`,
+			[]*ColumnLineage{
+				{Name: "a", Sources: []*ColumnSource{{Table: "other", Column: "a"}}},
+				{Name: "c", Sources: []*ColumnSource{{Table: "other", Column: "b"}}},
+			},
+			[]*ColumnSource{
+				{Table: "other", Column: "a"},
+				{Table: "other", Column: "b"},
+				{Table: "other", Column: "d"},
+			},
+		},
+		{
+			"table function",
+			`select a from read_parquet('data.parquet')`,
+			[]*ColumnLineage{
+				{Name: "a", Sources: []*ColumnSource{}},
+			},
+			[]*ColumnSource{},
+		},
+	}
+
+	for _, tt := range sqlVariations {
+		t.Run(tt.title, func(t *testing.T) {
+			ast, err := Parse(tt.sql)
+			require.NoError(t, err)
+
+			lineage, err := ast.ExtractColumnLineage(columns)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.columns, lineage.Columns)
+			require.EqualValues(t, tt.references, lineage.References)
+		})
+	}
+}
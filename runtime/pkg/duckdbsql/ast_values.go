@@ -43,6 +43,19 @@ const (
 	astKeyCastType         string = "cast_type"
 	astKeySource           string = "source"
 	astKeyPosition         string = "position"
+	astKeyAliases          string = "aliases"
+	astKeySetOpType        string = "setop_type"
+	astKeyWhereClause      string = "where_clause"
+	astKeyGroupExpressions string = "group_expressions"
+	astKeyHaving           string = "having"
+	astKeyQualify          string = "qualify"
+	astKeyExpr             string = "expr"
+	astKeyExcludeList      string = "exclude_list"
+	astKeyReplaceList      string = "replace_list"
+	astKeySchemaName       string = "schema_name"
+	astKeyCondition        string = "condition"
+	astKeyUsingColumns     string = "using_columns"
+	astKeyLHS              string = "lhs"
 )
 
 func toBoolean(a astNode, k string) bool {
@@ -15,6 +15,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 
 	// need to import parser driver as well
 	_ "github.com/pingcap/tidb/pkg/parser/test_driver"
@@ -87,8 +88,14 @@ type transformer struct {
 	metricsView   *runtimev1.MetricsViewV2
 	refs          []*runtimev1.ResourceName
 	connector     string
+	dialect       drivers.Dialect
+	rowFilter     string
 	dimsToExpr    map[string]string
 	measureToExpr map[string]string
+	// windowMeasures are the measures computed with window functions, which are not supported in metrics SQL
+	windowMeasures map[string]bool
+	// usedDims are the dimensions referenced by the query, which determine the tables to join
+	usedDims []string
 }
 
 func (t *transformer) transformSelectStmt(ctx context.Context, node *ast.SelectStmt) (string, error) {
@@ -107,9 +114,7 @@ func (t *transformer) transformSelectStmt(ctx context.Context, node *ast.SelectS
 		return "", fmt.Errorf("metrics sql: need from clause")
 	}
 
-	var sb strings.Builder
-	sb.WriteString("SELECT ")
-	fromClause, err := t.transformFromClause(ctx, node.From)
+	err := t.transformFromClause(ctx, node.From)
 	if err != nil {
 		return "", err
 	}
@@ -119,49 +124,65 @@ func (t *transformer) transformSelectStmt(ctx context.Context, node *ast.SelectS
 		return "", err
 	}
 
-	sb.WriteString(selectList)
-	sb.WriteString(" FROM ")
-	sb.WriteString(fromClause)
-
+	var where string
 	if node.Where != nil {
-		where, err := t.transformExprNode(ctx, node.Where)
+		res, err := t.transformExprNode(ctx, node.Where)
 		if err != nil {
 			return "", err
 		}
-		sb.WriteString(" WHERE ")
-		sb.WriteString(where.expr)
+		where = res.expr
 	}
 	if node.GroupBy != nil {
 		return "", fmt.Errorf("metrics sql: Explicit group by clause is not supported. Group by clause is implicitly added when both measure and dimensions are selected. The implicit group by includes all selected dimensions")
 	}
-	if groupByClause != "" {
-		sb.WriteString(" GROUP BY ")
-		sb.WriteString(groupByClause)
-	}
 
+	var having string
 	if node.Having != nil {
-		having, err := t.transformHavingClause(ctx, node.Having)
+		having, err = t.transformHavingClause(ctx, node.Having)
 		if err != nil {
 			return "", err
 		}
-		sb.WriteString(" HAVING ")
-		sb.WriteString(having)
 	}
 
+	var orderBy string
 	if node.OrderBy != nil {
-		orderBy, err := t.transformOrderByClause(ctx, node.OrderBy)
+		orderBy, err = t.transformOrderByClause(ctx, node.OrderBy)
 		if err != nil {
 			return "", err
 		}
-		sb.WriteString(" ORDER BY ")
-		sb.WriteString(orderBy)
 	}
 
+	var limit string
 	if node.Limit != nil {
-		limit, err := t.transformLimitClause(ctx, node.Limit)
+		limit, err = t.transformLimitClause(ctx, node.Limit)
 		if err != nil {
 			return "", err
 		}
+	}
+
+	// The FROM clause is built last since the tables to join depend on the dimensions referenced by the other clauses
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(selectList)
+	sb.WriteString(" FROM ")
+	sb.WriteString(t.fromClause())
+	if where != "" {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(where)
+	}
+	if groupByClause != "" {
+		sb.WriteString(" GROUP BY ")
+		sb.WriteString(groupByClause)
+	}
+	if having != "" {
+		sb.WriteString(" HAVING ")
+		sb.WriteString(having)
+	}
+	if orderBy != "" {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(orderBy)
+	}
+	if limit != "" {
 		sb.WriteString(" LIMIT ")
 		sb.WriteString(limit)
 	}
@@ -222,36 +243,36 @@ func (t *transformer) transformSelectStmtColumns(ctx context.Context, node *ast.
 	return sb.String(), "", nil
 }
 
-func (t *transformer) transformFromClause(ctx context.Context, node *ast.TableRefsClause) (string, error) {
+func (t *transformer) transformFromClause(ctx context.Context, node *ast.TableRefsClause) error {
 	n := node.TableRefs
 	if n == nil || n.Left == nil {
-		return "", fmt.Errorf("metrics sql: need `FROM metrics_view` clause")
+		return fmt.Errorf("metrics sql: need `FROM metrics_view` clause")
 	}
 
 	tblSrc, ok := n.Left.(*ast.TableSource)
 	if !ok {
 		// if left is not a table source, then it must be a join
-		return "", fmt.Errorf("metrics sql: join is not supported")
+		return fmt.Errorf("metrics sql: join is not supported")
 	}
 
 	tblName, ok := tblSrc.Source.(*ast.TableName)
 	if !ok {
-		return "", fmt.Errorf("metrics sql: only FROM `metrics_view` is supported")
+		return fmt.Errorf("metrics sql: only FROM `metrics_view` is supported")
 	}
 
 	resource := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: tblName.Name.String()}
 	mv, err := t.controller.Get(ctx, resource, false)
 	if err != nil {
 		if errors.Is(err, drivers.ErrNotFound) {
-			return "", fmt.Errorf("metrics sql: metrics view `%s` not found", tblName.Name.String())
+			return fmt.Errorf("metrics sql: metrics view `%s` not found", tblName.Name.String())
 		}
-		return "", err
+		return err
 	}
 
 	t.metricsView = mv.GetMetricsView()
 	t.refs = []*runtimev1.ResourceName{resource}
 	t.connector = mv.GetMetricsView().Spec.Connector
-	return t.fromQueryForMetricsView(ctx, mv)
+	return t.resolveMetricsView(ctx, mv)
 }
 
 func (t *transformer) transformHavingClause(ctx context.Context, node *ast.HavingClause) (string, error) {
@@ -337,12 +358,16 @@ func (t *transformer) transformColumnNameExpr(_ context.Context, node *ast.Colum
 	}
 
 	col := node.Name.Name.O
+	if t.windowMeasures[col] {
+		return exprResult{}, fmt.Errorf("metrics sql: measure `%s` is computed with a window function, which is not supported in metrics SQL", col)
+	}
 	if colExpr, ok := t.measureToExpr[col]; ok {
 		return exprResult{expr: colExpr, columns: []string{restore(node.Name)}, types: []string{"MEASURE"}}, nil
 	}
 	var expr string
 	if colExpr, ok := t.dimsToExpr[col]; ok {
 		expr = colExpr
+		t.usedDims = append(t.usedDims, col)
 	} else if t.metricsView.Spec.TimeDimension == col {
 		expr = col
 	} else {
@@ -539,32 +564,46 @@ func (t *transformer) transformValueExpr(_ context.Context, node ast.ValueExpr)
 	return exprResult{expr: sb.String()}, nil
 }
 
-func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1.Resource) (string, error) {
+func (t *transformer) resolveMetricsView(ctx context.Context, mv *runtimev1.Resource) error {
 	spec := mv.GetMetricsView().State.ValidSpec
 	if spec == nil {
-		return "", fmt.Errorf("metrics view %q is not ready for querying, reconcile status: %q", mv.Meta.GetName(), mv.Meta.ReconcileStatus)
+		return fmt.Errorf("metrics view %q is not ready for querying, reconcile status: %q", mv.Meta.GetName(), mv.Meta.ReconcileStatus)
 	}
 
 	olap, release, err := t.controller.Runtime.OLAP(ctx, t.instanceID, spec.Connector)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer release()
 	dialect := olap.Dialect()
+	t.dialect = dialect
 
 	security, err := t.controller.Runtime.ResolveMetricsViewSecurity(t.userAttributes, t.instanceID, spec, mv.Meta.StateUpdatedOn.AsTime())
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	t.measureToExpr = make(map[string]string, len(spec.Measures))
+	t.windowMeasures = make(map[string]bool)
 	for _, measure := range spec.Measures {
-		t.measureToExpr[measure.Name] = measure.Expression
+		if metricsview.IsWindowMeasure(measure) {
+			// Measures computed with window functions (e.g. running sums) depend on the query's grouping, which metrics SQL doesn't constrain
+			t.windowMeasures[measure.Name] = true
+			continue
+		}
+		expr, _, err := metricsview.MeasureExpression(spec, measure, dialect, nil)
+		if err != nil {
+			return err
+		}
+		t.measureToExpr[measure.Name] = expr
 	}
 
 	t.dimsToExpr = make(map[string]string, len(spec.Dimensions))
 	for _, dim := range spec.Dimensions {
-		if dim.Expression != "" {
+		if dim.Join != "" {
+			// Joined dimensions are exposed as a column by the relation built in fromClause
+			t.dimsToExpr[dim.Name] = metricsview.JoinedDimensionColumn(dialect, dim)
+		} else if dim.Expression != "" {
 			t.dimsToExpr[dim.Name] = dim.Expression
 		} else {
 			t.dimsToExpr[dim.Name] = dialect.EscapeIdentifier(dim.Column)
@@ -572,11 +611,11 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 	}
 
 	if security == nil {
-		return dialect.EscapeIdentifier(spec.Table), nil
+		return nil
 	}
 
 	if !security.Access || security.ExcludeAll {
-		return "", fmt.Errorf("access to metrics view %q forbidden", mv.Meta.Name.Name)
+		return fmt.Errorf("access to metrics view %q forbidden", mv.Meta.Name.Name)
 	}
 
 	if len(security.Include) != 0 {
@@ -601,11 +640,19 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 		}
 	}
 
-	sql := "SELECT * FROM " + dialect.EscapeIdentifier(spec.Table)
-	if security.RowFilter != "" {
-		sql += " WHERE " + security.RowFilter
+	t.rowFilter = security.RowFilter
+	return nil
+}
+
+// fromClause returns the FROM clause for the metrics view.
+// It must be called after the query's other clauses have been transformed, so it only joins the tables needed by the referenced dimensions.
+func (t *transformer) fromClause() string {
+	spec := t.metricsView.State.ValidSpec
+	from := metricsview.Relation(spec, t.dialect, t.usedDims)
+	if t.rowFilter == "" {
+		return from
 	}
-	return fmt.Sprintf("(%s)", sql), nil
+	return fmt.Sprintf("(SELECT * FROM %s WHERE %s)", from, t.rowFilter)
 }
 
 func opToString(op opcode.Op) string {
@@ -77,3 +77,103 @@ func TestCompiler_CompileError(t *testing.T) {
 		// require.ErrorContains(t, err, errMsg)
 	}
 }
+
+func TestCompiler_CompileJoins(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:            ``,
+			`models/orders.sql`:    `SELECT * FROM (VALUES (1, 'open', 10), (1, 'closed', 20), (2, 'open', 30)) t(customer_id, status, amount)`,
+			`models/customers.sql`: `SELECT * FROM (VALUES (1, 'US'), (2, 'DK')) t(id, country)`,
+			`dashboards/orders_metrics.yaml`: `
+model: orders
+joins:
+- model: customers
+  on:
+    customer_id: id
+dimensions:
+- name: status
+  column: status
+- name: country
+  column: country
+  join: customers
+measures:
+- name: total
+  expression: sum(amount)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), instanceID)
+	require.NoError(t, err)
+	olap, release, err := rt.OLAP(context.Background(), instanceID, "")
+	require.NoError(t, err)
+	defer release()
+
+	compiler := New(ctrl, instanceID, make(map[string]any))
+
+	// Queries that don't reference a joined dimension don't join
+	got, _, _, err := compiler.Compile(context.Background(), "select status, total from orders_metrics")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "status" AS "status", sum(amount) AS "total" FROM "orders" GROUP BY "status"`, got)
+
+	// Joined dimensions are resolved against the joined table, whether they are selected or only filtered on
+	tt := map[string]string{
+		"select country, total from orders_metrics order by country":    `SELECT "__rill_join_country" AS "country", sum(amount) AS "total" FROM (SELECT "orders".*, ("customers"."country") AS "__rill_join_country" FROM "orders" LEFT JOIN "customers" AS "customers" ON "orders"."customer_id" = "customers"."id") AS "orders" GROUP BY "__rill_join_country" ORDER BY "__rill_join_country" ASC`,
+		"select status, total from orders_metrics where country = 'US'": `SELECT "status" AS "status", sum(amount) AS "total" FROM (SELECT "orders".*, ("customers"."country") AS "__rill_join_country" FROM "orders" LEFT JOIN "customers" AS "customers" ON "orders"."customer_id" = "customers"."id") AS "orders" WHERE "__rill_join_country" = 'US' GROUP BY "status"`,
+	}
+	for inSQL, outSQL := range tt {
+		got, _, _, err := compiler.Compile(context.Background(), inSQL)
+		require.NoError(t, err, "input = %v", inSQL)
+		require.Equal(t, outSQL, got, "input = %v", inSQL)
+	}
+
+	// The join doesn't duplicate rows of the metrics view's table
+	got, _, _, err = compiler.Compile(context.Background(), "select country, total from orders_metrics order by country")
+	require.NoError(t, err)
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: got})
+	require.NoError(t, err)
+	defer res.Close()
+	var totals []int
+	for res.Next() {
+		var country string
+		var total int
+		require.NoError(t, res.Scan(&country, &total))
+		totals = append(totals, total)
+	}
+	require.NoError(t, res.Err())
+	require.Equal(t, []int{30, 30}, totals)
+}
+
+func TestCompiler_CompileWindowMeasures(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:         ``,
+			`models/orders.sql`: `SELECT * FROM (VALUES ('open', 10), ('closed', 20)) t(status, amount)`,
+			`dashboards/orders_metrics.yaml`: `
+model: orders
+dimensions:
+- name: status
+  column: status
+measures:
+- name: total
+  expression: sum(amount)
+- name: total_share
+  type: share_of_total
+  requires: [total]
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), instanceID)
+	require.NoError(t, err)
+	compiler := New(ctrl, instanceID, make(map[string]any))
+
+	got, _, _, err := compiler.Compile(context.Background(), "select status, total from orders_metrics")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "status" AS "status", sum(amount) AS "total" FROM "orders" GROUP BY "status"`, got)
+
+	_, _, _, err = compiler.Compile(context.Background(), "select status, total_share from orders_metrics")
+	require.ErrorContains(t, err, "metrics sql: measure `total_share` is computed with a window function, which is not supported in metrics SQL")
+}
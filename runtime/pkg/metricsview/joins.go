@@ -0,0 +1,96 @@
+package metricsview
+
+import (
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Relation returns the relation to use in the FROM clause of a query against the metrics view.
+// The query references the dimensions in dims and the identifiers in exprs (e.g. filters).
+// If any of them is a dimension resolved against a join, it returns a subquery that joins the metrics view's table with the joined tables needed by those dimensions.
+// The subquery exposes the joined dimensions as extra columns (see JoinedDimensionColumn) and is aliased to the table's name, so references to the table's columns keep working.
+// Otherwise, it returns the metrics view's table, so queries that don't reference joined dimensions don't pay for the joins.
+//
+// The join keys of a joined table are validated to be unique when the metrics view is reconciled, so the joins don't duplicate rows of the metrics view's table.
+func Relation(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, dims []string, exprs ...*runtimev1.Expression) string {
+	names := append([]string{}, dims...)
+	for _, expr := range exprs {
+		names = append(names, ExpressionIdents(expr)...)
+	}
+
+	var cols []string
+	usedJoins := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, dim := range mv.Dimensions {
+			if dim.Join == "" || dim.Name != name || seen[dim.Name] {
+				continue
+			}
+			seen[dim.Name] = true
+			usedJoins[strings.ToLower(dim.Join)] = true
+
+			expr := dim.Expression
+			if expr == "" {
+				expr = dialect.EscapeIdentifier(dim.Join) + "." + dialect.EscapeIdentifier(dim.Column)
+			}
+			cols = append(cols, fmt.Sprintf("(%s) AS %s", expr, JoinedDimensionColumn(dialect, dim)))
+		}
+	}
+	if len(cols) == 0 {
+		return dialect.EscapeIdentifier(mv.Table)
+	}
+
+	var joins []string
+	for _, join := range mv.Joins {
+		if !usedJoins[strings.ToLower(join.Name)] {
+			continue
+		}
+
+		typ := "LEFT JOIN"
+		if join.Type == runtimev1.MetricsViewSpec_JOIN_TYPE_INNER {
+			typ = "INNER JOIN"
+		}
+		joins = append(joins, fmt.Sprintf("%s %s AS %s ON %s", typ, dialect.EscapeIdentifier(join.Table), dialect.EscapeIdentifier(join.Name), JoinCondition(mv.Table, join, dialect)))
+	}
+
+	return fmt.Sprintf(
+		"(SELECT %s.*, %s FROM %s %s) AS %s",
+		dialect.EscapeIdentifier(mv.Table),
+		strings.Join(cols, ", "),
+		dialect.EscapeIdentifier(mv.Table),
+		strings.Join(joins, " "),
+		dialect.EscapeIdentifier(mv.Table),
+	)
+}
+
+// JoinCondition returns the ON condition for joining the table with a join of its metrics view.
+func JoinCondition(table string, join *runtimev1.MetricsViewSpec_JoinV2, dialect drivers.Dialect) string {
+	conds := make([]string, 0, len(join.Keys))
+	for _, key := range join.Keys {
+		conds = append(conds, fmt.Sprintf("%s.%s = %s.%s", dialect.EscapeIdentifier(table), dialect.EscapeIdentifier(key.Column), dialect.EscapeIdentifier(join.Name), dialect.EscapeIdentifier(key.JoinColumn)))
+	}
+	return strings.Join(conds, " AND ")
+}
+
+// JoinedDimensionColumn returns the escaped name of the column that Relation exposes a joined dimension as.
+func JoinedDimensionColumn(dialect drivers.Dialect, dim *runtimev1.MetricsViewSpec_DimensionV2) string {
+	return dialect.EscapeIdentifier("__rill_join_" + dim.Name)
+}
+
+// ExpressionIdents returns the identifiers referenced in an expression, including in nested conditions.
+func ExpressionIdents(expr *runtimev1.Expression) []string {
+	switch e := expr.GetExpression().(type) {
+	case *runtimev1.Expression_Ident:
+		return []string{e.Ident}
+	case *runtimev1.Expression_Cond:
+		var res []string
+		for _, sub := range e.Cond.Exprs {
+			res = append(res, ExpressionIdents(sub)...)
+		}
+		return res
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package metricsview
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRelation(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{
+				Name:  "customers",
+				Table: "customers",
+				Keys:  []*runtimev1.MetricsViewSpec_JoinV2_Key{{Column: "customer_id", JoinColumn: "id"}, {Column: "tenant_id", JoinColumn: "tenant_id"}},
+			},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "status", Column: "status"},
+			{Name: "country", Column: "country", Join: "customers"},
+		},
+	}
+
+	// Joined dimensions referenced in nested conditions are found
+	ident := func(name string) *runtimev1.Expression {
+		return &runtimev1.Expression{Expression: &runtimev1.Expression_Ident{Ident: name}}
+	}
+	val := func(v string) *runtimev1.Expression {
+		return &runtimev1.Expression{Expression: &runtimev1.Expression_Val{Val: structpb.NewStringValue(v)}}
+	}
+	cond := func(op runtimev1.Operation, exprs ...*runtimev1.Expression) *runtimev1.Expression {
+		return &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{Op: op, Exprs: exprs}}}
+	}
+	where := cond(runtimev1.Operation_OPERATION_AND,
+		cond(runtimev1.Operation_OPERATION_EQ, ident("status"), val("open")),
+		cond(runtimev1.Operation_OPERATION_OR,
+			cond(runtimev1.Operation_OPERATION_IN, ident("country"), val("US"), val("DK")),
+			cond(runtimev1.Operation_OPERATION_EQ, ident("status"), val("closed")),
+		),
+	)
+	require.Equal(t, []string{"status", "country", "status"}, ExpressionIdents(where))
+	require.Equal(t,
+		`(SELECT "orders".*, ("customers"."country") AS "__rill_join_country" FROM "orders" LEFT JOIN "customers" AS "customers" ON "orders"."customer_id" = "customers"."id" AND "orders"."tenant_id" = "customers"."tenant_id") AS "orders"`,
+		Relation(mv, drivers.DialectDuckDB, []string{"status"}, where),
+	)
+
+	// No joins are needed for unjoined dimensions
+	require.Equal(t, `"orders"`, Relation(mv, drivers.DialectDuckDB, []string{"status"}, cond(runtimev1.Operation_OPERATION_EQ, ident("status"), val("open")), nil))
+}
@@ -0,0 +1,279 @@
+// Package metricsview contains helpers for compiling the measures of a metrics view to SQL.
+package metricsview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Window describes the rows that measures computed with a window function are evaluated over.
+// It's derived from the dimensions of the query the measures are used in.
+type Window struct {
+	// PartitionBy contains SQL expressions for the query's dimensions, excluding the time dimension in OrderBy.
+	PartitionBy []string
+	// PartitionByArgs are the values for the placeholders in PartitionBy, in order.
+	PartitionByArgs []any
+	// OrderBy is a SQL expression for the query's time dimension, truncated to Grain.
+	OrderBy string
+	// OrderByArgs are the values for the placeholders in OrderBy, in order.
+	OrderByArgs []any
+	// Grain is the time grain OrderBy is truncated to.
+	// If it's set, previous period values are only taken from the preceding row if it is the previous period, so gaps in the data are not bridged.
+	Grain runtimev1.TimeGrain
+}
+
+// MeasureExpression returns the SQL expression for a measure.
+// Derived measures are compiled by substituting references to other measures with their expressions.
+// Running sums and previous period values must be ordered by time, so they return an error if window is nil.
+// The returned args are the values for the window's placeholders if the expression uses the window.
+func MeasureExpression(mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2, dialect drivers.Dialect, window *Window) (string, []any, error) {
+	expr, err := measureExpression(mv, m, dialect, window, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch m.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM:
+		return expr, window.overArgs(), nil
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD:
+		if previousPeriodInterval(window.Grain, dialect) == "" {
+			return expr, window.overArgs(), nil
+		}
+		// The expression is LAG(OrderBy) OVER (...) > OrderBy - INTERVAL ... THEN LAG(...) OVER (...)
+		var args []any
+		args = append(args, window.OrderByArgs...)
+		args = append(args, window.overArgs()...)
+		args = append(args, window.OrderByArgs...)
+		args = append(args, window.overArgs()...)
+		return expr, args, nil
+	}
+	return expr, nil, nil
+}
+
+// overArgs returns the values for the placeholders in the window's OVER clause.
+func (w *Window) overArgs() []any {
+	var args []any
+	args = append(args, w.PartitionByArgs...)
+	args = append(args, w.OrderByArgs...)
+	return args
+}
+
+// IsWindowMeasure returns true if the measure is computed with a window function over the result of the query it's used in.
+func IsWindowMeasure(m *runtimev1.MetricsViewSpec_MeasureV2) bool {
+	switch m.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM,
+		runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL,
+		runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD:
+		return true
+	}
+	return false
+}
+
+func measureExpression(mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2, dialect drivers.Dialect, window *Window, visiting []string) (string, error) {
+	if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE {
+		return m.Expression, nil
+	}
+
+	if slices.Contains(visiting, m.Name) {
+		return "", fmt.Errorf("measure %q references itself", m.Name)
+	}
+	visiting = append(visiting, m.Name)
+
+	// Compile the required measures
+	exprs := make(map[string]string, len(m.Requires))
+	for _, name := range m.Requires {
+		req := lookupMeasure(mv, name)
+		if req == nil {
+			return "", fmt.Errorf("measure %q required by measure %q not found", name, m.Name)
+		}
+		if IsWindowMeasure(req) {
+			return "", fmt.Errorf("measure %q cannot require measure %q, which is computed with a window function", m.Name, name)
+		}
+		expr, err := measureExpression(mv, req, dialect, nil, visiting)
+		if err != nil {
+			return "", err
+		}
+		exprs[strings.ToLower(name)] = expr
+	}
+
+	if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED {
+		return substituteMeasures(m.Expression, exprs), nil
+	}
+
+	if len(m.Requires) != 1 {
+		return "", fmt.Errorf("measure %q must require exactly one measure", m.Name)
+	}
+	base := exprs[strings.ToLower(m.Requires[0])]
+
+	if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL {
+		// Cast to avoid integer division
+		return fmt.Sprintf("CAST((%s) AS DOUBLE) / NULLIF(SUM(%s) OVER (), 0)", base, base), nil
+	}
+
+	if window == nil || window.OrderBy == "" {
+		return "", fmt.Errorf("measure %q can only be used in queries grouped by a time dimension", m.Name)
+	}
+	var partition string
+	if len(window.PartitionBy) > 0 {
+		partition = fmt.Sprintf("PARTITION BY %s ", strings.Join(window.PartitionBy, ", "))
+	}
+
+	switch m.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM:
+		return fmt.Sprintf("SUM(%s) OVER (%sORDER BY %s ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)", base, partition, window.OrderBy), nil
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD:
+		lag := func(expr string) string {
+			if dialect == drivers.DialectClickHouse {
+				// ClickHouse doesn't support LAG. lagInFrame returns the type's default value instead of NULL for the first row unless the type is nullable.
+				return fmt.Sprintf("lagInFrame(toNullable(%s), 1, NULL) OVER (%sORDER BY %s ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)", expr, partition, window.OrderBy)
+			}
+			return fmt.Sprintf("LAG(%s) OVER (%sORDER BY %s)", expr, partition, window.OrderBy)
+		}
+
+		interval := previousPeriodInterval(window.Grain, dialect)
+		if interval == "" {
+			return lag(base), nil
+		}
+
+		// The preceding row is only the previous period if its time is less than one and a half periods earlier.
+		// Comparing with a margin instead of subtracting exactly one period is robust to periods of different lengths (e.g. months) and DST changes.
+		return fmt.Sprintf("CASE WHEN %s > %s - %s THEN %s END", lag(window.OrderBy), window.OrderBy, interval, lag(base)), nil
+	default:
+		return "", fmt.Errorf("measure %q has unsupported type %q", m.Name, m.Type.String())
+	}
+}
+
+// previousPeriodInterval returns a SQL interval of one and a half periods of the time grain.
+// It returns an empty string if the grain is unspecified.
+func previousPeriodInterval(grain runtimev1.TimeGrain, dialect drivers.Dialect) string {
+	var n, unit string
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		if dialect == drivers.DialectDruid {
+			// Druid doesn't support sub-millisecond intervals, so we use two periods instead
+			return "INTERVAL '0.002' SECOND"
+		}
+		n, unit = "1500", "MICROSECOND"
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		n, unit = "1500", "MILLISECOND"
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		n, unit = "90", "SECOND"
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		n, unit = "90", "MINUTE"
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		n, unit = "36", "HOUR"
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		n, unit = "252", "HOUR"
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		n, unit = "45", "DAY"
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		n, unit = "135", "DAY"
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		n, unit = "548", "DAY"
+	default:
+		return ""
+	}
+
+	if dialect == drivers.DialectDruid {
+		// Druid only supports standard SQL interval literals, which have a quoted value and don't support milliseconds
+		if unit == "MILLISECOND" {
+			return "INTERVAL '1.5' SECOND"
+		}
+		return fmt.Sprintf("INTERVAL '%s' %s", n, unit)
+	}
+	return fmt.Sprintf("INTERVAL %s %s", n, unit)
+}
+
+func lookupMeasure(mv *runtimev1.MetricsViewSpec, name string) *runtimev1.MetricsViewSpec_MeasureV2 {
+	for _, m := range mv.Measures {
+		if strings.EqualFold(m.Name, name) {
+			return m
+		}
+	}
+	return nil
+}
+
+// substituteMeasures replaces references to measures in a SQL expression with the measures' expressions.
+// The keys of exprs are lower case measure names. References are bare or double quoted identifiers that match a key case-insensitively.
+// String literals, qualified identifiers (e.g. "t.revenue") and function names are left as is.
+func substituteMeasures(expr string, exprs map[string]string) string {
+	var b strings.Builder
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == '\'':
+			j := skipQuoted(expr, i, '\'')
+			b.WriteString(expr[i:j])
+			i = j
+		case c == '"':
+			j := skipQuoted(expr, i, '"')
+			name := strings.ReplaceAll(expr[i+1:max(j-1, i+1)], `""`, `"`)
+			b.WriteString(substituteIdentifier(expr, i, j, name, exprs))
+			i = j
+		case isIdentifierStart(c):
+			j := i + 1
+			for j < len(expr) && isIdentifierPart(expr[j]) {
+				j++
+			}
+			b.WriteString(substituteIdentifier(expr, i, j, expr[i:j], exprs))
+			i = j
+		case c >= '0' && c <= '9':
+			// Skip numbers so exponents like "1e5" are not read as identifiers
+			j := i + 1
+			for j < len(expr) && (isIdentifierPart(expr[j]) || expr[j] == '.') {
+				j++
+			}
+			b.WriteString(expr[i:j])
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// substituteIdentifier returns the replacement for the identifier at expr[start:end].
+func substituteIdentifier(expr string, start, end int, name string, exprs map[string]string) string {
+	replacement, ok := exprs[strings.ToLower(name)]
+	if !ok {
+		return expr[start:end]
+	}
+
+	prev := strings.TrimRight(expr[:start], " \t\n")
+	next := strings.TrimLeft(expr[end:], " \t\n")
+	if strings.HasSuffix(prev, ".") || strings.HasPrefix(next, ".") || strings.HasPrefix(next, "(") {
+		return expr[start:end]
+	}
+
+	return "(" + replacement + ")"
+}
+
+// skipQuoted returns the index after the quoted string or identifier starting at expr[start]. Quotes are escaped by doubling them.
+func skipQuoted(expr string, start int, quote byte) int {
+	i := start + 1
+	for i < len(expr) {
+		if expr[i] == quote {
+			if i+1 < len(expr) && expr[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		i++
+	}
+	return i
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
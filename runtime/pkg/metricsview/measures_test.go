@@ -0,0 +1,81 @@
+package metricsview
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasureExpression(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(revenue)"},
+			{Name: "orders", Expression: "count(*)"},
+			{Name: "aov", Expression: `revenue / "Orders"`, Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, Requires: []string{"revenue", "orders"}},
+			{Name: "aov_pct", Expression: "aov * 100", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, Requires: []string{"aov"}},
+			{Name: "revenue_running", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_SUM, Requires: []string{"revenue"}},
+			{Name: "revenue_share", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_TOTAL, Requires: []string{"revenue"}},
+			{Name: "revenue_previous", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_PREVIOUS_PERIOD, Requires: []string{"revenue"}},
+			{Name: "invalid", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, Expression: "revenue_share * 2", Requires: []string{"revenue_share"}},
+		},
+	}
+	window := &Window{PartitionBy: []string{`"country"`}, OrderBy: `timezone(?, date_trunc('day', timezone(?, "ts")))`, OrderByArgs: []any{"Asia/Kolkata", "Asia/Kolkata"}}
+	grainWindow := &Window{PartitionBy: []string{`lower(?)`}, PartitionByArgs: []any{"x"}, OrderBy: `date_trunc('day', ?)`, OrderByArgs: []any{"y"}, Grain: runtimev1.TimeGrain_TIME_GRAIN_DAY}
+
+	tt := []struct {
+		name    string
+		dialect drivers.Dialect
+		window  *Window
+		want    string
+		args    []any
+		wantErr bool
+	}{
+		{name: "revenue", want: "sum(revenue)"},
+		{name: "aov", want: "(sum(revenue)) / (count(*))"},
+		{name: "aov_pct", want: "((sum(revenue)) / (count(*))) * 100"},
+		{name: "revenue_share", want: "CAST((sum(revenue)) AS DOUBLE) / NULLIF(SUM(sum(revenue)) OVER (), 0)"},
+		{name: "revenue_running", wantErr: true},
+		{name: "revenue_running", window: window, want: `SUM(sum(revenue)) OVER (PARTITION BY "country" ORDER BY timezone(?, date_trunc('day', timezone(?, "ts"))) ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`, args: []any{"Asia/Kolkata", "Asia/Kolkata"}},
+		{name: "revenue_share", window: window, want: "CAST((sum(revenue)) AS DOUBLE) / NULLIF(SUM(sum(revenue)) OVER (), 0)"},
+		{name: "revenue_previous", window: &Window{OrderBy: `"ts"`}, want: `LAG(sum(revenue)) OVER (ORDER BY "ts")`},
+		{name: "revenue_previous", dialect: drivers.DialectClickHouse, window: &Window{OrderBy: `"ts"`}, want: `lagInFrame(toNullable(sum(revenue)), 1, NULL) OVER (ORDER BY "ts" ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)`},
+		{name: "revenue_previous", window: grainWindow, want: `CASE WHEN LAG(date_trunc('day', ?)) OVER (PARTITION BY lower(?) ORDER BY date_trunc('day', ?)) > date_trunc('day', ?) - INTERVAL 36 HOUR THEN LAG(sum(revenue)) OVER (PARTITION BY lower(?) ORDER BY date_trunc('day', ?)) END`, args: []any{"y", "x", "y", "y", "x", "y"}},
+		{name: "revenue_previous", dialect: drivers.DialectClickHouse, window: &Window{OrderBy: `"ts"`, Grain: runtimev1.TimeGrain_TIME_GRAIN_MONTH}, want: `CASE WHEN lagInFrame(toNullable("ts"), 1, NULL) OVER (ORDER BY "ts" ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) > "ts" - INTERVAL 45 DAY THEN lagInFrame(toNullable(sum(revenue)), 1, NULL) OVER (ORDER BY "ts" ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) END`},
+		{name: "revenue_running", dialect: drivers.DialectDruid, window: &Window{OrderBy: `"__time"`}, want: `SUM(sum(revenue)) OVER (ORDER BY "__time" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`},
+		{name: "revenue_share", dialect: drivers.DialectDruid, want: "CAST((sum(revenue)) AS DOUBLE) / NULLIF(SUM(sum(revenue)) OVER (), 0)"},
+		{name: "revenue_previous", dialect: drivers.DialectDruid, window: &Window{OrderBy: `"__time"`, Grain: runtimev1.TimeGrain_TIME_GRAIN_DAY}, want: `CASE WHEN LAG("__time") OVER (ORDER BY "__time") > "__time" - INTERVAL '36' HOUR THEN LAG(sum(revenue)) OVER (ORDER BY "__time") END`},
+		{name: "aov", dialect: drivers.DialectDruid, want: "(sum(revenue)) / (count(*))"},
+		{name: "invalid", wantErr: true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, args, err := MeasureExpression(mv, lookupMeasure(mv, tc.name), tc.dialect, tc.window)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.args, args)
+		})
+	}
+}
+
+func TestSubstituteMeasures(t *testing.T) {
+	exprs := map[string]string{"revenue": "sum(revenue)", "orders": "count(*)"}
+	tt := []struct {
+		expr string
+		want string
+	}{
+		{"revenue / orders", "(sum(revenue)) / (count(*))"},
+		{`"revenue"/"ORDERS"`, "(sum(revenue))/(count(*))"},
+		{"revenue / nullif(orders, 0)", "(sum(revenue)) / nullif((count(*)), 0)"},
+		{"'revenue' || t.revenue || revenue_2", "'revenue' || t.revenue || revenue_2"},
+		{"orders() + 1e5 + 'it''s orders'", "orders() + 1e5 + 'it''s orders'"},
+	}
+	for _, tc := range tt {
+		require.Equal(t, tc.want, substituteMeasures(tc.expr, exprs), tc.expr)
+	}
+}
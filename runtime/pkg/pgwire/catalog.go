@@ -0,0 +1,236 @@
+package pgwire
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/marcboeker/go-duckdb"
+)
+
+// Table is a table that clients can discover through the emulated system catalogs.
+type Table struct {
+	Name    string
+	Columns []Column
+}
+
+// _catalogMaxRows is the maximum number of rows a catalog query can return.
+const _catalogMaxRows = 10000
+
+// catalogQueryRegexp matches queries that reference the Postgres system catalogs or functions that describe the session.
+var catalogQueryRegexp = regexp.MustCompile(`(?i)\b(?:(?:pg_catalog|information_schema|pg_(?:am|attrdef|attribute|class|constraint|database|depend|description|enum|index|indexes|namespace|proc|roles|sequences?|settings|tables|tablespace|type|user|views|get_\w+|\w+_is_visible)|current_schemas?|current_database|current_user|session_user)\b|(?:current_setting|version)\s*\()`)
+
+// isCatalogQuery returns true if the statement is answered from the emulated system catalogs instead of the Handler.
+func isCatalogQuery(sql string) bool {
+	return catalogQueryRegexp.MatchString(sql)
+}
+
+// catalogQuery runs a statement against the emulated system catalogs.
+// They are backed by an in-memory DuckDB database with an empty table in the "public" schema for each of the Handler's tables.
+// DuckDB provides the pg_catalog and information_schema views, which is enough for the introspection queries of most BI tools.
+func (c *conn) catalogQuery(ctx context.Context, query string) (*Result, error) {
+	tables, err := c.opts.Handler.Catalog(ctx, c.database)
+	if err != nil {
+		return nil, err
+	}
+
+	connector, err := duckdb.NewConnector("", nil)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	// Settings like USE only apply to the connection they run on
+	dbConn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer dbConn.Close()
+
+	database := quoteIdentifier(c.database)
+	stmts := []string{
+		fmt.Sprintf("ATTACH ':memory:' AS %s", database),
+		fmt.Sprintf("USE %s", database),
+		"DETACH memory",
+		"CREATE SCHEMA public",
+		fmt.Sprintf("USE %s.public", database),
+	}
+	for _, t := range tables {
+		if len(t.Columns) == 0 {
+			continue
+		}
+		cols := make([]string, len(t.Columns))
+		for i, col := range t.Columns {
+			cols[i] = fmt.Sprintf("%s %s", quoteIdentifier(col.Name), duckdbType(col.TypeOID))
+		}
+		stmts = append(stmts, fmt.Sprintf("CREATE TABLE public.%s (%s)", quoteIdentifier(t.Name), strings.Join(cols, ", ")))
+	}
+	// Lock it down, since clients can run arbitrary statements against it
+	stmts = append(stmts,
+		"SET threads = 1",
+		"SET memory_limit = '256MB'",
+		"SET enable_external_access = false",
+		"SET lock_configuration = true",
+	)
+	for _, stmt := range stmts {
+		if _, err := dbConn.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to prepare catalog: %w", err)
+		}
+	}
+
+	rows, err := dbConn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, &Error{Code: "42000", Message: err.Error()}
+	}
+	defer rows.Close()
+
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{Columns: make([]Column, len(colTypes))}
+	for i, ct := range colTypes {
+		res.Columns[i] = Column{Name: ct.Name(), TypeOID: catalogTypeOID(ct.DatabaseTypeName())}
+	}
+	for rows.Next() {
+		if len(res.Rows) == _catalogMaxRows {
+			return nil, &Error{Code: "54000", Message: fmt.Sprintf("catalog query returned more than %d rows", _catalogMaxRows)}
+		}
+		row := make([]any, len(colTypes))
+		dest := make([]any, len(colTypes))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, v := range row {
+			row[i] = catalogValue(colTypes[i].DatabaseTypeName(), v)
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, &Error{Code: "42000", Message: err.Error()}
+	}
+	return res, nil
+}
+
+// duckdbType returns the DuckDB type for a column of a catalog table.
+func duckdbType(oid uint32) string {
+	switch oid {
+	case pgtype.BoolOID:
+		return "BOOLEAN"
+	case pgtype.Int2OID:
+		return "SMALLINT"
+	case pgtype.Int4OID:
+		return "INTEGER"
+	case pgtype.Int8OID:
+		return "BIGINT"
+	case pgtype.NumericOID:
+		return "DECIMAL(38, 9)"
+	case pgtype.Float4OID:
+		return "REAL"
+	case pgtype.Float8OID:
+		return "DOUBLE"
+	case pgtype.DateOID:
+		return "DATE"
+	case pgtype.TimeOID:
+		return "TIME"
+	case pgtype.TimestampOID:
+		return "TIMESTAMP"
+	case pgtype.TimestamptzOID:
+		return "TIMESTAMPTZ"
+	case pgtype.ByteaOID:
+		return "BLOB"
+	case pgtype.UUIDOID:
+		return "UUID"
+	default:
+		return "VARCHAR"
+	}
+}
+
+// catalogTypeOID maps the DuckDB type of a catalog query result column to the closest Postgres type.
+func catalogTypeOID(typ string) uint32 {
+	switch {
+	case strings.HasSuffix(typ, "[]"):
+		return pgtype.TextArrayOID
+	case strings.HasPrefix(typ, "DECIMAL"):
+		return pgtype.NumericOID
+	}
+	switch typ {
+	case "BOOLEAN":
+		return pgtype.BoolOID
+	case "TINYINT", "SMALLINT", "UTINYINT":
+		return pgtype.Int2OID
+	case "INTEGER", "USMALLINT":
+		return pgtype.Int4OID
+	case "BIGINT", "UINTEGER":
+		return pgtype.Int8OID
+	case "UBIGINT", "HUGEINT":
+		return pgtype.NumericOID
+	case "FLOAT":
+		return pgtype.Float4OID
+	case "DOUBLE":
+		return pgtype.Float8OID
+	case "DATE":
+		return pgtype.DateOID
+	case "TIME":
+		return pgtype.TimeOID
+	case "TIMESTAMP", "TIMESTAMP_S", "TIMESTAMP_MS", "TIMESTAMP_NS":
+		return pgtype.TimestampOID
+	case "TIMESTAMPTZ":
+		return pgtype.TimestamptzOID
+	case "BLOB":
+		return pgtype.ByteaOID
+	case "UUID":
+		return pgtype.UUIDOID
+	default:
+		return pgtype.TextOID
+	}
+}
+
+// catalogValue converts a value scanned from DuckDB to a value that encodeText encodes in the Postgres text format.
+func catalogValue(typ string, v any) any {
+	switch v := v.(type) {
+	case []any:
+		return arrayLiteral(v)
+	case duckdb.Decimal:
+		return new(big.Rat).SetFrac(v.Value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.Scale)), nil)).FloatString(int(v.Scale))
+	case []byte:
+		if typ == "UUID" && len(v) == 16 {
+			return fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// arrayLiteral formats a list in the text format of Postgres arrays.
+func arrayLiteral(vals []any) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, v := range vals {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if v == nil {
+			sb.WriteString("NULL")
+			continue
+		}
+		s := fmt.Sprint(v)
+		sb.WriteByte('"')
+		sb.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,85 @@
+package pgwire
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// encodeText encodes a value in the Postgres text format for the given type OID.
+// It returns nil for NULL values.
+func encodeText(oid uint32, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []byte(v)
+	case []byte:
+		return []byte(`\x` + hex.EncodeToString(v))
+	case bool:
+		if v {
+			return []byte("t")
+		}
+		return []byte("f")
+	case json.Number:
+		return []byte(v.String())
+	case int:
+		return strconv.AppendInt(nil, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(nil, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(nil, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(nil, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(nil, v, 10)
+	case uint8:
+		return strconv.AppendUint(nil, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(nil, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(nil, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(nil, v, 10)
+	case float32:
+		return encodeFloat(float64(v), 32)
+	case float64:
+		return encodeFloat(v, 64)
+	case time.Time:
+		switch oid {
+		case pgtype.DateOID:
+			return []byte(v.Format("2006-01-02"))
+		case pgtype.TimestampOID:
+			return []byte(v.Format("2006-01-02 15:04:05.999999"))
+		default:
+			return []byte(v.Format("2006-01-02 15:04:05.999999Z07:00"))
+		}
+	case fmt.Stringer:
+		return []byte(v.String())
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return []byte(fmt.Sprint(v))
+		}
+		return data
+	}
+}
+
+// encodeFloat encodes a float using Postgres' spelling of the special values.
+func encodeFloat(f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return []byte("NaN")
+	case math.IsInf(f, 1):
+		return []byte("Infinity")
+	case math.IsInf(f, -1):
+		return []byte("-Infinity")
+	default:
+		return strconv.AppendFloat(nil, f, 'g', -1, bitSize)
+	}
+}
@@ -0,0 +1,178 @@
+package pgwire
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// numericRegexp matches the text format of Postgres numeric values.
+var numericRegexp = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+// bindParams replaces the $n placeholders in sql with the text encoded parameter values as SQL literals.
+// The OIDs are the parameter types declared by the client in the Parse message. They may be shorter than params or zero for untyped parameters.
+func bindParams(sql string, oids []uint32, params [][]byte) (string, error) {
+	res, n, err := replaceParams(sql, func(i int) (string, error) {
+		if i > len(params) {
+			return "", nil
+		}
+		var oid uint32
+		if i <= len(oids) {
+			oid = oids[i-1]
+		}
+		return paramLiteral(oid, params[i-1])
+	})
+	if err != nil {
+		return "", err
+	}
+	if n != len(params) {
+		return "", &Error{Code: "08P01", Message: fmt.Sprintf("bind message supplies %d parameters, but prepared statement requires %d", len(params), n)}
+	}
+	return res, nil
+}
+
+// countParams returns the number of parameters that sql refers to.
+func countParams(sql string) int {
+	_, n, _ := replaceParams(sql, func(int) (string, error) { return "", nil })
+	return n
+}
+
+// replaceParams replaces the $n placeholders in sql with the result of literal.
+// Placeholders in string literals, quoted identifiers and comments are left as-is.
+// It returns the highest placeholder number, which is the number of parameters of the statement.
+func replaceParams(sql string, literal func(i int) (string, error)) (string, int, error) {
+	var sb strings.Builder
+	sb.Grow(len(sql))
+	n := 0
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'':
+			// E'...' strings support backslash escapes
+			escapes := i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e') && (i == 1 || !isIdentifierChar(sql[i-2]))
+			j := skipQuoted(sql, i, c, escapes)
+			sb.WriteString(sql[i:j])
+			i = j
+		case c == '"':
+			j := skipQuoted(sql, i, c, false)
+			sb.WriteString(sql[i:j])
+			i = j
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql)
+			} else {
+				j += i
+			}
+			sb.WriteString(sql[i:j])
+			i = j
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				j = len(sql)
+			} else {
+				j += i + 4
+			}
+			sb.WriteString(sql[i:j])
+			i = j
+		case c == '$' && (i == 0 || !isIdentifierChar(sql[i-1])):
+			j := i + 1
+			for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+				j++
+			}
+			if j > i+1 {
+				idx, err := strconv.Atoi(sql[i+1 : j])
+				if err != nil || idx == 0 {
+					return "", 0, &Error{Code: "42P02", Message: fmt.Sprintf("there is no parameter %s", sql[i:j])}
+				}
+				lit, err := literal(idx)
+				if err != nil {
+					return "", 0, err
+				}
+				n = max(n, idx)
+				sb.WriteString(lit)
+				i = j
+				continue
+			}
+			// Dollar-quoted string like $tag$...$tag$
+			for j < len(sql) && isIdentifierChar(sql[j]) && sql[j] != '$' {
+				j++
+			}
+			if j < len(sql) && sql[j] == '$' {
+				tag := sql[i : j+1]
+				end := strings.Index(sql[j+1:], tag)
+				if end < 0 {
+					end = len(sql)
+				} else {
+					end += j + 1 + len(tag)
+				}
+				sb.WriteString(sql[i:end])
+				i = end
+				continue
+			}
+			sb.WriteByte(c)
+			i++
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), n, nil
+}
+
+// skipQuoted returns the index after the closing quote of the quoted string or identifier starting at i.
+// Quotes are escaped by doubling them, and with backslashes if escapes is true.
+func skipQuoted(sql string, i int, quote byte, escapes bool) int {
+	for j := i + 1; j < len(sql); j++ {
+		switch {
+		case escapes && sql[j] == '\\':
+			j++
+		case sql[j] == quote:
+			if j+1 < len(sql) && sql[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(sql)
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// paramLiteral returns a SQL literal for a text encoded parameter value.
+// Values of numeric and boolean types are validated and inlined, other values are inlined as string literals.
+func paramLiteral(oid uint32, v []byte) (string, error) {
+	if v == nil {
+		return "NULL", nil
+	}
+	s := string(v)
+	switch oid {
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID, pgtype.Float4OID, pgtype.Float8OID, pgtype.NumericOID:
+		s = strings.TrimSpace(s)
+		if !numericRegexp.MatchString(s) {
+			return "", &Error{Code: "22P02", Message: fmt.Sprintf("invalid input syntax for a numeric parameter: %q", s)}
+		}
+		// Parenthesize negative numbers, so they don't form a comment with a preceding minus
+		if strings.HasPrefix(s, "-") {
+			return "(" + s + ")", nil
+		}
+		return s, nil
+	case pgtype.BoolOID:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return "TRUE", nil
+		case "f", "false", "n", "no", "off", "0":
+			return "FALSE", nil
+		default:
+			return "", &Error{Code: "22P02", Message: fmt.Sprintf("invalid input syntax for a boolean parameter: %q", s)}
+		}
+	default:
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'", nil
+	}
+}
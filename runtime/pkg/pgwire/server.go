@@ -0,0 +1,553 @@
+// Package pgwire implements a minimal server for the Postgres wire protocol.
+// It supports the simple and extended query protocols with text encoded parameters and results, which is enough for most BI tools to run read-only queries.
+// Queries on the system catalogs are answered from an emulated catalog of the Handler's tables (see catalogQuery).
+// Binary encoding and COPY are not supported.
+package pgwire
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ServerVersion is the Postgres version reported to clients.
+// Some clients adapt their introspection queries based on it, so it should be a real version.
+const ServerVersion = "14.0"
+
+// Handler executes queries on behalf of the server.
+type Handler interface {
+	// Authenticate validates the client's credentials for the requested database.
+	// It returns a context that is passed to Query for all queries on the connection.
+	Authenticate(ctx context.Context, database, user, password string) (context.Context, error)
+	// Query executes a single SQL statement against the database.
+	Query(ctx context.Context, database, sql string) (*Result, error)
+	// Catalog returns the tables that clients can discover through the pg_catalog and information_schema views of the database.
+	Catalog(ctx context.Context, database string) ([]Table, error)
+}
+
+// Result is the result of a query.
+type Result struct {
+	Columns []Column
+	Rows    [][]any
+}
+
+// Column describes a column in a Result.
+type Column struct {
+	Name string
+	// TypeOID is the Postgres type OID of the column (see the OID constants in github.com/jackc/pgx/v5/pgtype).
+	TypeOID uint32
+}
+
+// Error is an error with a Postgres SQLSTATE code.
+// Errors returned by a Handler that don't wrap an Error are reported with code XX000 (internal_error).
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Options configure a Server.
+type Options struct {
+	Handler Handler
+	Logger  *zap.Logger
+	// RequirePassword makes the server ask clients for a password. If false, Authenticate is called with an empty password.
+	RequirePassword bool
+	// TLSConfig enables TLS for clients that request it. If nil, TLS requests are declined.
+	TLSConfig *tls.Config
+	// AllowInsecureLocalPasswords allows clients connecting from a loopback address to send passwords without TLS.
+	// Passwords are sent in cleartext, so clients connecting from other addresses must always use TLS.
+	AllowInsecureLocalPasswords bool
+}
+
+// Server serves the Postgres wire protocol.
+type Server struct {
+	opts *Options
+}
+
+// NewServer creates a new Server.
+func NewServer(opts *Options) *Server {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	return &Server{opts: opts}
+}
+
+// Serve accepts connections on lis until ctx is cancelled. It closes lis and all open connections before returning.
+func (s *Server) Serve(ctx context.Context, lis net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		nc, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := &conn{
+				opts:    s.opts,
+				nc:      nc,
+				stmts:   make(map[string]*statement),
+				portals: make(map[string]*portal),
+			}
+			err := c.serve(ctx)
+			if err != nil && !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				s.opts.Logger.Debug("postgres connection closed with error", zap.String("remote_addr", nc.RemoteAddr().String()), zap.Error(err))
+			}
+		}()
+	}
+}
+
+// conn is a single client connection.
+type conn struct {
+	opts     *Options
+	nc       net.Conn
+	be       *pgproto3.Backend
+	tls      bool
+	database string
+	stmts    map[string]*statement
+	portals  map[string]*portal
+}
+
+// statement is a prepared statement.
+type statement struct {
+	sql string
+	// paramOIDs are the parameter types specified by the client. They may be zero or omitted for parameters of unspecified type.
+	paramOIDs []uint32
+}
+
+// portal is a bound statement. The result is computed on the first Describe or Execute.
+type portal struct {
+	sql string
+	res *Result
+	// sent is the number of rows of res that have been sent by previous Execute messages.
+	sent int
+}
+
+func (c *conn) serve(ctx context.Context) error {
+	defer c.nc.Close()
+
+	// Close the connection when the server shuts down to unblock reads
+	stop := context.AfterFunc(ctx, func() { c.nc.Close() })
+	defer stop()
+
+	c.be = pgproto3.NewBackend(c.nc, c.nc)
+	ctx, err := c.startup(ctx)
+	if err != nil {
+		return err
+	}
+
+	// After an error in the extended query protocol, messages are discarded until the next Sync.
+	var skipUntilSync bool
+	for {
+		msg, err := c.be.Receive()
+		if err != nil {
+			return err
+		}
+
+		if skipUntilSync {
+			if _, ok := msg.(*pgproto3.Sync); !ok {
+				continue
+			}
+			skipUntilSync = false
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.Query:
+			c.simpleQuery(ctx, msg.String)
+		case *pgproto3.Parse:
+			c.stmts[msg.Name] = &statement{sql: msg.Query, paramOIDs: msg.ParameterOIDs}
+			c.be.Send(&pgproto3.ParseComplete{})
+		case *pgproto3.Bind:
+			err = c.bind(msg)
+		case *pgproto3.Describe:
+			err = c.describe(ctx, msg)
+		case *pgproto3.Execute:
+			err = c.execute(ctx, msg)
+		case *pgproto3.Close:
+			if msg.ObjectType == 'S' {
+				delete(c.stmts, msg.Name)
+			} else {
+				delete(c.portals, msg.Name)
+			}
+			c.be.Send(&pgproto3.CloseComplete{})
+		case *pgproto3.Sync:
+			c.be.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+		case *pgproto3.Flush:
+			// Flushed below
+		case *pgproto3.Terminate:
+			return nil
+		default:
+			err = &Error{Code: "0A000", Message: fmt.Sprintf("unsupported message type %T", msg)}
+		}
+		if err != nil {
+			c.sendError(err)
+			skipUntilSync = true
+		}
+
+		if err := c.be.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// startup handles the startup and authentication phase of the connection.
+func (c *conn) startup(ctx context.Context) (context.Context, error) {
+	var startup *pgproto3.StartupMessage
+	for startup == nil {
+		msg, err := c.be.ReceiveStartupMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.StartupMessage:
+			startup = msg
+		case *pgproto3.SSLRequest:
+			if c.opts.TLSConfig == nil {
+				if _, err := c.nc.Write([]byte("N")); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if _, err := c.nc.Write([]byte("S")); err != nil {
+				return nil, err
+			}
+			c.nc = tls.Server(c.nc, c.opts.TLSConfig)
+			c.be = pgproto3.NewBackend(c.nc, c.nc)
+			c.tls = true
+		case *pgproto3.GSSEncRequest:
+			if _, err := c.nc.Write([]byte("N")); err != nil {
+				return nil, err
+			}
+		case *pgproto3.CancelRequest:
+			// Queries are not cancellable, so we just close the connection
+			return nil, io.EOF
+		default:
+			return nil, fmt.Errorf("unexpected startup message %T", msg)
+		}
+	}
+
+	user := startup.Parameters["user"]
+	c.database = startup.Parameters["database"]
+	if c.database == "" {
+		c.database = user
+	}
+
+	var password string
+	if c.opts.RequirePassword {
+		if !c.tls && !(c.opts.AllowInsecureLocalPasswords && isLoopback(c.nc.RemoteAddr())) {
+			err := &Error{Code: "28000", Message: "password authentication requires a TLS connection (use sslmode=require)"}
+			c.sendError(err)
+			_ = c.be.Flush()
+			return nil, err
+		}
+
+		c.be.Send(&pgproto3.AuthenticationCleartextPassword{})
+		if err := c.be.Flush(); err != nil {
+			return nil, err
+		}
+		if err := c.be.SetAuthType(pgproto3.AuthTypeCleartextPassword); err != nil {
+			return nil, err
+		}
+		msg, err := c.be.Receive()
+		if err != nil {
+			return nil, err
+		}
+		pw, ok := msg.(*pgproto3.PasswordMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected password message, got %T", msg)
+		}
+		password = pw.Password
+	}
+
+	ctx, err := c.opts.Handler.Authenticate(ctx, c.database, user, password)
+	if err != nil {
+		var pgErr *Error
+		if !errors.As(err, &pgErr) {
+			err = &Error{Code: "28P01", Message: err.Error()}
+		}
+		c.sendError(err)
+		_ = c.be.Flush()
+		return nil, err
+	}
+
+	c.be.Send(&pgproto3.AuthenticationOk{})
+	for k, v := range map[string]string{
+		"server_version":              ServerVersion,
+		"server_encoding":             "UTF8",
+		"client_encoding":             "UTF8",
+		"DateStyle":                   "ISO, MDY",
+		"IntervalStyle":               "postgres",
+		"TimeZone":                    "UTC",
+		"integer_datetimes":           "on",
+		"standard_conforming_strings": "on",
+	} {
+		c.be.Send(&pgproto3.ParameterStatus{Name: k, Value: v})
+	}
+	c.be.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	return ctx, c.be.Flush()
+}
+
+// simpleQuery handles a query sent with the simple query protocol.
+func (c *conn) simpleQuery(ctx context.Context, sql string) {
+	defer c.be.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+
+	sql = trimStatement(sql)
+	if sql == "" {
+		c.be.Send(&pgproto3.EmptyQueryResponse{})
+		return
+	}
+
+	if tag, ok := sessionCommandTag(sql); ok {
+		c.be.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+		return
+	}
+
+	res, err := c.query(ctx, sql)
+	if err != nil {
+		c.sendError(err)
+		return
+	}
+	c.be.Send(rowDescription(res))
+	c.sendRows(res, res.Rows)
+	c.be.Send(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("SELECT %d", len(res.Rows)))})
+}
+
+// bind handles a Bind message of the extended query protocol.
+// Parameters are substituted into the statement as SQL literals.
+func (c *conn) bind(msg *pgproto3.Bind) error {
+	stmt, ok := c.stmts[msg.PreparedStatement]
+	if !ok {
+		return &Error{Code: "26000", Message: fmt.Sprintf("prepared statement %q does not exist", msg.PreparedStatement)}
+	}
+	for _, f := range msg.ParameterFormatCodes {
+		if f != pgproto3.TextFormat {
+			return &Error{Code: "0A000", Message: "binary parameter format is not supported"}
+		}
+	}
+	for _, f := range msg.ResultFormatCodes {
+		if f != pgproto3.TextFormat {
+			return &Error{Code: "0A000", Message: "binary result format is not supported"}
+		}
+	}
+
+	sql, err := bindParams(trimStatement(stmt.sql), stmt.paramOIDs, msg.Parameters)
+	if err != nil {
+		return err
+	}
+
+	c.portals[msg.DestinationPortal] = &portal{sql: sql}
+	c.be.Send(&pgproto3.BindComplete{})
+	return nil
+}
+
+// describe handles a Describe message of the extended query protocol.
+// Since the result schema is only known after running the query, it runs the query and caches the result for Execute.
+// Statements are run with NULL for all parameters to find their result schema.
+func (c *conn) describe(ctx context.Context, msg *pgproto3.Describe) error {
+	var p *portal
+	if msg.ObjectType == 'S' {
+		stmt, ok := c.stmts[msg.Name]
+		if !ok {
+			return &Error{Code: "26000", Message: fmt.Sprintf("prepared statement %q does not exist", msg.Name)}
+		}
+		sql := trimStatement(stmt.sql)
+		n := countParams(sql)
+		oids := make([]uint32, n)
+		for i := range oids {
+			oids[i] = pgtype.TextOID
+			if i < len(stmt.paramOIDs) && stmt.paramOIDs[i] != 0 {
+				oids[i] = stmt.paramOIDs[i]
+			}
+		}
+		sql, err := bindParams(sql, nil, make([][]byte, n))
+		if err != nil {
+			return err
+		}
+		p = &portal{sql: sql}
+		c.be.Send(&pgproto3.ParameterDescription{ParameterOIDs: oids})
+	} else {
+		var ok bool
+		p, ok = c.portals[msg.Name]
+		if !ok {
+			return &Error{Code: "34000", Message: fmt.Sprintf("portal %q does not exist", msg.Name)}
+		}
+	}
+
+	if _, ok := sessionCommandTag(p.sql); ok || p.sql == "" {
+		c.be.Send(&pgproto3.NoData{})
+		return nil
+	}
+
+	if p.res == nil {
+		res, err := c.query(ctx, p.sql)
+		if err != nil {
+			return err
+		}
+		p.res = res
+	}
+	c.be.Send(rowDescription(p.res))
+	return nil
+}
+
+// execute handles an Execute message of the extended query protocol.
+// If the message has a row limit and more rows remain, the portal is suspended and the next Execute continues where it left off.
+func (c *conn) execute(ctx context.Context, msg *pgproto3.Execute) error {
+	p, ok := c.portals[msg.Portal]
+	if !ok {
+		return &Error{Code: "34000", Message: fmt.Sprintf("portal %q does not exist", msg.Portal)}
+	}
+
+	if p.sql == "" {
+		c.be.Send(&pgproto3.EmptyQueryResponse{})
+		return nil
+	}
+	if tag, ok := sessionCommandTag(p.sql); ok {
+		c.be.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+		return nil
+	}
+
+	if p.res == nil {
+		res, err := c.query(ctx, p.sql)
+		if err != nil {
+			return err
+		}
+		p.res = res
+	}
+
+	rows := p.res.Rows[p.sent:]
+	if msg.MaxRows > 0 && len(rows) > int(msg.MaxRows) {
+		rows = rows[:msg.MaxRows]
+		c.sendRows(p.res, rows)
+		p.sent += len(rows)
+		c.be.Send(&pgproto3.PortalSuspended{})
+		return nil
+	}
+	c.sendRows(p.res, rows)
+	p.sent += len(rows)
+	c.be.Send(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("SELECT %d", len(rows)))})
+	return nil
+}
+
+// query runs a statement against the emulated system catalogs if it references them, and with the Handler otherwise.
+func (c *conn) query(ctx context.Context, sql string) (*Result, error) {
+	if isCatalogQuery(sql) {
+		return c.catalogQuery(ctx, sql)
+	}
+	return c.opts.Handler.Query(ctx, c.database, sql)
+}
+
+// sendRows sends rows of res as DataRow messages.
+func (c *conn) sendRows(res *Result, rows [][]any) {
+	for _, row := range rows {
+		vals := make([][]byte, len(row))
+		for i, v := range row {
+			vals[i] = encodeText(res.Columns[i].TypeOID, v)
+		}
+		c.be.Send(&pgproto3.DataRow{Values: vals})
+	}
+}
+
+// sendError sends an ErrorResponse for err.
+func (c *conn) sendError(err error) {
+	code := "XX000"
+	var pgErr *Error
+	if errors.As(err, &pgErr) {
+		code = pgErr.Code
+	}
+	severity := "ERROR"
+	if code == "28P01" || code == "28000" || code == "3D000" {
+		severity = "FATAL"
+	}
+	c.be.Send(&pgproto3.ErrorResponse{Severity: severity, Code: code, Message: err.Error()})
+}
+
+// isLoopback returns true if addr is a loopback address.
+func isLoopback(addr net.Addr) bool {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// rowDescription builds a RowDescription message for the columns of res.
+func rowDescription(res *Result) *pgproto3.RowDescription {
+	fields := make([]pgproto3.FieldDescription, len(res.Columns))
+	for i, col := range res.Columns {
+		fields[i] = pgproto3.FieldDescription{
+			Name:         []byte(col.Name),
+			DataTypeOID:  col.TypeOID,
+			DataTypeSize: -1,
+			TypeModifier: -1,
+			Format:       pgproto3.TextFormat,
+		}
+	}
+	return &pgproto3.RowDescription{Fields: fields}
+}
+
+// trimStatement removes leading comments, whitespace and trailing semicolons from a SQL statement.
+// Clients like pgx check connections with comment-only statements, which should be treated as empty.
+func trimStatement(sql string) string {
+	for {
+		sql = strings.TrimSpace(sql)
+		if strings.HasPrefix(sql, "--") {
+			_, rest, _ := strings.Cut(sql, "\n")
+			sql = rest
+		} else if strings.HasPrefix(sql, "/*") {
+			_, rest, found := strings.Cut(sql, "*/")
+			if !found {
+				return ""
+			}
+			sql = rest
+		} else {
+			break
+		}
+	}
+	return strings.TrimSpace(strings.TrimRight(sql, "; \t\r\n"))
+}
+
+// sessionCommandTag returns a command tag if the statement is a session or transaction command that is accepted as a no-op.
+// Clients commonly issue such commands when connecting, so rejecting them would break the connection.
+func sessionCommandTag(sql string) (string, bool) {
+	keyword, _, _ := strings.Cut(sql, " ")
+	keyword = strings.ToUpper(keyword)
+	switch keyword {
+	case "SET", "RESET", "DISCARD", "DEALLOCATE":
+		return keyword, true
+	case "BEGIN", "START":
+		return "BEGIN", true
+	case "COMMIT", "END":
+		return "COMMIT", true
+	case "ROLLBACK", "ABORT":
+		return "ROLLBACK", true
+	default:
+		return "", false
+	}
+}
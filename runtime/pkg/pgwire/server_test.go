@@ -0,0 +1,257 @@
+package pgwire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+type testHandler struct{}
+
+type userKey struct{}
+
+func (h testHandler) Authenticate(ctx context.Context, database, user, password string) (context.Context, error) {
+	if database != "db" {
+		return nil, &Error{Code: "3D000", Message: fmt.Sprintf("database %q does not exist", database)}
+	}
+	if password != "secret" {
+		return nil, errors.New("invalid password")
+	}
+	return context.WithValue(ctx, userKey{}, user), nil
+}
+
+func (h testHandler) Query(ctx context.Context, database, sql string) (*Result, error) {
+	if sql == "SELECT n FROM mv WHERE user = 'it''s' AND n > (-1)" {
+		return &Result{Columns: []Column{{Name: "n", TypeOID: pgtype.Int8OID}}, Rows: [][]any{{int64(1)}}}, nil
+	}
+	if sql != "SELECT * FROM mv" {
+		return nil, &Error{Code: "42601", Message: "unsupported query"}
+	}
+	return &Result{
+		Columns: []Column{
+			{Name: "user", TypeOID: pgtype.TextOID},
+			{Name: "n", TypeOID: pgtype.Int8OID},
+			{Name: "f", TypeOID: pgtype.Float8OID},
+			{Name: "ok", TypeOID: pgtype.BoolOID},
+			{Name: "ts", TypeOID: pgtype.TimestamptzOID},
+		},
+		Rows: [][]any{
+			{ctx.Value(userKey{}), int64(1), 1.5, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+			{nil, int64(2), nil, false, nil},
+		},
+	}, nil
+}
+
+func (h testHandler) Catalog(ctx context.Context, database string) ([]Table, error) {
+	return []Table{{
+		Name: "mv",
+		Columns: []Column{
+			{Name: "user", TypeOID: pgtype.TextOID},
+			{Name: "n", TypeOID: pgtype.Int8OID},
+			{Name: "ts", TypeOID: pgtype.TimestamptzOID},
+		},
+	}}, nil
+}
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := NewServer(&Options{Handler: testHandler{}, RequirePassword: true, AllowInsecureLocalPasswords: true})
+	done := make(chan error)
+	go func() { done <- srv.Serve(ctx, lis) }()
+
+	dsn := fmt.Sprintf("postgres://jane:secret@%s/db?sslmode=disable&default_query_exec_mode=simple_protocol", lis.Addr())
+	conn, err := pgx.Connect(ctx, dsn)
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	// Session commands are accepted
+	_, err = conn.Exec(ctx, "SET application_name = 'test';")
+	require.NoError(t, err)
+
+	// Simple query protocol
+	rows, err := conn.Query(ctx, "SELECT * FROM mv;")
+	require.NoError(t, err)
+	var res [][]any
+	for rows.Next() {
+		vals, err := rows.Values()
+		require.NoError(t, err)
+		if ts, ok := vals[4].(time.Time); ok {
+			vals[4] = ts.UTC()
+		}
+		res = append(res, vals)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, [][]any{
+		{"jane", int64(1), 1.5, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{nil, int64(2), nil, false, nil},
+	}, res)
+
+	// Extended query protocol with text results
+	rr := conn.PgConn().ExecParams(ctx, "SELECT * FROM mv", nil, nil, nil, nil).Read()
+	require.NoError(t, rr.Err)
+	require.Len(t, rr.Rows, 2)
+	require.Equal(t, "2024-01-02 03:04:05Z", string(rr.Rows[0][4]))
+	require.Equal(t, "SELECT 2", rr.CommandTag.String())
+	var pgErr *pgconn.PgError
+
+	// Text encoded parameters are substituted into the query
+	rr = conn.PgConn().ExecParams(ctx, "SELECT n FROM mv WHERE user = $1 AND n > $2", [][]byte{[]byte("it's"), []byte("-1")}, []uint32{0, pgtype.Int8OID}, nil, nil).Read()
+	require.NoError(t, rr.Err)
+	require.Equal(t, [][][]byte{{[]byte("1")}}, rr.Rows)
+	rr = conn.PgConn().ExecParams(ctx, "SELECT n FROM mv WHERE n > $1", [][]byte{[]byte("1; DROP TABLE mv")}, []uint32{pgtype.Int8OID}, nil, nil).Read()
+	require.ErrorAs(t, rr.Err, &pgErr)
+	require.Equal(t, "22P02", pgErr.Code)
+
+	// Catalog queries expose the handler's tables
+	rows, err = conn.Query(ctx, "SELECT table_schema, table_name, column_name FROM information_schema.columns WHERE table_catalog = current_database() ORDER BY ordinal_position")
+	require.NoError(t, err)
+	res = nil
+	for rows.Next() {
+		vals, err := rows.Values()
+		require.NoError(t, err)
+		res = append(res, vals)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, [][]any{
+		{"public", "mv", "user"},
+		{"public", "mv", "n"},
+		{"public", "mv", "ts"},
+	}, res)
+
+	// Catalog queries can't access files
+	_, err = conn.Exec(ctx, "SELECT * FROM read_csv('/etc/passwd'), pg_catalog.pg_class")
+	require.Error(t, err)
+
+	// Errors are reported with their code and the connection stays usable
+	_, err = conn.Exec(ctx, "SELECT 1")
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, "42601", pgErr.Code)
+	require.NoError(t, conn.Ping(ctx))
+
+	// Authentication errors
+	_, err = pgx.Connect(ctx, fmt.Sprintf("postgres://jane:wrong@%s/db?sslmode=disable", lis.Addr()))
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, "28P01", pgErr.Code)
+	_, err = pgx.Connect(ctx, fmt.Sprintf("postgres://jane:secret@%s/other?sslmode=disable", lis.Addr()))
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, "3D000", pgErr.Code)
+
+	require.NoError(t, conn.Close(ctx))
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestServerRequiresTLSForPasswords(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := NewServer(&Options{Handler: testHandler{}, RequirePassword: true})
+	done := make(chan error)
+	go func() { done <- srv.Serve(ctx, lis) }()
+
+	_, err = pgx.Connect(ctx, fmt.Sprintf("postgres://jane:secret@%s/db?sslmode=disable", lis.Addr()))
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, "28000", pgErr.Code)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestServerExecuteMaxRows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := NewServer(&Options{Handler: testHandler{}, RequirePassword: true, AllowInsecureLocalPasswords: true})
+	done := make(chan error)
+	go func() { done <- srv.Serve(ctx, lis) }()
+
+	nc, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer nc.Close()
+	fe := pgproto3.NewFrontend(nc, nc)
+
+	// receiveUntil discards messages up to and including a message of the same type as last
+	receiveUntil := func(last pgproto3.BackendMessage) {
+		for {
+			msg, err := fe.Receive()
+			require.NoError(t, err)
+			if fmt.Sprintf("%T", msg) == fmt.Sprintf("%T", last) {
+				return
+			}
+		}
+	}
+
+	fe.Send(&pgproto3.StartupMessage{ProtocolVersion: pgproto3.ProtocolVersionNumber, Parameters: map[string]string{"user": "jane", "database": "db"}})
+	require.NoError(t, fe.Flush())
+	receiveUntil(&pgproto3.AuthenticationCleartextPassword{})
+	fe.Send(&pgproto3.PasswordMessage{Password: "secret"})
+	require.NoError(t, fe.Flush())
+	receiveUntil(&pgproto3.ReadyForQuery{})
+
+	// The portal is suspended after each row until all rows have been sent
+	fe.Send(&pgproto3.Parse{Query: "SELECT * FROM mv"})
+	fe.Send(&pgproto3.Bind{})
+	fe.Send(&pgproto3.Execute{MaxRows: 1})
+	fe.Send(&pgproto3.Execute{MaxRows: 1})
+	fe.Send(&pgproto3.Execute{MaxRows: 1})
+	fe.Send(&pgproto3.Sync{})
+	require.NoError(t, fe.Flush())
+
+	var got []string
+	for done := false; !done; {
+		msg, err := fe.Receive()
+		require.NoError(t, err)
+		switch msg := msg.(type) {
+		case *pgproto3.DataRow:
+			got = append(got, "row "+string(msg.Values[1]))
+		case *pgproto3.PortalSuspended:
+			got = append(got, "suspended")
+		case *pgproto3.CommandComplete:
+			got = append(got, string(msg.CommandTag))
+		case *pgproto3.ReadyForQuery:
+			done = true
+		}
+	}
+	require.Equal(t, []string{"row 1", "suspended", "row 2", "SELECT 1", "SELECT 0"}, got)
+
+	nc.Close()
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestReplaceParams(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+		n    int
+	}{
+		{sql: "SELECT * FROM mv WHERE a = $1 AND b = $2", want: "SELECT * FROM mv WHERE a = <1> AND b = <2>", n: 2},
+		{sql: "SELECT '$1', \"$1\", E'\\'$1' FROM mv WHERE a=$1", want: "SELECT '$1', \"$1\", E'\\'$1' FROM mv WHERE a=<1>", n: 1},
+		{sql: "SELECT $$ $1 $$, $tag$ $2 $tag$ -- $3\n/* $4 */ FROM mv", want: "SELECT $$ $1 $$, $tag$ $2 $tag$ -- $3\n/* $4 */ FROM mv", n: 0},
+		{sql: "SELECT a$1 FROM mv LIMIT $3", want: "SELECT a$1 FROM mv LIMIT <3>", n: 3},
+	}
+	for _, tt := range tests {
+		got, n, err := replaceParams(tt.sql, func(i int) (string, error) { return fmt.Sprintf("<%d>", i), nil })
+		require.NoError(t, err, tt.sql)
+		require.Equal(t, tt.want, got, tt.sql)
+		require.Equal(t, tt.n, n, tt.sql)
+	}
+}
@@ -3,9 +3,11 @@ package queries
 import (
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,18 +15,23 @@ import (
 
 	"github.com/apache/arrow/go/v14/arrow"
 	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
 	"github.com/apache/arrow/go/v14/arrow/memory"
 	"github.com/apache/arrow/go/v14/parquet/pqarrow"
 	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
+	"github.com/rilldata/rill/runtime/pkg/avroutil"
 	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/xuri/excelize/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -115,10 +122,14 @@ func checkFieldAccess(field string, policy *runtime.ResolvedMetricsViewSecurity)
 	return true
 }
 
-// resolveMeasures returns the selected measures
-func resolveMeasures(mv *runtimev1.MetricsViewSpec, inlines []*runtimev1.InlineMeasure, selectedNames []string) ([]*runtimev1.MetricsViewSpec_MeasureV2, error) {
+// resolveMeasures returns the selected measures.
+// Measures that reference other measures are returned with their compiled expression.
+// The window is the query's grouping by time (see metricsview.Window), and it should be nil if the query is not grouped by time.
+// The returned args are the values for the placeholders in the measures' expressions, in order.
+func resolveMeasures(mv *runtimev1.MetricsViewSpec, inlines []*runtimev1.InlineMeasure, selectedNames []string, dialect drivers.Dialect, window *metricsview.Window) ([]*runtimev1.MetricsViewSpec_MeasureV2, []any, error) {
 	// Build combined measures
 	ms := make([]*runtimev1.MetricsViewSpec_MeasureV2, len(selectedNames))
+	var args []any
 	for i, n := range selectedNames {
 		found := false
 		// Search in the inlines (take precedence)
@@ -143,12 +154,21 @@ func resolveMeasures(mv *runtimev1.MetricsViewSpec, inlines []*runtimev1.InlineM
 				break
 			}
 		}
+		if found && len(ms[i].Requires) > 0 {
+			expr, exprArgs, err := metricsview.MeasureExpression(mv, ms[i], dialect, window)
+			if err != nil {
+				return nil, nil, err
+			}
+			args = append(args, exprArgs...)
+			ms[i] = proto.Clone(ms[i]).(*runtimev1.MetricsViewSpec_MeasureV2)
+			ms[i].Expression = expr
+		}
 		if !found {
-			return nil, fmt.Errorf("measure does not exist: '%s'", n)
+			return nil, nil, fmt.Errorf("measure does not exist: '%s'", n)
 		}
 	}
 
-	return ms, nil
+	return ms, args, nil
 }
 
 func metricsQuery(ctx context.Context, olap drivers.OLAPStore, priority int, sql string, args []any) ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
@@ -287,6 +307,10 @@ func dimensionSelect(table string, dim *runtimev1.MetricsViewSpec_DimensionV2, d
 
 	unnestColName := safeName(tempName(fmt.Sprintf("%s_%s_", "unnested", dim.Name)))
 	sel := fmt.Sprintf(`%s as %s`, unnestColName, colName)
+	if dim.Join != "" {
+		// select "unnested_colName" as "colName" ... FROM (...) "mv_table", LATERAL UNNEST("mv_table"."__rill_join_colName") tbl("unnested_colName") ...
+		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) tbl(%s)`, safeName(table), metricsViewDimensionExpression(dim), unnestColName)
+	}
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl("unnested_colName") ...
 		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) tbl(%s)`, safeName(table), colName, unnestColName)
@@ -295,6 +319,13 @@ func dimensionSelect(table string, dim *runtimev1.MetricsViewSpec_DimensionV2, d
 	return sel, fmt.Sprintf(`, LATERAL UNNEST(%s) tbl(%s)`, dim.Expression, unnestColName)
 }
 
+// metricsViewRelation returns the relation to use in the FROM clause of a query against the metrics view.
+// It only joins the tables needed by the dimensions in dims and the identifiers in exprs (see metricsview.Relation).
+func metricsViewRelation(mv *runtimev1.MetricsViewSpec, dims []string, exprs ...*runtimev1.Expression) string {
+	// Identifiers are escaped the same way in all dialects
+	return metricsview.Relation(mv, drivers.DialectUnspecified, dims, exprs...)
+}
+
 func buildExpression(mv *runtimev1.MetricsViewSpec, expr *runtimev1.Expression, aliases []*runtimev1.MetricsViewComparisonMeasureAlias, dialect drivers.Dialect) (string, []any, error) {
 	if expr == nil {
 		return "", nil, nil
@@ -630,7 +661,56 @@ func metricsViewDimension(mv *runtimev1.MetricsViewSpec, dimName string) (*runti
 	return nil, fmt.Errorf("dimension %s not found", dimName)
 }
 
+// metricsViewWithTimeDimension returns a copy of the metrics view that uses the column of the named dimension as its time dimension.
+// It returns the metrics view as is if the name is empty or matches the default time dimension.
+// The information schema is used to check that the dimension's column is a timestamp or date.
+func metricsViewWithTimeDimension(ctx context.Context, is drivers.InformationSchema, mv *runtimev1.MetricsViewSpec, name string, policy *runtime.ResolvedMetricsViewSecurity) (*runtimev1.MetricsViewSpec, error) {
+	if name == "" || strings.EqualFold(name, mv.TimeDimension) {
+		return mv, nil
+	}
+
+	if !checkFieldAccess(name, policy) {
+		return nil, ErrForbidden
+	}
+
+	dim, err := metricsViewDimension(mv, name)
+	if err != nil {
+		return nil, err
+	}
+	if dim.Column == "" || dim.Join != "" {
+		return nil, fmt.Errorf("dimension %q cannot be used as a time dimension: only dimensions that reference a column of %q are supported", name, mv.Table)
+	}
+
+	t, err := is.Lookup(ctx, mv.Table)
+	if err != nil {
+		return nil, fmt.Errorf("could not find table %q: %w", mv.Table, err)
+	}
+	var field *runtimev1.StructType_Field
+	for _, f := range t.Schema.Fields {
+		if strings.EqualFold(f.Name, dim.Column) {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return nil, fmt.Errorf("dimension %q cannot be used as a time dimension: column %q not found in table %q", name, dim.Column, mv.Table)
+	}
+	if field.Type.Code != runtimev1.Type_CODE_TIMESTAMP && field.Type.Code != runtimev1.Type_CODE_DATE {
+		return nil, fmt.Errorf("dimension %q cannot be used as a time dimension: column %q is not a TIMESTAMP column", name, dim.Column)
+	}
+
+	mv = proto.Clone(mv).(*runtimev1.MetricsViewSpec)
+	mv.TimeDimension = dim.Column
+	// The watermark expression is specific to the default time dimension
+	mv.WatermarkExpression = ""
+	return mv, nil
+}
+
 func metricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_DimensionV2) string {
+	if dimension.Join != "" {
+		// Joined dimensions are exposed as a column by metricsViewRelation
+		return metricsview.JoinedDimensionColumn(drivers.DialectUnspecified, dimension)
+	}
 	if dimension.Expression != "" {
 		return dimension.Expression
 	}
@@ -642,13 +722,15 @@ func metricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_Dimensi
 	return safeName(dimension.Name)
 }
 
-func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string) (string, error) {
+// metricsViewMeasureExpression returns the SQL expression for a measure (see metricsview.MeasureExpression).
+// The window is only needed for queries grouped by time, and it may be nil.
+func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string, dialect drivers.Dialect, window *metricsview.Window) (string, []any, error) {
 	for _, measure := range mv.Measures {
 		if strings.EqualFold(measure.Name, measureName) {
-			return measure.Expression, nil
+			return metricsview.MeasureExpression(mv, measure, dialect, window)
 		}
 	}
-	return "", fmt.Errorf("measure %s not found", measureName)
+	return "", nil, fmt.Errorf("measure %s not found", measureName)
 }
 
 func WriteCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
@@ -740,6 +822,139 @@ func WriteXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, wri
 }
 
 func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := toArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	parquetwriter, err := pqarrow.NewFileWriter(rec.Schema(), ioWriter, nil, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return err
+	}
+
+	defer parquetwriter.Close()
+
+	err = parquetwriter.Write(rec)
+	return err
+}
+
+// WriteArrow writes the data to ioWriter in the Arrow IPC streaming format.
+func WriteArrow(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := toArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	w := ipc.NewWriter(ioWriter, ipc.WithSchema(rec.Schema()))
+	err = w.Write(rec)
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// WriteJSONL writes the data to ioWriter as newline-delimited JSON, with one object per row.
+// The keys of each object are ordered to match the columns in meta.
+func WriteJSONL(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	names := make([]string, len(meta))
+	for i, f := range meta {
+		names[i] = f.Name
+	}
+
+	enc, err := newJSONLEncoder(names)
+	if err != nil {
+		return err
+	}
+
+	row := make([]any, len(meta))
+	for _, s := range data {
+		for i, f := range meta {
+			row[i] = nil
+			if pbvalue, ok := s.Fields[f.Name]; ok {
+				row[i] = pbvalue.AsInterface()
+			}
+		}
+
+		err := enc.encode(ioWriter, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonlEncoder writes rows as JSON objects on separate lines, with the keys of each object in a fixed order.
+type jsonlEncoder struct {
+	keys [][]byte
+	buf  []byte
+}
+
+// newJSONLEncoder returns an encoder for rows with the given column names.
+func newJSONLEncoder(names []string) (*jsonlEncoder, error) {
+	keys := make([][]byte, len(names))
+	for i, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return &jsonlEncoder{keys: keys}, nil
+}
+
+// encode writes a row to w. The values in row must be in the same order as the encoder's column names.
+func (e *jsonlEncoder) encode(w io.Writer, row []any) error {
+	e.buf = append(e.buf[:0], '{')
+	for i, key := range e.keys {
+		if i > 0 {
+			e.buf = append(e.buf, ',')
+		}
+		e.buf = append(e.buf, key...)
+		e.buf = append(e.buf, ':')
+
+		val, err := json.Marshal(row[i])
+		if err != nil {
+			return err
+		}
+		e.buf = append(e.buf, val...)
+	}
+	e.buf = append(e.buf, '}', '\n')
+
+	_, err := w.Write(e.buf)
+	return err
+}
+
+// WriteAvro writes the data to ioWriter as an Avro object container file.
+func WriteAvro(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	schema := &runtimev1.StructType{Fields: make([]*runtimev1.StructType_Field, len(meta))}
+	for i, f := range meta {
+		schema.Fields[i] = &runtimev1.StructType_Field{
+			Name: f.Name,
+			Type: &runtimev1.Type{Code: runtimev1.Type_Code(runtimev1.Type_Code_value[f.Type])},
+		}
+	}
+
+	w := avroutil.NewWriter(ioWriter, schema)
+	row := make([]any, len(meta))
+	for _, s := range data {
+		for i, f := range meta {
+			row[i] = nil
+			if pbvalue, ok := s.Fields[f.Name]; ok {
+				row[i] = pbvalue.AsInterface()
+			}
+		}
+		err := w.Append(row)
+		if err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func toArrowRecord(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct) (arrow.Record, error) {
 	fields := make([]arrow.Field, 0, len(meta))
 	for _, f := range meta {
 		arrowField := arrow.Field{}
@@ -820,14 +1035,14 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
 				tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.TimestampBuilder).Append(tmp)
 			case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
 				bts, err := protojson.Marshal(v)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.StringBuilder).Append(string(bts))
@@ -835,16 +1050,30 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 		}
 	}
 
-	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
+	return recordBuilder.NewRecord(), nil
+}
 
-	defer parquetwriter.Close()
+// supportsDuckDBExport returns true if DuckDBExport can export the result of a SQL query directly to the format.
+func supportsDuckDBExport(format runtimev1.ExportFormat) bool {
+	switch format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV, runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, runtimev1.ExportFormat_EXPORT_FORMAT_ARROW, runtimev1.ExportFormat_EXPORT_FORMAT_JSONL, runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return true
+	default:
+		return false
+	}
+}
 
-	rec := recordBuilder.NewRecord()
-	err = parquetwriter.Write(rec)
-	return err
+// DuckDBExport exports the result of a SQL query in DuckDB to a format supported by supportsDuckDBExport.
+// DuckDB's COPY doesn't support the Arrow IPC and Avro formats, so the rows are streamed directly for those.
+func DuckDBExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, exportFormat runtimev1.ExportFormat) error {
+	switch exportFormat {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return ArrowStreamExport(ctx, w, opts, sql, args, filename, olap)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return AvroStreamExport(ctx, w, opts, sql, args, filename, olap)
+	default:
+		return DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, exportFormat)
+	}
 }
 
 func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, exportFormat runtimev1.ExportFormat) error {
@@ -854,6 +1083,8 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 		extension = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
 		extension = "csv"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		extension = "jsonl"
 	}
 
 	tmpPath := fmt.Sprintf("export_%s.%s", uuid.New().String(), extension)
@@ -861,8 +1092,11 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 	defer os.Remove(tmpPath)
 
 	sql = fmt.Sprintf("COPY (%s) TO '%s'", sql, tmpPath)
-	if extension == "csv" {
+	switch extension {
+	case "csv":
 		sql += " (FORMAT CSV, HEADER)"
+	case "jsonl":
+		sql += " (FORMAT JSON)"
 	}
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -893,6 +1127,94 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 	return err
 }
 
+// ArrowStreamExport executes the query and streams the result to w in the Arrow IPC streaming format.
+// Unlike the other export formats, the rows are converted directly to Arrow record batches without buffering the full result.
+func ArrowStreamExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore) error {
+	return streamExport(ctx, w, opts, sql, args, filename, olap, func(w io.Writer, schema *runtimev1.StructType, rows *drivers.Result) error {
+		return arrowutil.WriteRows(w, schema, rows)
+	})
+}
+
+// AvroStreamExport executes the query and streams the result to w as an Avro object container file.
+// Like ArrowStreamExport, the rows are converted directly to Avro blocks without buffering the full result.
+func AvroStreamExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore) error {
+	return streamExport(ctx, w, opts, sql, args, filename, olap, func(w io.Writer, schema *runtimev1.StructType, rows *drivers.Result) error {
+		return avroutil.WriteRows(w, schema, rows)
+	})
+}
+
+// JSONLStreamExport executes the query and streams the result to w as newline-delimited JSON.
+// The rows are written as they are read from the driver. If w implements http.Flusher, it is flushed periodically so clients receive the rows in chunks.
+func JSONLStreamExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore) error {
+	return streamExport(ctx, w, opts, sql, args, filename, olap, writeJSONLRows)
+}
+
+// jsonlFlushInterval is the number of rows written between flushes in JSONLStreamExport.
+const jsonlFlushInterval = 1000
+
+// writeJSONLRows writes rows as newline-delimited JSON with the keys of each object ordered to match the schema.
+func writeJSONLRows(w io.Writer, schema *runtimev1.StructType, rows *drivers.Result) error {
+	names := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		names[i] = f.Name
+	}
+
+	enc, err := newJSONLEncoder(names)
+	if err != nil {
+		return err
+	}
+
+	flusher, _ := w.(http.Flusher)
+	var n int
+	for rows.Next() {
+		row, err := rows.SliceScan()
+		if err != nil {
+			return err
+		}
+
+		err = enc.encode(w, row)
+		if err != nil {
+			return err
+		}
+
+		n++
+		if flusher != nil && n%jsonlFlushInterval == 0 {
+			flusher.Flush()
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if flusher != nil {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// streamExport executes the query and passes the resulting rows to write.
+func streamExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, write func(w io.Writer, schema *runtimev1.StructType, rows *drivers.Result) error) error {
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         opts.Priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if opts.PreWriteHook != nil {
+		err = opts.PreWriteHook(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return write(w, rows.Schema, rows)
+}
+
 func (q *MetricsViewRows) generateFilename(mv *runtimev1.MetricsViewSpec) string {
 	filename := strings.ReplaceAll(mv.Table, `"`, `_`)
 	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	duckdbolap "github.com/rilldata/rill/runtime/drivers/duckdb"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -26,6 +27,7 @@ type MetricsViewAggregation struct {
 	Measures           []*runtimev1.MetricsViewAggregationMeasure   `json:"measures,omitempty"`
 	Sort               []*runtimev1.MetricsViewAggregationSort      `json:"sort,omitempty"`
 	TimeRange          *runtimev1.TimeRange                         `json:"time_range,omitempty"`
+	TimeDimension      string                                       `json:"time_dimension,omitempty"`
 	Where              *runtimev1.Expression                        `json:"where,omitempty"`
 	Having             *runtimev1.Expression                        `json:"having,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter                 `json:"filter,omitempty"` // Backwards compatibility
@@ -87,6 +89,11 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 	}
 	defer release()
 
+	mv, err = metricsViewWithTimeDimension(ctx, olap.InformationSchema(), mv, q.TimeDimension, security)
+	if err != nil {
+		return err
+	}
+
 	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -453,6 +460,12 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 		return WriteXLSX(meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(meta, q.Result.Data, w)
 	}
 
 	return nil
@@ -485,6 +498,9 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 	groupCols := make([]string, 0, len(q.Dimensions))
 	unnestClauses := make([]string, 0)
 	var selectArgs []any
+	// Measures computed with window functions are ordered by the first time dimension and partitioned by the other dimensions
+	window := &metricsview.Window{}
+	var unnested bool
 	for _, d := range q.Dimensions {
 		// Handle regular dimensions
 		if d.TimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
@@ -496,8 +512,10 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 			selectCols = append(selectCols, dimSel)
 			if unnestClause != "" {
 				unnestClauses = append(unnestClauses, unnestClause)
+				unnested = true
 			}
 			groupCols = append(groupCols, fmt.Sprintf("%d", len(selectCols)))
+			window.PartitionBy = append(window.PartitionBy, metricsViewDimensionExpression(dim))
 			continue
 		}
 
@@ -517,16 +535,29 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		// Note that the non-timestamp columns also use the numbered group-by for constancy.
 		groupCols = append(groupCols, fmt.Sprintf("%d", len(selectCols)))
 		selectArgs = append(selectArgs, exprArgs...)
+		if window.OrderBy == "" {
+			window.OrderBy = expr
+			window.OrderByArgs = exprArgs
+			window.Grain = d.TimeGrain
+		} else {
+			window.PartitionBy = append(window.PartitionBy, expr)
+			window.PartitionByArgs = append(window.PartitionByArgs, exprArgs...)
+		}
+	}
+	if window.OrderBy == "" || unnested {
+		// Running sums and previous period values are not supported for queries that are not grouped by time or that unnest dimensions
+		window = nil
 	}
 
 	for _, m := range q.Measures {
 		sn := safeName(m.Name)
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
-			expr, err := metricsViewMeasureExpression(mv, m.Name)
+			expr, exprArgs, err := metricsViewMeasureExpression(mv, m.Name, dialect, window)
 			if err != nil {
 				return "", nil, err
 			}
+			selectArgs = append(selectArgs, exprArgs...)
 
 			selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, sn))
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT:
@@ -593,6 +624,17 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		}
 	}
 
+	// Only join the tables needed by the referenced dimensions
+	dims := make([]string, 0, len(q.Dimensions))
+	for _, d := range q.Dimensions {
+		dims = append(dims, d.Name)
+	}
+	exprs := []*runtimev1.Expression{q.Where, q.Having}
+	for _, m := range q.Measures {
+		exprs = append(exprs, m.Filter)
+	}
+	from := metricsViewRelation(mv, dims, exprs...)
+
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
 		sortCriterion := safeName(s.Name)
@@ -634,7 +676,7 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		// SELECT m1, m2, d1, d2 FROM t, LATERAL UNNEST(t.d1) tbl(unnested_d1_) WHERE d1 = 'a' GROUP BY d1, d2
 		sql = fmt.Sprintf("SELECT %[1]s FROM %[2]s %[3]s %[4]s %[5]s %[6]s %[7]s %[8]s",
 			strings.Join(selectCols, ", "),  // 1
-			safeName(mv.Table),              // 2
+			from,                            // 2
 			strings.Join(unnestClauses, ""), // 3
 			whereClause,                     // 4
 			groupClause,                     // 5
@@ -665,11 +707,11 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 			bacause FILTER cannot be applied for arbitrary measure, ie sum(a)/1000
 		*/
 		if filterCount == 1 {
-			return q.buildMeasureFilterSQL(mv, unnestClauses, selectCols, limitClause, orderClause, havingClause, whereClause, groupClause, args, selectArgs, whereArgs, havingClauseArgs, dialect)
+			return q.buildMeasureFilterSQL(mv, from, unnestClauses, selectCols, limitClause, orderClause, havingClause, whereClause, groupClause, args, selectArgs, whereArgs, havingClauseArgs, dialect)
 		}
 		sql = fmt.Sprintf("SELECT %s FROM %s %s %s %s %s %s %s OFFSET %d",
 			strings.Join(selectCols, ", "),
-			safeName(mv.Table),
+			from,
 			strings.Join(unnestClauses, ""),
 			whereClause,
 			groupClause,
@@ -683,7 +725,7 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 	return sql, args, nil
 }
 
-func (q *MetricsViewAggregation) buildMeasureFilterSQL(mv *runtimev1.MetricsViewSpec, unnestClauses, selectCols []string, limitClause, orderClause, havingClause, whereClause, groupClause string, args, selectArgs, whereArgs, havingClauseArgs []any, dialect drivers.Dialect) (string, []any, error) {
+func (q *MetricsViewAggregation) buildMeasureFilterSQL(mv *runtimev1.MetricsViewSpec, from string, unnestClauses, selectCols []string, limitClause, orderClause, havingClause, whereClause, groupClause string, args, selectArgs, whereArgs, havingClauseArgs []any, dialect drivers.Dialect) (string, []any, error) {
 	joinConditions := make([]string, 0, len(q.Dimensions))
 	selfJoinCols := make([]string, 0, len(q.Dimensions)+1)
 	finalProjection := make([]string, 0, len(q.Dimensions)+1)
@@ -729,10 +771,10 @@ func (q *MetricsViewAggregation) buildMeasureFilterSQL(mv *runtimev1.MetricsView
 	sql := fmt.Sprintf(`
 					SELECT %[16]s FROM (
 						SELECT %[1]s FROM (
-							SELECT %[10]s FROM %[2]s %[3]s %[4]s %[5]s %[6]s 
+							SELECT %[10]s FROM %[17]s %[3]s %[4]s %[5]s %[6]s 
 						) %[2]s 
 						LEFT JOIN (
-							SELECT %[10]s FROM %[2]s %[3]s %[9]s %[5]s %[6]s
+							SELECT %[10]s FROM %[17]s %[3]s %[9]s %[5]s %[6]s
 						) %[7]s 
 						ON (%[8]s)
 					)
@@ -758,6 +800,7 @@ func (q *MetricsViewAggregation) buildMeasureFilterSQL(mv *runtimev1.MetricsView
 		extraWhere,                            // 14
 		druidGroupBy,                          // 15
 		strings.Join(finalProjection, ","),    // 16
+		from,                                  // 17
 	)
 
 	args = args[:0]
@@ -0,0 +1,430 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// anomalyDefaultDimensionLimit is the default number of dimension values to check for anomalies.
+	anomalyDefaultDimensionLimit = 10
+	// anomalyMinSamples is the minimum number of intervals in the baseline needed to check an interval for anomalies.
+	anomalyMinSamples = 3
+	// madToSigma scales the median absolute deviation to be comparable to a standard deviation for normally distributed data.
+	madToSigma = 1.4826
+)
+
+// MetricsViewAnomalies detects anomalies in the latest closed interval before ExecutionTime.
+// For each measure (and each of the top dimension values in the baseline window or latest interval, if a dimension is set), it runs a MetricsViewTimeSeries query over a trailing window
+// and compares the latest interval to a baseline computed from the preceding intervals.
+type MetricsViewAnomalies struct {
+	MetricsViewName    string                          `json:"metrics_view,omitempty"`
+	MeasureNames       []string                        `json:"measures,omitempty"`
+	DimensionName      string                          `json:"dimension,omitempty"`
+	DimensionLimit     int64                           `json:"dimension_limit,omitempty"`
+	TimeGranularity    runtimev1.TimeGrain             `json:"time_granularity,omitempty"`
+	TimeZone           string                          `json:"time_zone,omitempty"`
+	BaselineDuration   string                          `json:"baseline_duration,omitempty"`
+	BaselineMethod     runtimev1.AnomalyBaselineMethod `json:"baseline_method,omitempty"`
+	ThresholdSigma     float64                         `json:"threshold_sigma,omitempty"`
+	ExecutionTime      time.Time                       `json:"execution_time,omitempty"`
+	SecurityAttributes map[string]any                  `json:"security_attributes,omitempty"`
+
+	Result *MetricsViewAnomaliesResult `json:"-"`
+}
+
+// MetricsViewAnomaliesResult is the result of a MetricsViewAnomalies query.
+type MetricsViewAnomaliesResult struct {
+	// IntervalStart and IntervalEnd are the bounds of the interval that was checked.
+	IntervalStart time.Time
+	IntervalEnd   time.Time
+	// Anomalies are sorted by the absolute deviation from the baseline in descending order.
+	Anomalies []*MetricsViewAnomaly
+}
+
+// MetricsViewAnomaly is an anomalous value of a measure.
+type MetricsViewAnomaly struct {
+	Measure        string
+	DimensionValue any
+	Value          float64
+	Baseline       float64
+	// Deviation is the number of standard deviations from the baseline. It is infinite if the baseline has no variance.
+	Deviation float64
+	// Samples is the number of intervals the baseline was computed from.
+	Samples int
+}
+
+var _ runtime.Query = &MetricsViewAnomalies{}
+
+func (q *MetricsViewAnomalies) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewAnomalies:%s", r)
+}
+
+func (q *MetricsViewAnomalies) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
+	}
+}
+
+func (q *MetricsViewAnomalies) MarshalResult() *runtime.QueryResult {
+	size := int64(reflect.TypeOf(q.Result).Size())
+	if q.Result != nil {
+		size += int64(len(q.Result.Anomalies)) * int64(reflect.TypeOf(MetricsViewAnomaly{}).Size())
+	}
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: size,
+	}
+}
+
+func (q *MetricsViewAnomalies) UnmarshalResult(v any) error {
+	res, ok := v.(*MetricsViewAnomaliesResult)
+	if !ok {
+		return fmt.Errorf("MetricsViewAnomalies: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewAnomalies) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	if len(q.MeasureNames) == 0 {
+		return errors.New("anomaly detection requires at least one measure")
+	}
+	if q.ThresholdSigma <= 0 {
+		return errors.New("anomaly detection requires a positive threshold")
+	}
+	if q.BaselineMethod == runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_SEASONAL && !seasonalTimeGrain(q.TimeGranularity) {
+		return fmt.Errorf("seasonal baselines require a time grain of a day or less, got %s", q.TimeGranularity)
+	}
+
+	var dims []*runtimev1.MetricsViewAggregationDimension
+	if q.DimensionName != "" {
+		dims = append(dims, &runtimev1.MetricsViewAggregationDimension{Name: q.DimensionName})
+	}
+	measures := make([]*runtimev1.MetricsViewAggregationMeasure, len(q.MeasureNames))
+	for i, m := range q.MeasureNames {
+		measures[i] = &runtimev1.MetricsViewAggregationMeasure{Name: m}
+	}
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityAttributes, dims, measures)
+	if err != nil {
+		return err
+	}
+	if mv.TimeDimension == "" {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
+	}
+
+	tz := time.UTC
+	if q.TimeZone != "" {
+		tz, err = time.LoadLocation(q.TimeZone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", q.TimeZone, err)
+		}
+	}
+
+	// Find the bounds of the latest closed interval and of the baseline window before it
+	fdow := mv.FirstDayOfWeek
+	if fdow > 7 || fdow <= 0 {
+		fdow = 1
+	}
+	fmoy := mv.FirstMonthOfYear
+	if fmoy > 12 || fmoy <= 0 {
+		fmoy = 1
+	}
+	grain := convTimeGrain(q.TimeGranularity)
+	end := timeutil.TruncateTime(q.ExecutionTime, grain, tz, int(fdow), int(fmoy))
+	latestStart := timeutil.TruncateTime(end.Add(-time.Nanosecond), grain, tz, int(fdow), int(fmoy))
+	d, err := duration.ParseISO8601(q.BaselineDuration)
+	if err != nil {
+		return fmt.Errorf("invalid baseline duration %q: %w", q.BaselineDuration, err)
+	}
+	start := d.Sub(latestStart.In(tz))
+
+	// Without a dimension, there's a single series to check
+	if q.DimensionName == "" {
+		anomalies, err := q.checkSeries(ctx, rt, instanceID, priority, mv, security, nil, nil, start, latestStart, end, tz)
+		if err != nil {
+			return err
+		}
+		q.Result = newMetricsViewAnomaliesResult(latestStart, end, anomalies)
+		return nil
+	}
+
+	// Check the top values of the dimension in the baseline window and in the latest interval.
+	// Using both catches values that drop from the top as well as values that spike into it.
+	limit := q.DimensionLimit
+	if limit <= 0 {
+		limit = anomalyDefaultDimensionLimit
+	}
+	var tops [][]*structpb.Struct
+	for _, tr := range [][2]time.Time{{start, latestStart}, {latestStart, end}} {
+		top := &MetricsViewAggregation{
+			MetricsViewName:    q.MetricsViewName,
+			Dimensions:         dims,
+			Measures:           measures[:1],
+			Sort:               []*runtimev1.MetricsViewAggregationSort{{Name: q.MeasureNames[0], Desc: true}},
+			TimeRange:          &runtimev1.TimeRange{Start: timestamppb.New(tr[0]), End: timestamppb.New(tr[1])},
+			Limit:              &limit,
+			SecurityAttributes: q.SecurityAttributes,
+		}
+		err = rt.Query(ctx, instanceID, top, priority)
+		if err != nil {
+			return err
+		}
+		tops = append(tops, top.Result.Data)
+	}
+
+	var anomalies []*MetricsViewAnomaly
+	for _, val := range mergeDimensionValues(q.DimensionName, tops...) {
+		where := &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_EQ,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: q.DimensionName}},
+						{Expression: &runtimev1.Expression_Val{Val: val}},
+					},
+				},
+			},
+		}
+		res, err := q.checkSeries(ctx, rt, instanceID, priority, mv, security, where, val.AsInterface(), start, latestStart, end, tz)
+		if err != nil {
+			return err
+		}
+		anomalies = append(anomalies, res...)
+	}
+
+	q.Result = newMetricsViewAnomaliesResult(latestStart, end, anomalies)
+	return nil
+}
+
+func (q *MetricsViewAnomalies) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
+
+// checkSeries runs a time series query for [start, end) and checks the values of the interval starting at latestStart against the baseline of the preceding intervals.
+func (q *MetricsViewAnomalies) checkSeries(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int, mv *runtimev1.MetricsViewSpec, security *runtime.ResolvedMetricsViewSecurity, where *runtimev1.Expression, dimVal any, start, latestStart, end time.Time, tz *time.Location) ([]*MetricsViewAnomaly, error) {
+	ts := &MetricsViewTimeSeries{
+		MetricsViewName:    q.MetricsViewName,
+		MeasureNames:       q.MeasureNames,
+		TimeStart:          timestamppb.New(start),
+		TimeEnd:            timestamppb.New(end),
+		Where:              where,
+		TimeGranularity:    q.TimeGranularity,
+		TimeZone:           q.TimeZone,
+		MetricsView:        mv,
+		ResolvedMVSecurity: security,
+	}
+	err := rt.Query(ctx, instanceID, ts, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	var anomalies []*MetricsViewAnomaly
+	for _, m := range q.MeasureNames {
+		var latest *float64
+		var history []timeValue
+		for _, v := range ts.Result.Data {
+			f, ok := numericValue(v.Records.Fields[m])
+			if !ok {
+				continue
+			}
+			t := v.Ts.AsTime()
+			if t.Equal(latestStart) {
+				latest = &f
+			} else if t.Before(latestStart) {
+				history = append(history, timeValue{t: t.In(tz), v: f})
+			}
+		}
+		if latest == nil {
+			continue
+		}
+
+		baseline, spread, samples := q.baseline(history, latestStart.In(tz))
+		if samples < anomalyMinSamples {
+			continue
+		}
+
+		var deviation float64
+		if spread == 0 {
+			if *latest == baseline {
+				continue
+			}
+			deviation = math.Copysign(math.Inf(1), *latest-baseline)
+		} else {
+			deviation = (*latest - baseline) / spread
+		}
+		if math.Abs(deviation) <= q.ThresholdSigma {
+			continue
+		}
+
+		anomalies = append(anomalies, &MetricsViewAnomaly{
+			Measure:        m,
+			DimensionValue: dimVal,
+			Value:          *latest,
+			Baseline:       baseline,
+			Deviation:      deviation,
+			Samples:        samples,
+		})
+	}
+	return anomalies, nil
+}
+
+// baseline computes the expected value and its spread (in standard deviations) for the interval starting at t.
+// It returns the number of historical intervals the baseline was computed from.
+func (q *MetricsViewAnomalies) baseline(history []timeValue, t time.Time) (float64, float64, int) {
+	vals := make([]float64, 0, len(history))
+	switch q.BaselineMethod {
+	case runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_SEASONAL:
+		key := seasonKey(t, q.TimeGranularity)
+		for _, h := range history {
+			if seasonKey(h.t, q.TimeGranularity) == key {
+				vals = append(vals, h.v)
+			}
+		}
+		median, mad := medianAbsoluteDeviation(vals)
+		return median, mad * madToSigma, len(vals)
+	default:
+		for _, h := range history {
+			vals = append(vals, h.v)
+		}
+		mean, stddev := meanStddev(vals)
+		return mean, stddev, len(vals)
+	}
+}
+
+// mergeDimensionValues returns the distinct non-null values of the dimension in the rows, in the order they first appear.
+// Null values are skipped since they can't be matched with an equality condition.
+func mergeDimensionValues(dim string, rows ...[]*structpb.Struct) []*structpb.Value {
+	var res []*structpb.Value
+	seen := make(map[string]bool)
+	for _, rs := range rows {
+		for _, row := range rs {
+			val, ok := row.Fields[dim]
+			if !ok {
+				continue
+			}
+			if _, isNull := val.Kind.(*structpb.Value_NullValue); isNull {
+				continue
+			}
+			key := fmt.Sprintf("%T:%v", val.Kind, val.AsInterface())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			res = append(res, val)
+		}
+	}
+	return res
+}
+
+type timeValue struct {
+	t time.Time
+	v float64
+}
+
+func newMetricsViewAnomaliesResult(start, end time.Time, anomalies []*MetricsViewAnomaly) *MetricsViewAnomaliesResult {
+	slices.SortStableFunc(anomalies, func(a, b *MetricsViewAnomaly) int {
+		da, db := math.Abs(a.Deviation), math.Abs(b.Deviation)
+		switch {
+		case da > db:
+			return -1
+		case da < db:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return &MetricsViewAnomaliesResult{
+		IntervalStart: start,
+		IntervalEnd:   end,
+		Anomalies:     anomalies,
+	}
+}
+
+// seasonalTimeGrain returns true if seasonal baselines are supported for the time grain.
+func seasonalTimeGrain(tg runtimev1.TimeGrain) bool {
+	switch tg {
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE, runtimev1.TimeGrain_TIME_GRAIN_HOUR, runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return true
+	default:
+		return false
+	}
+}
+
+// seasonKey returns the weekday and hour of t (or just the weekday for daily intervals).
+// Intervals with the same key are compared by seasonal baselines.
+func seasonKey(t time.Time, tg runtimev1.TimeGrain) int {
+	if tg == runtimev1.TimeGrain_TIME_GRAIN_DAY {
+		return int(t.Weekday())
+	}
+	return int(t.Weekday())*24 + t.Hour()
+}
+
+// meanStddev returns the mean and sample standard deviation of vals.
+func meanStddev(vals []float64) (float64, float64) {
+	if len(vals) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	mean := sum / float64(len(vals))
+	if len(vals) == 1 {
+		return mean, 0
+	}
+	var sq float64
+	for _, v := range vals {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(sq / float64(len(vals)-1))
+}
+
+// medianAbsoluteDeviation returns the median of vals and the median of the absolute deviations from it.
+func medianAbsoluteDeviation(vals []float64) (float64, float64) {
+	if len(vals) == 0 {
+		return 0, 0
+	}
+	m := median(vals)
+	devs := make([]float64, len(vals))
+	for i, v := range vals {
+		devs[i] = math.Abs(v - m)
+	}
+	return m, median(devs)
+}
+
+func median(vals []float64) float64 {
+	sorted := slices.Clone(vals)
+	slices.Sort(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// numericValue returns the value as a float64 if it is a number.
+func numericValue(v *structpb.Value) (float64, bool) {
+	if n, ok := v.GetKind().(*structpb.Value_NumberValue); ok {
+		return n.NumberValue, true
+	}
+	return 0, false
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMetricsViewAnomalies_baseline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // A Monday
+	var history []timeValue
+	for i := 0; i < 21; i++ {
+		v := 10.0
+		if i%7 == 5 {
+			v = float64(90 + i/7*10) // Saturdays are busy
+		}
+		history = append(history, timeValue{t: start.AddDate(0, 0, i), v: v})
+	}
+	saturday := start.AddDate(0, 0, 26)
+
+	q := &MetricsViewAnomalies{TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY}
+	mean, stddev, n := q.baseline(history, saturday)
+	require.Equal(t, 21, n)
+	require.InDelta(t, 22.857, mean, 0.001)
+	require.InDelta(t, 32.426, stddev, 0.001)
+
+	q.BaselineMethod = runtimev1.AnomalyBaselineMethod_ANOMALY_BASELINE_METHOD_SEASONAL
+	median, spread, n := q.baseline(history, saturday)
+	require.Equal(t, 3, n)
+	require.Equal(t, 100.0, median)
+	require.InDelta(t, 10*madToSigma, spread, 0.0001)
+}
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	m, mad := medianAbsoluteDeviation([]float64{1, 1, 2, 2, 4, 6, 9})
+	require.Equal(t, 2.0, m)
+	require.Equal(t, 1.0, mad)
+
+	m, mad = medianAbsoluteDeviation([]float64{4, 1, 3, 2})
+	require.Equal(t, 2.5, m)
+	require.Equal(t, 1.0, mad)
+
+	m, mad = medianAbsoluteDeviation(nil)
+	require.Equal(t, 0.0, m)
+	require.Equal(t, 0.0, mad)
+}
+
+func TestMeanStddev(t *testing.T) {
+	mean, stddev := meanStddev([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	require.Equal(t, 5.0, mean)
+	require.InDelta(t, math.Sqrt(32.0/7), stddev, 0.0001)
+
+	mean, stddev = meanStddev([]float64{3})
+	require.Equal(t, 3.0, mean)
+	require.Equal(t, 0.0, stddev)
+}
+
+func TestMergeDimensionValues(t *testing.T) {
+	row := func(v any) *structpb.Struct {
+		s, err := structpb.NewStruct(map[string]any{"country": v})
+		require.NoError(t, err)
+		return s
+	}
+	baseline := []*structpb.Struct{row("US"), row("DK"), row(nil)}
+	latest := []*structpb.Struct{row("IN"), row("US"), row(1.0)}
+
+	vals := mergeDimensionValues("country", baseline, latest)
+	res := make([]any, len(vals))
+	for i, v := range vals {
+		res[i] = v.AsInterface()
+	}
+	require.Equal(t, []any{"US", "DK", "IN", 1.0}, res)
+}
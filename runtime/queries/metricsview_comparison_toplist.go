@@ -25,6 +25,7 @@ type MetricsViewComparison struct {
 	ComparisonMeasures  []string                                       `json:"comparison_measures,omitempty"`
 	TimeRange           *runtimev1.TimeRange                           `json:"base_time_range,omitempty"`
 	ComparisonTimeRange *runtimev1.TimeRange                           `json:"comparison_time_range,omitempty"`
+	TimeDimension       string                                         `json:"time_dimension,omitempty"`
 	Limit               int64                                          `json:"limit,omitempty"`
 	Offset              int64                                          `json:"offset,omitempty"`
 	Sort                []*runtimev1.MetricsViewComparisonSort         `json:"sort,omitempty"`
@@ -92,6 +93,11 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 	}
 	defer release()
 
+	mv, err = metricsViewWithTimeDimension(ctx, olap.InformationSchema(), mv, q.TimeDimension, security)
+	if err != nil {
+		return err
+	}
+
 	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -440,7 +446,7 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 	for _, m := range q.Measures {
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
-			expr, err := metricsViewMeasureExpression(mv, m.Name)
+			expr, _, err := metricsViewMeasureExpression(mv, m.Name, dialect, nil)
 			if err != nil {
 				return "", nil, err
 			}
@@ -543,27 +549,27 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 		labelSelectClause := strings.Join(labelCols, ", ")
 		sql = fmt.Sprintf(
 			`SELECT %[8]s FROM (SELECT %[1]s FROM %[2]s %[7]s WHERE %[3]s GROUP BY 1 %[9]s %[4]s %[5]s OFFSET %[6]d)`,
-			selectClause,       // 1
-			safeName(mv.Table), // 2
-			baseWhereClause,    // 3
-			orderByClause,      // 4
-			limitClause,        // 5
-			q.Offset,           // 6
-			unnestClause,       // 7
-			labelSelectClause,  // 8
-			havingClause,       // 9
+			selectClause, // 1
+			metricsViewRelation(mv, []string{dim.Name}, q.Where, q.Having), // 2
+			baseWhereClause,   // 3
+			orderByClause,     // 4
+			limitClause,       // 5
+			q.Offset,          // 6
+			unnestClause,      // 7
+			labelSelectClause, // 8
+			havingClause,      // 9
 		)
 	} else {
 		sql = fmt.Sprintf(
 			`SELECT %[1]s FROM %[2]s %[7]s WHERE %[3]s GROUP BY 1 %[8]s %[4]s %[5]s OFFSET %[6]d`,
-			selectClause,       // 1
-			safeName(mv.Table), // 2
-			baseWhereClause,    // 3
-			orderByClause,      // 4
-			limitClause,        // 5
-			q.Offset,           // 6
-			unnestClause,       // 7
-			havingClause,       // 8
+			selectClause, // 1
+			metricsViewRelation(mv, []string{dim.Name}, q.Where, q.Having), // 2
+			baseWhereClause, // 3
+			orderByClause,   // 4
+			limitClause,     // 5
+			q.Offset,        // 6
+			unnestClause,    // 7
+			havingClause,    // 8
 		)
 	}
 
@@ -595,7 +601,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 	for _, m := range q.Measures {
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
-			expr, err := metricsViewMeasureExpression(mv, m.Name)
+			expr, _, err := metricsViewMeasureExpression(mv, m.Name, dialect, nil)
 			if err != nil {
 				return "", nil, err
 			}
@@ -885,9 +891,9 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 						%[8]d
 				) WHERE %[15]s 
 			`,
-				subSelectClause,           // 1
-				colName,                   // 2
-				safeName(mv.Table),        // 3
+				subSelectClause, // 1
+				colName,         // 2
+				metricsViewRelation(mv, []string{dim.Name}, q.Where), // 3
 				baseWhereClause,           // 4
 				comparisonWhereClause,     // 5
 				orderByClause,             // 6
@@ -920,9 +926,9 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		OFFSET
 			%[8]d
 		`,
-				subSelectClause,           // 1
-				colName,                   // 2
-				safeName(mv.Table),        // 3
+				subSelectClause, // 1
+				colName,         // 2
+				metricsViewRelation(mv, []string{dim.Name}, q.Where), // 3
 				baseWhereClause,           // 4
 				comparisonWhereClause,     // 5
 				orderByClause,             // 6
@@ -1008,9 +1014,9 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 				%[7]s
 				OFFSET %[8]d
 			`,
-			subSelectClause,                     // 1
-			colName,                             // 2
-			safeName(mv.Table),                  // 3
+			subSelectClause, // 1
+			colName,         // 2
+			metricsViewRelation(mv, []string{dim.Name}, q.Where), // 3
 			leftWhereClause,                     // 4
 			rightWhereClause,                    // 5
 			orderByClause,                       // 6
@@ -1044,9 +1050,14 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 	}
 	defer release()
 
+	mv, err = metricsViewWithTimeDimension(ctx, olap.InformationSchema(), mv, q.TimeDimension, security)
+	if err != nil {
+		return err
+	}
+
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if supportsDuckDBExport(opts.Format) {
 			// temporary backwards compatibility
 			if q.Filter != nil {
 				if q.Where != nil {
@@ -1074,7 +1085,7 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 			}
 
 			filename := q.generateFilename()
-			if err := DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
+			if err := DuckDBExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
 			}
 		} else {
@@ -1208,6 +1219,12 @@ func (q *MetricsViewComparison) generalExport(ctx context.Context, rt *runtime.R
 		return WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(meta, data, w)
 	}
 
 	return nil
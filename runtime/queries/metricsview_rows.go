@@ -120,7 +120,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if supportsDuckDBExport(opts.Format) {
 			if q.MetricsView.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 			}
@@ -144,7 +144,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 			}
 
 			filename := q.generateFilename(q.MetricsView)
-			if err := DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
+			if err := DuckDBExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
 			}
 		} else {
@@ -189,6 +189,12 @@ func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime
 		return WriteXLSX(q.Result.Meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(q.Result.Meta, q.Result.Data, w)
 	}
 
 	return nil
@@ -310,7 +316,7 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsViewSpec, dia
 
 	sql := fmt.Sprintf("SELECT %s FROM %s WHERE %s %s %s OFFSET %d",
 		strings.Join(selectColumns, ","),
-		safeName(mv.Table),
+		metricsViewRelation(mv, nil, q.Where),
 		whereClause,
 		orderClause,
 		limitClause,
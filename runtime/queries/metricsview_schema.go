@@ -9,6 +9,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 )
 
 type MetricsViewSchema struct {
@@ -59,7 +60,10 @@ func (q *MetricsViewSchema) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
-	sql := q.buildMetricsViewDataTypesSQL(mv, olap.Dialect())
+	sql, err := q.buildMetricsViewDataTypesSQL(mv, olap.Dialect())
+	if err != nil {
+		return err
+	}
 
 	schema, _, err := olapQuery(ctx, olap, priority, sql, nil)
 	if err != nil {
@@ -77,10 +81,12 @@ func (q *MetricsViewSchema) Export(ctx context.Context, rt *runtime.Runtime, ins
 	return nil
 }
 
-func (q *MetricsViewSchema) buildMetricsViewDataTypesSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect) string {
+func (q *MetricsViewSchema) buildMetricsViewDataTypesSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect) (string, error) {
 	var dimensions []string
+	var dimensionNames []string
 	var unnestClauses []string
 	for _, dim := range mv.Dimensions {
+		dimensionNames = append(dimensionNames, dim.Name)
 		sel, unnestClause := dimensionSelect(mv.Table, dim, dialect)
 		if unnestClause != "" {
 			unnestClauses = append(unnestClauses, unnestClause)
@@ -89,8 +95,14 @@ func (q *MetricsViewSchema) buildMetricsViewDataTypesSQL(mv *runtimev1.MetricsVi
 	}
 
 	var measures []string
+	// The query is only used to infer types, so measures computed over time can be ordered by a constant
+	window := &metricsview.Window{OrderBy: "1"}
 	for _, meas := range mv.Measures {
-		measures = append(measures, fmt.Sprintf("%s as %s", meas.Expression, safeName(meas.Name)))
+		expr, _, err := metricsview.MeasureExpression(mv, meas, dialect, window)
+		if err != nil {
+			return "", err
+		}
+		measures = append(measures, fmt.Sprintf("%s as %s", expr, safeName(meas.Name)))
 	}
 
 	groups := make([]string, len(dimensions))
@@ -114,11 +126,12 @@ func (q *MetricsViewSchema) buildMetricsViewDataTypesSQL(mv *runtimev1.MetricsVi
 		groupBy = fmt.Sprintf("GROUP BY %s", groupBy)
 	}
 
-	return fmt.Sprintf(
+	sql := fmt.Sprintf(
 		`SELECT %[1]s FROM %[2]s %[3]s %[4]s LIMIT 0`,
-		columns,                         // 1
-		safeName(mv.Table),              // 2
-		strings.Join(unnestClauses, ""), // 3
-		groupBy,                         // 4
+		columns,                                 // 1
+		metricsViewRelation(mv, dimensionNames), // 2
+		strings.Join(unnestClauses, ""),         // 3
+		groupBy,                                 // 4
 	)
+	return sql, nil
 }
@@ -2,10 +2,13 @@ package queries
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -454,3 +457,181 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_writeJSONL(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{
+			Name: "b",
+		},
+		{
+			Name: "a",
+		},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewStringValue("x\"y"),
+				"b": structpb.NewNumberValue(2.5),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewBoolValue(true)}}),
+				"b": structpb.NewNullValue(),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteJSONL(meta, data, &buf)
+	require.NoError(t, err)
+	require.Equal(t, "{\"b\":2.5,\"a\":\"x\\\"y\"}\n{\"b\":null,\"a\":[true]}\n{\"b\":null,\"a\":null}\n", buf.String())
+}
+
+func Test_writeAvro(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{
+			Name: "count(*)",
+			Type: runtimev1.Type_CODE_INT64.String(),
+		},
+		{
+			Name: "ts",
+			Type: runtimev1.Type_CODE_TIMESTAMP.String(),
+		},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"count(*)": structpb.NewNumberValue(3),
+				"ts":       structpb.NewStringValue("2024-01-02T03:04:05Z"),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteAvro(meta, data, &buf)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("Obj\x01")))
+	require.Contains(t, buf.String(), `{"name":"count___","doc":"count(*)","type":["null","long"],"default":null}`)
+}
+
+func Test_metricsViewRelation(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{
+				Name:  "customers",
+				Table: "customers",
+				Type:  runtimev1.MetricsViewSpec_JOIN_TYPE_LEFT,
+				Keys:  []*runtimev1.MetricsViewSpec_JoinV2_Key{{Column: "customer_id", JoinColumn: "id"}},
+			},
+			{
+				Name:  "r",
+				Table: "regions",
+				Type:  runtimev1.MetricsViewSpec_JOIN_TYPE_INNER,
+				Keys:  []*runtimev1.MetricsViewSpec_JoinV2_Key{{Column: "region_id", JoinColumn: "id"}},
+			},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "status", Column: "status"},
+			{Name: "customer", Column: "name", Join: "customers"},
+			{Name: "region", Expression: "upper(r.name)", Join: "r"},
+		},
+	}
+
+	// No joined dimensions referenced
+	require.Equal(t, `"orders"`, metricsViewRelation(mv, []string{"status"}))
+
+	// Joined dimension referenced by a filter only
+	where := &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+		Op: runtimev1.Operation_OPERATION_EQ,
+		Exprs: []*runtimev1.Expression{
+			{Expression: &runtimev1.Expression_Ident{Ident: "customer"}},
+			{Expression: &runtimev1.Expression_Val{Val: structpb.NewStringValue("a")}},
+		},
+	}}}
+	require.Equal(t,
+		`(SELECT "orders".*, ("customers"."name") AS "__rill_join_customer" FROM "orders" LEFT JOIN "customers" AS "customers" ON "orders"."customer_id" = "customers"."id") AS "orders"`,
+		metricsViewRelation(mv, []string{"status"}, where, nil),
+	)
+
+	// Multiple joined dimensions
+	require.Equal(t,
+		`(SELECT "orders".*, (upper(r.name)) AS "__rill_join_region", ("customers"."name") AS "__rill_join_customer" FROM "orders" LEFT JOIN "customers" AS "customers" ON "orders"."customer_id" = "customers"."id" INNER JOIN "regions" AS "r" ON "orders"."region_id" = "r"."id") AS "orders"`,
+		metricsViewRelation(mv, []string{"region", "customer"}),
+	)
+	require.Equal(t, `"__rill_join_region"`, metricsViewDimensionExpression(mv.Dimensions[2]))
+}
+
+func Test_metricsViewWithTimeDimension(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:               "orders",
+		TimeDimension:       "created_at",
+		WatermarkExpression: "MAX(created_at)",
+		Joins:               []*runtimev1.MetricsViewSpec_JoinV2{{Name: "customers", Table: "customers"}},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "shipped", Column: "shipped_at"},
+			{Name: "delivered_day", Expression: "date_trunc('day', delivered_at)"},
+			{Name: "signup", Column: "signed_up_at", Join: "customers"},
+			{Name: "status", Column: "status"},
+		},
+	}
+	is := testInformationSchema{&drivers.Table{
+		Name: "orders",
+		Schema: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+			{Name: "created_at", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+			{Name: "shipped_at", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+			{Name: "status", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		}},
+	}}
+	ctx := context.Background()
+
+	// Defaults to the metrics view's time dimension
+	res, err := metricsViewWithTimeDimension(ctx, is, mv, "", nil)
+	require.NoError(t, err)
+	require.Same(t, mv, res)
+	res, err = metricsViewWithTimeDimension(ctx, is, mv, "created_at", nil)
+	require.NoError(t, err)
+	require.Same(t, mv, res)
+
+	// Overrides with the dimension's column without changing the original
+	res, err = metricsViewWithTimeDimension(ctx, is, mv, "shipped", nil)
+	require.NoError(t, err)
+	require.Equal(t, "shipped_at", res.TimeDimension)
+	require.Empty(t, res.WatermarkExpression)
+	require.Equal(t, "created_at", mv.TimeDimension)
+
+	// Unsupported dimensions
+	_, err = metricsViewWithTimeDimension(ctx, is, mv, "delivered_day", nil)
+	require.Error(t, err)
+	_, err = metricsViewWithTimeDimension(ctx, is, mv, "signup", nil)
+	require.Error(t, err)
+	_, err = metricsViewWithTimeDimension(ctx, is, mv, "missing", nil)
+	require.Error(t, err)
+	_, err = metricsViewWithTimeDimension(ctx, is, mv, "status", nil)
+	require.ErrorContains(t, err, "is not a TIMESTAMP column")
+
+	// Respects field access
+	_, err = metricsViewWithTimeDimension(ctx, is, mv, "shipped", &runtime.ResolvedMetricsViewSecurity{Access: true, Exclude: []string{"shipped"}})
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+type testInformationSchema []*drivers.Table
+
+func (s testInformationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	return s, nil
+}
+
+func (s testInformationSchema) Lookup(ctx context.Context, name string) (*drivers.Table, error) {
+	for _, t := range s {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, drivers.ErrNotFound
+}
@@ -19,6 +19,7 @@ type MetricsViewTimeRange struct {
 	MetricsViewName    string                               `json:"name"`
 	MetricsView        *runtimev1.MetricsViewSpec           `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
+	TimeDimension      string                               `json:"time_dimension,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeRangeResponse `json:"_"`
 }
@@ -61,23 +62,28 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 		policyFilter = q.ResolvedMVSecurity.RowFilter
 	}
 
-	if q.MetricsView.TimeDimension == "" {
-		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
-	}
-
 	olap, release, err := rt.OLAP(ctx, instanceID, q.MetricsView.Connector)
 	if err != nil {
 		return err
 	}
 	defer release()
 
+	mv, err := metricsViewWithTimeDimension(ctx, olap.InformationSchema(), q.MetricsView, q.TimeDimension, q.ResolvedMVSecurity)
+	if err != nil {
+		return err
+	}
+
+	if mv.TimeDimension == "" {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
+	}
+
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Table, policyFilter, priority)
+		return q.resolveDuckDB(ctx, olap, mv.TimeDimension, mv.Table, policyFilter, priority)
 	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Table, policyFilter, priority)
+		return q.resolveDruid(ctx, olap, mv.TimeDimension, mv.Table, policyFilter, priority)
 	case drivers.DialectClickHouse:
-		return q.resolveClickHouse(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Table, policyFilter, priority)
+		return q.resolveClickHouse(ctx, olap, mv.TimeDimension, mv.Table, policyFilter, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
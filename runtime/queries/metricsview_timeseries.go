@@ -13,6 +13,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/pkg/timeutil"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -32,6 +33,7 @@ type MetricsViewTimeSeries struct {
 	Having             *runtimev1.Expression                `json:"having,omitempty"`
 	TimeGranularity    runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
 	TimeZone           string                               `json:"time_zone,omitempty"`
+	TimeDimension      string                               `json:"time_dimension,omitempty"`
 	MetricsView        *runtimev1.MetricsViewSpec           `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
 
@@ -74,7 +76,7 @@ func (q *MetricsViewTimeSeries) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	if q.MetricsView.TimeDimension == "" {
+	if q.MetricsView.TimeDimension == "" && q.TimeDimension == "" {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
@@ -102,7 +104,11 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 		q.Where = convertFilterToExpression(q.Filter)
 	}
 
-	mv := r.GetMetricsView().Spec
+	mv, err := metricsViewWithTimeDimension(ctx, olap.InformationSchema(), r.GetMetricsView().Spec, q.TimeDimension, q.ResolvedMVSecurity)
+	if err != nil {
+		return err
+	}
+
 	sql, tsAlias, args, err := q.buildMetricsTimeseriesSQL(olap, mv, q.ResolvedMVSecurity)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
@@ -230,7 +236,16 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return err
 	}
 
-	mv := r.GetMetricsView().Spec
+	olap, release, err := rt.OLAP(ctx, instanceID, q.MetricsView.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	mv, err := metricsViewWithTimeDimension(ctx, olap.InformationSchema(), r.GetMetricsView().Spec, q.TimeDimension, q.ResolvedMVSecurity)
+	if err != nil {
+		return err
+	}
 
 	if opts.PreWriteHook != nil {
 		err = opts.PreWriteHook(q.generateFilename())
@@ -257,6 +272,12 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return WriteXLSX(meta, tmp, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(meta, tmp, w)
 	}
 
 	return nil
@@ -271,7 +292,32 @@ func (q *MetricsViewTimeSeries) generateFilename() string {
 }
 
 func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore, mv *runtimev1.MetricsViewSpec, policy *runtime.ResolvedMetricsViewSecurity) (string, string, []any, error) {
-	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	timezone := "UTC"
+	if q.TimeZone != "" {
+		timezone = q.TimeZone
+	}
+
+	// The time series is grouped by the truncated time, so measures computed with window functions are ordered by it
+	var timeClause string
+	var timeArgs []any
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		timeClause = q.duckDBTimeClause(mv, timezone)
+	case drivers.DialectDruid:
+		timeClause = q.druidTimeClause(mv)
+		timeArgs = []any{timezone}
+	case drivers.DialectClickHouse:
+		timeClause = q.clickHouseTimeClause(mv, timezone)
+	default:
+		return "", "", nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+	window := &metricsview.Window{
+		OrderBy:     timeClause,
+		OrderByArgs: timeArgs,
+		Grain:       q.TimeGranularity,
+	}
+
+	ms, measureArgs, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames, olap.Dialect(), window)
 	if err != nil {
 		return "", "", nil, err
 	}
@@ -284,6 +330,8 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 
 	whereClause := "1=1"
 	args := []any{}
+	args = append(args, timeArgs...)
+	args = append(args, measureArgs...)
 	if q.TimeStart != nil {
 		whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
 		args = append(args, q.TimeStart.AsTime())
@@ -321,53 +369,68 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 	}
 
 	tsAlias := tempName("_ts_")
-	timezone := "UTC"
-	if q.TimeZone != "" {
-		timezone = q.TimeZone
-	}
 
 	var sql string
 	switch olap.Dialect() {
-	case drivers.DialectDuckDB:
-		sql = q.buildDuckDBSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
-	case drivers.DialectDruid:
-		args = append([]any{timezone}, args...)
-		sql = q.buildDruidSQL(mv, tsAlias, selectCols, whereClause, havingClause)
 	case drivers.DialectClickHouse:
-		sql = q.buildClickHouseSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
+		sql = fmt.Sprintf(
+			`
+				SELECT
+					%[1]s as %[2]s,
+					%[3]s
+				FROM %[4]s
+				WHERE %[5]s
+				GROUP BY %[2]s
+				%[6]s
+				ORDER BY %[2]s`,
+			timeClause,                     // 1
+			tsAlias,                        // 2
+			strings.Join(selectCols, ", "), // 3
+			metricsViewRelation(mv, nil, q.Where, q.Having), // 4
+			whereClause,  // 5
+			havingClause, // 6
+		)
 	default:
-		return "", "", nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+		sql = fmt.Sprintf(
+			`
+				SELECT
+					%[1]s as %[2]s,
+					%[3]s
+				FROM %[4]s
+				WHERE %[5]s
+				GROUP BY 1
+				%[6]s
+				ORDER BY 1`,
+			timeClause,                     // 1
+			tsAlias,                        // 2
+			strings.Join(selectCols, ", "), // 3
+			metricsViewRelation(mv, nil, q.Where, q.Having), // 4
+			whereClause,  // 5
+			havingClause, // 6
+		)
 	}
 
 	return sql, tsAlias, args, nil
 }
 
-func (q *MetricsViewTimeSeries) buildDruidSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause string) string {
+// druidTimeClause returns an expression that truncates the time dimension to the time grain.
+// It has a placeholder for the time zone.
+func (q *MetricsViewTimeSeries) druidTimeClause(mv *runtimev1.MetricsViewSpec) string {
 	tsSpecifier := convertToDruidTimeFloorSpecifier(q.TimeGranularity)
 
-	timeClause := fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR))", safeName(mv.TimeDimension), tsSpecifier)
 	if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_WEEK && mv.FirstDayOfWeek > 1 {
 		dayOffset := 8 - mv.FirstDayOfWeek
-		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1D', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1D', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, dayOffset)
+		return fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1D', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1D', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, dayOffset)
 	} else if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_YEAR && mv.FirstMonthOfYear > 1 {
 		monthOffset := 13 - mv.FirstMonthOfYear
-		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1M', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1M', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, monthOffset)
+		return fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1M', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1M', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, monthOffset)
 	}
 
-	sql := fmt.Sprintf(
-		`SELECT %s AS %s, %s FROM %s WHERE %s GROUP BY 1 %s ORDER BY 1`,
-		timeClause,
-		tsAlias,
-		strings.Join(selectCols, ", "),
-		safeName(mv.Table),
-		whereClause,
-		havingClause,
-	)
-
-	return sql
+	return fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR))", safeName(mv.TimeDimension), tsSpecifier)
 }
 
-func (q *MetricsViewTimeSeries) buildClickHouseSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) string {
+// clickHouseTimeClause returns an expression that truncates the time dimension to the time grain in the time zone.
+func (q *MetricsViewTimeSeries) clickHouseTimeClause(mv *runtimev1.MetricsViewSpec, timezone string) string {
 	dateTruncSpecifier := convertToDateTruncSpecifier(q.TimeGranularity)
 
 	shift := "" // shift to accommodate FirstDayOfWeek or FirstMonthOfYear
@@ -379,54 +442,14 @@ func (q *MetricsViewTimeSeries) buildClickHouseSQL(mv *runtimev1.MetricsViewSpec
 		shift = fmt.Sprintf("%d MONTH", offset)
 	}
 
-	sql := ""
 	if shift == "" {
-		sql = fmt.Sprintf(
-			`
-					SELECT
-					toTimeZone(date_trunc('%[1]s', toTimeZone(%[2]s::DateTime64, '%[7]s'))::DateTime64, '%[7]s') as %[3]s,
-					%[4]s
-					FROM %[5]s
-					WHERE %[6]s
-					GROUP BY %[3]s
-					%[8]s
-					ORDER BY %[3]s`,
-			dateTruncSpecifier,             // 1
-			safeName(mv.TimeDimension),     // 2
-			tsAlias,                        // 3
-			strings.Join(selectCols, ", "), // 4
-			safeName(mv.Table),             // 5
-			whereClause,                    // 6
-			timezone,                       // 7
-			havingClause,                   // 8
-		)
-	} else {
-		sql = fmt.Sprintf(
-			`
-				SELECT
-					toTimeZone(date_trunc('%[1]s', toTimeZone(%[2]s::DateTime64, '%[7]s') + INTERVAL %[8]s) - (INTERVAL %[8]s), '%[7]s') as %[3]s,
-				%[4]s
-				FROM %[5]s
-				WHERE %[6]s
-				GROUP BY %[3]s
-				%[9]s
-				ORDER BY %[3]s`,
-			dateTruncSpecifier,             // 1
-			safeName(mv.TimeDimension),     // 2
-			tsAlias,                        // 3
-			strings.Join(selectCols, ", "), // 4
-			safeName(mv.Table),             // 5
-			whereClause,                    // 6
-			timezone,                       // 7
-			shift,                          // 8
-			havingClause,                   // 9
-		)
+		return fmt.Sprintf("toTimeZone(date_trunc('%[1]s', toTimeZone(%[2]s::DateTime64, '%[3]s'))::DateTime64, '%[3]s')", dateTruncSpecifier, safeName(mv.TimeDimension), timezone)
 	}
-
-	return sql
+	return fmt.Sprintf("toTimeZone(date_trunc('%[1]s', toTimeZone(%[2]s::DateTime64, '%[3]s') + INTERVAL %[4]s) - (INTERVAL %[4]s), '%[3]s')", dateTruncSpecifier, safeName(mv.TimeDimension), timezone, shift)
 }
 
-func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) string {
+// duckDBTimeClause returns an expression that truncates the time dimension to the time grain in the time zone.
+func (q *MetricsViewTimeSeries) duckDBTimeClause(mv *runtimev1.MetricsViewSpec, timezone string) string {
 	dateTruncSpecifier := convertToDateTruncSpecifier(q.TimeGranularity)
 
 	shift := "" // shift to accommodate FirstDayOfWeek or FirstMonthOfYear
@@ -438,75 +461,18 @@ func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, ts
 		shift = fmt.Sprintf("%d MONTH", offset)
 	}
 
-	sql := ""
-	if shift == "" {
-		if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_HOUR ||
-			q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_MINUTE ||
-			q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_SECOND {
-			sql = fmt.Sprintf(
-				`
-					SELECT
-						time_bucket(INTERVAL '1 %[1]s', %[2]s::TIMESTAMPTZ, '%[7]s') as %[3]s,
-						%[4]s
-					FROM %[5]s
-					WHERE %[6]s
-					GROUP BY 1
-					%[8]s
-					ORDER BY 1`,
-				dateTruncSpecifier,             // 1
-				safeName(mv.TimeDimension),     // 2
-				tsAlias,                        // 3
-				strings.Join(selectCols, ", "), // 4
-				safeName(mv.Table),             // 5
-				whereClause,                    // 6
-				timezone,                       // 7
-				havingClause,                   // 8
-			)
-		} else { // date_trunc is faster than time_bucket for year, month, week
-			sql = fmt.Sprintf(
-				`
-					SELECT
-					timezone('%[7]s', date_trunc('%[1]s', timezone('%[7]s', %[2]s::TIMESTAMPTZ))) as %[3]s,
-					%[4]s
-					FROM %[5]s
-					WHERE %[6]s
-					GROUP BY 1
-					%[8]s
-					ORDER BY 1`,
-				dateTruncSpecifier,             // 1
-				safeName(mv.TimeDimension),     // 2
-				tsAlias,                        // 3
-				strings.Join(selectCols, ", "), // 4
-				safeName(mv.Table),             // 5
-				whereClause,                    // 6
-				timezone,                       // 7
-				havingClause,                   // 8
-			)
-		}
-	} else {
-		sql = fmt.Sprintf(
-			`
-				SELECT
-					timezone('%[7]s', date_trunc('%[1]s', timezone('%[7]s', %[2]s::TIMESTAMPTZ) + INTERVAL %[8]s) - (INTERVAL %[8]s)) as %[3]s,
-				%[4]s
-				FROM %[5]s
-				WHERE %[6]s
-				GROUP BY 1
-				%[9]s
-				ORDER BY 1`,
-			dateTruncSpecifier,             // 1
-			safeName(mv.TimeDimension),     // 2
-			tsAlias,                        // 3
-			strings.Join(selectCols, ", "), // 4
-			safeName(mv.Table),             // 5
-			whereClause,                    // 6
-			timezone,                       // 7
-			shift,                          // 8
-			havingClause,                   // 9
-		)
+	if shift != "" {
+		return fmt.Sprintf("timezone('%[3]s', date_trunc('%[1]s', timezone('%[3]s', %[2]s::TIMESTAMPTZ) + INTERVAL %[4]s) - (INTERVAL %[4]s))", dateTruncSpecifier, safeName(mv.TimeDimension), timezone, shift)
+	}
+
+	if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_HOUR ||
+		q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_MINUTE ||
+		q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_SECOND {
+		return fmt.Sprintf("time_bucket(INTERVAL '1 %[1]s', %[2]s::TIMESTAMPTZ, '%[3]s')", dateTruncSpecifier, safeName(mv.TimeDimension), timezone)
 	}
 
-	return sql
+	// date_trunc is faster than time_bucket for year, month, week
+	return fmt.Sprintf("timezone('%[3]s', date_trunc('%[1]s', timezone('%[3]s', %[2]s::TIMESTAMPTZ)))", dateTruncSpecifier, safeName(mv.TimeDimension), timezone)
 }
 
 func generateNullRecords(schema *runtimev1.StructType) *structpb.Struct {
@@ -118,7 +118,7 @@ func (q *MetricsViewToplist) Export(ctx context.Context, rt *runtime.Runtime, in
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if supportsDuckDBExport(opts.Format) {
 			if q.MetricsView.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 			}
@@ -129,7 +129,7 @@ func (q *MetricsViewToplist) Export(ctx context.Context, rt *runtime.Runtime, in
 			}
 
 			filename := q.generateFilename()
-			if err := DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
+			if err := DuckDBExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
 			}
 		} else {
@@ -174,6 +174,12 @@ func (q *MetricsViewToplist) generalExport(ctx context.Context, rt *runtime.Runt
 		return WriteXLSX(q.Result.Meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(q.Result.Meta, q.Result.Data, w)
 	}
 
 	return nil
@@ -189,7 +195,8 @@ func (q *MetricsViewToplist) generateFilename() string {
 }
 
 func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
-	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	// The toplist is not grouped by time, so running sums and previous period values are not available
+	ms, _, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames, dialect, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -272,7 +279,7 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsViewSpe
 
 	sql := fmt.Sprintf("SELECT %s FROM %s %s WHERE %s GROUP BY 1 %s %s %s OFFSET %d",
 		strings.Join(selectCols, ", "),
-		safeName(mv.Table),
+		metricsViewRelation(mv, []string{dim.Name}, q.Where, q.Having),
 		unnestClause,
 		whereClause,
 		havingClause,
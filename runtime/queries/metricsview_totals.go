@@ -112,7 +112,8 @@ func (q *MetricsViewTotals) Export(ctx context.Context, rt *runtime.Runtime, ins
 }
 
 func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
-	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	// The totals are not grouped by time, so running sums and previous period values are not available
+	ms, _, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames, dialect, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -152,9 +153,9 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsViewSpec,
 	}
 
 	sql := fmt.Sprintf(
-		"SELECT %s FROM %q WHERE %s",
+		"SELECT %s FROM %s WHERE %s",
 		strings.Join(selectCols, ", "),
-		mv.Table,
+		metricsViewRelation(mv, nil, q.Where),
 		whereClause,
 	)
 	return sql, args, nil
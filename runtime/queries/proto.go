@@ -31,6 +31,7 @@ func ProtoToQuery(q *runtimev1.Query, attrs map[string]any) (runtime.Query, erro
 			Measures:           req.Measures,
 			Sort:               req.Sort,
 			TimeRange:          tr,
+			TimeDimension:      req.TimeDimension,
 			Where:              req.Where,
 			Having:             req.Having,
 			Filter:             req.Filter,
@@ -47,6 +48,7 @@ func ProtoToQuery(q *runtimev1.Query, attrs map[string]any) (runtime.Query, erro
 			ComparisonMeasures:  req.ComparisonMeasures,
 			TimeRange:           req.TimeRange,
 			ComparisonTimeRange: req.ComparisonTimeRange,
+			TimeDimension:       req.TimeDimension,
 			Limit:               req.Limit,
 			Offset:              req.Offset,
 			Sort:                req.Sort,
@@ -61,6 +63,21 @@ func ProtoToQuery(q *runtimev1.Query, attrs map[string]any) (runtime.Query, erro
 	}
 }
 
+// ProtoTimeDimension returns the time dimension override of a proto query.
+// It returns an empty string if the query uses the metrics view's default time dimension.
+func ProtoTimeDimension(q *runtimev1.Query) string {
+	switch r := q.Query.(type) {
+	case *runtimev1.Query_MetricsViewAggregationRequest:
+		return r.MetricsViewAggregationRequest.TimeDimension
+	case *runtimev1.Query_MetricsViewComparisonRequest:
+		return r.MetricsViewComparisonRequest.TimeDimension
+	case *runtimev1.Query_MetricsViewTimeSeriesRequest:
+		return r.MetricsViewTimeSeriesRequest.TimeDimension
+	default:
+		return ""
+	}
+}
+
 // ProtoFromJSON builds a proto query from a query name, JSON args, and optional execution time.
 func ProtoFromJSON(qryName, qryArgsJSON string, executionTime *time.Time) (*runtimev1.Query, error) {
 	qry := &runtimev1.Query{}
@@ -105,6 +122,10 @@ func ProtoFromJSON(qryName, qryArgsJSON string, executionTime *time.Time) (*runt
 		}
 		if executionTime != nil {
 			req.TimeRange = overrideTimeRange(req.TimeRange, *executionTime)
+			// A relative comparison time range is anchored to the execution time as well, so its offset is applied relative to the base time range.
+			if ctr := req.ComparisonTimeRange; ctr != nil && ctr.IsoDuration != "" && ctr.Start == nil {
+				req.ComparisonTimeRange = overrideTimeRange(ctr, *executionTime)
+			}
 		}
 	default:
 		return nil, fmt.Errorf("query %q not supported for reports", qryName)
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoTimeDimension(t *testing.T) {
+	q, err := ProtoFromJSON("MetricsViewAggregation", `{"metricsView":"orders","timeDimension":"shipped"}`, nil)
+	require.NoError(t, err)
+	require.Equal(t, "shipped", ProtoTimeDimension(q))
+
+	q, err = ProtoFromJSON("MetricsViewComparison", `{"metricsViewName":"orders","time_dimension":"delivered"}`, nil)
+	require.NoError(t, err)
+	require.Equal(t, "delivered", ProtoTimeDimension(q))
+
+	q, err = ProtoFromJSON("MetricsViewToplist", `{"metricsViewName":"orders"}`, nil)
+	require.NoError(t, err)
+	require.Empty(t, ProtoTimeDimension(q))
+}
@@ -12,14 +12,19 @@ import (
 )
 
 type ResourceWatermark struct {
-	ResourceKind string     `json:"resource_kind,omitempty"`
-	ResourceName string     `json:"resource_name,omitempty"`
-	Result       *time.Time `json:"-"`
+	ResourceKind string `json:"resource_kind,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	// TimeDimension optionally overrides the time dimension that the watermark of a metrics view is computed for.
+	TimeDimension string     `json:"time_dimension,omitempty"`
+	Result        *time.Time `json:"-"`
 }
 
 var _ runtime.Query = &ResourceWatermark{}
 
 func (q *ResourceWatermark) Key() string {
+	if q.TimeDimension != "" {
+		return fmt.Sprintf("ResourceWatermark:%s/%s/%s", q.ResourceKind, q.ResourceName, q.TimeDimension)
+	}
 	return fmt.Sprintf("ResourceWatermark:%s/%s", q.ResourceKind, q.ResourceName)
 }
 
@@ -80,6 +85,17 @@ func (q *ResourceWatermark) resolveMetricsView(ctx context.Context, rt *runtime.
 		return fmt.Errorf("metrics view %q is not valid", rs.Meta.Name.Name)
 	}
 
+	olap, release, err := rt.OLAP(ctx, instanceID, spec.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	spec, err = metricsViewWithTimeDimension(ctx, olap.InformationSchema(), spec, q.TimeDimension, nil)
+	if err != nil {
+		return err
+	}
+
 	sql := ""
 	if spec.WatermarkExpression != "" {
 		sql = fmt.Sprintf("SELECT %s FROM %s", spec.WatermarkExpression, safeName(spec.Table))
@@ -90,12 +106,6 @@ func (q *ResourceWatermark) resolveMetricsView(ctx context.Context, rt *runtime.
 		return nil
 	}
 
-	olap, release, err := rt.OLAP(ctx, instanceID, spec.Connector)
-	if err != nil {
-		return err
-	}
-	defer release()
-
 	res, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            sql,
 		Priority:         priority,
@@ -18,7 +18,7 @@ func TestResourceWatermark_MetricsView(t *testing.T) {
 
 	rt, instanceID := testruntime.NewInstance(t)
 	testruntime.PutFiles(t, rt, instanceID, map[string]string{
-		"/models/foo.sql": fmt.Sprintf(`SELECT '%s'::TIMESTAMP as time`, ts.Add(-time.Second).Format(time.RFC3339)),
+		"/models/foo.sql": fmt.Sprintf(`SELECT '%s'::TIMESTAMP as time, '%s'::TIMESTAMP as shipped_at`, ts.Add(-time.Second).Format(time.RFC3339), ts.Add(-time.Hour-time.Second).Format(time.RFC3339)),
 		"/dashboards/bare.yaml": `
 model: foo
 measures:
@@ -28,6 +28,9 @@ measures:
 		"/dashboards/with_time_dimension.yaml": `
 model: foo
 timeseries: time
+dimensions:
+- name: shipped
+  column: shipped_at
 measures:
 - name: a
   expression: count(*)
@@ -62,6 +65,19 @@ measures:
 	require.NoError(t, err)
 	require.Equal(t, ts, *q.Result)
 
+	// "with_time_dimension" should have a watermark of the max of another time dimension when it's overridden
+	q = &queries.ResourceWatermark{
+		ResourceKind:  runtime.ResourceKindMetricsView,
+		ResourceName:  "with_time_dimension",
+		TimeDimension: "shipped",
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, ts.Add(-time.Hour), *q.Result)
+
+	// Overrides are cached separately
+	require.NotEqual(t, q.Key(), (&queries.ResourceWatermark{ResourceKind: q.ResourceKind, ResourceName: q.ResourceName}).Key())
+
 	// "with_watermark_expression" should have a watermark that's one day less than the max time
 	q = &queries.ResourceWatermark{
 		ResourceKind: runtime.ResourceKindMetricsView,
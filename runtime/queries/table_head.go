@@ -100,14 +100,14 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if supportsDuckDBExport(opts.Format) {
 			filename := q.TableName
 			sql, err := q.buildTableHeadSQL(ctx, olap)
 			if err != nil {
 				return err
 			}
 			args := []interface{}{}
-			if err := DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
+			if err := DuckDBExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
 			}
 		} else {
@@ -154,6 +154,12 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 		return WriteXLSX(meta, q.Result, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return WriteAvro(meta, q.Result, w)
 	}
 
 	return nil
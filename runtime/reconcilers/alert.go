@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -18,6 +19,7 @@ import (
 	"github.com/rilldata/rill/runtime/queries"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -256,6 +258,17 @@ func (r *AlertReconciler) executionSpecHash(spec *runtimev1.AlertSpec, refs []*r
 		return "", err
 	}
 
+	if spec.Anomaly != nil {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(spec.Anomaly)
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write(data)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	_, err = hash.Write([]byte(spec.GetQueryForUserId()))
 	if err != nil {
 		return "", err
@@ -444,7 +457,7 @@ func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1
 	// Evaluate watermark unless refs check failed.
 	watermark := triggerTime
 	if a.Spec.WatermarkInherit {
-		t, ok, err := r.computeInheritedWatermark(ctx, self.Meta.Refs)
+		t, ok, err := r.computeInheritedWatermark(ctx, self.Meta.Refs, alertTimeDimension(a))
 		if err != nil {
 			return err
 		}
@@ -533,12 +546,6 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	// Log
 	r.C.Logger.Debug("Checking alert", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
 
-	// Build query proto
-	qpb, err := queries.ProtoFromJSON(a.Spec.QueryName, a.Spec.QueryArgsJson, &executionTime)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse query: %w", err)
-	}
-
 	// Evaluate query attributes
 	var queryForAttrs map[string]any
 	if adminMeta != nil {
@@ -548,12 +555,35 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 		queryForAttrs = a.Spec.GetQueryForAttributes().AsMap()
 	}
 
-	// Create and execute query
-	q, err := queries.ProtoToQuery(qpb, queryForAttrs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build query: %w", err)
+	// Create query
+	var q runtime.Query
+	if spec := a.Spec.Anomaly; spec != nil {
+		q = &queries.MetricsViewAnomalies{
+			MetricsViewName:    spec.MetricsView,
+			MeasureNames:       spec.Measures,
+			DimensionName:      spec.Dimension,
+			DimensionLimit:     int64(spec.DimensionLimit),
+			TimeGranularity:    spec.TimeGrain,
+			TimeZone:           spec.TimeZone,
+			BaselineDuration:   spec.BaselineIsoDuration,
+			BaselineMethod:     spec.BaselineMethod,
+			ThresholdSigma:     spec.ThresholdSigma,
+			ExecutionTime:      executionTime,
+			SecurityAttributes: queryForAttrs,
+		}
+	} else {
+		qpb, err := queries.ProtoFromJSON(a.Spec.QueryName, a.Spec.QueryArgsJson, &executionTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse query: %w", err)
+		}
+		q, err = queries.ProtoToQuery(qpb, queryForAttrs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build query: %w", err)
+		}
 	}
-	err = r.C.Runtime.Query(ctx, r.C.InstanceID, q, alertQueryPriority)
+
+	// Execute query
+	err := r.C.Runtime.Query(ctx, r.C.InstanceID, q, alertQueryPriority)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -578,7 +608,7 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	return &runtimev1.AssertionResult{Status: runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, FailRow: failRow}, nil
 }
 
-// popCurrentExecution moves the current execution into the execution history and sends emails if the execution matched the emailing criteria.
+// popCurrentExecution moves the current execution into the execution history and sends emails and notifications if the execution matched the emailing criteria.
 // At a certain limit, it trims old executions from the history to prevent it from growing unboundedly.
 func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata) error {
 	if a.State.CurrentExecution == nil {
@@ -709,6 +739,25 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			}
 		}
 		sentEmails = true
+
+		// Deliver to the other notification channels.
+		// Failed deliveries are tracked in the execution's notification statuses instead of overriding the assertion result.
+		if len(a.Spec.Notifiers) > 0 {
+			status := &drivers.AlertStatus{
+				Name:           self.Meta.Name.Name,
+				Title:          msg.Title,
+				ExecutionTime:  msg.ExecutionTime,
+				Status:         msg.Status,
+				IsRecover:      msg.IsRecover,
+				FailRow:        msg.FailRow,
+				ExecutionError: msg.ExecutionError,
+				OpenLink:       msg.OpenLink,
+				EditLink:       msg.EditLink,
+			}
+			a.State.CurrentExecution.Notifications = sendNotifications(ctx, r.C, a.Spec.Notifiers, func(n drivers.Notifier, props map[string]any) error {
+				return n.SendAlertStatus(ctx, props, status)
+			})
+		}
 	}
 
 	// If sending emails failed, add the error as an execution error.
@@ -733,14 +782,18 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 }
 
 // computeInheritedWatermark computes the inherited watermark for the alert.
+// If timeDimension is set, the watermarks of metrics views are computed for that time dimension instead of the default one.
 // It returns false if the watermark could not be computed.
-func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []*runtimev1.ResourceName) (time.Time, bool, error) {
+func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []*runtimev1.ResourceName, timeDimension string) (time.Time, bool, error) {
 	var t time.Time
 	for _, ref := range refs {
 		q := &queries.ResourceWatermark{
 			ResourceKind: ref.Kind,
 			ResourceName: ref.Name,
 		}
+		if ref.Kind == runtime.ResourceKindMetricsView {
+			q.TimeDimension = timeDimension
+		}
 		err := r.C.Runtime.Query(ctx, r.C.InstanceID, q, alertQueryPriority)
 		if err != nil {
 			return t, false, fmt.Errorf("failed to resolve watermark for %s/%s: %w", ref.Kind, ref.Name, err)
@@ -754,6 +807,19 @@ func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []
 	return t, !t.IsZero(), nil
 }
 
+// alertTimeDimension returns the time dimension override of the alert's query, if any.
+// Errors parsing the query are ignored here since they are reported when the query is executed.
+func alertTimeDimension(a *runtimev1.Alert) string {
+	if a.Spec.Anomaly != nil || a.Spec.QueryName == "" {
+		return ""
+	}
+	qpb, err := queries.ProtoFromJSON(a.Spec.QueryName, a.Spec.QueryArgsJson, nil)
+	if err != nil {
+		return ""
+	}
+	return queries.ProtoTimeDimension(qpb)
+}
+
 // calculateExecutionTimes calculates the execution times for an alert, taking into consideration the alert's intervals configuration and previous executions.
 // If the alert is not configured to run on intervals, it will return a slice containing only the current watermark.
 func calculateExecutionTimes(a *runtimev1.Alert, watermark, previousWatermark time.Time) ([]time.Time, error) {
@@ -868,6 +934,24 @@ func extractQueryResultFirstRow(q runtime.Query) (map[string]any, bool, error) {
 			return res, true, nil
 		}
 		return nil, false, nil
+	case *queries.MetricsViewAnomalies:
+		if q.Result != nil && len(q.Result.Anomalies) > 0 {
+			anomaly := q.Result.Anomalies[0]
+			res := make(map[string]any)
+			if q.DimensionName != "" {
+				res[q.DimensionName] = anomaly.DimensionValue
+			}
+			res["measure"] = anomaly.Measure
+			res["interval_start"] = q.Result.IntervalStart.Format(time.RFC3339)
+			res["value"] = anomaly.Value
+			res["baseline"] = anomaly.Baseline
+			if !math.IsInf(anomaly.Deviation, 0) {
+				res["deviation (σ)"] = anomaly.Deviation
+			}
+			res["anomalies"] = len(q.Result.Anomalies)
+			return res, true, nil
+		}
+		return nil, false, nil
 	default:
 		return nil, false, fmt.Errorf("query type %T not supported for alerts", q)
 	}
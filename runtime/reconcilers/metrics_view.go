@@ -79,6 +79,12 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 	if ctx.Err() != nil {
 		return runtime.ReconcileResult{Err: errors.Join(validateErr, ctx.Err())}
 	}
+
+	// Data quality tests configured with block_downstream prevent the metrics view from being served while they are failing.
+	if validateErr == nil {
+		validateErr = checkBlockingTests(ctx, r.C, self.Meta.Refs)
+	}
+
 	if validateErr == nil {
 		mv.State.ValidSpec = mv.Spec
 	} else {
@@ -5,14 +5,19 @@ import (
 	"crypto/md5"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -118,6 +123,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 			model.State.Table = ""
 			model.State.SpecHash = ""
 			model.State.RefreshedOn = nil
+			model.State.IncrementalState = nil
+			model.State.Partitions = nil
 			subErr := r.C.UpdateState(ctx, self.Meta.Name, self)
 			if subErr != nil {
 				r.C.Logger.Error("refs check: failed to update state", zap.Any("error", subErr))
@@ -150,6 +157,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// Check if the model still exists (might have been corrupted/lost somehow)
 	t, exists := olapTableInfo(ctx, r.C, model.State.Connector, model.State.Table)
 
+	// Incremental models insert new data into the existing table when refreshed.
+	// They are only rebuilt from scratch if the table doesn't exist or the spec has changed since the last run.
+	incrementalRun := model.Spec.Incremental && exists
+	incrementalRun = incrementalRun && model.State.Table == tableName
+	incrementalRun = incrementalRun && model.State.Connector == model.Spec.Connector
+	incrementalRun = incrementalRun && model.State.SpecHash == hash
+
 	// Decide if we should trigger an update
 	trigger := model.Spec.Trigger
 	trigger = trigger || model.State.Table == ""
@@ -163,7 +177,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// This is useful to support keystroke-by-keystroke editing.
 	var delayedMaterializeOn time.Time
 	var delayedMaterialize bool
-	if !trigger && materialize && t.View {
+	if !trigger && materialize && !model.Spec.Incremental && t.View {
 		var refreshedOn time.Time
 		if model.State.RefreshedOn != nil {
 			refreshedOn = model.State.RefreshedOn.AsTime()
@@ -183,6 +197,12 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Retrigger: refreshOn}
 	}
 
+	// Track the existing table to detect schema changes when the model is rebuilt
+	var prevTable *drivers.Table
+	if exists && !incrementalRun && model.State.Connector == model.Spec.Connector {
+		prevTable = t
+	}
+
 	// If the Connector was changed, drop data in the old connector
 	if model.State.Table != "" && model.State.Connector != model.Spec.Connector {
 		if t, ok := olapTableInfo(ctx, r.C, model.State.Connector, model.State.Table); ok {
@@ -193,8 +213,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
-	// Always stage changes if running a delayed materialization
-	stage := model.Spec.StageChanges || delayedMaterialize
+	// Always stage changes if running a delayed materialization (but never for incremental runs, which insert into the existing table).
+	// We also stage changes if the model should fail on schema changes, so the existing table is retained if the schema changed.
+	failOnSchemaChange := model.Spec.OnSchemaChange == runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_FAIL && prevTable != nil
+	stage := (model.Spec.StageChanges || delayedMaterialize || failOnSchemaChange) && !incrementalRun
 	stagingTableName := tableName
 	if stage {
 		stagingTableName = r.stagingTableName(tableName)
@@ -202,7 +224,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Determine if we should delay materialization (note difference between "delayedMaterialize" and "delayingMaterialize")
 	delayingMaterialize := false
-	if !delayedMaterialize && materialize && model.State.SpecHash != hash && model.Spec.MaterializeDelaySeconds > 0 {
+	if !delayedMaterialize && materialize && !model.Spec.Incremental && model.State.SpecHash != hash && model.Spec.MaterializeDelaySeconds > 0 {
 		delayingMaterialize = true
 		materialize = false
 	}
@@ -216,19 +238,45 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		r.C.Logger.Info("Materializing model", zap.String("name", n.Name))
 	}
 
-	// Drop the staging table if it exists
+	// Drop the staging table if it exists (for incremental runs, we insert directly into the existing table)
 	connector := model.Spec.Connector
-	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
-		olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+	if !incrementalRun {
+		if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
+			olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+		}
 	}
 
 	// Create the model
-	createErr := r.createModel(ctx, self, stagingTableName, !materialize)
-	if createErr != nil {
-		createErr = fmt.Errorf("failed to create model: %w", createErr)
-	} else if !r.C.Runtime.AllowHostAccess() {
-		// temporarily for debugging
-		logTableNameAndType(ctx, r.C, connector, stagingTableName)
+	var createErr error
+	var partitions []*runtimev1.ModelPartition
+	var schemaChanges []*runtimev1.SchemaChange
+	if model.Spec.PartitionsResolver != "" {
+		partitions, schemaChanges, createErr = r.createPartitionedModel(ctx, self, stagingTableName, incrementalRun)
+		if createErr != nil {
+			createErr = fmt.Errorf("failed to execute partitioned model: %w", createErr)
+		}
+	} else if incrementalRun {
+		schemaChanges, createErr = r.createModel(ctx, self, tableName, false, true, nil)
+		if createErr != nil {
+			createErr = fmt.Errorf("failed to incrementally refresh model: %w", createErr)
+		}
+	} else {
+		_, createErr = r.createModel(ctx, self, stagingTableName, !materialize, false, nil)
+		if createErr != nil {
+			createErr = fmt.Errorf("failed to create model: %w", createErr)
+		} else if !r.C.Runtime.AllowHostAccess() {
+			// temporarily for debugging
+			logTableNameAndType(ctx, r.C, connector, stagingTableName)
+		}
+	}
+
+	// Detect and handle schema changes compared to the previous table.
+	// For incremental runs, schema changes were already handled before inserting into the existing table.
+	if createErr == nil && !incrementalRun {
+		schemaChanges, createErr = olapApplySchemaChangePolicy(ctx, r.C, connector, model.Spec.OnSchemaChange, prevTable, stagingTableName, !materialize)
+	}
+	if len(schemaChanges) > 0 {
+		r.C.Logger.Info("Detected model schema changes", zap.String("name", n.Name), zap.Int("changes", len(schemaChanges)))
 	}
 
 	if createErr == nil && stage {
@@ -239,9 +287,20 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
+	// For incremental models, resolve the state that will be passed to the SQL template on the next incremental run
+	var incrementalState *structpb.Struct
+	var stateErr error
+	if createErr == nil && model.Spec.Incremental {
+		incrementalState, stateErr = r.resolveIncrementalState(ctx, model.Spec)
+		if stateErr != nil {
+			stateErr = fmt.Errorf("failed to resolve incremental state: %w", stateErr)
+		}
+	}
+
 	// How we handle ingestErr depends on several things:
 	// If ctx was cancelled, we cleanup and exit
-	// If StageChanges is true, we retain the existing table, but still return the error.
+	// If it was an incremental run, the failed insert did not change the existing table, so we retain it and its state, but still return the error.
+	// If StageChanges is true or the model fails on schema changes, we retain the existing table, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
@@ -255,16 +314,42 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Update state
 	update := false
-	if createErr == nil {
+	if createErr == nil && stateErr == nil {
 		// Successful ingestion
 		update = true
 		model.State.Connector = connector
 		model.State.Table = tableName
 		model.State.SpecHash = hash
 		model.State.RefreshedOn = timestamppb.Now()
-	} else if model.Spec.StageChanges {
+		model.State.IncrementalState = incrementalState
+		model.State.Partitions = partitions
+		model.State.SchemaChanges = schemaChanges
+	} else if createErr == nil {
+		// Successful ingestion, but the incremental state could not be resolved.
+		// We clear the spec hash to force a full rebuild on the next run, since the data may not be consistent with the previous state.
+		update = true
+		model.State.Connector = connector
+		model.State.Table = tableName
+		model.State.SpecHash = ""
+		model.State.RefreshedOn = timestamppb.Now()
+		model.State.IncrementalState = nil
+		model.State.Partitions = partitions
+		model.State.SchemaChanges = schemaChanges
+		createErr = stateErr
+	} else if incrementalRun {
+		// Failed incremental insert into the main table.
+		// The insert is atomic, so we retain the existing table and state, but record schema changes that caused the failure.
+		if len(schemaChanges) > 0 {
+			update = true
+			model.State.SchemaChanges = schemaChanges
+		}
+	} else if model.Spec.StageChanges || failOnSchemaChange {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, !materialize)
+		if len(schemaChanges) > 0 {
+			update = true
+			model.State.SchemaChanges = schemaChanges
+		}
 	} else {
 		// Failed ingestion to main table
 		update = true
@@ -273,6 +358,9 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.Table = ""
 		model.State.SpecHash = ""
 		model.State.RefreshedOn = nil
+		model.State.IncrementalState = nil
+		model.State.Partitions = nil
+		model.State.SchemaChanges = schemaChanges
 	}
 	if update {
 		// We don't UpdateState for delayed materializations to avoid triggering derived models to re-compute materializations redundantly (since ref's state versions are incorporated into the hash).
@@ -285,6 +373,11 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
+	// Surface errors for partitions that failed to execute. They are retried on the next refresh.
+	if createErr == nil {
+		createErr = partitionsError(partitions)
+	}
+
 	// See earlier note – essential cleanup is done, we can return now.
 	if ctx.Err() != nil {
 		return runtime.ReconcileResult{Err: createErr}
@@ -343,7 +436,8 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		}
 
 		// Incorporate the ref's state info in the hash if and only if we are supposed to trigger when a ref has refreshed (denoted by RefreshSchedule.RefUpdate).
-		if spec.RefreshSchedule != nil && spec.RefreshSchedule.RefUpdate {
+		// Incremental models are never triggered by ref updates since a change in the hash causes a full rebuild.
+		if spec.RefreshSchedule != nil && spec.RefreshSchedule.RefUpdate && !spec.Incremental {
 			// Note: Only writing the state info to the hash, not spec version, because it doesn't matter whether the spec/meta changes, only whether the state changes.
 			// Note: Also using StateUpdatedOn because the state version is reset when the resource is deleted and recreated.
 			r, err := r.C.Get(ctx, ref, false)
@@ -390,6 +484,57 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		return "", err
 	}
 
+	if spec.Incremental {
+		err = binary.Write(hash, binary.BigEndian, spec.Incremental)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = hash.Write([]byte(spec.IncrementalStateResolver))
+		if err != nil {
+			return "", err
+		}
+
+		if spec.IncrementalStateResolverProperties != nil {
+			props, err := protojson.Marshal(spec.IncrementalStateResolverProperties)
+			if err != nil {
+				return "", err
+			}
+			_, err = hash.Write(props)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		err = binary.Write(hash, binary.BigEndian, int32(spec.IncrementalStrategy))
+		if err != nil {
+			return "", err
+		}
+
+		for _, k := range spec.UniqueKey {
+			_, err = hash.Write([]byte(k))
+			if err != nil {
+				return "", err
+			}
+		}
+
+		_, err = hash.Write([]byte(spec.PartitionsResolver))
+		if err != nil {
+			return "", err
+		}
+
+		if spec.PartitionsResolverProperties != nil {
+			props, err := protojson.Marshal(spec.PartitionsResolverProperties)
+			if err != nil {
+				return "", err
+			}
+			_, err = hash.Write(props)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -414,10 +559,13 @@ func (r *ModelReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Reso
 }
 
 // createModel creates or updates the model in the OLAP connector.
-func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, tableName string, view bool) error {
+// If incremental is true, it inserts the model's output into the existing table using the model's incremental strategy.
+// Before inserting, it applies the model's schema change policy (if any) to the existing table and returns the detected schema changes.
+// The partition is passed to the SQL template for partitioned models; it should be nil for other models.
+func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, tableName string, view, incremental bool, partition map[string]any) ([]*runtimev1.SchemaChange, error) {
 	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	spec := self.Resource.(*runtimev1.Resource_Model).Model.Spec
@@ -425,7 +573,7 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 
 	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer release()
 
@@ -435,6 +583,11 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 			Environment: inst.Environment,
 			User:        map[string]interface{}{},
 			Variables:   inst.ResolveVariables(),
+			ExtraProps: map[string]any{
+				"incremental":       incremental,
+				"incremental_state": state.IncrementalState.AsMap(),
+				"partition":         partition,
+			},
 			Self: compilerv1.TemplateResource{
 				Meta:  self.Meta,
 				Spec:  spec,
@@ -459,7 +612,7 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("failed to resolve template: %w", err)
+			return nil, fmt.Errorf("failed to resolve template: %w", err)
 		}
 	} else {
 		sql = spec.Sql
@@ -476,5 +629,234 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 		defer cancel()
 	}
 
-	return olap.CreateTableAsSelect(ctx, tableName, view, sql)
+	if incremental {
+		var schemaChanges []*runtimev1.SchemaChange
+		if spec.OnSchemaChange != runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_UNSPECIFIED {
+			schemaChanges, err = olapEvolveTableSchema(ctx, olap, tableName, sql, spec.OnSchemaChange)
+			if err != nil {
+				return schemaChanges, err
+			}
+		}
+
+		var strategy drivers.IncrementalStrategy
+		switch spec.IncrementalStrategy {
+		case runtimev1.ModelIncrementalStrategy_MODEL_INCREMENTAL_STRATEGY_MERGE:
+			strategy = drivers.IncrementalStrategyMerge
+		default:
+			strategy = drivers.IncrementalStrategyAppend
+		}
+		return schemaChanges, olap.InsertTableAsSelect(ctx, tableName, true, sql, strategy, spec.UniqueKey)
+	}
+
+	return nil, olap.CreateTableAsSelect(ctx, tableName, view, sql)
+}
+
+// resolveIncrementalState runs the model's incremental state resolver (if any) and returns the first row of its output.
+func (r *ModelReconciler) resolveIncrementalState(ctx context.Context, spec *runtimev1.ModelSpec) (*structpb.Struct, error) {
+	if spec.IncrementalStateResolver == "" {
+		return nil, nil
+	}
+
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           spec.IncrementalStateResolver,
+		ResolverProperties: spec.IncrementalStateResolverProperties.AsMap(),
+		Args:               map[string]any{"priority": 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]any
+	err = json.Unmarshal(res.Data, &rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	if len(rows) > 1 {
+		return nil, fmt.Errorf("state resolver returned %d rows, expected at most one", len(rows))
+	}
+
+	return structpb.NewStruct(rows[0])
+}
+
+// createPartitionedModel executes the model's SQL once for each partition returned by its partitions resolver.
+// On a full build, the first successful partition creates the table and the following partitions are inserted into it.
+// On an incremental run, only new partitions and partitions that failed in a previous run are executed.
+// If partitions were removed since the previous run, the table is rebuilt from the current partitions to remove their rows.
+// Errors for individual partitions are recorded in the returned partitions instead of being returned.
+// It also returns the schema changes detected when inserting partitions into the existing table.
+func (r *ModelReconciler) createPartitionedModel(ctx context.Context, self *runtimev1.Resource, tableName string, incremental bool) ([]*runtimev1.ModelPartition, []*runtimev1.SchemaChange, error) {
+	model := self.GetModel()
+
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           model.Spec.PartitionsResolver,
+		ResolverProperties: model.Spec.PartitionsResolverProperties.AsMap(),
+		Args:               map[string]any{"priority": 1},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve partitions: %w", err)
+	}
+
+	var rows []map[string]any
+	err = json.Unmarshal(res.Data, &rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]string, len(rows))
+	seen := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		keys[i], err = partitionKey(row)
+		if err != nil {
+			return nil, nil, err
+		}
+		seen[keys[i]] = true
+	}
+
+	// The rows of a removed partition can't be identified in the table, so we rebuild it if any partition was removed.
+	if incremental {
+		for _, p := range model.State.Partitions {
+			if !seen[p.Key] {
+				r.C.Logger.Info("Rebuilding partitioned model because partitions were removed", zap.String("name", self.Meta.Name.Name))
+				incremental = false
+				break
+			}
+		}
+	}
+
+	if !incremental && len(rows) == 0 {
+		return nil, nil, errors.New("the partitions resolver did not return any partitions")
+	}
+
+	// Partitions that executed successfully in a previous run are carried over.
+	// On a full build, all partitions are executed again.
+	prev := make(map[string]*runtimev1.ModelPartition)
+	if incremental {
+		for _, p := range model.State.Partitions {
+			prev[p.Key] = p
+		}
+	}
+
+	partitions := make([]*runtimev1.ModelPartition, 0, len(rows))
+	var pending []*runtimev1.ModelPartition
+	added := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		key := keys[i]
+		if added[key] {
+			continue
+		}
+		added[key] = true
+
+		if p, ok := prev[key]; ok && p.Error == "" {
+			partitions = append(partitions, p)
+			continue
+		}
+
+		data, err := structpb.NewStruct(row)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid partition data: %w", err)
+		}
+		p := &runtimev1.ModelPartition{Key: key, Data: data}
+		partitions = append(partitions, p)
+		pending = append(pending, p)
+	}
+
+	progress := &partitionsProgress{logger: r.C.Logger, name: self.Meta.Name.Name}
+	progress.Target(int64(len(pending)), drivers.ProgressUnitPartition)
+
+	// Execute the pending partitions.
+	// If ctx is cancelled, we stop and only track the partitions that were executed.
+	created := incremental
+	var executed int
+	var schemaChanges []*runtimev1.SchemaChange
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		start := time.Now()
+		changes, err := r.createModel(ctx, self, tableName, false, created, p.Data.AsMap())
+		schemaChanges = appendSchemaChanges(schemaChanges, changes)
+		p.ExecutedOn = timestamppb.Now()
+		p.ElapsedMs = uint32(time.Since(start).Milliseconds())
+		if err != nil {
+			p.Error = err.Error()
+		} else {
+			p.Error = ""
+			created = true
+		}
+		executed++
+
+		progress.Observe(1, drivers.ProgressUnitPartition)
+	}
+
+	// Drop partitions that were not executed due to cancellation (they'll be treated as new partitions on the next run)
+	if executed < len(pending) {
+		partitions = slices.DeleteFunc(partitions, func(p *runtimev1.ModelPartition) bool {
+			return p.ExecutedOn == nil
+		})
+	}
+
+	if !created {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		return nil, nil, fmt.Errorf("all %d partitions failed, first error: %s", len(pending), pending[0].Error)
+	}
+
+	return partitions, schemaChanges, nil
+}
+
+// partitionKey derives a stable key for a partition from its data.
+func partitionKey(data map[string]any) (string, error) {
+	// Note: json.Marshal sorts map keys, so the output is deterministic
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("invalid partition data: %w", err)
+	}
+	hash := md5.Sum(b)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// partitionsError returns an error summarizing the partitions that failed to execute (if any).
+func partitionsError(partitions []*runtimev1.ModelPartition) error {
+	var failed int
+	var first string
+	for _, p := range partitions {
+		if p.Error == "" {
+			continue
+		}
+		if failed == 0 {
+			first = p.Error
+		}
+		failed++
+	}
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d partitions failed, first error: %s", failed, len(partitions), first)
+}
+
+// partitionsProgress implements drivers.Progress for logging the progress of a partitioned model's execution.
+type partitionsProgress struct {
+	logger   *zap.Logger
+	name     string
+	target   int64
+	observed int64
+}
+
+var _ drivers.Progress = &partitionsProgress{}
+
+func (p *partitionsProgress) Target(val int64, unit drivers.ProgressUnit) {
+	p.target = val
+	p.logger.Info("Executing model partitions", zap.String("name", p.name), zap.Int64("partitions", val))
+}
+
+func (p *partitionsProgress) Observe(val int64, unit drivers.ProgressUnit) {
+	p.observed += val
+	p.logger.Debug("Executed model partition", zap.String("name", p.name), zap.Int64("executed", p.observed), zap.Int64("partitions", p.target))
 }
@@ -0,0 +1,67 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// notifierMaxAttempts is the number of times delivery to a notifier is attempted before giving up.
+const notifierMaxAttempts = 3
+
+// notifierRetryDelay is the delay before the first retry of a failed delivery. It doubles on every subsequent retry.
+var notifierRetryDelay = 5 * time.Second
+
+// sendNotifications delivers a notification to each of the notifiers using the send function.
+// Failed deliveries are retried with exponential backoff. It returns the delivery status for each notifier.
+func sendNotifications(ctx context.Context, c *runtime.Controller, notifiers []*runtimev1.Notifier, send func(n drivers.Notifier, props map[string]any) error) []*runtimev1.NotificationStatus {
+	res := make([]*runtimev1.NotificationStatus, 0, len(notifiers))
+	for _, n := range notifiers {
+		status := &runtimev1.NotificationStatus{Connector: n.Connector}
+		err := sendNotification(ctx, c, n, status, send)
+		if err != nil {
+			status.ErrorMessage = err.Error()
+			c.Logger.Warn("Failed to deliver notification", zap.String("connector", n.Connector), zap.Uint32("attempts", status.Attempts), zap.Error(err))
+		} else {
+			status.Delivered = true
+		}
+		res = append(res, status)
+	}
+	return res
+}
+
+// sendNotification delivers a notification to a single notifier. It increments status.Attempts for every delivery attempt.
+func sendNotification(ctx context.Context, c *runtime.Controller, n *runtimev1.Notifier, status *runtimev1.NotificationStatus, send func(n drivers.Notifier, props map[string]any) error) error {
+	handle, release, err := c.AcquireConn(ctx, n.Connector)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connector %q: %w", n.Connector, err)
+	}
+	defer release()
+
+	notifier, ok := handle.AsNotifier()
+	if !ok {
+		return fmt.Errorf("connector %q does not support notifications", n.Connector)
+	}
+
+	props := n.Properties.AsMap()
+	delay := notifierRetryDelay
+	for {
+		status.Attempts++
+		err = send(notifier, props)
+		if err == nil || status.Attempts >= notifierMaxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
@@ -547,6 +547,10 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, inst
 		if existing == nil || !equalAPISpec(existing.GetApi().Spec, def.APISpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Api{Api: &runtimev1.API{Spec: def.APISpec}}}
 		}
+	case compilerv1.ResourceKindTest:
+		if existing == nil || !equalTestSpec(existing.GetTest().Spec, def.TestSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Test{Test: &runtimev1.Test{Spec: def.TestSpec}}}
+		}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", def.Name.Kind))
 	}
@@ -723,3 +727,7 @@ func equalDashboardSpec(a, b *runtimev1.DashboardSpec) bool {
 func equalAPISpec(a, b *runtimev1.APISpec) bool {
 	return proto.Equal(a, b)
 }
+
+func equalTestSpec(a, b *runtimev1.TestSpec) bool {
+	return proto.Equal(a, b)
+}
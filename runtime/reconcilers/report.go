@@ -10,6 +10,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server"
@@ -314,6 +315,23 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 		}
 	}
 
+	// Deliver to the other notification channels.
+	// Like for alerts, failed deliveries are tracked in the execution's notification statuses instead of failing the report.
+	if len(rep.Spec.Notifiers) > 0 {
+		report := &drivers.ScheduledReport{
+			Name:           self.Meta.Name.Name,
+			Title:          rep.Spec.Title,
+			ReportTime:     t,
+			DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+			OpenLink:       meta.OpenURL,
+			DownloadLink:   exportURL.String(),
+			EditLink:       meta.EditURL,
+		}
+		rep.State.CurrentExecution.Notifications = sendNotifications(ctx, r.C, rep.Spec.Notifiers, func(n drivers.Notifier, props map[string]any) error {
+			return n.SendScheduledReport(ctx, props, report)
+		})
+	}
+
 	return false, nil
 }
 
@@ -325,6 +343,12 @@ func formatExportFormat(f runtimev1.ExportFormat) string {
 		return "Excel"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return "Parquet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return "Arrow"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "JSON Lines"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return "Avro"
 	default:
 		return f.String()
 	}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -22,6 +23,9 @@ import (
 
 const _defaultIngestTimeout = 60 * time.Minute
 
+// _defaultStreamInterval is the default interval between micro-batches for sources with stream ingestion.
+const _defaultStreamInterval = 5 * time.Second
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindSource, newSourceReconciler)
 }
@@ -80,6 +84,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Handle deletion
 	if self.Meta.DeletedOn != nil {
+		r.closeStream(ctx, self, tableName)
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, src.State.Table, false)
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(tableName), false)
 		return runtime.ReconcileResult{}
@@ -102,6 +107,15 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 				return runtime.ReconcileResult{Err: err}
 			}
 		}
+		// The stream ID is derived from the name, so streaming sources start over from a new stream
+		if len(src.State.StreamOffsets) > 0 {
+			r.closeStream(ctx, self, self.Meta.RenamedFrom.Name)
+			src.State.StreamOffsets = nil
+			err = r.C.UpdateState(ctx, self.Meta.Name, self)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+		}
 		// Note: Not exiting early. It might need to be (re-)ingested, and we need to set the correct retrigger time based on the refresh schedule.
 	}
 
@@ -145,9 +159,14 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Check if the table still exists (might have been corrupted/lost somehow)
 	tableExists := false
+	var prevTable *drivers.Table
 	if src.State.Table != "" {
-		_, ok := olapTableInfo(ctx, r.C, src.State.Connector, src.State.Table)
-		tableExists = ok // NOTE: Not checking if it's a view because some backends will represent sources as views (like DuckDB with external table storage enabled)
+		prevTable, tableExists = olapTableInfo(ctx, r.C, src.State.Connector, src.State.Table) // NOTE: Not checking if it's a view because some backends will represent sources as views (like DuckDB with external table storage enabled)
+	}
+
+	// Streaming sources continuously ingest micro-batches instead of refreshing on a schedule
+	if src.Spec.StreamIngestion {
+		return r.reconcileStream(ctx, self, hash, tableExists)
 	}
 
 	// Decide if we should trigger a refresh
@@ -167,12 +186,16 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	if src.State.Table != "" && src.State.Connector != src.Spec.SinkConnector {
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, src.State.Table, false)
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(src.State.Table), false)
+		prevTable = nil
 	}
 
-	// Prepare for ingestion
+	// Prepare for ingestion.
+	// We also stage changes if the source should fail on schema changes, so the existing table is retained if the schema changed.
+	failOnSchemaChange := src.Spec.OnSchemaChange == runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_FAIL && prevTable != nil
+	stage := src.Spec.StageChanges || failOnSchemaChange
 	stagingTableName := tableName
 	connector := src.Spec.SinkConnector
-	if src.Spec.StageChanges {
+	if stage {
 		stagingTableName = r.stagingTableName(tableName)
 	}
 
@@ -191,7 +214,16 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		logTableNameAndType(ctx, r.C, connector, stagingTableName)
 	}
 
-	if ingestErr == nil && src.Spec.StageChanges {
+	// Detect and handle schema changes compared to the previous table
+	var schemaChanges []*runtimev1.SchemaChange
+	if ingestErr == nil {
+		schemaChanges, ingestErr = olapApplySchemaChangePolicy(ctx, r.C, connector, src.Spec.OnSchemaChange, prevTable, stagingTableName, false)
+		if len(schemaChanges) > 0 {
+			r.C.Logger.Info("Detected source schema changes", zap.String("name", n.Name), zap.Int("changes", len(schemaChanges)))
+		}
+	}
+
+	if ingestErr == nil && stage {
 		// Rename staging table to main table
 		err = olapForceRenameTable(ctx, r.C, connector, stagingTableName, false, tableName)
 		if err != nil {
@@ -201,8 +233,8 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// How we handle ingestErr depends on several things:
 	// If ctx was cancelled, we cleanup and exit
-	// If StageChanges is true, we retain the existing table, but still return the error.
-	// If StageChanges is false, we clear the existing table and return the error.
+	// If changes were staged, we retain the existing table, but still return the error.
+	// If changes were not staged, we clear the existing table and return the error.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
 	// We just clean up temp tables and state, then return.
@@ -222,9 +254,15 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = tableName
 		src.State.SpecHash = hash
 		src.State.RefreshedOn = timestamppb.Now()
-	} else if src.Spec.StageChanges {
-		// Failed ingestion to staging table
+		src.State.SchemaChanges = schemaChanges
+	} else if stage {
+		// Failed ingestion to staging table.
+		// We still record schema changes that caused the failure.
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
+		if len(schemaChanges) > 0 {
+			update = true
+			src.State.SchemaChanges = schemaChanges
+		}
 	} else {
 		// Failed ingestion to main table
 		update = true
@@ -233,6 +271,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = ""
 		src.State.SpecHash = ""
 		src.State.RefreshedOn = nil
+		src.State.SchemaChanges = schemaChanges
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -263,6 +302,78 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	return runtime.ReconcileResult{Err: ingestErr, Retrigger: refreshOn}
 }
 
+// reconcileStream ingests a micro-batch of new data for a source with stream ingestion enabled.
+// The offsets to resume from are persisted in the state, and the source is retriggered after a short interval to ingest the next micro-batch.
+// Unlike regular sources, the data is appended directly to the table (stage_changes is not applicable).
+func (r *SourceReconciler) reconcileStream(ctx context.Context, self *runtimev1.Resource, hash string, tableExists bool) runtime.ReconcileResult {
+	src := self.GetSource()
+	tableName := self.Meta.Name.Name
+	connector := src.Spec.SinkConnector
+
+	// Start over from the source's start position if the spec changed, a full refresh was triggered, or the table has disappeared
+	reset := src.Spec.Trigger
+	reset = reset || src.State.SpecHash != hash
+	reset = reset || src.State.Connector != connector
+	reset = reset || src.State.Table != "" && !tableExists
+	if reset {
+		if src.State.Table != "" {
+			olapDropTableIfExists(ctx, r.C, src.State.Connector, src.State.Table, false)
+		}
+		olapDropTableIfExists(ctx, r.C, connector, tableName, false)
+		src.State.Connector = connector
+		src.State.Table = ""
+		src.State.SpecHash = hash
+		src.State.RefreshedOn = nil
+		src.State.StreamOffsets = nil
+	}
+
+	// Ingest the next micro-batch
+	res, ingestErr := r.ingestStream(ctx, self, tableName)
+	if ingestErr != nil {
+		ingestErr = fmt.Errorf("failed to ingest source: %w", ingestErr)
+	}
+
+	// Update state. On failure, we keep the previously ingested data and retry from the same offsets.
+	update := reset
+	if ingestErr == nil && (res.Rows > 0 || !maps.Equal(res.Offsets, src.State.StreamOffsets)) {
+		update = true
+		src.State.StreamOffsets = res.Offsets
+		if res.Rows > 0 {
+			src.State.RefreshedOn = timestamppb.Now()
+		}
+		// The table is created when the first micro-batch with data is ingested
+		if _, ok := olapTableInfo(ctx, r.C, connector, tableName); ok {
+			src.State.Table = tableName
+		}
+	}
+	if update {
+		err := r.C.UpdateState(ctx, self.Meta.Name, self)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	if ctx.Err() != nil {
+		return runtime.ReconcileResult{Err: errors.Join(ingestErr, ctx.Err())}
+	}
+
+	// Reset spec.Trigger
+	if src.Spec.Trigger {
+		err := r.setTriggerFalse(ctx, self.Meta.Name)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	// The refresh ticker, if set, overrides the interval between micro-batches
+	interval := _defaultStreamInterval
+	if src.Spec.RefreshSchedule != nil && src.Spec.RefreshSchedule.TickerSeconds > 0 {
+		interval = time.Duration(src.Spec.RefreshSchedule.TickerSeconds) * time.Second
+	}
+
+	return runtime.ReconcileResult{Err: ingestErr, Retrigger: time.Now().Add(interval)}
+}
+
 // ingestionSpecHash computes a hash of only those source spec properties that impact ingestion.
 func (r *SourceReconciler) ingestionSpecHash(spec *runtimev1.SourceSpec) (string, error) {
 	hash := md5.New()
@@ -365,6 +476,10 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 	release()
 
 	// Execute the data transfer
+	tempDir, err := r.C.Runtime.TempDir(r.C.InstanceID)
+	if err != nil {
+		return err
+	}
 	opts := &drivers.TransferOptions{
 		AllowHostAccess: r.C.Runtime.AllowHostAccess(),
 		RepoRoot:        repoRoot,
@@ -372,6 +487,7 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 			return r.C.AcquireConn(ctx, name)
 		},
 		Progress: drivers.NoOpProgress{},
+		TempDir:  tempDir,
 	}
 
 	transferStart := time.Now()
@@ -392,6 +508,118 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 	return err
 }
 
+// ingestStream appends a micro-batch of new data from a streaming source to the table with tableName.
+// It resumes from the offsets in the source's state.
+func (r *SourceReconciler) ingestStream(ctx context.Context, self *runtimev1.Resource, tableName string) (*drivers.StreamTransferResult, error) {
+	src := self.GetSource()
+
+	// Get transporter
+	st, release, err := r.streamingTransporter(ctx, src.Spec)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Get source and sink configs
+	srcConfig, err := r.driversSource(ctx, self, src.Spec.Properties)
+	if err != nil {
+		return nil, err
+	}
+	sinkConfig := driversSink(tableName)
+
+	tempDir, err := r.C.Runtime.TempDir(r.C.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timeout on ctx
+	timeout := _defaultIngestTimeout
+	if src.Spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(src.Spec.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return st.TransferBatch(ctx, srcConfig, sinkConfig, &drivers.StreamTransferOptions{
+		TransferOptions: drivers.TransferOptions{
+			AllowHostAccess: r.C.Runtime.AllowHostAccess(),
+			AcquireConnector: func(name string) (drivers.Handle, func(), error) {
+				return r.C.AcquireConn(ctx, name)
+			},
+			Progress: drivers.NoOpProgress{},
+			TempDir:  tempDir,
+		},
+		StreamID: r.streamID(self.Meta.Name.Name),
+		Offsets:  src.State.StreamOffsets,
+	})
+}
+
+// closeStream releases the state kept in the source for the stream of a streaming source with the given name, such as a replication slot.
+// It is called when the source is deleted or renamed. Errors are logged instead of returned since they should not block those operations.
+func (r *SourceReconciler) closeStream(ctx context.Context, self *runtimev1.Resource, name string) {
+	src := self.GetSource()
+	if !src.Spec.StreamIngestion || len(src.State.StreamOffsets) == 0 {
+		return
+	}
+
+	err := func() error {
+		st, release, err := r.streamingTransporter(ctx, src.Spec)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		sc, ok := st.(drivers.StreamCloser)
+		if !ok {
+			return nil
+		}
+
+		srcConfig, err := r.driversSource(ctx, self, src.Spec.Properties)
+		if err != nil {
+			return err
+		}
+
+		return sc.CloseStream(ctx, srcConfig, r.streamID(name))
+	}()
+	if err != nil {
+		r.C.Logger.Warn("failed to close source stream", zap.String("name", name), zap.Error(err))
+	}
+}
+
+// streamingTransporter returns a transporter for streaming data from the source's connector to its sink connector.
+func (r *SourceReconciler) streamingTransporter(ctx context.Context, spec *runtimev1.SourceSpec) (drivers.StreamingTransporter, func(), error) {
+	srcConn, release1, err := r.C.AcquireConn(ctx, spec.SourceConnector)
+	if err != nil {
+		return nil, nil, err
+	}
+	sinkConn, release2, err := r.C.AcquireConn(ctx, spec.SinkConnector)
+	if err != nil {
+		release1()
+		return nil, nil, err
+	}
+	release := func() {
+		release2()
+		release1()
+	}
+
+	t, ok := sinkConn.AsTransporter(srcConn, sinkConn)
+	if !ok {
+		t, ok = srcConn.AsTransporter(srcConn, sinkConn)
+	}
+	st, isStreaming := t.(drivers.StreamingTransporter)
+	if !ok || !isStreaming {
+		release()
+		return nil, nil, fmt.Errorf("cannot stream data between connectors %q and %q", spec.SourceConnector, spec.SinkConnector)
+	}
+
+	return st, release, nil
+}
+
+// streamID returns an ID for the stream of a streaming source that is unique across instances and sources.
+func (r *SourceReconciler) streamID(name string) string {
+	return r.C.InstanceID + "_" + name
+}
+
 func (r *SourceReconciler) driversSource(ctx context.Context, self *runtimev1.Resource, propsPB *structpb.Struct) (map[string]any, error) {
 	tself := rillv1.TemplateResource{
 		Meta:  self.Meta,
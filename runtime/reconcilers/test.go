@@ -0,0 +1,272 @@
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testSampleRowsLimit is the maximum number of failing rows stored in the state for each assertion.
+const testSampleRowsLimit = 10
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindTest, newTestReconciler)
+}
+
+type TestReconciler struct {
+	C *runtime.Controller
+}
+
+func newTestReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &TestReconciler{C: c}
+}
+
+func (r *TestReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *TestReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetTest()
+	b := to.GetTest()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *TestReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetTest()
+	b := to.GetTest()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *TestReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetTest().State = &runtimev1.TestState{}
+	return nil
+}
+
+func (r *TestReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	t := self.GetTest()
+	if t == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a test")}
+	}
+
+	// Unblock downstream metrics views on deletion
+	if self.Meta.DeletedOn != nil {
+		if testBlocksDownstream(t) {
+			r.reconcileDownstream(ctx, self)
+		}
+		return runtime.ReconcileResult{}
+	}
+
+	blocked := testBlocksDownstream(t)
+
+	// Check refs - don't evaluate the assertions if the tested model or source has errors
+	err = checkRefs(ctx, r.C, self.Meta.Refs)
+	if err != nil {
+		t.State.EvaluatedOn = nil
+		t.State.Passed = false
+		t.State.Results = nil
+		subErr := r.C.UpdateState(ctx, self.Meta.Name, self)
+		if subErr != nil {
+			return runtime.ReconcileResult{Err: subErr}
+		}
+		if blocked != testBlocksDownstream(t) {
+			r.reconcileDownstream(ctx, self)
+		}
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Evaluate the assertions
+	results, err := r.evaluate(ctx, t.Spec)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	var failed []string
+	for _, res := range results {
+		if !res.Passed {
+			failed = append(failed, res.Name)
+		}
+	}
+
+	// Update state
+	t.State.EvaluatedOn = timestamppb.Now()
+	t.State.Passed = len(failed) == 0
+	t.State.Results = results
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// If the test's blocking status changed, we need to re-reconcile the downstream metrics views
+	if blocked != testBlocksDownstream(t) {
+		r.reconcileDownstream(ctx, self)
+	}
+
+	if len(failed) > 0 {
+		return runtime.ReconcileResult{Err: fmt.Errorf("%d of %d assertions failed: %s", len(failed), len(results), strings.Join(failed, ", "))}
+	}
+	return runtime.ReconcileResult{}
+}
+
+// evaluate runs the test's assertions against its connector.
+// Errors encountered while running an individual assertion are recorded in its result instead of being returned.
+func (r *TestReconciler) evaluate(ctx context.Context, spec *runtimev1.TestSpec) ([]*runtimev1.TestAssertionResult, error) {
+	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	results := make([]*runtimev1.TestAssertionResult, len(spec.Assertions))
+	for i, a := range spec.Assertions {
+		res := &runtimev1.TestAssertionResult{Name: a.Name}
+		rows, count, err := r.evaluateAssertion(ctx, olap, a)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			res.Error = err.Error()
+		} else {
+			res.Passed = count == 0
+			res.FailingRowsCount = count
+			res.FailingRows = rows
+		}
+		results[i] = res
+	}
+
+	return results, nil
+}
+
+// evaluateAssertion runs an assertion's query and returns a sample of the failing rows along with the total number of failing rows.
+func (r *TestReconciler) evaluateAssertion(ctx context.Context, olap drivers.OLAPStore, a *runtimev1.TestAssertion) ([]*structpb.Struct, int64, error) {
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT * FROM (%s\n) LIMIT %d", a.Sql, testSampleRowsLimit),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Close()
+
+	var rows []*structpb.Struct
+	for res.Next() {
+		row := make(map[string]any)
+		err := res.MapScan(row)
+		if err != nil {
+			return nil, 0, err
+		}
+		s, err := pbutil.ToStruct(row, res.Schema)
+		if err != nil {
+			return nil, 0, err
+		}
+		rows = append(rows, s)
+	}
+	if err := res.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Only count the failing rows if there are more than the sample size
+	if len(rows) < testSampleRowsLimit {
+		return rows, int64(len(rows)), nil
+	}
+
+	countRes, err := olap.Execute(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT COUNT(*) FROM (%s\n)", a.Sql),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer countRes.Close()
+
+	var count int64
+	if countRes.Next() {
+		err := countRes.Scan(&count)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	if err := countRes.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return rows, count, nil
+}
+
+// reconcileDownstream enqueues the metrics views that reference the test's model or source for reconciliation.
+// It is called when the test's blocking status changes.
+func (r *TestReconciler) reconcileDownstream(ctx context.Context, self *runtimev1.Resource) {
+	mvs, err := r.C.List(ctx, runtime.ResourceKindMetricsView, false)
+	if err != nil {
+		r.C.Logger.Warn("failed to list metrics views", zap.String("name", self.Meta.Name.Name), zap.Error(err))
+		return
+	}
+
+	for _, mv := range mvs {
+		if !sharesRef(self.Meta.Refs, mv.Meta.Refs) {
+			continue
+		}
+		err = r.C.Reconcile(ctx, mv.Meta.Name)
+		if err != nil {
+			r.C.Logger.Warn("failed to reconcile metrics view", zap.String("name", mv.Meta.Name.Name), zap.Error(err))
+		}
+	}
+}
+
+// testBlocksDownstream returns true if the test is failing and configured to block downstream metrics views.
+func testBlocksDownstream(t *runtimev1.Test) bool {
+	return t.Spec.BlockDownstream && t.State.EvaluatedOn != nil && !t.State.Passed
+}
+
+// checkBlockingTests returns an error if a test that references one of the refs is failing and configured to block downstream resources.
+func checkBlockingTests(ctx context.Context, c *runtime.Controller, refs []*runtimev1.ResourceName) error {
+	tests, err := c.List(ctx, runtime.ResourceKindTest, false)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range tests {
+		t := res.GetTest()
+		if res.Meta.DeletedOn != nil || !testBlocksDownstream(t) {
+			continue
+		}
+		if sharesRef(res.Meta.Refs, refs) {
+			return fmt.Errorf("dependency error: test %q is failing", res.Meta.Name.Name)
+		}
+	}
+
+	return nil
+}
+
+// sharesRef returns true if the two lists of refs have a source or model in common.
+func sharesRef(a, b []*runtimev1.ResourceName) bool {
+	for _, x := range a {
+		if x.Kind != runtime.ResourceKindSource && x.Kind != runtime.ResourceKindModel {
+			continue
+		}
+		for _, y := range b {
+			if x.Kind == y.Kind && strings.EqualFold(x.Name, y.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
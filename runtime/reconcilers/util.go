@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -171,6 +172,176 @@ func olapForceRenameTable(ctx context.Context, c *runtime.Controller, connector,
 	return olap.RenameTable(ctx, fromName, toName, fromIsView)
 }
 
+// olapApplySchemaChangePolicy compares the schema of a table that was rebuilt from scratch with the schema of the table it replaces (prev),
+// and applies the schema change policy to the rebuilt table. It returns the detected schema changes (if any).
+// For SCHEMA_CHANGE_POLICY_FAIL, it returns an error if the schema changed; the caller should retain the previous table.
+// For SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS, columns missing from the rebuilt table are added back, and columns that changed type are cast back to their previous type.
+// NOTE: Not using the table info to check if the rebuilt table is a view because some backends will represent tables as views (like DuckDB with external table storage enabled).
+func olapApplySchemaChangePolicy(ctx context.Context, c *runtime.Controller, connector string, policy runtimev1.SchemaChangePolicy, prev *drivers.Table, table string, view bool) ([]*runtimev1.SchemaChange, error) {
+	if prev == nil {
+		return nil, nil
+	}
+
+	next, ok := olapTableInfo(ctx, c, connector, table)
+	if !ok {
+		return nil, fmt.Errorf("failed to get schema of table %q", table)
+	}
+
+	changes := diffTableSchemas(prev, next)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	switch policy {
+	case runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_FAIL:
+		return changes, schemaChangesError(changes)
+	case runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS:
+		if view {
+			return changes, fmt.Errorf("%w: the append_new_columns policy is only supported for materialized tables", schemaChangesError(changes))
+		}
+
+		olap, release, err := c.AcquireOLAP(ctx, connector)
+		if err != nil {
+			return changes, err
+		}
+		defer release()
+
+		for _, change := range changes {
+			switch {
+			case change.NewType == "":
+				err = olap.AddTableColumn(ctx, table, change.Column, change.PreviousType)
+			case change.PreviousType != "":
+				err = olap.AlterTableColumn(ctx, table, change.Column, change.PreviousType)
+			}
+			if err != nil {
+				return changes, fmt.Errorf("failed to retain column %q: %w", change.Column, err)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// olapEvolveTableSchema compares the schema of an existing table with the schema of the output of sql,
+// and applies the schema change policy to the existing table before the output is inserted into it.
+// It returns the detected schema changes (if any).
+// The output's schema is detected using a temporary view, so the sql is not executed.
+func olapEvolveTableSchema(ctx context.Context, olap drivers.OLAPStore, table, sql string, policy runtimev1.SchemaChangePolicy) ([]*runtimev1.SchemaChange, error) {
+	prev, err := olap.InformationSchema().Lookup(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp := "__rill_tmp_schema_" + table
+	err = olap.CreateTableAsSelect(ctx, tmp, true, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = olap.DropTable(context.Background(), tmp, true) }()
+
+	next, err := olap.InformationSchema().Lookup(ctx, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffTableSchemas(prev, next)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	if policy == runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_FAIL {
+		return changes, schemaChangesError(changes)
+	}
+
+	for _, change := range changes {
+		switch policy {
+		case runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS:
+			// Removed columns are filled with NULLs and changed columns are cast to the existing type on insert
+			if change.PreviousType == "" {
+				err = olap.AddTableColumn(ctx, table, change.Column, change.NewType)
+			}
+		case runtimev1.SchemaChangePolicy_SCHEMA_CHANGE_POLICY_SYNC_ALL_COLUMNS:
+			switch {
+			case change.PreviousType == "":
+				err = olap.AddTableColumn(ctx, table, change.Column, change.NewType)
+			case change.NewType == "":
+				err = olap.DropTableColumn(ctx, table, change.Column)
+			default:
+				err = olap.AlterTableColumn(ctx, table, change.Column, change.NewType)
+			}
+		}
+		if err != nil {
+			return changes, fmt.Errorf("failed to apply schema change for column %q: %w", change.Column, err)
+		}
+	}
+
+	return changes, nil
+}
+
+// diffTableSchemas returns the columns that were added, removed or changed type from prev to next.
+// Added and changed columns are returned in the order of next, followed by removed columns in the order of prev.
+func diffTableSchemas(prev, next *drivers.Table) []*runtimev1.SchemaChange {
+	prevTypes := make(map[string]string, len(prev.Schema.Fields))
+	for _, f := range prev.Schema.Fields {
+		prevTypes[f.Name] = tableColumnType(prev, f)
+	}
+
+	var changes []*runtimev1.SchemaChange
+	seen := make(map[string]bool, len(next.Schema.Fields))
+	for _, f := range next.Schema.Fields {
+		seen[f.Name] = true
+		typ := tableColumnType(next, f)
+		prevTyp, ok := prevTypes[f.Name]
+		if !ok || prevTyp != typ {
+			changes = append(changes, &runtimev1.SchemaChange{Column: f.Name, PreviousType: prevTyp, NewType: typ})
+		}
+	}
+	for _, f := range prev.Schema.Fields {
+		if !seen[f.Name] {
+			changes = append(changes, &runtimev1.SchemaChange{Column: f.Name, PreviousType: prevTypes[f.Name]})
+		}
+	}
+	return changes
+}
+
+// tableColumnType returns the type of a table's column in the OLAP's SQL dialect.
+// If the OLAP doesn't report native types, it falls back to the name of the column's type code.
+func tableColumnType(t *drivers.Table, f *runtimev1.StructType_Field) string {
+	if typ, ok := t.ColumnTypes[f.Name]; ok {
+		return typ
+	}
+	return strings.TrimPrefix(f.Type.Code.String(), "CODE_")
+}
+
+// appendSchemaChanges appends schema changes to a list of changes, skipping columns that already have a change in the list.
+func appendSchemaChanges(changes, more []*runtimev1.SchemaChange) []*runtimev1.SchemaChange {
+	for _, change := range more {
+		exists := slices.ContainsFunc(changes, func(c *runtimev1.SchemaChange) bool {
+			return c.Column == change.Column
+		})
+		if !exists {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// schemaChangesError returns an error describing schema changes.
+func schemaChangesError(changes []*runtimev1.SchemaChange) error {
+	descs := make([]string, len(changes))
+	for i, change := range changes {
+		switch {
+		case change.PreviousType == "":
+			descs[i] = fmt.Sprintf("column %q added with type %s", change.Column, change.NewType)
+		case change.NewType == "":
+			descs[i] = fmt.Sprintf("column %q removed", change.Column)
+		default:
+			descs[i] = fmt.Sprintf("column %q changed type from %s to %s", change.Column, change.PreviousType, change.NewType)
+		}
+	}
+	return fmt.Errorf("schema changed: %s", strings.Join(descs, ", "))
+}
+
 func logTableNameAndType(ctx context.Context, c *runtime.Controller, connector, name string) {
 	olap, release, err := c.AcquireOLAP(ctx, connector)
 	if err != nil {
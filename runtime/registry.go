@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -123,6 +124,12 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropOLA
 		}
 	}
 
+	// Clean up the instance's temporary files
+	err = os.RemoveAll(instanceTempDir(instanceID))
+	if err != nil {
+		r.logger.Error("delete instance: error removing temp dir", zap.Error(err), zap.String("instance_id", instanceID), observability.ZapCtx(ctx))
+	}
+
 	return nil
 }
 
@@ -44,6 +44,9 @@ type ResolverResult struct {
 	Schema *runtimev1.StructType
 	// Cache indicates whether the result can be cached.
 	Cache bool
+	// NextPageToken is set if the result was paginated and there are more rows.
+	// It can be passed back to the resolver in the APIArgPageToken arg to get the next page.
+	NextPageToken string
 }
 
 // ResolverExportOptions are the options passed to a resolver's ResolveExport method.
@@ -89,8 +92,9 @@ type ResolveOptions struct {
 
 // ResolveResult is subset of ResolverResult that is cached
 type ResolveResult struct {
-	Data   []byte
-	Schema *runtimev1.StructType
+	Data          []byte
+	Schema        *runtimev1.StructType
+	NextPageToken string
 }
 
 // Resolve resolves a query using the given options.
@@ -163,8 +167,9 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 		}
 
 		cRes := ResolveResult{
-			Data:   res.Data,
-			Schema: res.Schema,
+			Data:          res.Data,
+			Schema:        res.Schema,
+			NextPageToken: res.NextPageToken,
 		}
 		if res.Cache {
 			r.queryCache.cache.Set(key, cRes, int64(len(res.Data)))
@@ -176,3 +181,26 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 	}
 	return val.(ResolveResult), nil
 }
+
+// ResolveExport resolves a query and writes the result to w in the format specified in exportOpts.
+// Unlike Resolve, the result is streamed and not cached. The resolver is initialized for export.
+func (r *Runtime) ResolveExport(ctx context.Context, opts *ResolveOptions, w io.Writer, exportOpts *ResolverExportOptions) error {
+	initializer, ok := ResolverInitializers[opts.Resolver]
+	if !ok {
+		return fmt.Errorf("no resolver found for name %q", opts.Resolver)
+	}
+	resolver, err := initializer(ctx, &ResolverOptions{
+		Runtime:        r,
+		InstanceID:     opts.InstanceID,
+		Properties:     opts.ResolverProperties,
+		Args:           opts.Args,
+		UserAttributes: opts.UserAttributes,
+		ForExport:      true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resolver.Close()
+
+	return resolver.ResolveExport(ctx, w, exportOpts)
+}
@@ -0,0 +1,132 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("glob", newGlob)
+}
+
+type globResolver struct {
+	connector string
+	path      string
+	store     drivers.ObjectStore
+	release   func()
+}
+
+type globProps struct {
+	Connector string `mapstructure:"connector"`
+	Path      string `mapstructure:"path"`
+}
+
+// newGlob creates a resolver that lists the objects matching a glob pattern in an object store.
+// It returns a row for each matched object with its full URI and its path within the bucket.
+func newGlob(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	props := &globProps{}
+	if err := mapstructure.Decode(opts.Properties, props); err != nil {
+		return nil, err
+	}
+
+	if props.Path == "" {
+		return nil, errors.New(`glob resolver: the "path" property is required`)
+	}
+
+	// Infer the connector from the path's scheme if not provided
+	if props.Connector == "" {
+		url, err := globutil.ParseBucketURL(props.Path)
+		if err != nil {
+			return nil, fmt.Errorf("glob resolver: %w", err)
+		}
+		switch url.Scheme {
+		case "s3":
+			props.Connector = "s3"
+		case "gs":
+			props.Connector = "gcs"
+		case "azure":
+			props.Connector = "azure"
+		default:
+			return nil, fmt.Errorf("glob resolver: unsupported scheme %q", url.Scheme)
+		}
+	}
+
+	handle, release, err := opts.Runtime.AcquireHandle(ctx, opts.InstanceID, props.Connector)
+	if err != nil {
+		return nil, err
+	}
+
+	store, ok := handle.AsObjectStore()
+	if !ok {
+		release()
+		return nil, fmt.Errorf("glob resolver: connector %q is not an object store", props.Connector)
+	}
+
+	return &globResolver{
+		connector: props.Connector,
+		path:      props.Path,
+		store:     store,
+		release:   release,
+	}, nil
+}
+
+func (r *globResolver) Close() error {
+	r.release()
+	return nil
+}
+
+func (r *globResolver) Key() string {
+	return fmt.Sprintf("%s:%s", r.connector, r.path)
+}
+
+func (r *globResolver) Refs() []*runtimev1.ResourceName {
+	return nil
+}
+
+func (r *globResolver) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *globResolver) ResolveInteractive(ctx context.Context) (*runtime.ResolverResult, error) {
+	entries, err := r.store.ListObjectsForGlob(ctx, r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]map[string]any, len(entries))
+	for i, e := range entries {
+		out[i] = map[string]any{
+			"uri":  e.Path,
+			"path": e.Key,
+		}
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtime.ResolverResult{
+		Data: data,
+		Schema: &runtimev1.StructType{
+			Fields: []*runtimev1.StructType_Field{
+				{Name: "uri", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+				{Name: "path", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+			},
+		},
+		Cache: false,
+	}, nil
+}
+
+func (r *globResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errors.New("glob resolver: export not supported")
+}
@@ -2,7 +2,9 @@ package resolvers
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -29,6 +31,8 @@ type sqlResolver struct {
 	olap        drivers.OLAPStore
 	olapRelease func()
 	priority    int
+	limit       int64
+	offset      int64
 }
 
 type sqlProps struct {
@@ -37,7 +41,9 @@ type sqlProps struct {
 }
 
 type sqlArgs struct {
-	Priority int `mapstructure:"priority"`
+	Priority  int    `mapstructure:"priority"`
+	Limit     int64  `mapstructure:"_limit"`
+	PageToken string `mapstructure:"_page_token"`
 	// NOTE: Not exhaustive. Any other args are passed to the "args" property when resolving the SQL template.
 }
 
@@ -60,11 +66,22 @@ func newSQLWithRefs(ctx context.Context, opts *runtime.ResolverOptions, extraRef
 		return nil, err
 	}
 
+	limit, offset, err := parsePagination(args.Limit, args.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	olap, release, err := opts.Runtime.OLAP(ctx, opts.InstanceID, props.Connector)
 	if err != nil {
 		return nil, err
 	}
 
+	// Druid only supports OFFSET for some query shapes, so pagination is not supported for it
+	if limit > 0 && olap.Dialect() == drivers.DialectDruid {
+		release()
+		return nil, errors.New("pagination is not supported for Druid")
+	}
+
 	resolvedSQL, refs, err := buildSQL(props.SQL, olap.Dialect(), opts.Args, opts.UserAttributes, opts.ForExport)
 	if err != nil {
 		return nil, err
@@ -81,6 +98,8 @@ func newSQLWithRefs(ctx context.Context, opts *runtime.ResolverOptions, extraRef
 		olap:        olap,
 		olapRelease: release,
 		priority:    args.Priority,
+		limit:       limit,
+		offset:      offset,
 	}, nil
 }
 
@@ -90,6 +109,9 @@ func (r *sqlResolver) Close() error {
 }
 
 func (r *sqlResolver) Key() string {
+	if r.limit > 0 {
+		return fmt.Sprintf("%s\nlimit=%d offset=%d", r.sql, r.limit, r.offset)
+	}
 	return r.sql
 }
 
@@ -106,6 +128,10 @@ func (r *sqlResolver) Validate(ctx context.Context) error {
 }
 
 func (r *sqlResolver) ResolveInteractive(ctx context.Context) (*runtime.ResolverResult, error) {
+	if r.limit > 0 {
+		return r.resolvePage(ctx)
+	}
+
 	// Wrap the SQL with an outer SELECT to limit the number of rows returned in interactive mode.
 	// Adding +1 to the limit so we can return a nice error message if the limit is exceeded.
 	sql := fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", r.sql, sqlResolverInteractiveRowLimit+1)
@@ -151,6 +177,58 @@ func (r *sqlResolver) ResolveInteractive(ctx context.Context) (*runtime.Resolver
 	}, nil
 }
 
+// resolvePage resolves a single page of the result.
+// The rows keep the order of the query, so the query must have an ORDER BY clause for the pages to be consistent across requests.
+// It fetches one row more than the page size to determine if there is a next page.
+func (r *sqlResolver) resolvePage(ctx context.Context) (*runtime.ResolverResult, error) {
+	sql := fmt.Sprintf("SELECT * FROM (%s) LIMIT %d OFFSET %d", r.sql, r.limit+1, r.offset)
+
+	res, err := r.olap.Execute(ctx, &drivers.Statement{
+		Query:    sql,
+		Priority: r.priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	out := []map[string]any{}
+	var nextPageToken string
+	for res.Rows.Next() {
+		if int64(len(out)) >= r.limit {
+			nextPageToken = encodePageToken(r.limit, r.offset+r.limit)
+			break
+		}
+
+		row := make(map[string]any)
+		err = res.Rows.MapScan(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	if err := res.Rows.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	var cache bool
+	if r.olap.Dialect() == drivers.DialectDuckDB {
+		cache = len(r.refs) != 0
+	}
+
+	return &runtime.ResolverResult{
+		Data:          data,
+		Schema:        res.Schema,
+		Cache:         cache,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
 	exportOpts := &runtime.ExportOptions{
 		Format:       opts.Format,
@@ -160,6 +238,16 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 
 	filename := "api_export_" + time.Now().Format("2006-01-02T15-04-05.000Z")
 
+	// Arrow IPC, Avro and JSON Lines results are streamed directly from the driver's rows
+	switch opts.Format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return queries.ArrowStreamExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+		return queries.AvroStreamExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return queries.JSONLStreamExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap)
+	}
+
 	switch r.olap.Dialect() {
 	case drivers.DialectDuckDB:
 		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
@@ -220,6 +308,8 @@ func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename s
 		return queries.WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return queries.WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return queries.WriteJSONL(meta, data, w)
 	}
 
 	return nil
@@ -274,3 +364,46 @@ func buildSQL(sqlTemplate string, dialect drivers.Dialect, args, userAttributes
 
 	return sql, normalizeRefs(refs), nil
 }
+
+// pageToken is the decoded form of the opaque page tokens returned by the SQL resolver.
+type pageToken struct {
+	Limit  int64 `json:"l"`
+	Offset int64 `json:"o"`
+}
+
+// encodePageToken returns a page token for the page of the given size starting at offset.
+func encodePageToken(limit, offset int64) string {
+	data, err := json.Marshal(pageToken{Limit: limit, Offset: offset})
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parsePagination returns the limit and offset for the given pagination args.
+// If a page token is provided without a limit, the page size of the previous page is used.
+// It returns a zero limit if the result should not be paginated.
+func parsePagination(limit int64, token string) (int64, int64, error) {
+	if limit < 0 {
+		return 0, 0, fmt.Errorf("invalid limit %d: must be positive", limit)
+	}
+	if limit > sqlResolverInteractiveRowLimit {
+		return 0, 0, fmt.Errorf("invalid limit %d: must be at most %d", limit, sqlResolverInteractiveRowLimit)
+	}
+	if token == "" {
+		return limit, 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, 0, errors.New("invalid page token")
+	}
+	t := &pageToken{}
+	if err := json.Unmarshal(data, t); err != nil || t.Limit <= 0 || t.Offset < 0 {
+		return 0, 0, errors.New("invalid page token")
+	}
+	if limit == 0 {
+		limit = t.Limit
+	}
+	return limit, t.Offset, nil
+}
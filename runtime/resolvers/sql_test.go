@@ -96,3 +96,87 @@ func TestTemplateSQLApi2(t *testing.T) {
 		require.Equal(t, "msn.com", row["domain"])
 	}
 }
+
+func TestPaginatedSQLApi(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	api, err := rt.APIForName(context.Background(), instanceID, "simple_sql_api")
+	require.NoError(t, err)
+
+	resolve := func(args map[string]any) runtime.ResolveResult {
+		res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+			InstanceID:         instanceID,
+			Resolver:           api.Spec.Resolver,
+			ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+			Args:               args,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// The API returns 5 rows, so pages of 2 rows are expected to be split 2+2+1
+	var all []map[string]any
+	var pages int
+	args := map[string]any{"_limit": int64(2)}
+	for {
+		res := resolve(args)
+		var rows []map[string]any
+		require.NoError(t, json.Unmarshal(res.Data, &rows))
+		require.LessOrEqual(t, len(rows), 2)
+		all = append(all, rows...)
+		pages++
+		if res.NextPageToken == "" {
+			break
+		}
+		args = map[string]any{"_page_token": res.NextPageToken}
+	}
+	require.Equal(t, 3, pages)
+	require.Len(t, all, 5)
+
+	// Invalid pagination args
+	_, err = rt.Resolve(context.Background(), &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+		Args:               map[string]any{"_page_token": "invalid"},
+	})
+	require.ErrorContains(t, err, "invalid page token")
+}
+
+func TestPaginatedSQLApiKeepsOrder(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml":       "",
+			"models/nums.sql": "SELECT range AS n FROM range(5)",
+			"apis/nums.yaml": `
+kind: api
+sql: SELECT n FROM nums ORDER BY n DESC
+`,
+		},
+	})
+
+	api, err := rt.APIForName(context.Background(), instanceID, "nums")
+	require.NoError(t, err)
+
+	var all []int
+	args := map[string]any{"_limit": int64(2)}
+	for {
+		res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+			InstanceID:         instanceID,
+			Resolver:           api.Spec.Resolver,
+			ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+			Args:               args,
+		})
+		require.NoError(t, err)
+		var rows []map[string]any
+		require.NoError(t, json.Unmarshal(res.Data, &rows))
+		for _, row := range rows {
+			all = append(all, int(row["n"].(float64)))
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		args = map[string]any{"_page_token": res.NextPageToken}
+	}
+	require.Equal(t, []int{4, 3, 2, 1, 0}, all)
+}
@@ -23,6 +23,7 @@ const (
 	ResourceKindChart          string = "rill.runtime.v1.Chart"
 	ResourceKindDashboard      string = "rill.runtime.v1.Dashboard"
 	ResourceKindAPI            string = "rill.runtime.v1.API"
+	ResourceKindTest           string = "rill.runtime.v1.Test"
 )
 
 // ResourceNameFromCompiler converts a compiler resource name to a runtime resource name.
@@ -48,6 +49,8 @@ func ResourceNameFromCompiler(name compilerv1.ResourceName) *runtimev1.ResourceN
 		return &runtimev1.ResourceName{Kind: ResourceKindDashboard, Name: name.Name}
 	case compilerv1.ResourceKindAPI:
 		return &runtimev1.ResourceName{Kind: ResourceKindAPI, Name: name.Name}
+	case compilerv1.ResourceKindTest:
+		return &runtimev1.ResourceName{Kind: ResourceKindTest, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
 	}
@@ -76,6 +79,8 @@ func ResourceNameToCompiler(name *runtimev1.ResourceName) compilerv1.ResourceNam
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindDashboard, Name: name.Name}
 	case ResourceKindAPI:
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindAPI, Name: name.Name}
+	case ResourceKindTest:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindTest, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -76,6 +78,21 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Cl
 	return rt, nil
 }
 
+// TempDir returns a directory for temporary files for the given instance.
+// The directory is created if it doesn't exist and is removed when the instance is deleted.
+func (r *Runtime) TempDir(instanceID string) (string, error) {
+	path := instanceTempDir(instanceID)
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func instanceTempDir(instanceID string) string {
+	return filepath.Join(os.TempDir(), "rill", instanceID)
+}
+
 func (r *Runtime) AllowHostAccess() bool {
 	return r.opts.AllowHostAccess
 }
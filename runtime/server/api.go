@@ -1,17 +1,35 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/go-redis/redis_rate/v10"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	// ndjsonContentType is the MIME type of newline-delimited JSON.
+	// Clients can request it in the Accept header to stream the full result of an API in chunks.
+	ndjsonContentType = "application/x-ndjson"
+	// nextPageTokenHeader is the response header that holds the token for the next page of a paginated API result.
+	nextPageTokenHeader = "X-Next-Page-Token"
 )
 
 func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
@@ -28,9 +46,13 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 	s.addInstanceRequestAttributes(ctx, instanceID)
 
 	// Check if user has access to query for API data
-	if !auth.GetClaims(ctx).CanInstance(instanceID, auth.ReadAPI) {
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(instanceID, auth.ReadAPI) {
 		return httputil.Errorf(http.StatusForbidden, "does not have access to custom APIs")
 	}
+	if !claims.CanAPI(instanceID, apiName) {
+		return httputil.Errorf(http.StatusForbidden, "does not have access to custom API %q", apiName)
+	}
 
 	// Parse args from the request body and URL query
 	args := make(map[string]any)
@@ -57,20 +79,71 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
 
-	// Resolve the API to JSON data
-	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+	// Apply the rate limit declared for the API
+	if api.Spec.RateLimitRequests > 0 {
+		if err := s.checkAPIRateLimit(ctx, req, instanceID, apiName, api.Spec); err != nil {
+			if errors.As(err, &ratelimit.QuotaExceededError{}) {
+				return httputil.Error(http.StatusTooManyRequests, err)
+			}
+			return httputil.Error(http.StatusInternalServerError, err)
+		}
+	}
+
+	// Validate the args against the arguments declared for the API
+	args, err = runtime.ResolveAPIArgs(api.Spec, args)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	opts := &runtime.ResolveOptions{
 		InstanceID:         instanceID,
 		Resolver:           api.Spec.Resolver,
 		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
 		Args:               args,
-		UserAttributes:     auth.GetClaims(ctx).Attributes(),
-	})
+		UserAttributes:     claims.Attributes(),
+	}
+
+	// Stream the result as Arrow IPC if requested by the client
+	if strings.Contains(req.Header.Get("Accept"), arrowutil.ContentType) {
+		err = s.runtime.ResolveExport(ctx, opts, w, &runtime.ResolverExportOptions{
+			Format: runtimev1.ExportFormat_EXPORT_FORMAT_ARROW,
+			PreWriteHook: func(filename string) error {
+				w.Header().Set("Content-Type", arrowutil.ContentType)
+				return nil
+			},
+		})
+		if err != nil {
+			return httputil.Error(http.StatusBadRequest, err)
+		}
+		return nil
+	}
+
+	// Stream the result as newline-delimited JSON if requested by the client
+	if strings.Contains(req.Header.Get("Accept"), ndjsonContentType) {
+		err = s.runtime.ResolveExport(ctx, opts, w, &runtime.ResolverExportOptions{
+			Format: runtimev1.ExportFormat_EXPORT_FORMAT_JSONL,
+			PreWriteHook: func(filename string) error {
+				w.Header().Set("Content-Type", ndjsonContentType)
+				return nil
+			},
+		})
+		if err != nil {
+			return httputil.Error(http.StatusBadRequest, err)
+		}
+		return nil
+	}
+
+	// Resolve the API to JSON data
+	res, err := s.runtime.Resolve(ctx, opts)
 	if err != nil {
 		return httputil.Error(http.StatusBadRequest, err)
 	}
 
 	// Write the response
 	w.Header().Set("Content-Type", "application/json")
+	if res.NextPageToken != "" {
+		w.Header().Set(nextPageTokenHeader, res.NextPageToken)
+	}
 	_, err = w.Write(res.Data)
 	if err != nil {
 		return httputil.Error(http.StatusInternalServerError, err)
@@ -78,3 +151,33 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 
 	return nil
 }
+
+// checkAPIRateLimit applies the rate limit of an API to the caller of a request.
+// Authenticated callers are limited by their subject. Callers without a subject (such as when auth is disabled) are limited by their IP address.
+// If the server was started without a rate limiter (such as when running locally or without Redis), the rate limit is not enforced and a warning is logged instead.
+func (s *Server) checkAPIRateLimit(ctx context.Context, req *http.Request, instanceID, apiName string, spec *runtimev1.APISpec) error {
+	method := fmt.Sprintf("api:%s:%s", instanceID, apiName)
+
+	if _, ok := s.limiter.(*ratelimit.Noop); ok {
+		// Only warn once per API to avoid flooding the logs
+		if _, warned := s.unenforcedRateLimits.LoadOrStore(method, true); !warned {
+			s.logger.Warn("the rate limit declared for the API is not enforced because rate limiting is not configured", zap.String("instance_id", instanceID), zap.String("api", apiName))
+		}
+		return nil
+	}
+
+	limit := redis_rate.Limit{
+		Rate:   int(spec.RateLimitRequests),
+		Burst:  int(spec.RateLimitRequests),
+		Period: time.Duration(spec.RateLimitPeriodSeconds) * time.Second,
+	}
+
+	var limitKey string
+	if sub := auth.GetClaims(ctx).Subject(); sub != "" {
+		limitKey = ratelimit.AuthLimitKey(method, sub)
+	} else {
+		limitKey = ratelimit.AnonLimitKey(method, observability.HTTPPeer(req))
+	}
+
+	return s.limiter.Limit(ctx, limitKey, limit)
+}
@@ -0,0 +1,83 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	_ "github.com/rilldata/rill/runtime/resolvers"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAPIRateLimit(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"apis/limited.yaml": `
+kind: api
+sql: SELECT 1 AS one
+rate_limit: 2/min
+`,
+		},
+	})
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	limiter := ratelimit.NewRedis(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), limiter, activity.NewNoopClient())
+	require.NoError(t, err)
+
+	handler, err := srv.HTTPHandler(context.Background(), nil)
+	require.NoError(t, err)
+
+	call := func() int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/instances/"+instanceID+"/api/limited", http.NoBody)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The first requests are within the limit
+	require.Equal(t, http.StatusOK, call())
+	require.Equal(t, http.StatusOK, call())
+
+	// The next request exceeds the limit
+	require.Equal(t, http.StatusTooManyRequests, call())
+}
+
+func TestAPIRateLimitNotEnforced(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"apis/limited.yaml": `
+kind: api
+sql: SELECT 1 AS one
+rate_limit: 1/min
+`,
+		},
+	})
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	handler, err := srv.HTTPHandler(context.Background(), nil)
+	require.NoError(t, err)
+
+	// Without a rate limiter, requests exceeding the limit are still served
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/v1/instances/"+instanceID+"/api/limited", http.NoBody)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+}
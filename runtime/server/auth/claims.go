@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"slices"
+
 	"github.com/golang-jwt/jwt/v4"
 )
 
@@ -12,6 +14,8 @@ type Claims interface {
 	Can(p Permission) bool
 	// CanInstance resolves instance-level permissions.
 	CanInstance(instanceID string, p Permission) bool
+	// CanAPI resolves whether the requester can call the custom API with the given name.
+	CanAPI(instanceID, name string) bool
 	// Attributes returns the token attributes used in template rendering.
 	Attributes() map[string]any
 }
@@ -22,6 +26,8 @@ type jwtClaims struct {
 	System    []Permission            `json:"sys,omitempty"`
 	Instances map[string][]Permission `json:"ins,omitempty"`
 	Attrs     map[string]any          `json:"attr,omitempty"`
+	// APIs restricts access to the custom APIs with these names. If empty, all custom APIs can be called.
+	APIs []string `json:"api,omitempty"`
 }
 
 func (c *jwtClaims) Subject() string {
@@ -46,6 +52,13 @@ func (c *jwtClaims) CanInstance(instanceID string, p Permission) bool {
 	return c.Can(p)
 }
 
+func (c *jwtClaims) CanAPI(instanceID, name string) bool {
+	if !c.CanInstance(instanceID, ReadAPI) {
+		return false
+	}
+	return len(c.APIs) == 0 || slices.Contains(c.APIs, name)
+}
+
 func (c *jwtClaims) Attributes() map[string]any {
 	return c.Attrs
 }
@@ -66,6 +79,10 @@ func (c openClaims) CanInstance(instanceID string, p Permission) bool {
 	return true
 }
 
+func (c openClaims) CanAPI(instanceID, name string) bool {
+	return true
+}
+
 func (c openClaims) Attributes() map[string]any {
 	return nil
 }
@@ -86,6 +103,10 @@ func (c anonClaims) CanInstance(instanceID string, p Permission) bool {
 	return false
 }
 
+func (c anonClaims) CanAPI(instanceID, name string) bool {
+	return false
+}
+
 func (c anonClaims) Attributes() map[string]any {
 	return nil
 }
@@ -109,6 +130,10 @@ func (c devJWTClaims) CanInstance(instanceID string, p Permission) bool {
 	return true
 }
 
+func (c devJWTClaims) CanAPI(instanceID, name string) bool {
+	return true
+}
+
 func (c devJWTClaims) Attributes() map[string]any {
 	return c.Attrs
 }
@@ -95,6 +95,16 @@ func HTTPMiddleware(aud *Audience, next http.Handler) http.Handler {
 	})
 }
 
+// WithBearerToken sets claims on ctx based on a bearer token in the same way as UnaryServerInterceptor.
+// It should be used for non-HTTP endpoints that receive the token out of band (such as the Postgres wire protocol endpoint, which receives it as the password).
+// An empty token is treated the same way as a missing authorization header.
+func WithBearerToken(ctx context.Context, aud *Audience, token string) (context.Context, error) {
+	if token == "" {
+		return parseClaims(ctx, aud, "")
+	}
+	return parseClaims(ctx, aud, "Bearer "+token)
+}
+
 func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string) (context.Context, error) {
 	// When aud == nil, it means auth is disabled. Additionally, if auth header is not set then we set openClaims.
 	// If auth header is set then that means its running locally with some user context, so we set devJWTClaims.
@@ -112,6 +112,8 @@ type TokenOptions struct {
 	SystemPermissions   []Permission
 	InstancePermissions map[string][]Permission
 	Attributes          map[string]any
+	// APINames restricts the token to calling the custom APIs with these names. If empty, the token is not restricted.
+	APINames []string
 }
 
 // NewToken issues a new JWT based on the provided options.
@@ -129,6 +131,7 @@ func (i *Issuer) NewToken(opts TokenOptions) (string, error) {
 		System:    opts.SystemPermissions,
 		Instances: opts.InstancePermissions,
 		Attrs:     opts.Attributes,
+		APIs:      opts.APINames,
 	}
 
 	token := jwt.NewWithClaims(jwt.GetSigningMethod(i.signingKey.Algorithm), claims)
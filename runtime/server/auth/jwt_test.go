@@ -34,6 +34,24 @@ func TestTokens(t *testing.T) {
 		require.False(t, claims.CanInstance("unknown", ReadOLAP))
 	})
 
+	t.Run("Scoped to APIs", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			Subject:             "partner",
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadAPI}},
+			APINames:            []string{"bids"},
+		})
+		require.NoError(t, err)
+
+		claims, err := aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		require.True(t, claims.CanAPI("example", "bids"))
+		require.False(t, claims.CanAPI("example", "impressions"))
+		require.False(t, claims.CanAPI("unknown", "bids"))
+		require.False(t, claims.CanInstance("example", ReadObjects))
+	})
+
 	t.Run("Expired", func(t *testing.T) {
 		token, err := iss.NewToken(TokenOptions{
 			AudienceURL:         aud.audienceURL,
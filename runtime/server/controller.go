@@ -179,6 +179,37 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 	return &runtimev1.GetResourceResponse{Resource: r}, nil
 }
 
+// GetLineage implements runtimev1.RuntimeServiceServer
+func (s *Server) GetLineage(ctx context.Context, req *runtimev1.GetLineageRequest) (*runtimev1.GetLineageResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name.kind", req.Name.GetKind()),
+		attribute.String("args.name.name", req.Name.GetName()),
+		attribute.String("args.column", req.Column),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	if req.Column != "" && req.Name == nil {
+		return nil, status.Error(codes.InvalidArgument, "a resource name is required when a column is provided")
+	}
+
+	edges, err := s.runtime.Lineage(ctx, req.InstanceId, req.Name, req.Column)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	edges, err = s.applySecurityPolicyLineage(ctx, req.InstanceId, edges)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &runtimev1.GetLineageResponse{Edges: edges}, nil
+}
+
 // CreateTrigger implements runtimev1.RuntimeServiceServer
 func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
@@ -233,6 +264,68 @@ func (s *Server) applySecurityPolicy(ctx context.Context, instID string, r *runt
 	}
 }
 
+// applySecurityPolicyLineage removes lineage edges to metrics views, dimensions and measures that the caller doesn't have access to.
+func (s *Server) applySecurityPolicyLineage(ctx context.Context, instID string, edges []*runtimev1.LineageEdge) ([]*runtimev1.LineageEdge, error) {
+	ctrl, err := s.runtime.Controller(ctx, instID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the fields accessible in each metrics view. A nil map means the metrics view is not accessible.
+	fields := make(map[string]map[string]bool)
+	for _, e := range edges {
+		n := e.Target.Resource
+		if n.Kind != runtime.ResourceKindMetricsView {
+			continue
+		}
+		if _, ok := fields[n.Name]; ok {
+			continue
+		}
+
+		r, err := ctrl.Get(ctx, n, false)
+		if err != nil {
+			if errors.Is(err, drivers.ErrResourceNotFound) {
+				fields[n.Name] = nil
+				continue
+			}
+			return nil, err
+		}
+
+		r, access, err := s.applySecurityPolicy(ctx, instID, r)
+		if err != nil {
+			return nil, err
+		}
+		if !access {
+			fields[n.Name] = nil
+			continue
+		}
+
+		mv := r.GetMetricsView()
+		spec := mv.State.ValidSpec
+		if spec == nil {
+			spec = mv.Spec
+		}
+		names := map[string]bool{"": true, spec.TimeDimension: true}
+		for _, d := range spec.Dimensions {
+			names[d.Name] = true
+		}
+		for _, m := range spec.Measures {
+			names[m.Name] = true
+		}
+		fields[n.Name] = names
+	}
+
+	res := make([]*runtimev1.LineageEdge, 0, len(edges))
+	for _, e := range edges {
+		n := e.Target.Resource
+		if n.Kind == runtime.ResourceKindMetricsView && !fields[n.Name][e.Target.Column] {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
+
 // applySecurityPolicyMetricsView applies relevant security policies to a metrics view.
 func (s *Server) applySecurityPolicyMetricsView(ctx context.Context, instID string, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
 	ctx, span := tracer.Start(ctx, "applySecurityPolicyMetricsView", trace.WithAttributes(attribute.String("instance_id", instID), attribute.String("kind", r.Meta.Name.Kind), attribute.String("name", r.Meta.Name.Name)))
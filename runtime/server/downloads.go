@@ -15,6 +15,8 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
+	"github.com/rilldata/rill/runtime/pkg/avroutil"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"google.golang.org/grpc/codes"
@@ -126,6 +128,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Measures:           r.Measures,
 			Sort:               r.Sort,
 			TimeRange:          tr,
+			TimeDimension:      r.TimeDimension,
 			Where:              r.Where,
 			Having:             r.Having,
 			Filter:             r.Filter,
@@ -244,6 +247,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Where:              r.Where,
 			Having:             r.Having,
 			TimeZone:           r.TimeZone,
+			TimeDimension:      r.TimeDimension,
 			MetricsView:        mv,
 			ResolvedMVSecurity: security,
 		}
@@ -256,6 +260,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			ComparisonMeasures:  r.ComparisonMeasures,
 			TimeRange:           r.TimeRange,
 			ComparisonTimeRange: r.ComparisonTimeRange,
+			TimeDimension:       r.TimeDimension,
 			Limit:               s.resolveExportLimit(request.Limit, r.Limit),
 			Offset:              r.Offset,
 			Sort:                r.Sort,
@@ -299,6 +304,15 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 				w.Header().Set("Content-Type", "application/octet-stream")
 				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+				w.Header().Set("Content-Type", arrowutil.ContentType)
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.arrow\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.jsonl\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_AVRO:
+				w.Header().Set("Content-Type", avroutil.ContentType)
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.avro\"", filename))
 			default:
 				return fmt.Errorf("unsupported format %q", request.Format.String())
 			}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// openAPIHandler serves an OpenAPI 3 spec for the custom APIs of an instance.
+func (s *Server) openAPIHandler(w http.ResponseWriter, req *http.Request) error {
+	ctx := req.Context()
+	instanceID := req.PathValue("instance_id")
+
+	observability.AddRequestAttributes(ctx, attribute.String("args.instance_id", instanceID))
+	s.addInstanceRequestAttributes(ctx, instanceID)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(instanceID, auth.ReadAPI) {
+		return httputil.Errorf(http.StatusForbidden, "does not have access to custom APIs")
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	apis, err := ctrl.List(ctx, runtime.ResourceKindAPI, false)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	// Only describe the APIs the caller has access to
+	apis = slices.DeleteFunc(apis, func(r *runtimev1.Resource) bool {
+		return !claims.CanAPI(instanceID, r.Meta.Name.Name)
+	})
+
+	spec := newOpenAPISpec(instanceID, apis)
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// openAPISpec is the subset of the OpenAPI 3 document structure used to describe custom APIs.
+// See: https://spec.openapis.org/oas/v3.0.3
+type openAPISpec struct {
+	OpenAPI string                                 `json:"openapi"`
+	Info    openAPIInfo                            `json:"info"`
+	Servers []openAPIServer                        `json:"servers"`
+	Paths   map[string]map[string]openAPIOperation `json:"paths"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Headers     map[string]openAPIHeader    `json:"headers,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIHeader struct {
+	Description string         `json:"description,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Default              any                       `json:"default,omitempty"`
+	Enum                 []any                     `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties any                       `json:"additionalProperties,omitempty"`
+}
+
+// newOpenAPISpec builds an OpenAPI spec with a GET and POST operation for each of the APIs.
+// GET requests take args as query parameters and POST requests take them as a JSON object in the body.
+func newOpenAPISpec(instanceID string, apis []*runtimev1.Resource) *openAPISpec {
+	spec := &openAPISpec{
+		OpenAPI: "3.0.3",
+		Info:    openAPIInfo{Title: fmt.Sprintf("Custom APIs for instance %s", instanceID), Version: "1.0.0"},
+		// The spec is served next to the APIs, so a relative server URL works both for direct and proxied requests
+		Servers: []openAPIServer{{URL: "."}},
+		Paths:   make(map[string]map[string]openAPIOperation, len(apis)),
+	}
+
+	responses := map[string]openAPIResponse{
+		"200": {
+			Description: "The rows returned by the API",
+			Headers: map[string]openAPIHeader{
+				nextPageTokenHeader: {Description: "Token for the next page, set if the result was paginated and there are more rows", Schema: &openAPISchema{Type: "string"}},
+			},
+			Content: map[string]openAPIMediaType{
+				"application/json": {Schema: &openAPISchema{Type: "array", Items: &openAPISchema{Type: "object", AdditionalProperties: true}}},
+				ndjsonContentType:  {Schema: &openAPISchema{Type: "string", Description: "All rows as newline-delimited JSON objects, streamed in chunks"}},
+			},
+		},
+		"400": {
+			Description: "Invalid arguments or failed to resolve the API",
+			Content: map[string]openAPIMediaType{
+				"application/json": {Schema: &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{"error": {Type: "string"}}}},
+			},
+		},
+	}
+
+	for _, r := range apis {
+		name := r.Meta.Name.Name
+		api := r.GetApi()
+
+		body := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+		var params []openAPIParameter
+		for _, a := range api.Spec.Args {
+			schema := openAPIArgSchema(a)
+			params = append(params, openAPIParameter{
+				Name:        a.Name,
+				In:          "query",
+				Description: a.Description,
+				Required:    a.Required,
+				Schema:      schema,
+			})
+			body.Properties[a.Name] = schema
+			if a.Required {
+				body.Required = append(body.Required, a.Name)
+			}
+		}
+		// Without declared arguments, the API accepts any args
+		body.AdditionalProperties = len(api.Spec.Args) == 0
+
+		// The pagination args are accepted by all APIs
+		for _, p := range openAPIPaginationParams {
+			params = append(params, p)
+			body.Properties[p.Name] = p.Schema
+		}
+
+		spec.Paths["/"+name] = map[string]openAPIOperation{
+			"get": {
+				OperationID: "get_" + name,
+				Summary:     api.Spec.Description,
+				Parameters:  params,
+				Responses:   responses,
+			},
+			"post": {
+				OperationID: "post_" + name,
+				Summary:     api.Spec.Description,
+				RequestBody: &openAPIRequestBody{Content: map[string]openAPIMediaType{"application/json": {Schema: body}}},
+				Responses:   responses,
+			},
+		}
+	}
+
+	return spec
+}
+
+// openAPIPaginationParams describes the pagination args that are passed through to the resolver of every API.
+var openAPIPaginationParams = []openAPIParameter{
+	{
+		Name:        runtime.APIArgLimit,
+		In:          "query",
+		Description: "The maximum number of rows to return. If set, the result is paginated.",
+		Schema:      &openAPISchema{Type: "integer", Format: "int64"},
+	},
+	{
+		Name:        runtime.APIArgPageToken,
+		In:          "query",
+		Description: "The token returned in the " + nextPageTokenHeader + " header of the previous page",
+		Schema:      &openAPISchema{Type: "string"},
+	},
+}
+
+// openAPIArgSchema returns the OpenAPI schema for an API argument.
+func openAPIArgSchema(a *runtimev1.APIArgument) *openAPISchema {
+	schema := &openAPISchema{Description: a.Description}
+	switch a.Type {
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER:
+		schema.Type = "integer"
+		schema.Format = "int64"
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_NUMBER:
+		schema.Type = "number"
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_BOOLEAN:
+		schema.Type = "boolean"
+	case runtimev1.APIArgumentType_API_ARGUMENT_TYPE_TIMESTAMP:
+		schema.Type = "string"
+		schema.Format = "date-time"
+	default:
+		schema.Type = "string"
+	}
+	if a.DefaultValue != nil {
+		schema.Default = a.DefaultValue.AsInterface()
+	}
+	for _, v := range a.EnumValues {
+		schema.Enum = append(schema.Enum, v.AsInterface())
+	}
+	return schema
+}
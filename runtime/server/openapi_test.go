@@ -0,0 +1,76 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestNewOpenAPISpec(t *testing.T) {
+	apis := []*runtimev1.Resource{
+		{
+			Meta: &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: "rill.runtime.v1.API", Name: "bids"}},
+			Resource: &runtimev1.Resource_Api{Api: &runtimev1.API{Spec: &runtimev1.APISpec{
+				Description: "Bids by domain",
+				Args: []*runtimev1.APIArgument{
+					{Name: "domain", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_STRING, Required: true},
+					{Name: "limit", Type: runtimev1.APIArgumentType_API_ARGUMENT_TYPE_INTEGER, DefaultValue: structpb.NewNumberValue(10)},
+				},
+			}}},
+		},
+		{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: "rill.runtime.v1.API", Name: "untyped"}},
+			Resource: &runtimev1.Resource_Api{Api: &runtimev1.API{Spec: &runtimev1.APISpec{}}},
+		},
+	}
+
+	data, err := json.Marshal(newOpenAPISpec("inst", apis))
+	require.NoError(t, err)
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(data, &spec))
+	require.Equal(t, "3.0.3", spec["openapi"])
+	require.Equal(t, []any{map[string]any{"url": "."}}, spec["servers"])
+
+	paths := spec["paths"].(map[string]any)
+	require.Len(t, paths, 2)
+
+	get := paths["/bids"].(map[string]any)["get"].(map[string]any)
+	require.Equal(t, "get_bids", get["operationId"])
+	require.Equal(t, "Bids by domain", get["summary"])
+	require.Equal(t, []any{
+		map[string]any{"name": "domain", "in": "query", "required": true, "schema": map[string]any{"type": "string"}},
+		map[string]any{"name": "limit", "in": "query", "schema": map[string]any{"type": "integer", "format": "int64", "default": 10.0}},
+		map[string]any{"name": "_limit", "in": "query", "description": "The maximum number of rows to return. If set, the result is paginated.", "schema": map[string]any{"type": "integer", "format": "int64"}},
+		map[string]any{"name": "_page_token", "in": "query", "description": "The token returned in the X-Next-Page-Token header of the previous page", "schema": map[string]any{"type": "string"}},
+	}, get["parameters"])
+	require.Contains(t, get["responses"].(map[string]any)["200"].(map[string]any)["headers"], "X-Next-Page-Token")
+
+	post := paths["/bids"].(map[string]any)["post"].(map[string]any)
+	body := post["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"]
+	require.Equal(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"domain":      map[string]any{"type": "string"},
+			"limit":       map[string]any{"type": "integer", "format": "int64", "default": 10.0},
+			"_limit":      map[string]any{"type": "integer", "format": "int64"},
+			"_page_token": map[string]any{"type": "string"},
+		},
+		"required":             []any{"domain"},
+		"additionalProperties": false,
+	}, body)
+
+	post = paths["/untyped"].(map[string]any)["post"].(map[string]any)
+	body = post["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"]
+	require.Equal(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"_limit":      map[string]any{"type": "integer", "format": "int64"},
+			"_page_token": map[string]any{"type": "string"},
+		},
+		"additionalProperties": true,
+	}, body)
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/pgwire"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServePostgres starts a server for the Postgres wire protocol.
+// It enables BI tools to query metrics views using metrics SQL. The database name is the instance ID and the password is a JWT for the runtime.
+func (s *Server) ServePostgres(ctx context.Context) error {
+	var tlsConfig *tls.Config
+	if s.opts.TLSCertPath != "" && s.opts.TLSKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(s.opts.TLSCertPath, s.opts.TLSKeyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	if s.aud != nil && tlsConfig == nil {
+		s.logger.Warn("no TLS certificate is configured, so the Postgres wire protocol will reject all clients since passwords must be sent over TLS")
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.opts.PostgresPort))
+	if err != nil {
+		if strings.Contains(err.Error(), "address already in use") {
+			return fmt.Errorf("postgres port %d is in use by another process", s.opts.PostgresPort)
+		}
+		return err
+	}
+
+	srv := pgwire.NewServer(&pgwire.Options{
+		Handler:         &postgresHandler{server: s},
+		Logger:          s.logger,
+		RequirePassword: s.aud != nil,
+		TLSConfig:       tlsConfig,
+	})
+	s.logger.Sugar().Infof("serving Postgres wire protocol on port:%v", s.opts.PostgresPort)
+	return srv.Serve(ctx, lis)
+}
+
+// postgresHandler implements pgwire.Handler by resolving queries as metrics SQL.
+type postgresHandler struct {
+	server *Server
+}
+
+var _ pgwire.Handler = (*postgresHandler)(nil)
+
+// Authenticate implements pgwire.Handler.
+func (h *postgresHandler) Authenticate(ctx context.Context, database, user, password string) (context.Context, error) {
+	ctx, err := auth.WithBearerToken(ctx, h.server.aud, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.GetClaims(ctx).CanInstance(database, auth.ReadMetrics) {
+		return nil, &pgwire.Error{Code: "28000", Message: fmt.Sprintf("does not have access to metrics views in database %q", database)}
+	}
+
+	if _, err := h.server.runtime.Instance(ctx, database); err != nil {
+		return nil, &pgwire.Error{Code: "3D000", Message: fmt.Sprintf("database %q does not exist", database)}
+	}
+
+	return ctx, nil
+}
+
+// Query implements pgwire.Handler.
+func (h *postgresHandler) Query(ctx context.Context, database, sql string) (*pgwire.Result, error) {
+	ctx, span := tracer.Start(ctx, "postgres.Query", trace.WithAttributes(
+		attribute.String("instance_id", database),
+		attribute.String("sql", sql),
+	))
+	defer span.End()
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(database, auth.ReadMetrics) {
+		return nil, &pgwire.Error{Code: "42501", Message: "does not have access to metrics views"}
+	}
+
+	res, err := h.server.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         database,
+		Resolver:           "metrics_sql",
+		ResolverProperties: map[string]any{"sql": sql},
+		UserAttributes:     claims.Attributes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return postgresResult(res)
+}
+
+// Catalog implements pgwire.Handler.
+// It exposes each metrics view the client can access as a table with a column for its time dimension and each of its dimensions and measures.
+func (h *postgresHandler) Catalog(ctx context.Context, database string) ([]pgwire.Table, error) {
+	ctx, span := tracer.Start(ctx, "postgres.Catalog", trace.WithAttributes(
+		attribute.String("instance_id", database),
+	))
+	defer span.End()
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(database, auth.ReadMetrics) {
+		return nil, &pgwire.Error{Code: "42501", Message: "does not have access to metrics views"}
+	}
+
+	ctrl, err := h.server.runtime.Controller(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := ctrl.List(ctx, runtime.ResourceKindMetricsView, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []pgwire.Table
+	for _, r := range rs {
+		if r.GetMetricsView().State.ValidSpec == nil {
+			continue
+		}
+		r, access, err := h.server.applySecurityPolicyMetricsView(ctx, database, r)
+		if err != nil {
+			return nil, err
+		}
+		if !access {
+			continue
+		}
+		spec := r.GetMetricsView().State.ValidSpec
+
+		q := &queries.MetricsViewSchema{
+			MetricsViewName:    r.Meta.Name.Name,
+			SecurityAttributes: claims.Attributes(),
+		}
+		err = h.server.runtime.Query(ctx, database, q, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve schema of metrics view %q: %w", r.Meta.Name.Name, err)
+		}
+		types := make(map[string]*runtimev1.Type)
+		for _, f := range q.Result.Schema.Fields {
+			types[f.Name] = f.Type
+		}
+		column := func(name string) pgwire.Column {
+			oid := uint32(pgtype.TextOID)
+			if t, ok := types[name]; ok {
+				oid = postgresTypeOID(t)
+			}
+			return pgwire.Column{Name: name, TypeOID: oid}
+		}
+
+		t := pgwire.Table{Name: r.Meta.Name.Name}
+		if spec.TimeDimension != "" {
+			t.Columns = append(t.Columns, pgwire.Column{Name: spec.TimeDimension, TypeOID: pgtype.TimestamptzOID})
+		}
+		for _, d := range spec.Dimensions {
+			if d.Name != spec.TimeDimension {
+				t.Columns = append(t.Columns, column(d.Name))
+			}
+		}
+		for _, m := range spec.Measures {
+			t.Columns = append(t.Columns, column(m.Name))
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// postgresResult converts the JSON output of a resolver to a pgwire.Result.
+func postgresResult(res runtime.ResolveResult) (*pgwire.Result, error) {
+	if res.Schema == nil {
+		return nil, errors.New("query did not return a schema")
+	}
+
+	cols := make([]pgwire.Column, len(res.Schema.Fields))
+	for i, f := range res.Schema.Fields {
+		cols[i] = pgwire.Column{Name: f.Name, TypeOID: postgresTypeOID(f.Type)}
+	}
+
+	var data []map[string]any
+	dec := json.NewDecoder(bytes.NewReader(res.Data))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode query result: %w", err)
+	}
+
+	rows := make([][]any, len(data))
+	for i, obj := range data {
+		row := make([]any, len(res.Schema.Fields))
+		for j, f := range res.Schema.Fields {
+			v, err := postgresValue(f.Type, obj[f.Name])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for column %q: %w", f.Name, err)
+			}
+			row[j] = v
+		}
+		rows[i] = row
+	}
+
+	return &pgwire.Result{Columns: cols, Rows: rows}, nil
+}
+
+// postgresValue converts a JSON decoded value to a value that pgwire encodes correctly for the type.
+func postgresValue(t *runtimev1.Type, v any) (any, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	switch t.Code {
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE:
+		return time.Parse(time.RFC3339Nano, s)
+	case runtimev1.Type_CODE_BYTES:
+		return base64.StdEncoding.DecodeString(s)
+	default:
+		return s, nil
+	}
+}
+
+// postgresTypeOID maps a runtime type to the closest Postgres type.
+func postgresTypeOID(t *runtimev1.Type) uint32 {
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return pgtype.BoolOID
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_UINT8:
+		return pgtype.Int2OID
+	case runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_UINT16:
+		return pgtype.Int4OID
+	case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_UINT32:
+		return pgtype.Int8OID
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_DECIMAL:
+		return pgtype.NumericOID
+	case runtimev1.Type_CODE_FLOAT32:
+		return pgtype.Float4OID
+	case runtimev1.Type_CODE_FLOAT64:
+		return pgtype.Float8OID
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return pgtype.TimestamptzOID
+	case runtimev1.Type_CODE_DATE:
+		return pgtype.DateOID
+	case runtimev1.Type_CODE_TIME:
+		return pgtype.TimeOID
+	case runtimev1.Type_CODE_BYTES:
+		return pgtype.ByteaOID
+	case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON:
+		return pgtype.JSONOID
+	case runtimev1.Type_CODE_UUID:
+		return pgtype.UUIDOID
+	default:
+		return pgtype.TextOID
+	}
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -9,6 +11,7 @@ import (
 	jsonvalue "github.com/Andrew-M-C/go.jsonvalue"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"google.golang.org/grpc/codes"
@@ -25,10 +28,67 @@ func unmarshalJSON(sql []byte) (*jsonvalue.V, error) {
 	return jsonvalue.Unmarshal(sql)
 }
 
+// queryArrowChunkSize is the maximum size of the Arrow IPC chunks sent by QueryArrow.
+// It keeps messages well below gRPC's default max message size of 4 MB.
+const queryArrowChunkSize = 1 << 20
+
 // Query implements QueryService.
 func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runtimev1.QueryResponse, error) {
+	res, release, err := s.executeQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// NOTE: Currently, query returns nil res for successful dry-run queries
+	if req.DryRun {
+		// TODO: Return a meta object for dry-run queries
+		return &runtimev1.QueryResponse{}, nil
+	}
+
+	defer res.Close()
+
+	data, err := rowsToData(res)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &runtimev1.QueryResponse{
+		Meta: res.Schema,
+		Data: data,
+	}
+
+	return resp, nil
+}
+
+// QueryArrow implements QueryService.
+// Record batches are sent to the client as they are produced, so the full result is never buffered in memory.
+func (s *Server) QueryArrow(req *runtimev1.QueryRequest, srv runtimev1.QueryService_QueryArrowServer) error {
+	res, release, err := s.executeQuery(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if req.DryRun {
+		return nil
+	}
+
+	defer res.Close()
+
+	w := bufio.NewWriterSize(&queryArrowWriter{srv: srv}, queryArrowChunkSize)
+	err = arrowutil.WriteRows(w, res.Schema, res)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// executeQuery runs the SQL query in a QueryRequest.
+// The caller must call the returned release func when done with the result.
+func (s *Server) executeQuery(ctx context.Context, req *runtimev1.QueryRequest) (*drivers.Result, func(), error) {
 	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadOLAP) {
-		return nil, ErrForbidden
+		return nil, nil, ErrForbidden
 	}
 
 	args := make([]any, len(req.Args))
@@ -38,15 +98,15 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 
 	olap, release, err := s.runtime.OLAP(ctx, req.InstanceId, req.Connector)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer release()
 
 	transformedSQL := req.Sql
 	if req.Limit != 0 {
 		transformedSQL, err = ensureLimits(ctx, olap, req.Sql, int(req.Limit))
 		if err != nil {
-			return nil, err
+			release()
+			return nil, nil, err
 		}
 	}
 
@@ -58,29 +118,36 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 		ExecutionTimeout: 2 * time.Minute,
 	})
 	if err != nil {
+		release()
 		// TODO: Parse error to determine error code
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	// NOTE: Currently, query returns nil res for successful dry-run queries
-	if req.DryRun {
-		// TODO: Return a meta object for dry-run queries
-		return &runtimev1.QueryResponse{}, nil
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	defer res.Close()
+	return res, release, nil
+}
 
-	data, err := rowsToData(res)
-	if err != nil {
-		return nil, err
-	}
+// queryArrowWriter is an io.Writer that sends the data written to it to a QueryArrow client.
+// Each write is split into messages of at most queryArrowChunkSize bytes.
+type queryArrowWriter struct {
+	srv runtimev1.QueryService_QueryArrowServer
+}
 
-	resp := &runtimev1.QueryResponse{
-		Meta: res.Schema,
-		Data: data,
+func (w *queryArrowWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > queryArrowChunkSize {
+			chunk = chunk[:queryArrowChunkSize]
+		}
+		// The chunk is copied because p may be reused after Write returns, and gRPC doesn't allow modifying a message after it's sent.
+		err := w.srv.Send(&runtimev1.QueryArrowResponse{ArrowIpc: bytes.Clone(chunk)})
+		if err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
 	}
-
-	return resp, nil
+	return n, nil
 }
 
 func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
@@ -51,6 +51,7 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		Measures:           req.Measures,
 		Sort:               req.Sort,
 		TimeRange:          tr,
+		TimeDimension:      req.TimeDimension,
 		Where:              req.Where,
 		Having:             req.Having,
 		Filter:             req.Filter,
@@ -184,6 +185,7 @@ func (s *Server) MetricsViewComparison(ctx context.Context, req *runtimev1.Metri
 		ComparisonMeasures:  req.ComparisonMeasures,
 		TimeRange:           req.TimeRange,
 		ComparisonTimeRange: req.ComparisonTimeRange,
+		TimeDimension:       req.TimeDimension,
 		Limit:               req.Limit,
 		Offset:              req.Offset,
 		Sort:                req.Sort,
@@ -248,6 +250,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		Where:              req.Where,
 		Having:             req.Having,
 		TimeZone:           req.TimeZone,
+		TimeDimension:      req.TimeDimension,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
 		Filter:             req.Filter,
@@ -386,6 +389,7 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 		MetricsViewName:    req.MetricsViewName,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
+		TimeDimension:      req.TimeDimension,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -2,12 +2,16 @@ package server_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/apache/arrow/go/v14/arrow"
 	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
 	"github.com/apache/arrow/go/v14/arrow/memory"
 	"github.com/apache/arrow/go/v14/parquet"
 
@@ -20,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc"
 )
 
 func TestServer_MetricsViewRows(t *testing.T) {
@@ -308,6 +313,132 @@ func TestServer_MetricsViewRows_parquet_export(t *testing.T) {
 	require.True(t, len(tuuid.Value(0)) > 0)
 }
 
+func TestServer_MetricsViewRows_arrow_export(t *testing.T) {
+	t.Parallel()
+	rt, instanceId := testruntime.NewInstanceForProject(t, "ad_bids_2rows")
+
+	ctx := testCtx()
+	mvName := "ad_bids_metrics_parquet"
+	mv, security := resolveMVAndSecurity(t, rt, instanceId, mvName)
+
+	q := &queries.MetricsViewRows{
+		MetricsViewName:    mvName,
+		TimeGranularity:    runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MetricsView:        mv,
+		ResolvedMVSecurity: security,
+	}
+
+	var buf bytes.Buffer
+
+	err := q.Export(ctx, rt, instanceId, &buf, &runtime.ExportOptions{
+		Format: runtimev1.ExportFormat_EXPORT_FORMAT_ARROW,
+	})
+	require.NoError(t, err)
+
+	rdr, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer rdr.Release()
+	require.True(t, rdr.Next())
+	rec := rdr.Record()
+
+	schema := rec.Schema()
+	col := func(name string) arrow.Array {
+		idx := schema.FieldIndices(name)
+		require.Len(t, idx, 1, "missing column %q", name)
+		return rec.Column(idx[0])
+	}
+
+	require.Equal(t, int8(-1), col("tint1").(*array.Int8).Value(0))
+	require.Equal(t, int64(-8), col("tint8").(*array.Int64).Value(0))
+	require.Equal(t, uint64(8), col("tuint8").(*array.Uint64).Value(0))
+	require.Equal(t, float64(1), col("thugeint").(*array.Float64).Value(0))
+	require.Equal(t, float64(1), col("tdecimal").(*array.Float64).Value(0))
+	require.Equal(t, true, col("tbool").(*array.Boolean).Value(0))
+	require.Equal(t, `["a","b"]`, col("tlist").(*array.String).Value(0))
+	require.Equal(t, "2023-01-01T00:00:00Z", col("timestamp").(*array.Timestamp).Value(0).ToTime(arrow.Microsecond).Format(time.RFC3339))
+	require.Equal(t, "12:00:00", col("ttime").(*array.Time64).Value(0).ToTime(arrow.Microsecond).Format(time.TimeOnly))
+	require.Equal(t, "2023-01-02", col("tdate").(*array.Date32).Value(0).FormattedString())
+	require.Len(t, col("tuuid").(*array.String).Value(0), 36)
+
+	require.False(t, rdr.Next())
+	require.NoError(t, rdr.Err())
+}
+
+func TestServer_QueryArrow(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	srv := &fakeQueryArrowServer{ctx: testCtx()}
+	err := server.QueryArrow(&runtimev1.QueryRequest{
+		InstanceId: instanceId,
+		Sql:        "SELECT domain, bid_price FROM ad_bids ORDER BY domain",
+	}, srv)
+	require.NoError(t, err)
+	require.NotEmpty(t, srv.chunks)
+
+	var buf bytes.Buffer
+	for _, c := range srv.chunks {
+		buf.Write(c)
+	}
+
+	rdr, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer rdr.Release()
+	require.Len(t, rdr.Schema().Fields(), 2)
+	require.True(t, rdr.Next())
+	rec := rdr.Record()
+	require.Equal(t, int64(2), rec.NumRows())
+	require.Equal(t, "msn.com", rec.Column(0).(*array.String).Value(0))
+	require.False(t, rdr.Next())
+	require.NoError(t, rdr.Err())
+}
+
+type fakeQueryArrowServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (s *fakeQueryArrowServer) Send(m *runtimev1.QueryArrowResponse) error {
+	s.chunks = append(s.chunks, m.ArrowIpc)
+	return nil
+}
+
+func (s *fakeQueryArrowServer) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_MetricsViewRows_export_jsonl(t *testing.T) {
+	t.Parallel()
+	rt, instanceId := testruntime.NewInstanceForProject(t, "ad_bids_2rows")
+
+	ctx := testCtx()
+	mvName := "ad_bids_metrics"
+	mv, security := resolveMVAndSecurity(t, rt, instanceId, mvName)
+
+	q := &queries.MetricsViewRows{
+		MetricsViewName:    mvName,
+		TimeGranularity:    runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MetricsView:        mv,
+		ResolvedMVSecurity: security,
+	}
+
+	var buf bytes.Buffer
+
+	err := q.Export(ctx, rt, instanceId, &buf, &runtime.ExportOptions{
+		Format: runtimev1.ExportFormat_EXPORT_FORMAT_JSONL,
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		var row map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &row))
+		require.Contains(t, row, "domain")
+	}
+}
+
 func TestServer_MetricsViewRows_export_csv(t *testing.T) {
 	t.Parallel()
 	rt, instanceId := testruntime.NewInstanceForProject(t, "ad_bids_2rows")
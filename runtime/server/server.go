@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -41,6 +42,7 @@ var ErrForbidden = status.Error(codes.Unauthenticated, "action not allowed")
 type Options struct {
 	HTTPPort         int
 	GRPCPort         int
+	PostgresPort     int
 	AllowedOrigins   []string
 	ServePrometheus  bool
 	SessionKeyPairs  [][]byte
@@ -63,6 +65,8 @@ type Server struct {
 	codec    *securetoken.Codec
 	limiter  ratelimit.Limiter
 	activity *activity.Client
+	// unenforcedRateLimits tracks the APIs that have been warned about declaring a rate limit that is not enforced.
+	unenforcedRateLimits sync.Map
 }
 
 var (
@@ -213,6 +217,9 @@ func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers fun
 	// Add handler for dynamic APIs, i.e. APIs backed by resolvers (such as custom APIs defined in YAML).
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/{name...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.apiHandler))))
 
+	// Add handler for the OpenAPI spec of the dynamic APIs
+	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/openapi.json", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.openAPIHandler))))
+
 	// Add handler for resolving chart data
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/charts/{name}/data", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.chartDataHandler))))
 
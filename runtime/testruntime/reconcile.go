@@ -170,6 +170,9 @@ func RequireResource(t testing.TB, rt *runtime.Runtime, id string, a *runtimev1.
 			e.StartedOn = nil
 			e.FinishedOn = nil
 		}
+	case runtime.ResourceKindTest:
+		state := b.GetTest().State
+		state.EvaluatedOn = nil
 	}
 
 	// Hack to only compare the Resource field (not Meta)
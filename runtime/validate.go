@@ -8,6 +8,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/metricsview"
 )
 
 type ValidateMetricsViewResult struct {
@@ -85,9 +86,60 @@ func (r *Runtime) ValidateMetricsView(ctx context.Context, instanceID string, mv
 		}
 	}
 
+	// Check joined tables and join keys exist
+	joins := make(map[string]*joinedTable, len(mv.Joins))
+	for _, j := range mv.Joins {
+		jt, err := olap.InformationSchema().Lookup(ctx, j.Table)
+		if err != nil {
+			if errors.Is(err, drivers.ErrNotFound) {
+				res.OtherErrs = append(res.OtherErrs, fmt.Errorf("table %q for join %q does not exist", j.Table, j.Name))
+				continue
+			}
+			return nil, fmt.Errorf("could not find table %q: %w", j.Table, err)
+		}
+
+		jfields := make(map[string]*runtimev1.StructType_Field, len(jt.Schema.Fields))
+		for _, f := range jt.Schema.Fields {
+			jfields[strings.ToLower(f.Name)] = f
+		}
+
+		valid := true
+		for _, k := range j.Keys {
+			if _, ok := fields[strings.ToLower(k.Column)]; !ok {
+				res.OtherErrs = append(res.OtherErrs, fmt.Errorf("join %q: column %q not found in table %q", j.Name, k.Column, mv.Table))
+				valid = false
+			}
+			if _, ok := jfields[strings.ToLower(k.JoinColumn)]; !ok {
+				res.OtherErrs = append(res.OtherErrs, fmt.Errorf("join %q: column %q not found in table %q", j.Name, k.JoinColumn, j.Table))
+				valid = false
+			}
+		}
+		if !valid {
+			continue
+		}
+
+		// Check the join keys are unique in the joined table, so the join doesn't duplicate rows of the metrics view's table
+		err = validateJoinKeys(ctx, olap, mv, j)
+		if err != nil {
+			res.OtherErrs = append(res.OtherErrs, err)
+			continue
+		}
+
+		joins[strings.ToLower(j.Name)] = &joinedTable{join: j, fields: jfields}
+	}
+
 	// Check dimension columns exist
 	for idx, d := range mv.Dimensions {
-		err = validateDimension(ctx, olap, t, d, fields)
+		if d.Join != "" {
+			jt, ok := joins[strings.ToLower(d.Join)]
+			if !ok {
+				// The join is invalid, which has already been reported
+				continue
+			}
+			err = validateJoinedDimension(ctx, olap, t, jt, d)
+		} else {
+			err = validateDimension(ctx, olap, t, d, fields)
+		}
 		if err != nil {
 			res.DimensionErrs = append(res.DimensionErrs, IndexErr{
 				Idx: idx,
@@ -98,7 +150,7 @@ func (r *Runtime) ValidateMetricsView(ctx context.Context, instanceID string, mv
 
 	// Check measure expressions are valid
 	for idx, d := range mv.Measures {
-		err := validateMeasure(ctx, olap, t, d)
+		err := validateMeasure(ctx, olap, t, mv, d)
 		if err != nil {
 			res.MeasureErrs = append(res.MeasureErrs, IndexErr{
 				Idx: idx,
@@ -138,9 +190,61 @@ func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.T
 	return nil
 }
 
-func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+// joinedTable is a join of a metrics view that has been validated against the OLAP.
+type joinedTable struct {
+	join   *runtimev1.MetricsViewSpec_JoinV2
+	fields map[string]*runtimev1.StructType_Field
+}
+
+// validateJoinKeys returns an error if a value of the join's key columns occurs more than once in the joined table.
+// Since joins are looked up per row of the metrics view's table, duplicate keys would make measures double count those rows.
+func validateJoinKeys(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsViewSpec, j *runtimev1.MetricsViewSpec_JoinV2) error {
+	cols := make([]string, 0, len(j.Keys))
+	for _, k := range j.Keys {
+		cols = append(cols, safeSQLName(k.JoinColumn))
+	}
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT 1 FROM %s GROUP BY %s HAVING COUNT(*) > 1 LIMIT 1", safeSQLName(j.Table), strings.Join(cols, ", ")),
+	})
+	if err != nil {
+		return fmt.Errorf("join %q: failed to check join keys: %w", j.Name, err)
+	}
+	defer res.Close()
+
+	if res.Next() {
+		return fmt.Errorf("join %q: the join keys are not unique in table %q, which would duplicate rows of table %q", j.Name, j.Table, mv.Table)
+	}
+	return res.Err()
+}
+
+func validateJoinedDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, jt *joinedTable, d *runtimev1.MetricsViewSpec_DimensionV2) error {
+	if d.Column != "" {
+		if _, isColumn := jt.fields[strings.ToLower(d.Column)]; !isColumn {
+			return fmt.Errorf("failed to validate dimension %q: column %q not found in joined table %q", d.Name, d.Column, jt.join.Table)
+		}
+		return nil
+	}
+
 	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT 1, %s FROM %s GROUP BY 1", m.Expression, safeSQLName(t.Name)),
+		Query:  fmt.Sprintf("SELECT (%s) FROM %s LEFT JOIN %s AS %s ON %s GROUP BY 1", d.Expression, safeSQLName(t.Name), safeSQLName(jt.join.Table), safeSQLName(jt.join.Name), metricsview.JoinCondition(t.Name, jt.join, olap.Dialect())),
+		DryRun: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to validate expression for dimension %q: %w", d.Name, err)
+	}
+	return nil
+}
+
+func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+	// Measures computed over time are validated with a constant order since the query isn't grouped by time
+	expr, _, err := metricsview.MeasureExpression(mv, m, olap.Dialect(), &metricsview.Window{OrderBy: "1"})
+	if err != nil {
+		return err
+	}
+
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:  fmt.Sprintf("SELECT 1, %s FROM %s GROUP BY 1", expr, safeSQLName(t.Name)),
 		DryRun: true,
 	})
 	return err
@@ -34,3 +34,36 @@ func TestValidateMetricsView(t *testing.T) {
 	require.Equal(t, 1, res.MeasureErrs[0].Idx)
 	require.Equal(t, 2, res.MeasureErrs[1].Idx)
 }
+
+func TestValidateMetricsViewJoinKeys(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:            ``,
+			`models/orders.sql`:    `SELECT * FROM (VALUES (1, 10), (2, 30)) t(customer_id, amount)`,
+			`models/customers.sql`: `SELECT * FROM (VALUES (1, 'US'), (1, 'DK'), (2, 'DK')) t(id, country)`,
+		},
+	})
+
+	res, err := rt.ValidateMetricsView(context.Background(), instanceID, &runtimev1.MetricsViewSpec{
+		Connector: "duckdb",
+		Table:     "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{
+				Name:  "customers",
+				Table: "customers",
+				Keys:  []*runtimev1.MetricsViewSpec_JoinV2_Key{{Column: "customer_id", JoinColumn: "id"}},
+			},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country", Join: "customers"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "total", Expression: "sum(amount)"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.DimensionErrs)
+	require.Empty(t, res.MeasureErrs)
+	require.Len(t, res.OtherErrs, 1)
+	require.ErrorContains(t, res.OtherErrs[0], `join "customers": the join keys are not unique in table "customers"`)
+}
@@ -14,8 +14,9 @@ import type {
   QueryKey,
 } from "@tanstack/svelte-query";
 import type {
-  V1TriggerReconcileResponse,
+  V1DeleteDeploymentResponse,
   RpcStatus,
+  V1TriggerReconcileResponse,
   AdminServiceTriggerReconcileBodyBody,
   V1TriggerRefreshSourcesResponse,
   AdminServiceTriggerRefreshSourcesBody,
@@ -31,6 +32,8 @@ import type {
   AdminServiceUpdateOrganizationBody,
   V1ListOrganizationInvitesResponse,
   AdminServiceListOrganizationInvitesParams,
+  V1ListAuditEventsResponse,
+  AdminServiceListAuditEventsParams,
   V1ListOrganizationMembersResponse,
   AdminServiceListOrganizationMembersParams,
   V1AddOrganizationMemberResponse,
@@ -40,6 +43,18 @@ import type {
   V1SetOrganizationMemberRoleResponse,
   AdminServiceSetOrganizationMemberRoleBodyBody,
   V1LeaveOrganizationResponse,
+  V1ListOrganizationRolesResponse,
+  V1CreateOrganizationRoleResponse,
+  AdminServiceCreateOrganizationRoleBody,
+  V1DeleteOrganizationRoleResponse,
+  V1UpdateOrganizationRoleResponse,
+  AdminServiceUpdateOrganizationRoleBody,
+  V1ListProjectRolesResponse,
+  V1CreateProjectRoleResponse,
+  AdminServiceCreateProjectRoleBody,
+  V1DeleteProjectRoleResponse,
+  V1UpdateProjectRoleResponse,
+  AdminServiceUpdateProjectRoleBody,
   V1CreateAlertResponse,
   AdminServiceCreateAlertBodyBody,
   V1GenerateAlertYAMLResponse,
@@ -49,6 +64,9 @@ import type {
   V1GetAlertYAMLResponse,
   V1GetDeploymentCredentialsResponse,
   AdminServiceGetDeploymentCredentialsBody,
+  V1ListDeploymentsResponse,
+  V1CreateDeploymentResponse,
+  AdminServiceCreateDeploymentBody,
   V1GetGitCredentialsResponse,
   V1GetIFrameResponse,
   AdminServiceGetIFrameBody,
@@ -66,8 +84,23 @@ import type {
   V1EditReportResponse,
   V1TriggerReportResponse,
   V1UnsubscribeReportResponse,
+  V1ListProjectMemberUsergroupsResponse,
+  AdminServiceListProjectMemberUsergroupsParams,
+  V1RemoveUsergroupProjectRoleResponse,
+  V1SetUsergroupProjectRoleResponse,
   V1SearchProjectUsersResponse,
   AdminServiceSearchProjectUsersParams,
+  V1ListOrganizationUsergroupsResponse,
+  AdminServiceListOrganizationUsergroupsParams,
+  V1CreateUsergroupResponse,
+  AdminServiceCreateUsergroupBodyBody,
+  V1DeleteUsergroupResponse,
+  V1RenameUsergroupResponse,
+  V1ListUsergroupMembersResponse,
+  AdminServiceListUsergroupMembersParams,
+  V1AddUsergroupMemberResponse,
+  AdminServiceAddUsergroupMemberBody,
+  V1RemoveUsergroupMemberResponse,
   V1ListWhitelistedDomainsResponse,
   V1CreateWhitelistedDomainResponse,
   AdminServiceCreateWhitelistedDomainBody,
@@ -142,6 +175,51 @@ type AwaitedInput<T> = PromiseLike<T> | T;
 
 type Awaited<O> = O extends AwaitedInput<infer T> ? T : never;
 
+/**
+ * @summary DeleteDeployment tears down a preview deployment
+ */
+export const adminServiceDeleteDeployment = (deploymentId: string) => {
+  return httpClient<V1DeleteDeploymentResponse>({
+    url: `/v1/deployments/${deploymentId}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteDeploymentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteDeployment>>
+>;
+
+export type AdminServiceDeleteDeploymentMutationError = RpcStatus;
+
+export const createAdminServiceDeleteDeployment = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteDeployment>>,
+    TError,
+    { deploymentId: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteDeployment>>,
+    { deploymentId: string }
+  > = (props) => {
+    const { deploymentId } = props ?? {};
+
+    return adminServiceDeleteDeployment(deploymentId);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteDeployment>>,
+    TError,
+    { deploymentId: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary TriggerReconcile triggers reconcile for the project's prod deployment
  */
@@ -573,6 +651,75 @@ export const createAdminServiceUpdateOrganization = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary ListAuditEvents lists the audit events for an organization, most recent first
+ */
+export const adminServiceListAuditEvents = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListAuditEventsResponse>({
+    url: `/v1/organizations/${organization}/audit-events`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditEventsQueryKey = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+) => [
+  `/v1/organizations/${organization}/audit-events`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListAuditEventsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+>;
+export type AdminServiceListAuditEventsQueryError = RpcStatus;
+
+export const createAdminServiceListAuditEvents = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditEventsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+  > = ({ signal }) =>
+    adminServiceListAuditEvents(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
 /**
  * @summary ListOrganizationInvites lists all the org invites
  */
@@ -930,217 +1077,200 @@ export const createAdminServiceLeaveOrganization = <
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary CreateAlert adds a virtual file for an alert, triggers a reconcile, and waits for the alert to be added to the runtime catalog
+ * @summary ListOrganizationRoles lists the built-in and custom organization roles available in an organization
  */
-export const adminServiceCreateAlert = (
+export const adminServiceListOrganizationRoles = (
   organization: string,
-  project: string,
-  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1CreateAlertResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateAlertBodyBody,
+  return httpClient<V1ListOrganizationRolesResponse>({
+    url: `/v1/organizations/${organization}/org-roles`,
+    method: "get",
+    signal,
   });
 };
 
-export type AdminServiceCreateAlertMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceCreateAlert>>
+export const getAdminServiceListOrganizationRolesQueryKey = (
+  organization: string,
+) => [`/v1/organizations/${organization}/org-roles`];
+
+export type AdminServiceListOrganizationRolesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>
 >;
-export type AdminServiceCreateAlertMutationBody =
-  AdminServiceCreateAlertBodyBody;
-export type AdminServiceCreateAlertMutationError = RpcStatus;
+export type AdminServiceListOrganizationRolesQueryError = RpcStatus;
 
-export const createAdminServiceCreateAlert = <
+export const createAdminServiceListOrganizationRoles = <
+  TData = Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
   TError = RpcStatus,
-  TContext = unknown,
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
-    TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    },
-    TContext
-  >;
-}) => {
-  const { mutation: mutationOptions } = options ?? {};
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
 
-  const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    }
-  > = (props) => {
-    const { organization, project, data } = props ?? {};
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListOrganizationRolesQueryKey(organization);
 
-    return adminServiceCreateAlert(organization, project, data);
-  };
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>
+  > = ({ signal }) => adminServiceListOrganizationRoles(organization, signal);
 
-  return createMutation<
-    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListOrganizationRoles>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    },
-    TContext
-  >(mutationFn, mutationOptions);
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
 };
+
 /**
- * @summary GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
+ * @summary CreateOrganizationRole creates a custom organization role
  */
-export const adminServiceGenerateAlertYAML = (
+export const adminServiceCreateOrganizationRole = (
   organization: string,
-  project: string,
-  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+  adminServiceCreateOrganizationRoleBody: AdminServiceCreateOrganizationRoleBody,
 ) => {
-  return httpClient<V1GenerateAlertYAMLResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts/-/yaml`,
+  return httpClient<V1CreateOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/org-roles`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateAlertBodyBody,
+    data: adminServiceCreateOrganizationRoleBody,
   });
 };
 
-export type AdminServiceGenerateAlertYAMLMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>
+export type AdminServiceCreateOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>
 >;
-export type AdminServiceGenerateAlertYAMLMutationBody =
-  AdminServiceCreateAlertBodyBody;
-export type AdminServiceGenerateAlertYAMLMutationError = RpcStatus;
+export type AdminServiceCreateOrganizationRoleMutationBody =
+  AdminServiceCreateOrganizationRoleBody;
+export type AdminServiceCreateOrganizationRoleMutationError = RpcStatus;
 
-export const createAdminServiceGenerateAlertYAML = <
+export const createAdminServiceCreateOrganizationRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    },
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    }
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody }
   > = (props) => {
-    const { organization, project, data } = props ?? {};
+    const { organization, data } = props ?? {};
 
-    return adminServiceGenerateAlertYAML(organization, project, data);
+    return adminServiceCreateOrganizationRole(organization, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
+    Awaited<ReturnType<typeof adminServiceCreateOrganizationRole>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateAlertBodyBody;
-    },
+    { organization: string; data: AdminServiceCreateOrganizationRoleBody },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary DeleteAlert deletes the virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be deleted in the runtime
+ * @summary DeleteOrganizationRole deletes a custom organization role that is not in use
  */
-export const adminServiceDeleteAlert = (
+export const adminServiceDeleteOrganizationRole = (
   organization: string,
-  project: string,
   name: string,
 ) => {
-  return httpClient<V1DeleteAlertResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+  return httpClient<V1DeleteOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/org-roles/${name}`,
     method: "delete",
   });
 };
 
-export type AdminServiceDeleteAlertMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceDeleteAlert>>
+export type AdminServiceDeleteOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>
 >;
 
-export type AdminServiceDeleteAlertMutationError = RpcStatus;
+export type AdminServiceDeleteOrganizationRoleMutationError = RpcStatus;
 
-export const createAdminServiceDeleteAlert = <
+export const createAdminServiceDeleteOrganizationRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
     TError,
-    { organization: string; project: string; name: string },
+    { organization: string; name: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
-    { organization: string; project: string; name: string }
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
+    { organization: string; name: string }
   > = (props) => {
-    const { organization, project, name } = props ?? {};
+    const { organization, name } = props ?? {};
 
-    return adminServiceDeleteAlert(organization, project, name);
+    return adminServiceDeleteOrganizationRole(organization, name);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationRole>>,
     TError,
-    { organization: string; project: string; name: string },
+    { organization: string; name: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary EditAlert edits a virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be updated in the runtime
+ * @summary UpdateOrganizationRole updates the permissions of a custom organization role
  */
-export const adminServiceEditAlert = (
+export const adminServiceUpdateOrganizationRole = (
   organization: string,
-  project: string,
   name: string,
-  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+  adminServiceUpdateOrganizationRoleBody: AdminServiceUpdateOrganizationRoleBody,
 ) => {
-  return httpClient<V1EditAlertResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+  return httpClient<V1UpdateOrganizationRoleResponse>({
+    url: `/v1/organizations/${organization}/org-roles/${name}`,
     method: "put",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateAlertBodyBody,
+    data: adminServiceUpdateOrganizationRoleBody,
   });
 };
 
-export type AdminServiceEditAlertMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceEditAlert>>
+export type AdminServiceUpdateOrganizationRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>
 >;
-export type AdminServiceEditAlertMutationBody = AdminServiceCreateAlertBodyBody;
-export type AdminServiceEditAlertMutationError = RpcStatus;
+export type AdminServiceUpdateOrganizationRoleMutationBody =
+  AdminServiceUpdateOrganizationRoleBody;
+export type AdminServiceUpdateOrganizationRoleMutationError = RpcStatus;
 
-export const createAdminServiceEditAlert = <
+export const createAdminServiceUpdateOrganizationRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
     TError,
     {
       organization: string;
-      project: string;
       name: string;
-      data: AdminServiceCreateAlertBodyBody;
+      data: AdminServiceUpdateOrganizationRoleBody;
     },
     TContext
   >;
@@ -1148,67 +1278,1259 @@ export const createAdminServiceEditAlert = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
     {
       organization: string;
-      project: string;
       name: string;
-      data: AdminServiceCreateAlertBodyBody;
+      data: AdminServiceUpdateOrganizationRoleBody;
     }
   > = (props) => {
-    const { organization, project, name, data } = props ?? {};
+    const { organization, name, data } = props ?? {};
 
-    return adminServiceEditAlert(organization, project, name, data);
+    return adminServiceUpdateOrganizationRole(organization, name, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationRole>>,
     TError,
     {
       organization: string;
-      project: string;
       name: string;
-      data: AdminServiceCreateAlertBodyBody;
+      data: AdminServiceUpdateOrganizationRoleBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary UnsubscribeAlert removes the calling user from a alert's recipients list
+ * @summary ListProjectRoles lists the built-in and custom project roles available in an organization
  */
-export const adminServiceUnsubscribeAlert = (
+export const adminServiceListProjectRoles = (
   organization: string,
-  project: string,
-  name: string,
-  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1UnsubscribeAlertResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}/unsubscribe`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceTriggerReconcileBodyBody,
+  return httpClient<V1ListProjectRolesResponse>({
+    url: `/v1/organizations/${organization}/project-roles`,
+    method: "get",
+    signal,
   });
 };
 
-export type AdminServiceUnsubscribeAlertMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>
+export const getAdminServiceListProjectRolesQueryKey = (
+  organization: string,
+) => [`/v1/organizations/${organization}/project-roles`];
+
+export type AdminServiceListProjectRolesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectRoles>>
 >;
-export type AdminServiceUnsubscribeAlertMutationBody =
-  AdminServiceTriggerReconcileBodyBody;
-export type AdminServiceUnsubscribeAlertMutationError = RpcStatus;
+export type AdminServiceListProjectRolesQueryError = RpcStatus;
 
-export const createAdminServiceUnsubscribeAlert = <
+export const createAdminServiceListProjectRoles = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectRolesQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectRoles>>
+  > = ({ signal }) => adminServiceListProjectRoles(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectRoles>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateProjectRole creates a custom project role
+ */
+export const adminServiceCreateProjectRole = (
+  organization: string,
+  adminServiceCreateProjectRoleBody: AdminServiceCreateProjectRoleBody,
+) => {
+  return httpClient<V1CreateProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateProjectRoleBody,
+  });
+};
+
+export type AdminServiceCreateProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateProjectRole>>
+>;
+export type AdminServiceCreateProjectRoleMutationBody =
+  AdminServiceCreateProjectRoleBody;
+export type AdminServiceCreateProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceCreateProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateProjectRoleBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    { organization: string; data: AdminServiceCreateProjectRoleBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceCreateProjectRole(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateProjectRole>>,
+    TError,
+    { organization: string; data: AdminServiceCreateProjectRoleBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteProjectRole deletes a custom project role that is not in use
+ */
+export const adminServiceDeleteProjectRole = (
+  organization: string,
+  name: string,
+) => {
+  return httpClient<V1DeleteProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles/${name}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>
+>;
+
+export type AdminServiceDeleteProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceDeleteProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    TError,
+    { organization: string; name: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    { organization: string; name: string }
+  > = (props) => {
+    const { organization, name } = props ?? {};
+
+    return adminServiceDeleteProjectRole(organization, name);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteProjectRole>>,
+    TError,
+    { organization: string; name: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UpdateProjectRole updates the permissions of a custom project role
+ */
+export const adminServiceUpdateProjectRole = (
+  organization: string,
+  name: string,
+  adminServiceUpdateProjectRoleBody: AdminServiceUpdateProjectRoleBody,
+) => {
+  return httpClient<V1UpdateProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/project-roles/${name}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateProjectRoleBody,
+  });
+};
+
+export type AdminServiceUpdateProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>
+>;
+export type AdminServiceUpdateProjectRoleMutationBody =
+  AdminServiceUpdateProjectRoleBody;
+export type AdminServiceUpdateProjectRoleMutationError = RpcStatus;
+
+export const createAdminServiceUpdateProjectRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    TError,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    }
+  > = (props) => {
+    const { organization, name, data } = props ?? {};
+
+    return adminServiceUpdateProjectRole(organization, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectRole>>,
+    TError,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceUpdateProjectRoleBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary CreateAlert adds a virtual file for an alert, triggers a reconcile, and waits for the alert to be added to the runtime catalog
+ */
+export const adminServiceCreateAlert = (
+  organization: string,
+  project: string,
+  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+) => {
+  return httpClient<V1CreateAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateAlertBodyBody,
+  });
+};
+
+export type AdminServiceCreateAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateAlert>>
+>;
+export type AdminServiceCreateAlertMutationBody =
+  AdminServiceCreateAlertBodyBody;
+export type AdminServiceCreateAlertMutationError = RpcStatus;
+
+export const createAdminServiceCreateAlert = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceCreateAlert(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
+ */
+export const adminServiceGenerateAlertYAML = (
+  organization: string,
+  project: string,
+  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+) => {
+  return httpClient<V1GenerateAlertYAMLResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/-/yaml`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateAlertBodyBody,
+  });
+};
+
+export type AdminServiceGenerateAlertYAMLMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>
+>;
+export type AdminServiceGenerateAlertYAMLMutationBody =
+  AdminServiceCreateAlertBodyBody;
+export type AdminServiceGenerateAlertYAMLMutationError = RpcStatus;
+
+export const createAdminServiceGenerateAlertYAML = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceGenerateAlertYAML(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceGenerateAlertYAML>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteAlert deletes the virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be deleted in the runtime
+ */
+export const adminServiceDeleteAlert = (
+  organization: string,
+  project: string,
+  name: string,
+) => {
+  return httpClient<V1DeleteAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteAlert>>
+>;
+
+export type AdminServiceDeleteAlertMutationError = RpcStatus;
+
+export const createAdminServiceDeleteAlert = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    { organization: string; project: string; name: string }
+  > = (props) => {
+    const { organization, project, name } = props ?? {};
+
+    return adminServiceDeleteAlert(organization, project, name);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary EditAlert edits a virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be updated in the runtime
+ */
+export const adminServiceEditAlert = (
+  organization: string,
+  project: string,
+  name: string,
+  adminServiceCreateAlertBodyBody: AdminServiceCreateAlertBodyBody,
+) => {
+  return httpClient<V1EditAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateAlertBodyBody,
+  });
+};
+
+export type AdminServiceEditAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceEditAlert>>
+>;
+export type AdminServiceEditAlertMutationBody = AdminServiceCreateAlertBodyBody;
+export type AdminServiceEditAlertMutationError = RpcStatus;
+
+export const createAdminServiceEditAlert = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateAlertBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, name, data } = props ?? {};
+
+    return adminServiceEditAlert(organization, project, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateAlertBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UnsubscribeAlert removes the calling user from a alert's recipients list
+ */
+export const adminServiceUnsubscribeAlert = (
+  organization: string,
+  project: string,
+  name: string,
+  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
+) => {
+  return httpClient<V1UnsubscribeAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}/unsubscribe`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceTriggerReconcileBodyBody,
+  });
+};
+
+export type AdminServiceUnsubscribeAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>
+>;
+export type AdminServiceUnsubscribeAlertMutationBody =
+  AdminServiceTriggerReconcileBodyBody;
+export type AdminServiceUnsubscribeAlertMutationError = RpcStatus;
+
+export const createAdminServiceUnsubscribeAlert = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, name, data } = props ?? {};
+
+    return adminServiceUnsubscribeAlert(organization, project, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
+ */
+export const adminServiceGetAlertYAML = (
+  organization: string,
+  project: string,
+  name: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetAlertYAMLResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}/yaml`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetAlertYAMLQueryKey = (
+  organization: string,
+  project: string,
+  name: string,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/alerts/${name}/yaml`,
+];
+
+export type AdminServiceGetAlertYAMLQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetAlertYAML>>
+>;
+export type AdminServiceGetAlertYAMLQueryError = RpcStatus;
+
+export const createAdminServiceGetAlertYAML = <
+  TData = Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetAlertYAMLQueryKey(organization, project, name);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetAlertYAML>>
+  > = ({ signal }) =>
+    adminServiceGetAlertYAML(organization, project, name, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project && name),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetDeploymentCredentials returns runtime info and access token on behalf of a specific user, or alternatively for a raw set of JWT attributes
+ */
+export const adminServiceGetDeploymentCredentials = (
+  organization: string,
+  project: string,
+  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+) => {
+  return httpClient<V1GetDeploymentCredentialsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/credentials`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceGetDeploymentCredentialsBody,
+  });
+};
+
+export const getAdminServiceGetDeploymentCredentialsQueryKey = (
+  organization: string,
+  project: string,
+  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/credentials`,
+  adminServiceGetDeploymentCredentialsBody,
+];
+
+export type AdminServiceGetDeploymentCredentialsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>
+>;
+export type AdminServiceGetDeploymentCredentialsQueryError = RpcStatus;
+
+export const createAdminServiceGetDeploymentCredentials = <
+  TData = Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetDeploymentCredentialsQueryKey(
+      organization,
+      project,
+      adminServiceGetDeploymentCredentialsBody,
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>
+  > = () =>
+    adminServiceGetDeploymentCredentials(
+      organization,
+      project,
+      adminServiceGetDeploymentCredentialsBody,
+    );
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListDeployments lists the prod and preview deployments of a project
+ */
+export const adminServiceListDeployments = (
+  organization: string,
+  project: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListDeploymentsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/deployments`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListDeploymentsQueryKey = (
+  organization: string,
+  project: string,
+) => [`/v1/organizations/${organization}/projects/${project}/deployments`];
+
+export type AdminServiceListDeploymentsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListDeployments>>
+>;
+export type AdminServiceListDeploymentsQueryError = RpcStatus;
+
+export const createAdminServiceListDeployments = <
+  TData = Awaited<ReturnType<typeof adminServiceListDeployments>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListDeployments>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListDeploymentsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListDeployments>>
+  > = ({ signal }) =>
+    adminServiceListDeployments(organization, project, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListDeployments>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateDeployment creates a preview deployment of a non-prod branch of a project
+ */
+export const adminServiceCreateDeployment = (
+  organization: string,
+  project: string,
+  adminServiceCreateDeploymentBody: AdminServiceCreateDeploymentBody,
+) => {
+  return httpClient<V1CreateDeploymentResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/deployments`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateDeploymentBody,
+  });
+};
+
+export type AdminServiceCreateDeploymentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateDeployment>>
+>;
+export type AdminServiceCreateDeploymentMutationBody =
+  AdminServiceCreateDeploymentBody;
+export type AdminServiceCreateDeploymentMutationError = RpcStatus;
+
+export const createAdminServiceCreateDeployment = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateDeployment>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateDeploymentBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateDeployment>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateDeploymentBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceCreateDeployment(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateDeployment>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateDeploymentBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ */
+export const adminServiceGetGitCredentials = (
+  organization: string,
+  project: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetGitCredentialsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/git-credentials`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetGitCredentialsQueryKey = (
+  organization: string,
+  project: string,
+) => [`/v1/organizations/${organization}/projects/${project}/git-credentials`];
+
+export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+>;
+export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
+
+export const createAdminServiceGetGitCredentials = <
+  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+  > = ({ signal }) =>
+    adminServiceGetGitCredentials(organization, project, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetIFrame returns the iframe URL for the given project
+ */
+export const adminServiceGetIFrame = (
+  organization: string,
+  project: string,
+  adminServiceGetIFrameBody: AdminServiceGetIFrameBody,
+) => {
+  return httpClient<V1GetIFrameResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/iframe`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceGetIFrameBody,
+  });
+};
+
+export type AdminServiceGetIFrameMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetIFrame>>
+>;
+export type AdminServiceGetIFrameMutationBody = AdminServiceGetIFrameBody;
+export type AdminServiceGetIFrameMutationError = RpcStatus;
+
+export const createAdminServiceGetIFrame = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    TError,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceGetIFrame(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    TError,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListProjectInvites lists all the project invites
+ */
+export const adminServiceListProjectInvites = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectInvitesResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/invites`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectInvitesQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/invites`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListProjectInvitesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+>;
+export type AdminServiceListProjectInvitesQueryError = RpcStatus;
+
+export const createAdminServiceListProjectInvites = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+  > = ({ signal }) =>
+    adminServiceListProjectInvites(organization, project, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListProjectMembers lists all the project members
+ */
+export const adminServiceListProjectMembers = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectMembersResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectMembersQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/members`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListProjectMembersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+>;
+export type AdminServiceListProjectMembersQueryError = RpcStatus;
+
+export const createAdminServiceListProjectMembers = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+  > = ({ signal }) =>
+    adminServiceListProjectMembers(organization, project, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary AddProjectMember adds a member to the project
+ */
+export const adminServiceAddProjectMember = (
+  organization: string,
+  project: string,
+  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody,
+) => {
+  return httpClient<V1AddProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceAddOrganizationMemberBodyBody,
+  });
+};
+
+export type AdminServiceAddProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
+>;
+export type AdminServiceAddProjectMemberMutationBody =
+  AdminServiceAddOrganizationMemberBodyBody;
+export type AdminServiceAddProjectMemberMutationError = RpcStatus;
+
+export const createAdminServiceAddProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceAddProjectMember(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RemoveProjectMember removes member from the project
+ */
+export const adminServiceRemoveProjectMember = (
+  organization: string,
+  project: string,
+  email: string,
+) => {
+  return httpClient<V1RemoveProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
+>;
+
+export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+
+export const createAdminServiceRemoveProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    { organization: string; project: string; email: string }
+  > = (props) => {
+    const { organization, project, email } = props ?? {};
+
+    return adminServiceRemoveProjectMember(organization, project, email);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetProjectMemberRole sets the role for the member
+ */
+export const adminServiceSetProjectMemberRole = (
+  organization: string,
+  project: string,
+  email: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
+) => {
+  return httpClient<V1SetProjectMemberRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
+  });
+};
+
+export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
+>;
+export type AdminServiceSetProjectMemberRoleMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+
+export const createAdminServiceSetProjectMemberRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
     TError,
     {
       organization: string;
       project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
   >;
@@ -1216,142 +2538,135 @@ export const createAdminServiceUnsubscribeAlert = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
     {
       organization: string;
       project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
     }
   > = (props) => {
-    const { organization, project, name, data } = props ?? {};
+    const { organization, project, email, data } = props ?? {};
 
-    return adminServiceUnsubscribeAlert(organization, project, name, data);
+    return adminServiceSetProjectMemberRole(organization, project, email, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeAlert>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
     TError,
     {
       organization: string;
       project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
+ * @summary CreateReport adds a virtual file for a report, triggers a reconcile, and waits for the report to be added to the runtime catalog
  */
-export const adminServiceGetAlertYAML = (
+export const adminServiceCreateReport = (
   organization: string,
   project: string,
-  name: string,
-  signal?: AbortSignal,
+  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
 ) => {
-  return httpClient<V1GetAlertYAMLResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}/yaml`,
-    method: "get",
-    signal,
+  return httpClient<V1CreateReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateReportBodyBody,
   });
 };
 
-export const getAdminServiceGetAlertYAMLQueryKey = (
-  organization: string,
-  project: string,
-  name: string,
-) => [
-  `/v1/organizations/${organization}/projects/${project}/alerts/${name}/yaml`,
-];
-
-export type AdminServiceGetAlertYAMLQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetAlertYAML>>
+export type AdminServiceCreateReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateReport>>
 >;
-export type AdminServiceGetAlertYAMLQueryError = RpcStatus;
+export type AdminServiceCreateReportMutationBody =
+  AdminServiceCreateReportBodyBody;
+export type AdminServiceCreateReportMutationError = RpcStatus;
 
-export const createAdminServiceGetAlertYAML = <
-  TData = Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
+export const createAdminServiceCreateReport = <
   TError = RpcStatus,
->(
-  organization: string,
-  project: string,
-  name: string,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
-      TError,
-      TData
-    >;
-  },
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceGetAlertYAMLQueryKey(organization, project, name);
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetAlertYAML>>
-  > = ({ signal }) =>
-    adminServiceGetAlertYAML(organization, project, name, signal);
+    return adminServiceCreateReport(organization, project, data);
+  };
 
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetAlertYAML>>,
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
     TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project && name),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
-
-  query.queryKey = queryKey;
-
-  return query;
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
 };
-
 /**
- * @summary GetDeploymentCredentials returns runtime info and access token on behalf of a specific user, or alternatively for a raw set of JWT attributes
+ * @summary GenerateReportYAML generates YAML for a scheduled report to be copied into a project's Git repository
  */
-export const adminServiceGetDeploymentCredentials = (
+export const adminServiceGenerateReportYAML = (
   organization: string,
   project: string,
-  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
 ) => {
-  return httpClient<V1GetDeploymentCredentialsResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/credentials`,
+  return httpClient<V1GenerateReportYAMLResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/-/yaml`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceGetDeploymentCredentialsBody,
+    data: adminServiceCreateReportBodyBody,
   });
 };
 
-export const getAdminServiceGetDeploymentCredentialsQueryKey = (
+export const getAdminServiceGenerateReportYAMLQueryKey = (
   organization: string,
   project: string,
-  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
 ) => [
-  `/v1/organizations/${organization}/projects/${project}/credentials`,
-  adminServiceGetDeploymentCredentialsBody,
+  `/v1/organizations/${organization}/projects/${project}/reports/-/yaml`,
+  adminServiceCreateReportBodyBody,
 ];
 
-export type AdminServiceGetDeploymentCredentialsQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>
+export type AdminServiceGenerateReportYAMLQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>
 >;
-export type AdminServiceGetDeploymentCredentialsQueryError = RpcStatus;
+export type AdminServiceGenerateReportYAMLQueryError = RpcStatus;
 
-export const createAdminServiceGetDeploymentCredentials = <
-  TData = Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+export const createAdminServiceGenerateReportYAML = <
+  TData = Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
   TError = RpcStatus,
 >(
   organization: string,
   project: string,
-  adminServiceGetDeploymentCredentialsBody: AdminServiceGetDeploymentCredentialsBody,
+  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+      Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
       TError,
       TData
     >;
@@ -1361,23 +2676,23 @@ export const createAdminServiceGetDeploymentCredentials = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGetDeploymentCredentialsQueryKey(
+    getAdminServiceGenerateReportYAMLQueryKey(
       organization,
       project,
-      adminServiceGetDeploymentCredentialsBody,
+      adminServiceCreateReportBodyBody,
     );
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>
+    Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>
   > = () =>
-    adminServiceGetDeploymentCredentials(
+    adminServiceGenerateReportYAML(
       organization,
       project,
-      adminServiceGetDeploymentCredentialsBody,
+      adminServiceCreateReportBodyBody,
     );
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetDeploymentCredentials>>,
+    Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
     TError,
     TData
   >({
@@ -1393,236 +2708,299 @@ export const createAdminServiceGetDeploymentCredentials = <
 };
 
 /**
- * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ * @summary DeleteReport deletes the virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be deleted in the runtime
  */
-export const adminServiceGetGitCredentials = (
+export const adminServiceDeleteReport = (
   organization: string,
   project: string,
-  signal?: AbortSignal,
+  name: string,
 ) => {
-  return httpClient<V1GetGitCredentialsResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/git-credentials`,
-    method: "get",
-    signal,
+  return httpClient<V1DeleteReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
+    method: "delete",
   });
 };
 
-export const getAdminServiceGetGitCredentialsQueryKey = (
-  organization: string,
-  project: string,
-) => [`/v1/organizations/${organization}/projects/${project}/git-credentials`];
-
-export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+export type AdminServiceDeleteReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteReport>>
 >;
-export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
 
-export const createAdminServiceGetGitCredentials = <
-  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+export type AdminServiceDeleteReportMutationError = RpcStatus;
+
+export const createAdminServiceDeleteReport = <
   TError = RpcStatus,
->(
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    { organization: string; project: string; name: string }
+  > = (props) => {
+    const { organization, project, name } = props ?? {};
+
+    return adminServiceDeleteReport(organization, project, name);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary EditReport edits a virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be updated in the runtime
+ */
+export const adminServiceEditReport = (
   organization: string,
   project: string,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
-      TError,
-      TData
-    >;
-  },
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
-
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+  name: string,
+  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+) => {
+  return httpClient<V1EditReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateReportBodyBody,
+  });
+};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
-  > = ({ signal }) =>
-    adminServiceGetGitCredentials(organization, project, signal);
+export type AdminServiceEditReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceEditReport>>
+>;
+export type AdminServiceEditReportMutationBody =
+  AdminServiceCreateReportBodyBody;
+export type AdminServiceEditReportMutationError = RpcStatus;
 
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+export const createAdminServiceEditReport = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
     TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateReportBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  query.queryKey = queryKey;
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateReportBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, name, data } = props ?? {};
 
-  return query;
-};
+    return adminServiceEditReport(organization, project, name, data);
+  };
 
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceCreateReportBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
- * @summary GetIFrame returns the iframe URL for the given project
+ * @summary TriggerReport triggers an ad-hoc report run
  */
-export const adminServiceGetIFrame = (
+export const adminServiceTriggerReport = (
   organization: string,
   project: string,
-  adminServiceGetIFrameBody: AdminServiceGetIFrameBody,
+  name: string,
+  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
 ) => {
-  return httpClient<V1GetIFrameResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/iframe`,
+  return httpClient<V1TriggerReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}/trigger`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceGetIFrameBody,
+    data: adminServiceTriggerReconcileBodyBody,
   });
 };
 
-export type AdminServiceGetIFrameMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetIFrame>>
+export type AdminServiceTriggerReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceTriggerReport>>
 >;
-export type AdminServiceGetIFrameMutationBody = AdminServiceGetIFrameBody;
-export type AdminServiceGetIFrameMutationError = RpcStatus;
+export type AdminServiceTriggerReportMutationBody =
+  AdminServiceTriggerReconcileBodyBody;
+export type AdminServiceTriggerReportMutationError = RpcStatus;
 
-export const createAdminServiceGetIFrame = <
+export const createAdminServiceTriggerReport = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
     TError,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody }
+    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    }
   > = (props) => {
-    const { organization, project, data } = props ?? {};
+    const { organization, project, name, data } = props ?? {};
 
-    return adminServiceGetIFrame(organization, project, data);
+    return adminServiceTriggerReport(organization, project, name, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
     TError,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary ListProjectInvites lists all the project invites
+ * @summary UnsubscribeReport removes the calling user from a reports recipients list
  */
-export const adminServiceListProjectInvites = (
+export const adminServiceUnsubscribeReport = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  signal?: AbortSignal,
+  name: string,
+  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
 ) => {
-  return httpClient<V1ListProjectInvitesResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/invites`,
-    method: "get",
-    params,
-    signal,
+  return httpClient<V1UnsubscribeReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}/unsubscribe`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceTriggerReconcileBodyBody,
   });
 };
 
-export const getAdminServiceListProjectInvitesQueryKey = (
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-) => [
-  `/v1/organizations/${organization}/projects/${project}/invites`,
-  ...(params ? [params] : []),
-];
-
-export type AdminServiceListProjectInvitesQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+export type AdminServiceUnsubscribeReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>
 >;
-export type AdminServiceListProjectInvitesQueryError = RpcStatus;
+export type AdminServiceUnsubscribeReportMutationBody =
+  AdminServiceTriggerReconcileBodyBody;
+export type AdminServiceUnsubscribeReportMutationError = RpcStatus;
 
-export const createAdminServiceListProjectInvites = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+export const createAdminServiceUnsubscribeReport = <
   TError = RpcStatus,
->(
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-      TError,
-      TData
-    >;
-  },
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, name, data } = props ?? {};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
-  > = ({ signal }) =>
-    adminServiceListProjectInvites(organization, project, params, signal);
+    return adminServiceUnsubscribeReport(organization, project, name, data);
+  };
 
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
     TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
-
-  query.queryKey = queryKey;
-
-  return query;
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceTriggerReconcileBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
 };
-
 /**
- * @summary ListProjectMembers lists all the project members
+ * @summary ListProjectMemberUsergroups lists the user groups that have a role in the project
  */
-export const adminServiceListProjectMembers = (
+export const adminServiceListProjectMemberUsergroups = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   signal?: AbortSignal,
 ) => {
-  return httpClient<V1ListProjectMembersResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
+  return httpClient<V1ListProjectMemberUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups`,
     method: "get",
     params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectMembersQueryKey = (
+export const getAdminServiceListProjectMemberUsergroupsQueryKey = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
 ) => [
-  `/v1/organizations/${organization}/projects/${project}/members`,
+  `/v1/organizations/${organization}/projects/${project}/usergroups`,
   ...(params ? [params] : []),
 ];
 
-export type AdminServiceListProjectMembersQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+export type AdminServiceListProjectMemberUsergroupsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
 >;
-export type AdminServiceListProjectMembersQueryError = RpcStatus;
+export type AdminServiceListProjectMemberUsergroupsQueryError = RpcStatus;
 
-export const createAdminServiceListProjectMembers = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+export const createAdminServiceListProjectMemberUsergroups = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
   TError = RpcStatus,
 >(
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
       TError,
       TData
     >;
@@ -1632,15 +3010,24 @@ export const createAdminServiceListProjectMembers = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+    getAdminServiceListProjectMemberUsergroupsQueryKey(
+      organization,
+      project,
+      params,
+    );
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
   > = ({ signal }) =>
-    adminServiceListProjectMembers(organization, project, params, signal);
+    adminServiceListProjectMemberUsergroups(
+      organization,
+      project,
+      params,
+      signal,
+    );
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
     TError,
     TData
   >({
@@ -1656,153 +3043,93 @@ export const createAdminServiceListProjectMembers = <
 };
 
 /**
- * @summary AddProjectMember adds a member to the project
- */
-export const adminServiceAddProjectMember = (
-  organization: string,
-  project: string,
-  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody,
-) => {
-  return httpClient<V1AddProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceAddOrganizationMemberBodyBody,
-  });
-};
-
-export type AdminServiceAddProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
->;
-export type AdminServiceAddProjectMemberMutationBody =
-  AdminServiceAddOrganizationMemberBodyBody;
-export type AdminServiceAddProjectMemberMutationError = RpcStatus;
-
-export const createAdminServiceAddProjectMember = <
-  TError = RpcStatus,
-  TContext = unknown,
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
-    TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
-    },
-    TContext
-  >;
-}) => {
-  const { mutation: mutationOptions } = options ?? {};
-
-  const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
-    }
-  > = (props) => {
-    const { organization, project, data } = props ?? {};
-
-    return adminServiceAddProjectMember(organization, project, data);
-  };
-
-  return createMutation<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
-    TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
-    },
-    TContext
-  >(mutationFn, mutationOptions);
-};
-/**
- * @summary RemoveProjectMember removes member from the project
+ * @summary RemoveUsergroupProjectRole removes the role of a user group in the project
  */
-export const adminServiceRemoveProjectMember = (
+export const adminServiceRemoveUsergroupProjectRole = (
   organization: string,
   project: string,
-  email: string,
+  usergroup: string,
 ) => {
-  return httpClient<V1RemoveProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1RemoveUsergroupProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}`,
     method: "delete",
   });
 };
 
-export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
+export type AdminServiceRemoveUsergroupProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveUsergroupProjectRole>>
 >;
 
-export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+export type AdminServiceRemoveUsergroupProjectRoleMutationError = RpcStatus;
 
-export const createAdminServiceRemoveProjectMember = <
+export const createAdminServiceRemoveUsergroupProjectRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupProjectRole>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; usergroup: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
-    { organization: string; project: string; email: string }
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupProjectRole>>,
+    { organization: string; project: string; usergroup: string }
   > = (props) => {
-    const { organization, project, email } = props ?? {};
+    const { organization, project, usergroup } = props ?? {};
 
-    return adminServiceRemoveProjectMember(organization, project, email);
+    return adminServiceRemoveUsergroupProjectRole(
+      organization,
+      project,
+      usergroup,
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupProjectRole>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; usergroup: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary SetProjectMemberRole sets the role for the member
+ * @summary SetUsergroupProjectRole sets the role of a user group in the project
  */
-export const adminServiceSetProjectMemberRole = (
+export const adminServiceSetUsergroupProjectRole = (
   organization: string,
   project: string,
-  email: string,
+  usergroup: string,
   adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
 ) => {
-  return httpClient<V1SetProjectMemberRoleResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1SetUsergroupProjectRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}`,
     method: "put",
     headers: { "Content-Type": "application/json" },
     data: adminServiceSetOrganizationMemberRoleBodyBody,
   });
 };
 
-export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
+export type AdminServiceSetUsergroupProjectRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetUsergroupProjectRole>>
 >;
-export type AdminServiceSetProjectMemberRoleMutationBody =
+export type AdminServiceSetUsergroupProjectRoleMutationBody =
   AdminServiceSetOrganizationMemberRoleBodyBody;
-export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+export type AdminServiceSetUsergroupProjectRoleMutationError = RpcStatus;
 
-export const createAdminServiceSetProjectMemberRole = <
+export const createAdminServiceSetUsergroupProjectRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetUsergroupProjectRole>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
@@ -1811,135 +3138,147 @@ export const createAdminServiceSetProjectMemberRole = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetUsergroupProjectRole>>,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     }
   > = (props) => {
-    const { organization, project, email, data } = props ?? {};
+    const { organization, project, usergroup, data } = props ?? {};
 
-    return adminServiceSetProjectMemberRole(organization, project, email, data);
+    return adminServiceSetUsergroupProjectRole(
+      organization,
+      project,
+      usergroup,
+      data,
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetUsergroupProjectRole>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary CreateReport adds a virtual file for a report, triggers a reconcile, and waits for the report to be added to the runtime catalog
+ * @summary SearchProjectUsers returns users who has access to to a project (including org members that have access through a usergroup)
  */
-export const adminServiceCreateReport = (
+export const adminServiceSearchProjectUsers = (
   organization: string,
   project: string,
-  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+  params?: AdminServiceSearchProjectUsersParams,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1CreateReportResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateReportBodyBody,
+  return httpClient<V1SearchProjectUsersResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/users/search`,
+    method: "get",
+    params,
+    signal,
   });
 };
 
-export type AdminServiceCreateReportMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceCreateReport>>
+export const getAdminServiceSearchProjectUsersQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceSearchProjectUsersParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/users/search`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceSearchProjectUsersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>
 >;
-export type AdminServiceCreateReportMutationBody =
-  AdminServiceCreateReportBodyBody;
-export type AdminServiceCreateReportMutationError = RpcStatus;
+export type AdminServiceSearchProjectUsersQueryError = RpcStatus;
 
-export const createAdminServiceCreateReport = <
+export const createAdminServiceSearchProjectUsers = <
+  TData = Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
   TError = RpcStatus,
-  TContext = unknown,
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateReport>>,
-    TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateReportBodyBody;
-    },
-    TContext
-  >;
-}) => {
-  const { mutation: mutationOptions } = options ?? {};
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceSearchProjectUsersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
 
-  const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateReport>>,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateReportBodyBody;
-    }
-  > = (props) => {
-    const { organization, project, data } = props ?? {};
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceSearchProjectUsersQueryKey(organization, project, params);
 
-    return adminServiceCreateReport(organization, project, data);
-  };
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>
+  > = ({ signal }) =>
+    adminServiceSearchProjectUsers(organization, project, params, signal);
 
-  return createMutation<
-    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceCreateReportBodyBody;
-    },
-    TContext
-  >(mutationFn, mutationOptions);
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
 };
+
 /**
- * @summary GenerateReportYAML generates YAML for a scheduled report to be copied into a project's Git repository
+ * @summary ListOrganizationUsergroups lists the user groups in the organization
  */
-export const adminServiceGenerateReportYAML = (
+export const adminServiceListOrganizationUsergroups = (
   organization: string,
-  project: string,
-  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+  params?: AdminServiceListOrganizationUsergroupsParams,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1GenerateReportYAMLResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports/-/yaml`,
-    method: "post",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateReportBodyBody,
+  return httpClient<V1ListOrganizationUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "get",
+    params,
+    signal,
   });
 };
 
-export const getAdminServiceGenerateReportYAMLQueryKey = (
+export const getAdminServiceListOrganizationUsergroupsQueryKey = (
   organization: string,
-  project: string,
-  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+  params?: AdminServiceListOrganizationUsergroupsParams,
 ) => [
-  `/v1/organizations/${organization}/projects/${project}/reports/-/yaml`,
-  adminServiceCreateReportBodyBody,
+  `/v1/organizations/${organization}/usergroups`,
+  ...(params ? [params] : []),
 ];
 
-export type AdminServiceGenerateReportYAMLQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>
+export type AdminServiceListOrganizationUsergroupsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListOrganizationUsergroups>>
 >;
-export type AdminServiceGenerateReportYAMLQueryError = RpcStatus;
+export type AdminServiceListOrganizationUsergroupsQueryError = RpcStatus;
 
-export const createAdminServiceGenerateReportYAML = <
-  TData = Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
+export const createAdminServiceListOrganizationUsergroups = <
+  TData = Awaited<ReturnType<typeof adminServiceListOrganizationUsergroups>>,
   TError = RpcStatus,
 >(
   organization: string,
-  project: string,
-  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+  params?: AdminServiceListOrganizationUsergroupsParams,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
+      Awaited<ReturnType<typeof adminServiceListOrganizationUsergroups>>,
       TError,
       TData
     >;
@@ -1949,29 +3288,21 @@ export const createAdminServiceGenerateReportYAML = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGenerateReportYAMLQueryKey(
-      organization,
-      project,
-      adminServiceCreateReportBodyBody,
-    );
-
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>
-  > = () =>
-    adminServiceGenerateReportYAML(
-      organization,
-      project,
-      adminServiceCreateReportBodyBody,
-    );
+    getAdminServiceListOrganizationUsergroupsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListOrganizationUsergroups>>
+  > = ({ signal }) =>
+    adminServiceListOrganizationUsergroups(organization, params, signal);
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGenerateReportYAML>>,
+    Awaited<ReturnType<typeof adminServiceListOrganizationUsergroups>>,
     TError,
     TData
   >({
     queryKey,
     queryFn,
-    enabled: !!(organization && project),
+    enabled: !!organization,
     ...queryOptions,
   }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
 
@@ -1981,158 +3312,138 @@ export const createAdminServiceGenerateReportYAML = <
 };
 
 /**
- * @summary DeleteReport deletes the virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be deleted in the runtime
+ * @summary CreateUsergroup creates a user group in the organization
  */
-export const adminServiceDeleteReport = (
+export const adminServiceCreateUsergroup = (
   organization: string,
-  project: string,
-  name: string,
+  adminServiceCreateUsergroupBodyBody: AdminServiceCreateUsergroupBodyBody,
 ) => {
-  return httpClient<V1DeleteReportResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
-    method: "delete",
+  return httpClient<V1CreateUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateUsergroupBodyBody,
   });
 };
 
-export type AdminServiceDeleteReportMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceDeleteReport>>
+export type AdminServiceCreateUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateUsergroup>>
 >;
+export type AdminServiceCreateUsergroupMutationBody =
+  AdminServiceCreateUsergroupBodyBody;
+export type AdminServiceCreateUsergroupMutationError = RpcStatus;
 
-export type AdminServiceDeleteReportMutationError = RpcStatus;
-
-export const createAdminServiceDeleteReport = <
+export const createAdminServiceCreateUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
     TError,
-    { organization: string; project: string; name: string },
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
-    { organization: string; project: string; name: string }
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody }
   > = (props) => {
-    const { organization, project, name } = props ?? {};
+    const { organization, data } = props ?? {};
 
-    return adminServiceDeleteReport(organization, project, name);
+    return adminServiceCreateUsergroup(organization, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
     TError,
-    { organization: string; project: string; name: string },
+    { organization: string; data: AdminServiceCreateUsergroupBodyBody },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary EditReport edits a virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be updated in the runtime
+ * @summary DeleteUsergroup deletes a user group
  */
-export const adminServiceEditReport = (
+export const adminServiceDeleteUsergroup = (
   organization: string,
-  project: string,
-  name: string,
-  adminServiceCreateReportBodyBody: AdminServiceCreateReportBodyBody,
+  usergroup: string,
 ) => {
-  return httpClient<V1EditReportResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
-    method: "put",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateReportBodyBody,
+  return httpClient<V1DeleteUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "delete",
   });
 };
 
-export type AdminServiceEditReportMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceEditReport>>
+export type AdminServiceDeleteUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>
 >;
-export type AdminServiceEditReportMutationBody =
-  AdminServiceCreateReportBodyBody;
-export type AdminServiceEditReportMutationError = RpcStatus;
 
-export const createAdminServiceEditReport = <
+export type AdminServiceDeleteUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceDeleteUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceEditReport>>,
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceCreateReportBodyBody;
-    },
+    { organization: string; usergroup: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceEditReport>>,
-    {
-      organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceCreateReportBodyBody;
-    }
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    { organization: string; usergroup: string }
   > = (props) => {
-    const { organization, project, name, data } = props ?? {};
+    const { organization, usergroup } = props ?? {};
 
-    return adminServiceEditReport(organization, project, name, data);
+    return adminServiceDeleteUsergroup(organization, usergroup);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceEditReport>>,
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
     TError,
-    {
-      organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceCreateReportBodyBody;
-    },
+    { organization: string; usergroup: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary TriggerReport triggers an ad-hoc report run
+ * @summary RenameUsergroup renames a user group
  */
-export const adminServiceTriggerReport = (
+export const adminServiceRenameUsergroup = (
   organization: string,
-  project: string,
-  name: string,
-  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
+  usergroup: string,
+  adminServiceCreateUsergroupBodyBody: AdminServiceCreateUsergroupBodyBody,
 ) => {
-  return httpClient<V1TriggerReportResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}/trigger`,
-    method: "post",
+  return httpClient<V1RenameUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "put",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceTriggerReconcileBodyBody,
+    data: adminServiceCreateUsergroupBodyBody,
   });
 };
 
-export type AdminServiceTriggerReportMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceTriggerReport>>
+export type AdminServiceRenameUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRenameUsergroup>>
 >;
-export type AdminServiceTriggerReportMutationBody =
-  AdminServiceTriggerReconcileBodyBody;
-export type AdminServiceTriggerReportMutationError = RpcStatus;
+export type AdminServiceRenameUsergroupMutationBody =
+  AdminServiceCreateUsergroupBodyBody;
+export type AdminServiceRenameUsergroupMutationError = RpcStatus;
 
-export const createAdminServiceTriggerReport = <
+export const createAdminServiceRenameUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
     TError,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
     },
     TContext
   >;
@@ -2140,67 +3451,140 @@ export const createAdminServiceTriggerReport = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
     }
   > = (props) => {
-    const { organization, project, name, data } = props ?? {};
+    const { organization, usergroup, data } = props ?? {};
 
-    return adminServiceTriggerReport(organization, project, name, data);
+    return adminServiceRenameUsergroup(organization, usergroup, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceTriggerReport>>,
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
     TError,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceCreateUsergroupBodyBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary UnsubscribeReport removes the calling user from a reports recipients list
+ * @summary ListUsergroupMembers lists the members of a user group
  */
-export const adminServiceUnsubscribeReport = (
+export const adminServiceListUsergroupMembers = (
   organization: string,
-  project: string,
-  name: string,
-  adminServiceTriggerReconcileBodyBody: AdminServiceTriggerReconcileBodyBody,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1UnsubscribeReportResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}/unsubscribe`,
+  return httpClient<V1ListUsergroupMembersResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListUsergroupMembersQueryKey = (
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
+) => [
+  `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListUsergroupMembersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>
+>;
+export type AdminServiceListUsergroupMembersQueryError = RpcStatus;
+
+export const createAdminServiceListUsergroupMembers = <
+  TData = Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListUsergroupMembersQueryKey(
+      organization,
+      usergroup,
+      params,
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>
+  > = ({ signal }) =>
+    adminServiceListUsergroupMembers(organization, usergroup, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && usergroup),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary AddUsergroupMember adds a member of the organization to a user group
+ */
+export const adminServiceAddUsergroupMember = (
+  organization: string,
+  usergroup: string,
+  adminServiceAddUsergroupMemberBody: AdminServiceAddUsergroupMemberBody,
+) => {
+  return httpClient<V1AddUsergroupMemberResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceTriggerReconcileBodyBody,
+    data: adminServiceAddUsergroupMemberBody,
   });
 };
 
-export type AdminServiceUnsubscribeReportMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>
+export type AdminServiceAddUsergroupMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>
 >;
-export type AdminServiceUnsubscribeReportMutationBody =
-  AdminServiceTriggerReconcileBodyBody;
-export type AdminServiceUnsubscribeReportMutationError = RpcStatus;
+export type AdminServiceAddUsergroupMemberMutationBody =
+  AdminServiceAddUsergroupMemberBody;
+export type AdminServiceAddUsergroupMemberMutationError = RpcStatus;
 
-export const createAdminServiceUnsubscribeReport = <
+export const createAdminServiceAddUsergroupMember = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
     TError,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
     },
     TContext
   >;
@@ -2208,104 +3592,78 @@ export const createAdminServiceUnsubscribeReport = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
     }
   > = (props) => {
-    const { organization, project, name, data } = props ?? {};
+    const { organization, usergroup, data } = props ?? {};
 
-    return adminServiceUnsubscribeReport(organization, project, name, data);
+    return adminServiceAddUsergroupMember(organization, usergroup, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceUnsubscribeReport>>,
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
     TError,
     {
       organization: string;
-      project: string;
-      name: string;
-      data: AdminServiceTriggerReconcileBodyBody;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary SearchProjectUsers returns users who has access to to a project (including org members that have access through a usergroup)
+ * @summary RemoveUsergroupMember removes a member from a user group
  */
-export const adminServiceSearchProjectUsers = (
+export const adminServiceRemoveUsergroupMember = (
   organization: string,
-  project: string,
-  params?: AdminServiceSearchProjectUsersParams,
-  signal?: AbortSignal,
+  usergroup: string,
+  email: string,
 ) => {
-  return httpClient<V1SearchProjectUsersResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/users/search`,
-    method: "get",
-    params,
-    signal,
+  return httpClient<V1RemoveUsergroupMemberResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members/${email}`,
+    method: "delete",
   });
 };
 
-export const getAdminServiceSearchProjectUsersQueryKey = (
-  organization: string,
-  project: string,
-  params?: AdminServiceSearchProjectUsersParams,
-) => [
-  `/v1/organizations/${organization}/projects/${project}/users/search`,
-  ...(params ? [params] : []),
-];
-
-export type AdminServiceSearchProjectUsersQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>
+export type AdminServiceRemoveUsergroupMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>
 >;
-export type AdminServiceSearchProjectUsersQueryError = RpcStatus;
 
-export const createAdminServiceSearchProjectUsers = <
-  TData = Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
+export type AdminServiceRemoveUsergroupMemberMutationError = RpcStatus;
+
+export const createAdminServiceRemoveUsergroupMember = <
   TError = RpcStatus,
->(
-  organization: string,
-  project: string,
-  params?: AdminServiceSearchProjectUsersParams,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
-      TError,
-      TData
-    >;
-  },
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
+    TError,
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceSearchProjectUsersQueryKey(organization, project, params);
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
+    { organization: string; usergroup: string; email: string }
+  > = (props) => {
+    const { organization, usergroup, email } = props ?? {};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>
-  > = ({ signal }) =>
-    adminServiceSearchProjectUsers(organization, project, params, signal);
+    return adminServiceRemoveUsergroupMember(organization, usergroup, email);
+  };
 
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceSearchProjectUsers>>,
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
     TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
-
-  query.queryKey = queryKey;
-
-  return query;
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >(mutationFn, mutationOptions);
 };
-
 /**
  * @summary ListWhitelistedDomains lists all the whitelisted domains for the organization
  */
@@ -88,7 +88,12 @@ export type AdminServiceUpdateProjectBody = {
   prodVersion?: string;
 };
 
-export type AdminServiceGetProjectParams = { accessTokenTtlSeconds?: number };
+export type AdminServiceGetProjectParams = {
+  accessTokenTtlSeconds?: number;
+  /** Branch to return the deployment and JWT for. Defaults to the production branch.
+For other branches, the branch's preview deployment is returned. */
+  branch?: string;
+};
 
 export type AdminServiceCreateProjectBodyVariables = { [key: string]: string };
 
@@ -117,12 +122,31 @@ export type AdminServiceCreateWhitelistedDomainBody = {
   role?: string;
 };
 
+export type AdminServiceAddUsergroupMemberBody = {
+  email?: string;
+};
+
+export type AdminServiceListUsergroupMembersParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceListOrganizationUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceSearchProjectUsersParams = {
   emailQuery?: string;
   pageSize?: number;
   pageToken?: string;
 };
 
+export type AdminServiceListProjectMemberUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceListProjectMembersParams = {
   pageSize?: number;
   pageToken?: string;
@@ -171,6 +195,10 @@ export type AdminServiceGetIFrameBody = {
   query?: AdminServiceGetIFrameBodyQuery;
 };
 
+export type AdminServiceCreateDeploymentBody = {
+  branch?: string;
+};
+
 export type AdminServiceGetDeploymentCredentialsBodyAttributes = {
   [key: string]: any;
 };
@@ -183,6 +211,24 @@ export type AdminServiceGetDeploymentCredentialsBody = {
   attributes?: AdminServiceGetDeploymentCredentialsBodyAttributes;
 };
 
+export type AdminServiceUpdateProjectRoleBody = {
+  permissions?: V1ProjectPermissions;
+};
+
+export type AdminServiceCreateProjectRoleBody = {
+  name?: string;
+  permissions?: V1ProjectPermissions;
+};
+
+export type AdminServiceUpdateOrganizationRoleBody = {
+  permissions?: V1OrganizationPermissions;
+};
+
+export type AdminServiceCreateOrganizationRoleBody = {
+  name?: string;
+  permissions?: V1OrganizationPermissions;
+};
+
 export type AdminServiceRemoveOrganizationMemberParams = {
   keepProjectRoles?: boolean;
 };
@@ -197,6 +243,19 @@ export type AdminServiceListOrganizationInvitesParams = {
   pageToken?: string;
 };
 
+export type AdminServiceListAuditEventsParams = {
+  /** Optional. Only return events for this project. */
+  project?: string;
+  /** Optional. Only return events performed by this actor (a user's email or a service's name). */
+  actor?: string;
+  /** Optional. Only return events that happened at or after this time. */
+  startTime?: string;
+  /** Optional. Only return events that happened before this time. */
+  endTime?: string;
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceUpdateOrganizationBody = {
   description?: string;
   newName?: string;
@@ -213,6 +272,10 @@ export type AdminServiceTriggerRefreshSourcesBody = {
   sources?: string[];
 };
 
+export type AdminServiceCreateUsergroupBodyBody = {
+  name?: string;
+};
+
 export type AdminServiceCreateReportBodyBody = {
   options?: V1ReportOptions;
 };
@@ -244,6 +307,17 @@ export interface V1VirtualFile {
   updatedOn?: string;
 }
 
+export interface V1UsergroupMember {
+  userId?: string;
+  userEmail?: string;
+  userName?: string;
+}
+
+export interface V1Usergroup {
+  id?: string;
+  name?: string;
+}
+
 export interface V1UserQuotas {
   singleuserOrgs?: number;
 }
@@ -288,10 +362,18 @@ export interface V1UpdateProjectVariablesResponse {
   variables?: V1UpdateProjectVariablesResponseVariables;
 }
 
+export interface V1UpdateProjectRoleResponse {
+  role?: V1ProjectRole;
+}
+
 export interface V1UpdateProjectResponse {
   project?: V1Project;
 }
 
+export interface V1UpdateOrganizationRoleResponse {
+  role?: V1OrganizationRole;
+}
+
 export interface V1UpdateOrganizationResponse {
   organization?: V1Organization;
 }
@@ -383,6 +465,10 @@ export interface V1SudoGetResourceResponse {
   instance?: V1Deployment;
 }
 
+export interface V1SetUsergroupProjectRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1SetSuperuserResponse {
   [key: string]: any;
 }
@@ -404,6 +490,8 @@ export interface V1ServiceToken {
   id?: string;
   createdOn?: string;
   expiresOn?: string;
+  apiNames?: string[];
+  scim?: boolean;
 }
 
 export interface V1Service {
@@ -450,10 +538,22 @@ export interface V1ReportOptions {
   recipients?: string[];
 }
 
+export interface V1RenameUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1RemoveWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1RemoveUsergroupProjectRoleResponse {
+  [key: string]: any;
+}
+
+export interface V1RemoveUsergroupMemberResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveProjectMemberResponse {
   [key: string]: any;
 }
@@ -481,6 +581,13 @@ export interface V1PullVirtualRepoResponse {
   nextPageToken?: string;
 }
 
+export interface V1ProjectRole {
+  id?: string;
+  name?: string;
+  builtin?: boolean;
+  permissions?: V1ProjectPermissions;
+}
+
 export interface V1ProjectPermissions {
   readProject?: boolean;
   manageProject?: boolean;
@@ -528,6 +635,13 @@ export interface V1PingResponse {
   time?: string;
 }
 
+export interface V1OrganizationRole {
+  id?: string;
+  name?: string;
+  builtin?: boolean;
+  permissions?: V1OrganizationPermissions;
+}
+
 export interface V1OrganizationQuotas {
   projects?: number;
   deployments?: number;
@@ -555,6 +669,12 @@ export interface V1Organization {
   updatedOn?: string;
 }
 
+export interface V1MemberUsergroup {
+  usergroupId?: string;
+  usergroupName?: string;
+  roleName?: string;
+}
+
 export interface V1Member {
   userId?: string;
   userEmail?: string;
@@ -568,6 +688,11 @@ export interface V1ListWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
 
+export interface V1ListUsergroupMembersResponse {
+  members?: V1UsergroupMember[];
+  nextPageToken?: string;
+}
+
 export interface V1ListSuperusersResponse {
   users?: V1User[];
 }
@@ -585,11 +710,20 @@ export interface V1ListProjectsForOrganizationResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListProjectRolesResponse {
+  roles?: V1ProjectRole[];
+}
+
 export interface V1ListProjectMembersResponse {
   members?: V1Member[];
   nextPageToken?: string;
 }
 
+export interface V1ListProjectMemberUsergroupsResponse {
+  usergroups?: V1MemberUsergroup[];
+  nextPageToken?: string;
+}
+
 export interface V1ListProjectInvitesResponse {
   invites?: V1UserInvite[];
   nextPageToken?: string;
@@ -600,6 +734,15 @@ export interface V1ListOrganizationsResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListOrganizationUsergroupsResponse {
+  usergroups?: V1Usergroup[];
+  nextPageToken?: string;
+}
+
+export interface V1ListOrganizationRolesResponse {
+  roles?: V1OrganizationRole[];
+}
+
 export interface V1ListOrganizationMembersResponse {
   members?: V1Member[];
   nextPageToken?: string;
@@ -610,10 +753,19 @@ export interface V1ListOrganizationInvitesResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListDeploymentsResponse {
+  deployments?: V1Deployment[];
+}
+
 export interface V1ListBookmarksResponse {
   bookmarks?: V1Bookmark[];
 }
 
+export interface V1ListAuditEventsResponse {
+  events?: V1AuditEvent[];
+  nextPageToken?: string;
+}
+
 export interface V1LeaveOrganizationResponse {
   [key: string]: any;
 }
@@ -732,6 +884,9 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_AVRO: "EXPORT_FORMAT_AVRO",
 } as const;
 
 export interface V1EditReportResponse {
@@ -751,6 +906,7 @@ export const V1DeploymentStatus = {
   DEPLOYMENT_STATUS_PENDING: "DEPLOYMENT_STATUS_PENDING",
   DEPLOYMENT_STATUS_OK: "DEPLOYMENT_STATUS_OK",
   DEPLOYMENT_STATUS_ERROR: "DEPLOYMENT_STATUS_ERROR",
+  DEPLOYMENT_STATUS_HIBERNATED: "DEPLOYMENT_STATUS_HIBERNATED",
 } as const;
 
 export interface V1Deployment {
@@ -764,6 +920,11 @@ export interface V1Deployment {
   statusMessage?: string;
   createdOn?: string;
   updatedOn?: string;
+  preview?: boolean;
+}
+
+export interface V1DeleteUsergroupResponse {
+  [key: string]: any;
 }
 
 export interface V1DeleteServiceResponse {
@@ -774,14 +935,26 @@ export interface V1DeleteReportResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteProjectRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteProjectResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteOrganizationRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteOrganizationResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteDeploymentResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteAlertResponse {
   [key: string]: any;
 }
@@ -790,6 +963,10 @@ export interface V1CreateWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1CreateUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1CreateServiceResponse {
   service?: V1Service;
 }
@@ -798,10 +975,18 @@ export interface V1CreateReportResponse {
   name?: string;
 }
 
+export interface V1CreateProjectRoleResponse {
+  role?: V1ProjectRole;
+}
+
 export interface V1CreateProjectResponse {
   project?: V1Project;
 }
 
+export interface V1CreateOrganizationRoleResponse {
+  role?: V1OrganizationRole;
+}
+
 export interface V1CreateOrganizationResponse {
   organization?: V1Organization;
 }
@@ -811,6 +996,10 @@ export interface V1CreateOrganizationRequest {
   description?: string;
 }
 
+export interface V1CreateDeploymentResponse {
+  deployment?: V1Deployment;
+}
+
 export interface V1CreateBookmarkResponse {
   bookmark?: V1Bookmark;
 }
@@ -858,6 +1047,22 @@ export interface V1Bookmark {
   updatedOn?: string;
 }
 
+export type V1AuditEventMetadata = { [key: string]: any };
+
+export interface V1AuditEvent {
+  id?: string;
+  orgId?: string;
+  projectId?: string;
+  projectName?: string;
+  /** Type of the actor that performed the action. One of "user" or "service". */
+  actorType?: string;
+  actorId?: string;
+  actorName?: string;
+  action?: string;
+  metadata?: V1AuditEventMetadata;
+  createdOn?: string;
+}
+
 export interface V1AlertOptions {
   title?: string;
   intervalDuration?: string;
@@ -869,6 +1074,10 @@ export interface V1AlertOptions {
   emailRenotifyAfterSeconds?: number;
 }
 
+export interface V1AddUsergroupMemberResponse {
+  [key: string]: any;
+}
+
 export interface V1AddProjectMemberResponse {
   pendingSignup?: boolean;
 }
@@ -11,6 +11,12 @@ export function exportFormatToPrettyString(format: V1ExportFormat): string {
       return "Excel (XLSX)";
     case V1ExportFormat.EXPORT_FORMAT_PARQUET:
       return "Parquet";
+    case V1ExportFormat.EXPORT_FORMAT_ARROW:
+      return "Arrow";
+    case V1ExportFormat.EXPORT_FORMAT_JSONL:
+      return "JSON Lines";
+    case V1ExportFormat.EXPORT_FORMAT_AVRO:
+      return "Avro";
     default:
       return "Unknown";
   }
@@ -30,6 +30,11 @@ export enum DeploymentStatus {
    * @generated from enum value: DEPLOYMENT_STATUS_ERROR = 4;
    */
   ERROR = 4,
+
+  /**
+   * @generated from enum value: DEPLOYMENT_STATUS_HIBERNATED = 5;
+   */
+  HIBERNATED = 5,
 }
 // Retrieve enum metadata with: proto3.getEnumType(DeploymentStatus)
 proto3.util.setEnumType(DeploymentStatus, "rill.admin.v1.DeploymentStatus", [
@@ -37,6 +42,7 @@ proto3.util.setEnumType(DeploymentStatus, "rill.admin.v1.DeploymentStatus", [
   { no: 1, name: "DEPLOYMENT_STATUS_PENDING" },
   { no: 2, name: "DEPLOYMENT_STATUS_OK" },
   { no: 4, name: "DEPLOYMENT_STATUS_ERROR" },
+  { no: 5, name: "DEPLOYMENT_STATUS_HIBERNATED" },
 ]);
 
 /**
@@ -624,6 +630,14 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
    */
   accessTokenTtlSeconds = 0;
 
+  /**
+   * Branch to return the deployment and JWT for. Defaults to the production branch.
+   * For other branches, the branch's preview deployment is returned.
+   *
+   * @generated from field: string branch = 4;
+   */
+  branch = "";
+
   constructor(data?: PartialMessage<GetProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -635,6 +649,7 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
     { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "access_token_ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectRequest {
@@ -2320,6 +2335,240 @@ export class TriggerRedeployResponse extends Message<TriggerRedeployResponse> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.ListDeploymentsRequest
+ */
+export class ListDeploymentsRequest extends Message<ListDeploymentsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<ListDeploymentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListDeploymentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeploymentsRequest | PlainMessage<ListDeploymentsRequest> | undefined, b: ListDeploymentsRequest | PlainMessage<ListDeploymentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListDeploymentsResponse
+ */
+export class ListDeploymentsResponse extends Message<ListDeploymentsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.Deployment deployments = 1;
+   */
+  deployments: Deployment[] = [];
+
+  constructor(data?: PartialMessage<ListDeploymentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListDeploymentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployments", kind: "message", T: Deployment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeploymentsResponse | PlainMessage<ListDeploymentsResponse> | undefined, b: ListDeploymentsResponse | PlainMessage<ListDeploymentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateDeploymentRequest
+ */
+export class CreateDeploymentRequest extends Message<CreateDeploymentRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string branch = 3;
+   */
+  branch = "";
+
+  constructor(data?: PartialMessage<CreateDeploymentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateDeploymentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeploymentRequest {
+    return new CreateDeploymentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDeploymentRequest {
+    return new CreateDeploymentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDeploymentRequest {
+    return new CreateDeploymentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateDeploymentRequest | PlainMessage<CreateDeploymentRequest> | undefined, b: CreateDeploymentRequest | PlainMessage<CreateDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(CreateDeploymentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateDeploymentResponse
+ */
+export class CreateDeploymentResponse extends Message<CreateDeploymentResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Deployment deployment = 1;
+   */
+  deployment?: Deployment;
+
+  constructor(data?: PartialMessage<CreateDeploymentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateDeploymentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment", kind: "message", T: Deployment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeploymentResponse {
+    return new CreateDeploymentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDeploymentResponse {
+    return new CreateDeploymentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDeploymentResponse {
+    return new CreateDeploymentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateDeploymentResponse | PlainMessage<CreateDeploymentResponse> | undefined, b: CreateDeploymentResponse | PlainMessage<CreateDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(CreateDeploymentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteDeploymentRequest
+ */
+export class DeleteDeploymentRequest extends Message<DeleteDeploymentRequest> {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId = "";
+
+  constructor(data?: PartialMessage<DeleteDeploymentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteDeploymentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDeploymentRequest {
+    return new DeleteDeploymentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDeploymentRequest {
+    return new DeleteDeploymentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDeploymentRequest {
+    return new DeleteDeploymentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDeploymentRequest | PlainMessage<DeleteDeploymentRequest> | undefined, b: DeleteDeploymentRequest | PlainMessage<DeleteDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteDeploymentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteDeploymentResponse
+ */
+export class DeleteDeploymentResponse extends Message<DeleteDeploymentResponse> {
+  constructor(data?: PartialMessage<DeleteDeploymentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteDeploymentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDeploymentResponse {
+    return new DeleteDeploymentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDeploymentResponse {
+    return new DeleteDeploymentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDeploymentResponse {
+    return new DeleteDeploymentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDeploymentResponse | PlainMessage<DeleteDeploymentResponse> | undefined, b: DeleteDeploymentResponse | PlainMessage<DeleteDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteDeploymentResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ListOrganizationMembersRequest
  */
@@ -3827,150 +4076,1644 @@ export class SetProjectMemberRoleResponse extends Message<SetProjectMemberRoleRe
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserRequest
+ * @generated from message rill.admin.v1.ListOrganizationUsergroupsRequest
  */
-export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
-  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+export class ListOrganizationUsergroupsRequest extends Message<ListOrganizationUsergroupsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly typeName = "rill.admin.v1.ListOrganizationUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationUsergroupsRequest {
+    return new ListOrganizationUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationUsergroupsRequest {
+    return new ListOrganizationUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationUsergroupsRequest {
+    return new ListOrganizationUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  static equals(a: ListOrganizationUsergroupsRequest | PlainMessage<ListOrganizationUsergroupsRequest> | undefined, b: ListOrganizationUsergroupsRequest | PlainMessage<ListOrganizationUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserResponse
+ * @generated from message rill.admin.v1.ListOrganizationUsergroupsResponse
  */
-export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
+export class ListOrganizationUsergroupsResponse extends Message<ListOrganizationUsergroupsResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: repeated rill.admin.v1.Usergroup usergroups = 1;
    */
-  user?: User;
+  usergroups: Usergroup[] = [];
 
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   * @generated from field: string next_page_token = 2;
    */
-  preferences?: UserPreferences;
+  nextPageToken = "";
 
-  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+  constructor(data?: PartialMessage<ListOrganizationUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly typeName = "rill.admin.v1.ListOrganizationUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
-    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "usergroups", kind: "message", T: Usergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationUsergroupsResponse {
+    return new ListOrganizationUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationUsergroupsResponse {
+    return new ListOrganizationUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationUsergroupsResponse {
+    return new ListOrganizationUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  static equals(a: ListOrganizationUsergroupsResponse | PlainMessage<ListOrganizationUsergroupsResponse> | undefined, b: ListOrganizationUsergroupsResponse | PlainMessage<ListOrganizationUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserRequest
+ * @generated from message rill.admin.v1.CreateUsergroupRequest
  */
-export class GetUserRequest extends Message<GetUserRequest> {
+export class CreateUsergroupRequest extends Message<CreateUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetUserRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetUserRequest, a, b);
+  static equals(a: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined, b: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserResponse
+ * @generated from message rill.admin.v1.CreateUsergroupResponse
  */
-export class GetUserResponse extends Message<GetUserResponse> {
+export class CreateUsergroupResponse extends Message<CreateUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  user?: User;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<GetUserResponse>) {
+  constructor(data?: PartialMessage<CreateUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetUserResponse, a, b);
+  static equals(a: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined, b: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RenameUsergroupRequest
+ */
+export class RenameUsergroupRequest extends Message<RenameUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RenameUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RenameUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined, b: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RenameUsergroupResponse
+ */
+export class RenameUsergroupResponse extends Message<RenameUsergroupResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
+   */
+  usergroup?: Usergroup;
+
+  constructor(data?: PartialMessage<RenameUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RenameUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined, b: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupRequest
+ */
+export class DeleteUsergroupRequest extends Message<DeleteUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<DeleteUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined, b: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupResponse
+ */
+export class DeleteUsergroupResponse extends Message<DeleteUsergroupResponse> {
+  constructor(data?: PartialMessage<DeleteUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined, b: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupMembersRequest
+ */
+export class ListUsergroupMembersRequest extends Message<ListUsergroupMembersRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined, b: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupMembersResponse
+ */
+export class ListUsergroupMembersResponse extends Message<ListUsergroupMembersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.UsergroupMember members = 1;
+   */
+  members: UsergroupMember[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: UsergroupMember, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined, b: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberRequest
+ */
+export class AddUsergroupMemberRequest extends Message<AddUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<AddUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined, b: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberResponse
+ */
+export class AddUsergroupMemberResponse extends Message<AddUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<AddUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined, b: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberRequest
+ */
+export class RemoveUsergroupMemberRequest extends Message<RemoveUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<RemoveUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined, b: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberResponse
+ */
+export class RemoveUsergroupMemberResponse extends Message<RemoveUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined, b: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsRequest
+ */
+export class ListProjectMemberUsergroupsRequest extends Message<ListProjectMemberUsergroupsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined, b: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsResponse
+ */
+export class ListProjectMemberUsergroupsResponse extends Message<ListProjectMemberUsergroupsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup usergroups = 1;
+   */
+  usergroups: MemberUsergroup[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroups", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined, b: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetUsergroupProjectRoleRequest
+ */
+export class SetUsergroupProjectRoleRequest extends Message<SetUsergroupProjectRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SetUsergroupProjectRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetUsergroupProjectRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUsergroupProjectRoleRequest {
+    return new SetUsergroupProjectRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUsergroupProjectRoleRequest {
+    return new SetUsergroupProjectRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUsergroupProjectRoleRequest {
+    return new SetUsergroupProjectRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUsergroupProjectRoleRequest | PlainMessage<SetUsergroupProjectRoleRequest> | undefined, b: SetUsergroupProjectRoleRequest | PlainMessage<SetUsergroupProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetUsergroupProjectRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetUsergroupProjectRoleResponse
+ */
+export class SetUsergroupProjectRoleResponse extends Message<SetUsergroupProjectRoleResponse> {
+  constructor(data?: PartialMessage<SetUsergroupProjectRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetUsergroupProjectRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUsergroupProjectRoleResponse {
+    return new SetUsergroupProjectRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUsergroupProjectRoleResponse {
+    return new SetUsergroupProjectRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUsergroupProjectRoleResponse {
+    return new SetUsergroupProjectRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUsergroupProjectRoleResponse | PlainMessage<SetUsergroupProjectRoleResponse> | undefined, b: SetUsergroupProjectRoleResponse | PlainMessage<SetUsergroupProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetUsergroupProjectRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupProjectRoleRequest
+ */
+export class RemoveUsergroupProjectRoleRequest extends Message<RemoveUsergroupProjectRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<RemoveUsergroupProjectRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupProjectRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupProjectRoleRequest {
+    return new RemoveUsergroupProjectRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupProjectRoleRequest {
+    return new RemoveUsergroupProjectRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupProjectRoleRequest {
+    return new RemoveUsergroupProjectRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupProjectRoleRequest | PlainMessage<RemoveUsergroupProjectRoleRequest> | undefined, b: RemoveUsergroupProjectRoleRequest | PlainMessage<RemoveUsergroupProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupProjectRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupProjectRoleResponse
+ */
+export class RemoveUsergroupProjectRoleResponse extends Message<RemoveUsergroupProjectRoleResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupProjectRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupProjectRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupProjectRoleResponse {
+    return new RemoveUsergroupProjectRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupProjectRoleResponse {
+    return new RemoveUsergroupProjectRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupProjectRoleResponse {
+    return new RemoveUsergroupProjectRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupProjectRoleResponse | PlainMessage<RemoveUsergroupProjectRoleResponse> | undefined, b: RemoveUsergroupProjectRoleResponse | PlainMessage<RemoveUsergroupProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupProjectRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationRolesRequest
+ */
+export class ListOrganizationRolesRequest extends Message<ListOrganizationRolesRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<ListOrganizationRolesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListOrganizationRolesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationRolesRequest {
+    return new ListOrganizationRolesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationRolesRequest | PlainMessage<ListOrganizationRolesRequest> | undefined, b: ListOrganizationRolesRequest | PlainMessage<ListOrganizationRolesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationRolesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationRolesResponse
+ */
+export class ListOrganizationRolesResponse extends Message<ListOrganizationRolesResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.OrganizationRole roles = 1;
+   */
+  roles: OrganizationRole[] = [];
+
+  constructor(data?: PartialMessage<ListOrganizationRolesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListOrganizationRolesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "roles", kind: "message", T: OrganizationRole, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationRolesResponse {
+    return new ListOrganizationRolesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationRolesResponse | PlainMessage<ListOrganizationRolesResponse> | undefined, b: ListOrganizationRolesResponse | PlainMessage<ListOrganizationRolesResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationRolesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateOrganizationRoleRequest
+ */
+export class CreateOrganizationRoleRequest extends Message<CreateOrganizationRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 3;
+   */
+  permissions?: OrganizationPermissions;
+
+  constructor(data?: PartialMessage<CreateOrganizationRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateOrganizationRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: OrganizationPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRoleRequest {
+    return new CreateOrganizationRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOrganizationRoleRequest | PlainMessage<CreateOrganizationRoleRequest> | undefined, b: CreateOrganizationRoleRequest | PlainMessage<CreateOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateOrganizationRoleResponse
+ */
+export class CreateOrganizationRoleResponse extends Message<CreateOrganizationRoleResponse> {
+  /**
+   * @generated from field: rill.admin.v1.OrganizationRole role = 1;
+   */
+  role?: OrganizationRole;
+
+  constructor(data?: PartialMessage<CreateOrganizationRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateOrganizationRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: OrganizationRole },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRoleResponse {
+    return new CreateOrganizationRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOrganizationRoleResponse | PlainMessage<CreateOrganizationRoleResponse> | undefined, b: CreateOrganizationRoleResponse | PlainMessage<CreateOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UpdateOrganizationRoleRequest
+ */
+export class UpdateOrganizationRoleRequest extends Message<UpdateOrganizationRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 3;
+   */
+  permissions?: OrganizationPermissions;
+
+  constructor(data?: PartialMessage<UpdateOrganizationRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: OrganizationPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleRequest {
+    return new UpdateOrganizationRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOrganizationRoleRequest | PlainMessage<UpdateOrganizationRoleRequest> | undefined, b: UpdateOrganizationRoleRequest | PlainMessage<UpdateOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UpdateOrganizationRoleResponse
+ */
+export class UpdateOrganizationRoleResponse extends Message<UpdateOrganizationRoleResponse> {
+  /**
+   * @generated from field: rill.admin.v1.OrganizationRole role = 1;
+   */
+  role?: OrganizationRole;
+
+  constructor(data?: PartialMessage<UpdateOrganizationRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: OrganizationRole },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRoleResponse {
+    return new UpdateOrganizationRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOrganizationRoleResponse | PlainMessage<UpdateOrganizationRoleResponse> | undefined, b: UpdateOrganizationRoleResponse | PlainMessage<UpdateOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteOrganizationRoleRequest
+ */
+export class DeleteOrganizationRoleRequest extends Message<DeleteOrganizationRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteOrganizationRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleRequest {
+    return new DeleteOrganizationRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOrganizationRoleRequest | PlainMessage<DeleteOrganizationRoleRequest> | undefined, b: DeleteOrganizationRoleRequest | PlainMessage<DeleteOrganizationRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteOrganizationRoleResponse
+ */
+export class DeleteOrganizationRoleResponse extends Message<DeleteOrganizationRoleResponse> {
+  constructor(data?: PartialMessage<DeleteOrganizationRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationRoleResponse {
+    return new DeleteOrganizationRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOrganizationRoleResponse | PlainMessage<DeleteOrganizationRoleResponse> | undefined, b: DeleteOrganizationRoleResponse | PlainMessage<DeleteOrganizationRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectRolesRequest
+ */
+export class ListProjectRolesRequest extends Message<ListProjectRolesRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<ListProjectRolesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectRolesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined, b: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectRolesResponse
+ */
+export class ListProjectRolesResponse extends Message<ListProjectRolesResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.ProjectRole roles = 1;
+   */
+  roles: ProjectRole[] = [];
+
+  constructor(data?: PartialMessage<ListProjectRolesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectRolesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "roles", kind: "message", T: ProjectRole, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined, b: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateProjectRoleRequest
+ */
+export class CreateProjectRoleRequest extends Message<CreateProjectRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 3;
+   */
+  permissions?: ProjectPermissions;
+
+  constructor(data?: PartialMessage<CreateProjectRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: ProjectPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined, b: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateProjectRoleResponse
+ */
+export class CreateProjectRoleResponse extends Message<CreateProjectRoleResponse> {
+  /**
+   * @generated from field: rill.admin.v1.ProjectRole role = 1;
+   */
+  role?: ProjectRole;
+
+  constructor(data?: PartialMessage<CreateProjectRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: ProjectRole },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined, b: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UpdateProjectRoleRequest
+ */
+export class UpdateProjectRoleRequest extends Message<UpdateProjectRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 3;
+   */
+  permissions?: ProjectPermissions;
+
+  constructor(data?: PartialMessage<UpdateProjectRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UpdateProjectRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: ProjectPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProjectRoleRequest {
+    return new UpdateProjectRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateProjectRoleRequest | PlainMessage<UpdateProjectRoleRequest> | undefined, b: UpdateProjectRoleRequest | PlainMessage<UpdateProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateProjectRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UpdateProjectRoleResponse
+ */
+export class UpdateProjectRoleResponse extends Message<UpdateProjectRoleResponse> {
+  /**
+   * @generated from field: rill.admin.v1.ProjectRole role = 1;
+   */
+  role?: ProjectRole;
+
+  constructor(data?: PartialMessage<UpdateProjectRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UpdateProjectRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "message", T: ProjectRole },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProjectRoleResponse {
+    return new UpdateProjectRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateProjectRoleResponse | PlainMessage<UpdateProjectRoleResponse> | undefined, b: UpdateProjectRoleResponse | PlainMessage<UpdateProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateProjectRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteProjectRoleRequest
+ */
+export class DeleteProjectRoleRequest extends Message<DeleteProjectRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteProjectRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined, b: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteProjectRoleResponse
+ */
+export class DeleteProjectRoleResponse extends Message<DeleteProjectRoleResponse> {
+  constructor(data?: PartialMessage<DeleteProjectRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined, b: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetCurrentUserRequest
+ */
+export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
+  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetCurrentUserResponse
+ */
+export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
+  /**
+   * @generated from field: rill.admin.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   */
+  preferences?: UserPreferences;
+
+  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUserRequest
+ */
+export class GetUserRequest extends Message<GetUserRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<GetUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUserResponse
+ */
+export class GetUserResponse extends Message<GetUserResponse> {
+  /**
+   * @generated from field: rill.admin.v1.User user = 1;
+   */
+  user?: User;
+
+  constructor(data?: PartialMessage<GetUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetUserResponse, a, b);
   }
 }
 
@@ -4849,6 +6592,20 @@ export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenR
    */
   serviceName = "";
 
+  /**
+   * If set, the token can only be used to call the custom APIs with these names.
+   *
+   * @generated from field: repeated string api_names = 3;
+   */
+  apiNames: string[] = [];
+
+  /**
+   * If true, the token can only be used for SCIM provisioning of the organization.
+   *
+   * @generated from field: bool scim = 4;
+   */
+  scim = false;
+
   constructor(data?: PartialMessage<IssueServiceAuthTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4859,6 +6616,8 @@ export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenR
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "api_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenRequest {
@@ -5377,39 +7136,163 @@ export class ListWhitelistedDomainsRequest extends Message<ListWhitelistedDomain
 }
 
 /**
- * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
+ * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
+ */
+export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   */
+  domains: WhitelistedDomain[] = [];
+
+  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * Optional. Only return events for this project.
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Optional. Only return events performed by this actor (a user's email or a service's name).
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor = "";
+
+  /**
+   * Optional. Only return events that happened at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Optional. Only return events that happened before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: uint32 page_size = 6;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 7;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "start_time", kind: "message", T: Timestamp },
+    { no: 5, name: "end_time", kind: "message", T: Timestamp },
+    { no: 6, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
  */
-export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
    */
-  domains: WhitelistedDomain[] = [];
+  events: AuditEvent[] = [];
 
-  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
   }
 }
 
@@ -7064,6 +8947,103 @@ export class Service extends Message<Service> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string org_id = 2;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 4;
+   */
+  projectName = "";
+
+  /**
+   * Type of the actor that performed the action. One of "user" or "service".
+   *
+   * @generated from field: string actor_type = 5;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 6;
+   */
+  actorId = "";
+
+  /**
+   * Email of the user or name of the service that performed the action
+   *
+   * @generated from field: string actor_name = 7;
+   */
+  actorName = "";
+
+  /**
+   * Action that was performed, such as "project.delete"
+   *
+   * @generated from field: string action = 8;
+   */
+  action = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct metadata = 9;
+   */
+  metadata?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 10;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "actor_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "metadata", kind: "message", T: Struct },
+    { no: 10, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Organization
  */
@@ -7438,6 +9418,11 @@ export class Deployment extends Message<Deployment> {
    */
   updatedOn?: Timestamp;
 
+  /**
+   * @generated from field: bool preview = 11;
+   */
+  preview = false;
+
   constructor(data?: PartialMessage<Deployment>) {
     super();
     proto3.util.initPartial(data, this);
@@ -7456,6 +9441,7 @@ export class Deployment extends Message<Deployment> {
     { no: 8, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 9, name: "created_on", kind: "message", T: Timestamp },
     { no: 10, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 11, name: "preview", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deployment {
@@ -7663,6 +9649,116 @@ export class ProjectPermissions extends Message<ProjectPermissions> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.OrganizationRole
+ */
+export class OrganizationRole extends Message<OrganizationRole> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool builtin = 3;
+   */
+  builtin = false;
+
+  /**
+   * @generated from field: rill.admin.v1.OrganizationPermissions permissions = 4;
+   */
+  permissions?: OrganizationPermissions;
+
+  constructor(data?: PartialMessage<OrganizationRole>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.OrganizationRole";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "permissions", kind: "message", T: OrganizationPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationRole {
+    return new OrganizationRole().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationRole | PlainMessage<OrganizationRole> | undefined, b: OrganizationRole | PlainMessage<OrganizationRole> | undefined): boolean {
+    return proto3.util.equals(OrganizationRole, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ProjectRole
+ */
+export class ProjectRole extends Message<ProjectRole> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool builtin = 3;
+   */
+  builtin = false;
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 4;
+   */
+  permissions?: ProjectPermissions;
+
+  constructor(data?: PartialMessage<ProjectRole>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ProjectRole";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "permissions", kind: "message", T: ProjectPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectRole {
+    return new ProjectRole().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectRole | PlainMessage<ProjectRole> | undefined, b: ProjectRole | PlainMessage<ProjectRole> | undefined): boolean {
+    return proto3.util.equals(ProjectRole, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Member
  */
@@ -7730,6 +9826,147 @@ export class Member extends Message<Member> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.Usergroup
+ */
+export class Usergroup extends Message<Usergroup> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<Usergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Usergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Usergroup {
+    return new Usergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Usergroup | PlainMessage<Usergroup> | undefined, b: Usergroup | PlainMessage<Usergroup> | undefined): boolean {
+    return proto3.util.equals(Usergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UsergroupMember
+ */
+export class UsergroupMember extends Message<UsergroupMember> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string user_email = 2;
+   */
+  userEmail = "";
+
+  /**
+   * @generated from field: string user_name = 3;
+   */
+  userName = "";
+
+  constructor(data?: PartialMessage<UsergroupMember>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.UsergroupMember";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsergroupMember {
+    return new UsergroupMember().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsergroupMember {
+    return new UsergroupMember().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsergroupMember {
+    return new UsergroupMember().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UsergroupMember | PlainMessage<UsergroupMember> | undefined, b: UsergroupMember | PlainMessage<UsergroupMember> | undefined): boolean {
+    return proto3.util.equals(UsergroupMember, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.MemberUsergroup
+ */
+export class MemberUsergroup extends Message<MemberUsergroup> {
+  /**
+   * @generated from field: string usergroup_id = 1;
+   */
+  usergroupId = "";
+
+  /**
+   * @generated from field: string usergroup_name = 2;
+   */
+  usergroupName = "";
+
+  /**
+   * @generated from field: string role_name = 3;
+   */
+  roleName = "";
+
+  constructor(data?: PartialMessage<MemberUsergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.MemberUsergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined, b: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined): boolean {
+    return proto3.util.equals(MemberUsergroup, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.UserInvite
  */
@@ -7944,6 +10181,16 @@ export class ServiceToken extends Message<ServiceToken> {
    */
   expiresOn?: Timestamp;
 
+  /**
+   * @generated from field: repeated string api_names = 4;
+   */
+  apiNames: string[] = [];
+
+  /**
+   * @generated from field: bool scim = 5;
+   */
+  scim = false;
+
   constructor(data?: PartialMessage<ServiceToken>) {
     super();
     proto3.util.initPartial(data, this);
@@ -7955,6 +10202,8 @@ export class ServiceToken extends Message<ServiceToken> {
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "created_on", kind: "message", T: Timestamp },
     { no: 3, name: "expires_on", kind: "message", T: Timestamp },
+    { no: 4, name: "api_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceToken {
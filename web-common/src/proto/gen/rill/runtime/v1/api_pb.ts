@@ -2580,6 +2580,190 @@ export class GetResourceResponse extends Message<GetResourceResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.GetLineageRequest
+ */
+export class GetLineageRequest extends Message<GetLineageRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Optional resource to get lineage for. If not set, the lineage of all resources is returned.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   */
+  name?: ResourceName;
+
+  /**
+   * Optional column (or dimension or measure for metrics views) of the resource to get lineage for.
+   *
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<GetLineageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetLineageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: ResourceName },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLineageRequest {
+    return new GetLineageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLineageRequest {
+    return new GetLineageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLineageRequest {
+    return new GetLineageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLineageRequest | PlainMessage<GetLineageRequest> | undefined, b: GetLineageRequest | PlainMessage<GetLineageRequest> | undefined): boolean {
+    return proto3.util.equals(GetLineageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetLineageResponse
+ */
+export class GetLineageResponse extends Message<GetLineageResponse> {
+  /**
+   * Edges upstream and downstream of the requested resource or column.
+   *
+   * @generated from field: repeated rill.runtime.v1.LineageEdge edges = 1;
+   */
+  edges: LineageEdge[] = [];
+
+  constructor(data?: PartialMessage<GetLineageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetLineageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edges", kind: "message", T: LineageEdge, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLineageResponse {
+    return new GetLineageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLineageResponse {
+    return new GetLineageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLineageResponse {
+    return new GetLineageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLineageResponse | PlainMessage<GetLineageResponse> | undefined, b: GetLineageResponse | PlainMessage<GetLineageResponse> | undefined): boolean {
+    return proto3.util.equals(GetLineageResponse, a, b);
+  }
+}
+
+/**
+ * LineageColumn identifies a column of a source or model, or a dimension or measure of a metrics view.
+ *
+ * @generated from message rill.runtime.v1.LineageColumn
+ */
+export class LineageColumn extends Message<LineageColumn> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName resource = 1;
+   */
+  resource?: ResourceName;
+
+  /**
+   * Column name. It is empty if the resource depends on the source column without deriving a specific column from it (e.g. in a filter or join).
+   *
+   * @generated from field: string column = 2;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<LineageColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.LineageColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: ResourceName },
+    { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineageColumn {
+    return new LineageColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineageColumn {
+    return new LineageColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineageColumn {
+    return new LineageColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineageColumn | PlainMessage<LineageColumn> | undefined, b: LineageColumn | PlainMessage<LineageColumn> | undefined): boolean {
+    return proto3.util.equals(LineageColumn, a, b);
+  }
+}
+
+/**
+ * LineageEdge indicates that the target column is derived from or depends on the source column.
+ *
+ * @generated from message rill.runtime.v1.LineageEdge
+ */
+export class LineageEdge extends Message<LineageEdge> {
+  /**
+   * @generated from field: rill.runtime.v1.LineageColumn source = 1;
+   */
+  source?: LineageColumn;
+
+  /**
+   * @generated from field: rill.runtime.v1.LineageColumn target = 2;
+   */
+  target?: LineageColumn;
+
+  constructor(data?: PartialMessage<LineageEdge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.LineageEdge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "message", T: LineageColumn },
+    { no: 2, name: "target", kind: "message", T: LineageColumn },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineageEdge {
+    return new LineageEdge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineageEdge {
+    return new LineageEdge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineageEdge {
+    return new LineageEdge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineageEdge | PlainMessage<LineageEdge> | undefined, b: LineageEdge | PlainMessage<LineageEdge> | undefined): boolean {
+    return proto3.util.equals(LineageEdge, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.CreateTriggerRequest
  */
@@ -28,6 +28,27 @@ export enum ExportFormat {
    * @generated from enum value: EXPORT_FORMAT_PARQUET = 3;
    */
   PARQUET = 3,
+
+  /**
+   * Apache Arrow IPC streaming format
+   *
+   * @generated from enum value: EXPORT_FORMAT_ARROW = 4;
+   */
+  ARROW = 4,
+
+  /**
+   * Newline-delimited JSON (JSON Lines)
+   *
+   * @generated from enum value: EXPORT_FORMAT_JSONL = 5;
+   */
+  JSONL = 5,
+
+  /**
+   * Apache Avro object container file
+   *
+   * @generated from enum value: EXPORT_FORMAT_AVRO = 6;
+   */
+  AVRO = 6,
 }
 // Retrieve enum metadata with: proto3.getEnumType(ExportFormat)
 proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
@@ -35,5 +56,8 @@ proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
   { no: 1, name: "EXPORT_FORMAT_CSV" },
   { no: 2, name: "EXPORT_FORMAT_XLSX" },
   { no: 3, name: "EXPORT_FORMAT_PARQUET" },
+  { no: 4, name: "EXPORT_FORMAT_ARROW" },
+  { no: 5, name: "EXPORT_FORMAT_JSONL" },
+  { no: 6, name: "EXPORT_FORMAT_AVRO" },
 ]);
 
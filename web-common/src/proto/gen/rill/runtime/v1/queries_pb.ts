@@ -256,6 +256,46 @@ export class QueryResponse extends Message<QueryResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.QueryArrowResponse
+ */
+export class QueryArrowResponse extends Message<QueryArrowResponse> {
+  /**
+   * Chunk of the result in the Arrow IPC streaming format.
+   * Concatenating the chunks in the order they are received yields a complete Arrow IPC stream.
+   *
+   * @generated from field: bytes arrow_ipc = 1;
+   */
+  arrowIpc = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<QueryArrowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryArrowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "arrow_ipc", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryArrowResponse {
+    return new QueryArrowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryArrowResponse {
+    return new QueryArrowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryArrowResponse {
+    return new QueryArrowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryArrowResponse | PlainMessage<QueryArrowResponse> | undefined, b: QueryArrowResponse | PlainMessage<QueryArrowResponse> | undefined): boolean {
+    return proto3.util.equals(QueryArrowResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.QueryBatchRequest
  */
@@ -893,6 +933,13 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * Optional. Name of a dimension to use as the time dimension instead of the metrics view's default time dimension
+   *
+   * @generated from field: string time_dimension = 16;
+   */
+  timeDimension = "";
+
   constructor(data?: PartialMessage<MetricsViewAggregationRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -916,6 +963,7 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
     { no: 10, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 11, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 14, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 16, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewAggregationRequest {
@@ -1434,6 +1482,13 @@ export class MetricsViewComparisonRequest extends Message<MetricsViewComparisonR
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * Optional. Name of a dimension to use as the time dimension instead of the metrics view's default time dimension
+   *
+   * @generated from field: string time_dimension = 17;
+   */
+  timeDimension = "";
+
   constructor(data?: PartialMessage<MetricsViewComparisonRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1458,6 +1513,7 @@ export class MetricsViewComparisonRequest extends Message<MetricsViewComparisonR
     { no: 11, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 13, name: "exact", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 14, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 17, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewComparisonRequest {
@@ -1905,6 +1961,13 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * Optional. Name of a dimension to use as the time dimension instead of the metrics view's default time dimension
+   *
+   * @generated from field: string time_dimension = 13;
+   */
+  timeDimension = "";
+
   constructor(data?: PartialMessage<MetricsViewTimeSeriesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1925,6 +1988,7 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
     { no: 10, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 12, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 13, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewTimeSeriesRequest {
@@ -2561,6 +2625,13 @@ export class MetricsViewTimeRangeRequest extends Message<MetricsViewTimeRangeReq
    */
   priority = 0;
 
+  /**
+   * Optional. Name of a dimension to use as the time dimension instead of the metrics view's default time dimension
+   *
+   * @generated from field: string time_dimension = 4;
+   */
+  timeDimension = "";
+
   constructor(data?: PartialMessage<MetricsViewTimeRangeRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2572,6 +2643,7 @@ export class MetricsViewTimeRangeRequest extends Message<MetricsViewTimeRangeReq
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "metrics_view_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewTimeRangeRequest {
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Struct, Timestamp, Value } from "@bufbuild/protobuf";
 import { TimeGrain } from "./time_grain_pb.js";
 import { ExportFormat } from "./export_format_pb.js";
 import { Color } from "./colors_pb.js";
@@ -41,6 +41,94 @@ proto3.util.setEnumType(ReconcileStatus, "rill.runtime.v1.ReconcileStatus", [
   { no: 3, name: "RECONCILE_STATUS_RUNNING" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.SchemaChangePolicy
+ */
+export enum SchemaChangePolicy {
+  /**
+   * @generated from enum value: SCHEMA_CHANGE_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEMA_CHANGE_POLICY_FAIL = 1;
+   */
+  FAIL = 1,
+
+  /**
+   * @generated from enum value: SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS = 2;
+   */
+  APPEND_NEW_COLUMNS = 2,
+
+  /**
+   * @generated from enum value: SCHEMA_CHANGE_POLICY_SYNC_ALL_COLUMNS = 3;
+   */
+  SYNC_ALL_COLUMNS = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaChangePolicy)
+proto3.util.setEnumType(SchemaChangePolicy, "rill.runtime.v1.SchemaChangePolicy", [
+  { no: 0, name: "SCHEMA_CHANGE_POLICY_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_CHANGE_POLICY_FAIL" },
+  { no: 2, name: "SCHEMA_CHANGE_POLICY_APPEND_NEW_COLUMNS" },
+  { no: 3, name: "SCHEMA_CHANGE_POLICY_SYNC_ALL_COLUMNS" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.ModelIncrementalStrategy
+ */
+export enum ModelIncrementalStrategy {
+  /**
+   * @generated from enum value: MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MODEL_INCREMENTAL_STRATEGY_APPEND = 1;
+   */
+  APPEND = 1,
+
+  /**
+   * @generated from enum value: MODEL_INCREMENTAL_STRATEGY_MERGE = 2;
+   */
+  MERGE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ModelIncrementalStrategy)
+proto3.util.setEnumType(ModelIncrementalStrategy, "rill.runtime.v1.ModelIncrementalStrategy", [
+  { no: 0, name: "MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED" },
+  { no: 1, name: "MODEL_INCREMENTAL_STRATEGY_APPEND" },
+  { no: 2, name: "MODEL_INCREMENTAL_STRATEGY_MERGE" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.AnomalyBaselineMethod
+ */
+export enum AnomalyBaselineMethod {
+  /**
+   * @generated from enum value: ANOMALY_BASELINE_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Mean and standard deviation of all intervals in the baseline window.
+   *
+   * @generated from enum value: ANOMALY_BASELINE_METHOD_ROLLING = 1;
+   */
+  ROLLING = 1,
+
+  /**
+   * Median and median absolute deviation of the intervals in the baseline window with the same weekday and hour as the latest interval.
+   *
+   * @generated from enum value: ANOMALY_BASELINE_METHOD_SEASONAL = 2;
+   */
+  SEASONAL = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AnomalyBaselineMethod)
+proto3.util.setEnumType(AnomalyBaselineMethod, "rill.runtime.v1.AnomalyBaselineMethod", [
+  { no: 0, name: "ANOMALY_BASELINE_METHOD_UNSPECIFIED" },
+  { no: 1, name: "ANOMALY_BASELINE_METHOD_ROLLING" },
+  { no: 2, name: "ANOMALY_BASELINE_METHOD_SEASONAL" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.AssertionStatus
  */
@@ -73,6 +161,50 @@ proto3.util.setEnumType(AssertionStatus, "rill.runtime.v1.AssertionStatus", [
   { no: 3, name: "ASSERTION_STATUS_ERROR" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.APIArgumentType
+ */
+export enum APIArgumentType {
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_STRING = 1;
+   */
+  STRING = 1,
+
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_INTEGER = 2;
+   */
+  INTEGER = 2,
+
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_NUMBER = 3;
+   */
+  NUMBER = 3,
+
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_BOOLEAN = 4;
+   */
+  BOOLEAN = 4,
+
+  /**
+   * @generated from enum value: API_ARGUMENT_TYPE_TIMESTAMP = 5;
+   */
+  TIMESTAMP = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(APIArgumentType)
+proto3.util.setEnumType(APIArgumentType, "rill.runtime.v1.APIArgumentType", [
+  { no: 0, name: "API_ARGUMENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "API_ARGUMENT_TYPE_STRING" },
+  { no: 2, name: "API_ARGUMENT_TYPE_INTEGER" },
+  { no: 3, name: "API_ARGUMENT_TYPE_NUMBER" },
+  { no: 4, name: "API_ARGUMENT_TYPE_BOOLEAN" },
+  { no: 5, name: "API_ARGUMENT_TYPE_TIMESTAMP" },
+]);
+
 /**
  * @generated from message rill.runtime.v1.Resource
  */
@@ -171,6 +303,12 @@ export class Resource extends Message<Resource> {
      */
     value: API;
     case: "api";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Test test = 16;
+     */
+    value: Test;
+    case: "test";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Resource>) {
@@ -196,6 +334,7 @@ export class Resource extends Message<Resource> {
     { no: 13, name: "chart", kind: "message", T: Chart, oneof: "resource" },
     { no: 14, name: "dashboard", kind: "message", T: Dashboard, oneof: "resource" },
     { no: 15, name: "api", kind: "message", T: API, oneof: "resource" },
+    { no: 16, name: "test", kind: "message", T: Test, oneof: "resource" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Resource {
@@ -581,16 +720,25 @@ export class SourceSpec extends Message<SourceSpec> {
   timeoutSeconds = 0;
 
   /**
-   * Fields not derived from code files
+   * Stream ingestion continuously appends new data from the source connector in micro-batches instead of refreshing on a schedule.
    *
-   * @generated from field: bool stage_changes = 7;
+   * @generated from field: bool stream_ingestion = 8;
    */
-  stageChanges = false;
+  streamIngestion = false;
 
   /**
-   * @generated from field: bool stream_ingestion = 8;
+   * Policy for handling changes to the schema of the ingested data between refreshes.
+   *
+   * @generated from field: rill.runtime.v1.SchemaChangePolicy on_schema_change = 10;
    */
-  streamIngestion = false;
+  onSchemaChange = SchemaChangePolicy.UNSPECIFIED;
+
+  /**
+   * Fields not derived from code files
+   *
+   * @generated from field: bool stage_changes = 7;
+   */
+  stageChanges = false;
 
   /**
    * @generated from field: bool trigger = 9;
@@ -610,8 +758,9 @@ export class SourceSpec extends Message<SourceSpec> {
     { no: 3, name: "properties", kind: "message", T: Struct },
     { no: 5, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 6, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "stream_ingestion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "on_schema_change", kind: "enum", T: proto3.getEnumType(SchemaChangePolicy) },
+    { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
@@ -656,6 +805,21 @@ export class SourceState extends Message<SourceState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * Offsets to resume streaming ingestion from, keyed by driver-specific partition identifiers.
+   * Only set for sources with stream_ingestion enabled.
+   *
+   * @generated from field: map<string, int64> stream_offsets = 5;
+   */
+  streamOffsets: { [key: string]: bigint } = {};
+
+  /**
+   * Schema changes detected in the last refresh.
+   *
+   * @generated from field: repeated rill.runtime.v1.SchemaChange schema_changes = 6;
+   */
+  schemaChanges: SchemaChange[] = [];
+
   constructor(data?: PartialMessage<SourceState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -668,6 +832,8 @@ export class SourceState extends Message<SourceState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "stream_offsets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 3 /* ScalarType.INT64 */} },
+    { no: 6, name: "schema_changes", kind: "message", T: SchemaChange, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceState {
@@ -687,6 +853,61 @@ export class SourceState extends Message<SourceState> {
   }
 }
 
+/**
+ * SchemaChange describes a column that was added, removed or changed type between two refreshes of a table.
+ *
+ * @generated from message rill.runtime.v1.SchemaChange
+ */
+export class SchemaChange extends Message<SchemaChange> {
+  /**
+   * @generated from field: string column = 1;
+   */
+  column = "";
+
+  /**
+   * Type of the column before the change. Empty if the column was added.
+   *
+   * @generated from field: string previous_type = 2;
+   */
+  previousType = "";
+
+  /**
+   * Type of the column after the change. Empty if the column was removed.
+   *
+   * @generated from field: string new_type = 3;
+   */
+  newType = "";
+
+  constructor(data?: PartialMessage<SchemaChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SchemaChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "previous_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SchemaChange {
+    return new SchemaChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SchemaChange {
+    return new SchemaChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SchemaChange {
+    return new SchemaChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SchemaChange | PlainMessage<SchemaChange> | undefined, b: SchemaChange | PlainMessage<SchemaChange> | undefined): boolean {
+    return proto3.util.equals(SchemaChange, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ModelV2
  */
@@ -764,6 +985,56 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   usesTemplating = false;
 
+  /**
+   * Incremental models insert into the existing table on refresh instead of rebuilding it.
+   *
+   * @generated from field: bool incremental = 10;
+   */
+  incremental = false;
+
+  /**
+   * Resolver that computes the state passed to the SQL template as "incremental_state" after each refresh.
+   *
+   * @generated from field: string incremental_state_resolver = 11;
+   */
+  incrementalStateResolver = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct incremental_state_resolver_properties = 12;
+   */
+  incrementalStateResolverProperties?: Struct;
+
+  /**
+   * @generated from field: rill.runtime.v1.ModelIncrementalStrategy incremental_strategy = 13;
+   */
+  incrementalStrategy = ModelIncrementalStrategy.UNSPECIFIED;
+
+  /**
+   * Columns that uniquely identify a row. Required for the merge strategy.
+   *
+   * @generated from field: repeated string unique_key = 14;
+   */
+  uniqueKey: string[] = [];
+
+  /**
+   * Resolver that lists the model's partitions. If set, the SQL is executed once per partition with the partition's data passed to the SQL template as "partition".
+   *
+   * @generated from field: string partitions_resolver = 15;
+   */
+  partitionsResolver = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct partitions_resolver_properties = 16;
+   */
+  partitionsResolverProperties?: Struct;
+
+  /**
+   * Policy for handling changes to the schema of the model's output between refreshes.
+   *
+   * @generated from field: rill.runtime.v1.SchemaChangePolicy on_schema_change = 17;
+   */
+  onSchemaChange = SchemaChangePolicy.UNSPECIFIED;
+
   /**
    * Fields not derived from code files
    *
@@ -795,6 +1066,14 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 4, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 5, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 6, name: "uses_templating", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "incremental", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "incremental_state_resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "incremental_state_resolver_properties", kind: "message", T: Struct },
+    { no: 13, name: "incremental_strategy", kind: "enum", T: proto3.getEnumType(ModelIncrementalStrategy) },
+    { no: 14, name: "unique_key", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 15, name: "partitions_resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "partitions_resolver_properties", kind: "message", T: Struct },
+    { no: 17, name: "on_schema_change", kind: "enum", T: proto3.getEnumType(SchemaChangePolicy) },
     { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "materialize_delay_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -841,6 +1120,27 @@ export class ModelState extends Message<ModelState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * Output of the incremental state resolver after the last refresh (only set for incremental models).
+   *
+   * @generated from field: google.protobuf.Struct incremental_state = 5;
+   */
+  incrementalState?: Struct;
+
+  /**
+   * Partitions processed for the current table (only set for partitioned models).
+   *
+   * @generated from field: repeated rill.runtime.v1.ModelPartition partitions = 6;
+   */
+  partitions: ModelPartition[] = [];
+
+  /**
+   * Schema changes detected in the last refresh.
+   *
+   * @generated from field: repeated rill.runtime.v1.SchemaChange schema_changes = 7;
+   */
+  schemaChanges: SchemaChange[] = [];
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -853,6 +1153,9 @@ export class ModelState extends Message<ModelState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "incremental_state", kind: "message", T: Struct },
+    { no: 6, name: "partitions", kind: "message", T: ModelPartition, repeated: true },
+    { no: 7, name: "schema_changes", kind: "message", T: SchemaChange, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -872,6 +1175,73 @@ export class ModelState extends Message<ModelState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ModelPartition
+ */
+export class ModelPartition extends Message<ModelPartition> {
+  /**
+   * Key that uniquely identifies the partition. It is derived from the partition's data.
+   *
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * Data for the partition as returned by the partitions resolver.
+   *
+   * @generated from field: google.protobuf.Struct data = 2;
+   */
+  data?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp executed_on = 3;
+   */
+  executedOn?: Timestamp;
+
+  /**
+   * Error from the last execution of the partition. Partitions with an error are retried on the next refresh.
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * @generated from field: uint32 elapsed_ms = 5;
+   */
+  elapsedMs = 0;
+
+  constructor(data?: PartialMessage<ModelPartition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelPartition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "data", kind: "message", T: Struct },
+    { no: 3, name: "executed_on", kind: "message", T: Timestamp },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "elapsed_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelPartition {
+    return new ModelPartition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelPartition | PlainMessage<ModelPartition> | undefined, b: ModelPartition | PlainMessage<ModelPartition> | undefined): boolean {
+    return proto3.util.equals(ModelPartition, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewV2
  */
@@ -961,6 +1331,13 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
    */
   watermarkExpression = "";
 
+  /**
+   * Dimension tables that dimensions can be resolved against. Joins are only applied to queries that reference a dimension from the joined table.
+   *
+   * @generated from field: repeated rill.runtime.v1.MetricsViewSpec.JoinV2 joins = 21;
+   */
+  joins: MetricsViewSpec_JoinV2[] = [];
+
   /**
    * Dimensions in the metrics view
    *
@@ -1073,6 +1450,7 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 20, name: "watermark_expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "joins", kind: "message", T: MetricsViewSpec_JoinV2, repeated: true },
     { no: 6, name: "dimensions", kind: "message", T: MetricsViewSpec_DimensionV2, repeated: true },
     { no: 18, name: "default_dimensions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 7, name: "measures", kind: "message", T: MetricsViewSpec_MeasureV2, repeated: true },
@@ -1106,6 +1484,86 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
   }
 }
 
+/**
+ * @generated from enum rill.runtime.v1.MetricsViewSpec.JoinType
+ */
+export enum MetricsViewSpec_JoinType {
+  /**
+   * @generated from enum value: JOIN_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: JOIN_TYPE_LEFT = 1;
+   */
+  LEFT = 1,
+
+  /**
+   * @generated from enum value: JOIN_TYPE_INNER = 2;
+   */
+  INNER = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsViewSpec_JoinType)
+proto3.util.setEnumType(MetricsViewSpec_JoinType, "rill.runtime.v1.MetricsViewSpec.JoinType", [
+  { no: 0, name: "JOIN_TYPE_UNSPECIFIED" },
+  { no: 1, name: "JOIN_TYPE_LEFT" },
+  { no: 2, name: "JOIN_TYPE_INNER" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.MetricsViewSpec.MeasureType
+ */
+export enum MetricsViewSpec_MeasureType {
+  /**
+   * @generated from enum value: MEASURE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Aggregation expression on the table's columns
+   *
+   * @generated from enum value: MEASURE_TYPE_SIMPLE = 1;
+   */
+  SIMPLE = 1,
+
+  /**
+   * Expression that references other measures by name
+   *
+   * @generated from enum value: MEASURE_TYPE_DERIVED = 2;
+   */
+  DERIVED = 2,
+
+  /**
+   * Running sum of another measure over time
+   *
+   * @generated from enum value: MEASURE_TYPE_RUNNING_SUM = 3;
+   */
+  RUNNING_SUM = 3,
+
+  /**
+   * Share of another measure's total across the query result
+   *
+   * @generated from enum value: MEASURE_TYPE_SHARE_OF_TOTAL = 4;
+   */
+  SHARE_OF_TOTAL = 4,
+
+  /**
+   * Value of another measure in the previous time period
+   *
+   * @generated from enum value: MEASURE_TYPE_PREVIOUS_PERIOD = 5;
+   */
+  PREVIOUS_PERIOD = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsViewSpec_MeasureType)
+proto3.util.setEnumType(MetricsViewSpec_MeasureType, "rill.runtime.v1.MetricsViewSpec.MeasureType", [
+  { no: 0, name: "MEASURE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "MEASURE_TYPE_SIMPLE" },
+  { no: 2, name: "MEASURE_TYPE_DERIVED" },
+  { no: 3, name: "MEASURE_TYPE_RUNNING_SUM" },
+  { no: 4, name: "MEASURE_TYPE_SHARE_OF_TOTAL" },
+  { no: 5, name: "MEASURE_TYPE_PREVIOUS_PERIOD" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.MetricsViewSpec.ComparisonMode
  */
@@ -1174,8 +1632,15 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
    */
   unnest = false;
 
-  constructor(data?: PartialMessage<MetricsViewSpec_DimensionV2>) {
-    super();
+  /**
+   * Name of the join the dimension's column or expression is resolved against. If empty, it's resolved against the metrics view's table.
+   *
+   * @generated from field: string join = 7;
+   */
+  join = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_DimensionV2>) {
+    super();
     proto3.util.initPartial(data, this);
   }
 
@@ -1188,6 +1653,7 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
     { no: 3, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "unnest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "join", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_DimensionV2 {
@@ -1207,6 +1673,116 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
   }
 }
 
+/**
+ * Joins are dimension tables that can be joined with the metrics view's table
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.JoinV2
+ */
+export class MetricsViewSpec_JoinV2 extends Message<MetricsViewSpec_JoinV2> {
+  /**
+   * Name used to reference the join from dimensions. It's also the alias of the joined table.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Name of the table to join
+   *
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.JoinType type = 3;
+   */
+  type = MetricsViewSpec_JoinType.UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.MetricsViewSpec.JoinV2.Key keys = 4;
+   */
+  keys: MetricsViewSpec_JoinV2_Key[] = [];
+
+  constructor(data?: PartialMessage<MetricsViewSpec_JoinV2>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.JoinV2";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(MetricsViewSpec_JoinType) },
+    { no: 4, name: "keys", kind: "message", T: MetricsViewSpec_JoinV2_Key, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_JoinV2 | PlainMessage<MetricsViewSpec_JoinV2> | undefined, b: MetricsViewSpec_JoinV2 | PlainMessage<MetricsViewSpec_JoinV2> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_JoinV2, a, b);
+  }
+}
+
+/**
+ * Keys are pairs of columns to join on
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.JoinV2.Key
+ */
+export class MetricsViewSpec_JoinV2_Key extends Message<MetricsViewSpec_JoinV2_Key> {
+  /**
+   * Column in the metrics view's table
+   *
+   * @generated from field: string column = 1;
+   */
+  column = "";
+
+  /**
+   * Column in the joined table
+   *
+   * @generated from field: string join_column = 2;
+   */
+  joinColumn = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_JoinV2_Key>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.JoinV2.Key";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "join_column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_JoinV2_Key {
+    return new MetricsViewSpec_JoinV2_Key().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2_Key {
+    return new MetricsViewSpec_JoinV2_Key().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2_Key {
+    return new MetricsViewSpec_JoinV2_Key().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_JoinV2_Key | PlainMessage<MetricsViewSpec_JoinV2_Key> | undefined, b: MetricsViewSpec_JoinV2_Key | PlainMessage<MetricsViewSpec_JoinV2_Key> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_JoinV2_Key, a, b);
+  }
+}
+
 /**
  * Measures are aggregated computed values
  *
@@ -1248,6 +1824,20 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
    */
   validPercentOfTotal = false;
 
+  /**
+   * Type of the measure. Measures other than simple measures are computed from the measures in requires.
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.MeasureType type = 8;
+   */
+  type = MetricsViewSpec_MeasureType.UNSPECIFIED;
+
+  /**
+   * Names of the measures referenced by the measure
+   *
+   * @generated from field: repeated string requires = 9;
+   */
+  requires: string[] = [];
+
   constructor(data?: PartialMessage<MetricsViewSpec_MeasureV2>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1263,6 +1853,8 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
     { no: 5, name: "format_preset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "format_d3", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "valid_percent_of_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "type", kind: "enum", T: proto3.getEnumType(MetricsViewSpec_MeasureType) },
+    { no: 9, name: "requires", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_MeasureV2 {
@@ -1754,6 +2346,11 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.Notifier notifiers = 11;
+   */
+  notifiers: Notifier[] = [];
+
   constructor(data?: PartialMessage<ReportSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1772,6 +2369,7 @@ export class ReportSpec extends Message<ReportSpec> {
     { no: 8, name: "export_format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
     { no: 9, name: "email_recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 10, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 11, name: "notifiers", kind: "message", T: Notifier, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
@@ -1875,6 +2473,11 @@ export class ReportExecution extends Message<ReportExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.NotificationStatus notifications = 6;
+   */
+  notifications: NotificationStatus[] = [];
+
   constructor(data?: PartialMessage<ReportExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1888,6 +2491,7 @@ export class ReportExecution extends Message<ReportExecution> {
     { no: 3, name: "report_time", kind: "message", T: Timestamp },
     { no: 4, name: "started_on", kind: "message", T: Timestamp },
     { no: 5, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 6, name: "notifications", kind: "message", T: NotificationStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportExecution {
@@ -2064,6 +2668,18 @@ export class AlertSpec extends Message<AlertSpec> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.Notifier notifiers = 21;
+   */
+  notifiers: Notifier[] = [];
+
+  /**
+   * If set, the alert detects anomalies compared to a historical baseline instead of running query_name.
+   *
+   * @generated from field: rill.runtime.v1.AlertAnomalySpec anomaly = 22;
+   */
+  anomaly?: AlertAnomalySpec;
+
   constructor(data?: PartialMessage<AlertSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2092,6 +2708,8 @@ export class AlertSpec extends Message<AlertSpec> {
     { no: 18, name: "email_renotify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 19, name: "email_renotify_after_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 21, name: "notifiers", kind: "message", T: Notifier, repeated: true },
+    { no: 22, name: "anomaly", kind: "message", T: AlertAnomalySpec },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSpec {
@@ -2111,6 +2729,101 @@ export class AlertSpec extends Message<AlertSpec> {
   }
 }
 
+/**
+ * AlertAnomalySpec configures an alert that fires when the latest closed interval of a metrics view deviates from its historical baseline.
+ *
+ * @generated from message rill.runtime.v1.AlertAnomalySpec
+ */
+export class AlertAnomalySpec extends Message<AlertAnomalySpec> {
+  /**
+   * @generated from field: string metrics_view = 1;
+   */
+  metricsView = "";
+
+  /**
+   * @generated from field: repeated string measures = 2;
+   */
+  measures: string[] = [];
+
+  /**
+   * Optional. If set, the baseline is computed and checked separately for each of the top values of the dimension.
+   *
+   * @generated from field: string dimension = 3;
+   */
+  dimension = "";
+
+  /**
+   * Number of top dimension values by the first measure to check from each of the baseline window and the latest interval.
+   *
+   * @generated from field: uint32 dimension_limit = 4;
+   */
+  dimensionLimit = 0;
+
+  /**
+   * @generated from field: rill.runtime.v1.TimeGrain time_grain = 5;
+   */
+  timeGrain = TimeGrain.UNSPECIFIED;
+
+  /**
+   * @generated from field: string time_zone = 6;
+   */
+  timeZone = "";
+
+  /**
+   * Duration of the trailing window before the latest interval that the baseline is computed from.
+   *
+   * @generated from field: string baseline_iso_duration = 7;
+   */
+  baselineIsoDuration = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.AnomalyBaselineMethod baseline_method = 8;
+   */
+  baselineMethod = AnomalyBaselineMethod.UNSPECIFIED;
+
+  /**
+   * Number of standard deviations from the baseline that the latest interval must exceed to be anomalous.
+   *
+   * @generated from field: double threshold_sigma = 9;
+   */
+  thresholdSigma = 0;
+
+  constructor(data?: PartialMessage<AlertAnomalySpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.AlertAnomalySpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metrics_view", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "measures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "dimension_limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "time_grain", kind: "enum", T: proto3.getEnumType(TimeGrain) },
+    { no: 6, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "baseline_iso_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "baseline_method", kind: "enum", T: proto3.getEnumType(AnomalyBaselineMethod) },
+    { no: 9, name: "threshold_sigma", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertAnomalySpec {
+    return new AlertAnomalySpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertAnomalySpec {
+    return new AlertAnomalySpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertAnomalySpec {
+    return new AlertAnomalySpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertAnomalySpec | PlainMessage<AlertAnomalySpec> | undefined, b: AlertAnomalySpec | PlainMessage<AlertAnomalySpec> | undefined): boolean {
+    return proto3.util.equals(AlertAnomalySpec, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.AlertState
  */
@@ -2212,6 +2925,11 @@ export class AlertExecution extends Message<AlertExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.NotificationStatus notifications = 7;
+   */
+  notifications: NotificationStatus[] = [];
+
   constructor(data?: PartialMessage<AlertExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2226,6 +2944,7 @@ export class AlertExecution extends Message<AlertExecution> {
     { no: 4, name: "execution_time", kind: "message", T: Timestamp },
     { no: 5, name: "started_on", kind: "message", T: Timestamp },
     { no: 6, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 7, name: "notifications", kind: "message", T: NotificationStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertExecution {
@@ -2294,6 +3013,116 @@ export class AssertionResult extends Message<AssertionResult> {
   }
 }
 
+/**
+ * Notifier is a channel that alert and report notifications are delivered to, such as Slack or a webhook.
+ *
+ * @generated from message rill.runtime.v1.Notifier
+ */
+export class Notifier extends Message<Notifier> {
+  /**
+   * Name of the connector to deliver notifications with. Its driver must support notifications.
+   *
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * Properties of the notifier. They take precedence over the connector's config.
+   *
+   * @generated from field: google.protobuf.Struct properties = 2;
+   */
+  properties?: Struct;
+
+  constructor(data?: PartialMessage<Notifier>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Notifier";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "properties", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Notifier {
+    return new Notifier().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Notifier {
+    return new Notifier().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Notifier {
+    return new Notifier().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Notifier | PlainMessage<Notifier> | undefined, b: Notifier | PlainMessage<Notifier> | undefined): boolean {
+    return proto3.util.equals(Notifier, a, b);
+  }
+}
+
+/**
+ * NotificationStatus is the delivery status of a notification to a single notifier.
+ *
+ * @generated from message rill.runtime.v1.NotificationStatus
+ */
+export class NotificationStatus extends Message<NotificationStatus> {
+  /**
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * @generated from field: bool delivered = 2;
+   */
+  delivered = false;
+
+  /**
+   * Number of delivery attempts, including retries.
+   *
+   * @generated from field: uint32 attempts = 3;
+   */
+  attempts = 0;
+
+  /**
+   * Error from the last failed attempt.
+   *
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<NotificationStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.NotificationStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "delivered", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationStatus {
+    return new NotificationStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationStatus {
+    return new NotificationStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationStatus {
+    return new NotificationStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationStatus | PlainMessage<NotificationStatus> | undefined, b: NotificationStatus | PlainMessage<NotificationStatus> | undefined): boolean {
+    return proto3.util.equals(NotificationStatus, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.PullTrigger
  */
@@ -3191,6 +4020,34 @@ export class APISpec extends Message<APISpec> {
    */
   resolverProperties?: Struct;
 
+  /**
+   * Description of the API. It is used in the generated OpenAPI spec.
+   *
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * Arguments accepted by the API. If empty, the API accepts any arguments without validation.
+   *
+   * @generated from field: repeated rill.runtime.v1.APIArgument args = 4;
+   */
+  args: APIArgument[] = [];
+
+  /**
+   * Maximum number of requests per caller in each rate limit period. If 0, requests are not rate limited.
+   *
+   * @generated from field: uint32 rate_limit_requests = 5;
+   */
+  rateLimitRequests = 0;
+
+  /**
+   * Length of the rate limit period in seconds.
+   *
+   * @generated from field: uint32 rate_limit_period_seconds = 6;
+   */
+  rateLimitPeriodSeconds = 0;
+
   constructor(data?: PartialMessage<APISpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3201,6 +4058,10 @@ export class APISpec extends Message<APISpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "resolver_properties", kind: "message", T: Struct },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "args", kind: "message", T: APIArgument, repeated: true },
+    { no: 5, name: "rate_limit_requests", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "rate_limit_period_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APISpec {
@@ -3220,6 +4081,79 @@ export class APISpec extends Message<APISpec> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.APIArgument
+ */
+export class APIArgument extends Message<APIArgument> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.APIArgumentType type = 3;
+   */
+  type = APIArgumentType.UNSPECIFIED;
+
+  /**
+   * If true, requests that don't provide the argument are rejected.
+   *
+   * @generated from field: bool required = 4;
+   */
+  required = false;
+
+  /**
+   * Value used if the argument isn't provided.
+   *
+   * @generated from field: google.protobuf.Value default_value = 5;
+   */
+  defaultValue?: Value;
+
+  /**
+   * If not empty, the argument must be one of these values.
+   *
+   * @generated from field: repeated google.protobuf.Value enum_values = 6;
+   */
+  enumValues: Value[] = [];
+
+  constructor(data?: PartialMessage<APIArgument>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIArgument";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(APIArgumentType) },
+    { no: 4, name: "required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "default_value", kind: "message", T: Value },
+    { no: 6, name: "enum_values", kind: "message", T: Value, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIArgument {
+    return new APIArgument().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIArgument {
+    return new APIArgument().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIArgument {
+    return new APIArgument().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIArgument | PlainMessage<APIArgument> | undefined, b: APIArgument | PlainMessage<APIArgument> | undefined): boolean {
+    return proto3.util.equals(APIArgument, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.APIState
  */
@@ -3251,6 +4185,269 @@ export class APIState extends Message<APIState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.Test
+ */
+export class Test extends Message<Test> {
+  /**
+   * @generated from field: rill.runtime.v1.TestSpec spec = 1;
+   */
+  spec?: TestSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.TestState state = 2;
+   */
+  state?: TestState;
+
+  constructor(data?: PartialMessage<Test>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Test";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: TestSpec },
+    { no: 2, name: "state", kind: "message", T: TestState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Test {
+    return new Test().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Test {
+    return new Test().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Test {
+    return new Test().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Test | PlainMessage<Test> | undefined, b: Test | PlainMessage<Test> | undefined): boolean {
+    return proto3.util.equals(Test, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestSpec
+ */
+export class TestSpec extends Message<TestSpec> {
+  /**
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * Name of the model or source table that is tested.
+   *
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.TestAssertion assertions = 3;
+   */
+  assertions: TestAssertion[] = [];
+
+  /**
+   * If true, metrics views that reference the tested model or source will fail to reconcile while the test is failing.
+   *
+   * @generated from field: bool block_downstream = 4;
+   */
+  blockDownstream = false;
+
+  constructor(data?: PartialMessage<TestSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "assertions", kind: "message", T: TestAssertion, repeated: true },
+    { no: 4, name: "block_downstream", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestSpec {
+    return new TestSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestSpec {
+    return new TestSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestSpec {
+    return new TestSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestSpec | PlainMessage<TestSpec> | undefined, b: TestSpec | PlainMessage<TestSpec> | undefined): boolean {
+    return proto3.util.equals(TestSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestAssertion
+ */
+export class TestAssertion extends Message<TestAssertion> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Query that returns the rows that violate the assertion. The assertion passes if it returns zero rows.
+   *
+   * @generated from field: string sql = 2;
+   */
+  sql = "";
+
+  constructor(data?: PartialMessage<TestAssertion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestAssertion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestAssertion {
+    return new TestAssertion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestAssertion {
+    return new TestAssertion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestAssertion {
+    return new TestAssertion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestAssertion | PlainMessage<TestAssertion> | undefined, b: TestAssertion | PlainMessage<TestAssertion> | undefined): boolean {
+    return proto3.util.equals(TestAssertion, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestState
+ */
+export class TestState extends Message<TestState> {
+  /**
+   * @generated from field: google.protobuf.Timestamp evaluated_on = 1;
+   */
+  evaluatedOn?: Timestamp;
+
+  /**
+   * @generated from field: bool passed = 2;
+   */
+  passed = false;
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.TestAssertionResult results = 3;
+   */
+  results: TestAssertionResult[] = [];
+
+  constructor(data?: PartialMessage<TestState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "evaluated_on", kind: "message", T: Timestamp },
+    { no: 2, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "results", kind: "message", T: TestAssertionResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestState {
+    return new TestState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestState {
+    return new TestState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestState {
+    return new TestState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestState | PlainMessage<TestState> | undefined, b: TestState | PlainMessage<TestState> | undefined): boolean {
+    return proto3.util.equals(TestState, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestAssertionResult
+ */
+export class TestAssertionResult extends Message<TestAssertionResult> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool passed = 2;
+   */
+  passed = false;
+
+  /**
+   * Number of rows that violate the assertion.
+   *
+   * @generated from field: int64 failing_rows_count = 3;
+   */
+  failingRowsCount = protoInt64.zero;
+
+  /**
+   * Sample of the rows that violate the assertion.
+   *
+   * @generated from field: repeated google.protobuf.Struct failing_rows = 4;
+   */
+  failingRows: Struct[] = [];
+
+  /**
+   * Error encountered while evaluating the assertion (if any).
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<TestAssertionResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestAssertionResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "failing_rows_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "failing_rows", kind: "message", T: Struct, repeated: true },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestAssertionResult {
+    return new TestAssertionResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestAssertionResult {
+    return new TestAssertionResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestAssertionResult {
+    return new TestAssertionResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestAssertionResult | PlainMessage<TestAssertionResult> | undefined, b: TestAssertionResult | PlainMessage<TestAssertionResult> | undefined): boolean {
+    return proto3.util.equals(TestAssertionResult, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Schedule
  */
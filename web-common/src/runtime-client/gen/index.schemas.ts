@@ -80,6 +80,20 @@ export type QueryServiceQueryBatchBody = {
   queries?: V1Query[];
 };
 
+export type QueryServiceQueryArrow200 = {
+  result?: V1QueryArrowResponse;
+  error?: RpcStatus;
+};
+
+export type QueryServiceQueryArrowBody = {
+  connector?: string;
+  sql?: string;
+  args?: unknown[];
+  priority?: number;
+  dryRun?: boolean;
+  limit?: number;
+};
+
 export type QueryServiceQueryBody = {
   connector?: string;
   sql?: string;
@@ -202,10 +216,12 @@ export type QueryServiceMetricsViewTimeSeriesBody = {
   timeZone?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 };
 
 export type QueryServiceMetricsViewTimeRangeBody = {
   priority?: number;
+  timeDimension?: string;
 };
 
 export type QueryServiceMetricsViewSchemaParams = { priority?: number };
@@ -238,6 +254,7 @@ export type QueryServiceMetricsViewComparisonBody = {
   priority?: number;
   exact?: boolean;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 };
 
 export type QueryServiceMetricsViewAggregationBody = {
@@ -254,6 +271,7 @@ export type QueryServiceMetricsViewAggregationBody = {
   offset?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 };
 
 export type QueryServiceExportBody = {
@@ -323,6 +341,13 @@ export type RuntimeServiceGetLogsParams = {
   level?: RuntimeServiceGetLogsLevel;
 };
 
+export type RuntimeServiceGetLineageParams = {
+  "name.kind"?: string;
+  "name.name"?: string;
+  /** Optional column (or dimension or measure for metrics views) of the resource to get lineage for. */
+  column?: string;
+};
+
 export type RuntimeServiceGenerateResolverBody = {
   prompt?: string;
   /** Both connector and table must be specified if metrics_view is not. */
@@ -614,6 +639,45 @@ export interface V1Theme {
   state?: V1ThemeState;
 }
 
+export interface V1TestState {
+  evaluatedOn?: string;
+  passed?: boolean;
+  results?: V1TestAssertionResult[];
+}
+
+export interface V1TestSpec {
+  connector?: string;
+  /** Name of the model or source table that is tested. */
+  table?: string;
+  assertions?: V1TestAssertion[];
+  /** If true, metrics views that reference the tested model or source will fail to reconcile while the test is failing. */
+  blockDownstream?: boolean;
+}
+
+export type V1TestAssertionResultFailingRowsItem = { [key: string]: any };
+
+export interface V1TestAssertionResult {
+  name?: string;
+  passed?: boolean;
+  /** Number of rows that violate the assertion. */
+  failingRowsCount?: string;
+  /** Sample of the rows that violate the assertion. */
+  failingRows?: V1TestAssertionResultFailingRowsItem[];
+  /** Error encountered while evaluating the assertion (if any). */
+  error?: string;
+}
+
+export interface V1TestAssertion {
+  name?: string;
+  /** Query that returns the rows that violate the assertion. The assertion passes if it returns zero rows. */
+  sql?: string;
+}
+
+export interface V1Test {
+  spec?: V1TestSpec;
+  state?: V1TestState;
+}
+
 export type V1TableRowsResponseDataItem = { [key: string]: any };
 
 export interface V1TableRowsResponse {
@@ -678,11 +742,18 @@ scanning the database's information schema. */
   managed?: boolean;
 }
 
+/**
+ * Offsets to resume streaming ingestion from, keyed by driver-specific partition identifiers.
+Only set for sources with stream_ingestion enabled.
+ */
+export type V1SourceStateStreamOffsets = { [key: string]: string };
+
 export interface V1SourceState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  streamOffsets?: V1SourceStateStreamOffsets;
 }
 
 export type V1SourceSpecProperties = { [key: string]: any };
@@ -693,8 +764,9 @@ export interface V1SourceSpec {
   properties?: V1SourceSpecProperties;
   refreshSchedule?: V1Schedule;
   timeoutSeconds?: number;
-  stageChanges?: boolean;
+  /** Stream ingestion continuously appends new data from the source connector in micro-batches instead of refreshing on a schedule. */
   streamIngestion?: boolean;
+  stageChanges?: boolean;
   trigger?: boolean;
 }
 
@@ -807,6 +879,7 @@ export interface V1Resource {
   chart?: V1Chart;
   dashboard?: V1Dashboard;
   api?: V1API;
+  test?: V1Test;
 }
 
 export type V1ReportSpecAnnotations = { [key: string]: string };
@@ -822,6 +895,7 @@ export interface V1ReportSpec {
   exportFormat?: V1ExportFormat;
   emailRecipients?: string[];
   annotations?: V1ReportSpecAnnotations;
+  notifiers?: V1Notifier[];
 }
 
 export interface V1ReportExecution {
@@ -830,6 +904,7 @@ export interface V1ReportExecution {
   reportTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  notifications?: V1NotificationStatus[];
 }
 
 export interface V1ReportState {
@@ -992,6 +1067,12 @@ export interface V1QueryResponse {
   data?: V1QueryResponseDataItem[];
 }
 
+export interface V1QueryArrowResponse {
+  /** Chunk of the result in the Arrow IPC streaming format.
+Concatenating the chunks in the order they are received yields a complete Arrow IPC stream. */
+  arrowIpc?: string;
+}
+
 export interface V1QueryBatchResponse {
   index?: number;
   result?: V1QueryResult;
@@ -1161,13 +1242,79 @@ export interface V1NumericSummary {
   numericOutliers?: V1NumericOutliers;
 }
 
+/**
+ * Properties of the notifier. They take precedence over the connector's config.
+ */
+export type V1NotifierProperties = { [key: string]: any };
+
+/**
+ * Notifier is a channel that alert and report notifications are delivered to, such as Slack or a webhook.
+ */
+export interface V1Notifier {
+  /** Name of the connector to deliver notifications with. Its driver must support notifications. */
+  connector?: string;
+  properties?: V1NotifierProperties;
+}
+
+/**
+ * NotificationStatus is the delivery status of a notification to a single notifier.
+ */
+export interface V1NotificationStatus {
+  connector?: string;
+  delivered?: boolean;
+  /** Number of delivery attempts, including retries. */
+  attempts?: number;
+  /** Error from the last failed attempt. */
+  errorMessage?: string;
+}
+
+export type V1ModelIncrementalStrategy =
+  (typeof V1ModelIncrementalStrategy)[keyof typeof V1ModelIncrementalStrategy];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ModelIncrementalStrategy = {
+  MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED:
+    "MODEL_INCREMENTAL_STRATEGY_UNSPECIFIED",
+  MODEL_INCREMENTAL_STRATEGY_APPEND: "MODEL_INCREMENTAL_STRATEGY_APPEND",
+  MODEL_INCREMENTAL_STRATEGY_MERGE: "MODEL_INCREMENTAL_STRATEGY_MERGE",
+} as const;
+
+/**
+ * Data for the partition as returned by the partitions resolver.
+ */
+export type V1ModelPartitionData = { [key: string]: any };
+
+export interface V1ModelPartition {
+  /** Key that uniquely identifies the partition. It is derived from the partition's data. */
+  key?: string;
+  data?: V1ModelPartitionData;
+  executedOn?: string;
+  /** Error from the last execution of the partition. Partitions with an error are retried on the next refresh. */
+  error?: string;
+  elapsedMs?: number;
+}
+
+/**
+ * Output of the incremental state resolver after the last refresh (only set for incremental models).
+ */
+export type V1ModelStateIncrementalState = { [key: string]: any };
+
 export interface V1ModelState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  incrementalState?: V1ModelStateIncrementalState;
+  /** Partitions processed for the current table (only set for partitioned models). */
+  partitions?: V1ModelPartition[];
 }
 
+export type V1ModelSpecPartitionsResolverProperties = { [key: string]: any };
+
+export type V1ModelSpecIncrementalStateResolverProperties = {
+  [key: string]: any;
+};
+
 export interface V1ModelSpec {
   connector?: string;
   sql?: string;
@@ -1175,6 +1322,17 @@ export interface V1ModelSpec {
   refreshSchedule?: V1Schedule;
   timeoutSeconds?: number;
   usesTemplating?: boolean;
+  /** Incremental models insert into the existing table on refresh instead of rebuilding it. */
+  incremental?: boolean;
+  /** Resolver that computes the state passed to the SQL template as "incremental_state" after each refresh. */
+  incrementalStateResolver?: string;
+  incrementalStateResolverProperties?: V1ModelSpecIncrementalStateResolverProperties;
+  incrementalStrategy?: V1ModelIncrementalStrategy;
+  /** Columns that uniquely identify a row. Required for the merge strategy. */
+  uniqueKey?: string[];
+  /** Resolver that lists the model's partitions. If set, the SQL is executed once per partition with the partition's data passed to the SQL template as "partition". */
+  partitionsResolver?: string;
+  partitionsResolverProperties?: V1ModelSpecPartitionsResolverProperties;
   stageChanges?: boolean;
   materializeDelaySeconds?: number;
   trigger?: boolean;
@@ -1268,6 +1426,7 @@ export interface V1MetricsViewSpec {
   timeDimension?: string;
   /** Expression to evaluate a watermark for the metrics view. If not set, the watermark defaults to max(time_dimension). */
   watermarkExpression?: string;
+  joins?: MetricsViewSpecJoinV2[];
   dimensions?: MetricsViewSpecDimensionV2[];
   defaultDimensions?: string[];
   measures?: MetricsViewSpecMeasureV2[];
@@ -1334,6 +1493,7 @@ export interface V1MetricsViewTimeSeriesRequest {
   timeZone?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 }
 
 export interface V1MetricsViewRowsRequest {
@@ -1464,6 +1624,7 @@ export interface V1MetricsViewComparisonRequest {
   priority?: number;
   exact?: boolean;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 }
 
 export interface V1MetricsViewAggregationRequest {
@@ -1482,6 +1643,7 @@ export interface V1MetricsViewAggregationRequest {
   offset?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  timeDimension?: string;
 }
 
 export interface V1MetricsView {
@@ -1551,6 +1713,23 @@ export interface V1ListCatalogEntriesResponse {
   entries?: V1CatalogEntry[];
 }
 
+/**
+ * LineageEdge indicates that the target column is derived from or depends on the source column.
+ */
+export interface V1LineageEdge {
+  source?: V1LineageColumn;
+  target?: V1LineageColumn;
+}
+
+/**
+ * LineageColumn identifies a column of a source or model, or a dimension or measure of a metrics view.
+ */
+export interface V1LineageColumn {
+  resource?: V1ResourceName;
+  /** Column name. It is empty if the resource depends on the source column without deriving a specific column from it (e.g. in a filter or join). */
+  column?: string;
+}
+
 export interface V1IssueDevJWTResponse {
   jwt?: string;
 }
@@ -1613,6 +1792,11 @@ export interface V1GetLogsResponse {
   logs?: V1Log[];
 }
 
+export interface V1GetLineageResponse {
+  /** Edges upstream and downstream of the requested resource or column. */
+  edges?: V1LineageEdge[];
+}
+
 export interface V1GetInstanceResponse {
   instance?: V1Instance;
 }
@@ -1696,6 +1880,9 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_AVRO: "EXPORT_FORMAT_AVRO",
 } as const;
 
 /**
@@ -2052,6 +2239,20 @@ export interface V1AssertionResult {
   errorMessage?: string;
 }
 
+/**
+ * - ANOMALY_BASELINE_METHOD_ROLLING: Mean and standard deviation of all intervals in the baseline window.
+ - ANOMALY_BASELINE_METHOD_SEASONAL: Median and median absolute deviation of the intervals in the baseline window with the same weekday and hour as the latest interval.
+ */
+export type V1AnomalyBaselineMethod =
+  (typeof V1AnomalyBaselineMethod)[keyof typeof V1AnomalyBaselineMethod];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1AnomalyBaselineMethod = {
+  ANOMALY_BASELINE_METHOD_UNSPECIFIED: "ANOMALY_BASELINE_METHOD_UNSPECIFIED",
+  ANOMALY_BASELINE_METHOD_ROLLING: "ANOMALY_BASELINE_METHOD_ROLLING",
+  ANOMALY_BASELINE_METHOD_SEASONAL: "ANOMALY_BASELINE_METHOD_SEASONAL",
+} as const;
+
 export interface V1AlertState {
   specHash?: string;
   refsHash?: string;
@@ -2087,6 +2288,8 @@ export interface V1AlertSpec {
   emailRenotify?: boolean;
   emailRenotifyAfterSeconds?: number;
   annotations?: V1AlertSpecAnnotations;
+  notifiers?: V1Notifier[];
+  anomaly?: V1AlertAnomalySpec;
 }
 
 export interface V1AlertExecution {
@@ -2096,6 +2299,26 @@ export interface V1AlertExecution {
   executionTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  notifications?: V1NotificationStatus[];
+}
+
+/**
+ * AlertAnomalySpec configures an alert that fires when the latest closed interval of a metrics view deviates from its historical baseline.
+ */
+export interface V1AlertAnomalySpec {
+  metricsView?: string;
+  measures?: string[];
+  /** Optional. If set, the baseline is computed and checked separately for each of the top values of the dimension. */
+  dimension?: string;
+  /** Number of top dimension values by the first measure to check from each of the baseline window and the latest interval. */
+  dimensionLimit?: number;
+  timeGrain?: V1TimeGrain;
+  timeZone?: string;
+  /** Duration of the trailing window before the latest interval that the baseline is computed from. */
+  baselineIsoDuration?: string;
+  baselineMethod?: V1AnomalyBaselineMethod;
+  /** Number of standard deviations from the baseline that the latest interval must exceed to be anomalous. */
+  thresholdSigma?: number;
 }
 
 export interface V1Alert {
@@ -2112,6 +2335,39 @@ export type V1APISpecResolverProperties = { [key: string]: any };
 export interface V1APISpec {
   resolver?: string;
   resolverProperties?: V1APISpecResolverProperties;
+  /** Description of the API. It is used in the generated OpenAPI spec. */
+  description?: string;
+  /** Arguments accepted by the API. If empty, the API accepts any arguments without validation. */
+  args?: V1APIArgument[];
+  /** Maximum number of requests per caller in each rate limit period. If 0, requests are not rate limited. */
+  rateLimitRequests?: number;
+  /** Length of the rate limit period in seconds. */
+  rateLimitPeriodSeconds?: number;
+}
+
+export type V1APIArgumentType =
+  (typeof V1APIArgumentType)[keyof typeof V1APIArgumentType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1APIArgumentType = {
+  API_ARGUMENT_TYPE_UNSPECIFIED: "API_ARGUMENT_TYPE_UNSPECIFIED",
+  API_ARGUMENT_TYPE_STRING: "API_ARGUMENT_TYPE_STRING",
+  API_ARGUMENT_TYPE_INTEGER: "API_ARGUMENT_TYPE_INTEGER",
+  API_ARGUMENT_TYPE_NUMBER: "API_ARGUMENT_TYPE_NUMBER",
+  API_ARGUMENT_TYPE_BOOLEAN: "API_ARGUMENT_TYPE_BOOLEAN",
+  API_ARGUMENT_TYPE_TIMESTAMP: "API_ARGUMENT_TYPE_TIMESTAMP",
+} as const;
+
+export interface V1APIArgument {
+  name?: string;
+  description?: string;
+  type?: V1APIArgumentType;
+  /** If true, requests that don't provide the argument are rejected. */
+  required?: boolean;
+  /** Value used if the argument isn't provided. */
+  defaultValue?: unknown;
+  /** If not empty, the argument must be one of these values. */
+  enumValues?: unknown[];
 }
 
 /**
@@ -2226,8 +2482,42 @@ export interface MetricsViewSpecMeasureV2 {
   formatPreset?: string;
   formatD3?: string;
   validPercentOfTotal?: boolean;
+  /** Type of the measure. Measures other than simple measures are computed from the measures in requires. */
+  type?: MetricsViewSpecMeasureType;
+  requires?: string[];
 }
 
+export type MetricsViewSpecMeasureType =
+  (typeof MetricsViewSpecMeasureType)[keyof typeof MetricsViewSpecMeasureType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewSpecMeasureType = {
+  MEASURE_TYPE_UNSPECIFIED: "MEASURE_TYPE_UNSPECIFIED",
+  MEASURE_TYPE_SIMPLE: "MEASURE_TYPE_SIMPLE",
+  MEASURE_TYPE_DERIVED: "MEASURE_TYPE_DERIVED",
+  MEASURE_TYPE_RUNNING_SUM: "MEASURE_TYPE_RUNNING_SUM",
+  MEASURE_TYPE_SHARE_OF_TOTAL: "MEASURE_TYPE_SHARE_OF_TOTAL",
+  MEASURE_TYPE_PREVIOUS_PERIOD: "MEASURE_TYPE_PREVIOUS_PERIOD",
+} as const;
+
+export interface MetricsViewSpecJoinV2 {
+  /** Name used to reference the join from dimensions. It's also the alias of the joined table. */
+  name?: string;
+  table?: string;
+  type?: MetricsViewSpecJoinType;
+  keys?: JoinV2Key[];
+}
+
+export type MetricsViewSpecJoinType =
+  (typeof MetricsViewSpecJoinType)[keyof typeof MetricsViewSpecJoinType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewSpecJoinType = {
+  JOIN_TYPE_UNSPECIFIED: "JOIN_TYPE_UNSPECIFIED",
+  JOIN_TYPE_LEFT: "JOIN_TYPE_LEFT",
+  JOIN_TYPE_INNER: "JOIN_TYPE_INNER",
+} as const;
+
 export interface MetricsViewSpecDimensionV2 {
   name?: string;
   column?: string;
@@ -2235,6 +2525,8 @@ export interface MetricsViewSpecDimensionV2 {
   label?: string;
   description?: string;
   unnest?: boolean;
+  /** Name of the join the dimension's column or expression is resolved against. If empty, it's resolved against the metrics view's table. */
+  join?: string;
 }
 
 export type MetricsViewSpecComparisonMode =
@@ -2289,6 +2581,11 @@ export interface MetricsViewDimension {
   column?: string;
 }
 
+export interface JoinV2Key {
+  column?: string;
+  joinColumn?: string;
+}
+
 export type ConnectorSpecPropertyType =
   (typeof ConnectorSpecPropertyType)[keyof typeof ConnectorSpecPropertyType];
 
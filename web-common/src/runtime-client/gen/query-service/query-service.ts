@@ -61,6 +61,8 @@ import type {
   QueryServiceColumnTopKBody,
   V1QueryResponse,
   QueryServiceQueryBody,
+  QueryServiceQueryArrow200,
+  QueryServiceQueryArrowBody,
   QueryServiceQueryBatch200,
   QueryServiceQueryBatchBody,
 } from "../index.schemas";
@@ -1956,6 +1958,65 @@ export const createQueryServiceQuery = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary QueryArrow runs a SQL query against the instance's OLAP datastore and streams the result in the Arrow IPC streaming format.
+ */
+export const queryServiceQueryArrow = (
+  instanceId: string,
+  queryServiceQueryArrowBody: QueryServiceQueryArrowBody,
+) => {
+  return httpClient<QueryServiceQueryArrow200>({
+    url: `/v1/instances/${instanceId}/query/arrow`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceQueryArrowBody,
+  });
+};
+
+export type QueryServiceQueryArrowMutationResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceQueryArrow>>
+>;
+export type QueryServiceQueryArrowMutationBody = QueryServiceQueryArrowBody;
+export type QueryServiceQueryArrowMutationError = ErrorType<RpcStatus>;
+
+export const createQueryServiceQueryArrow = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof queryServiceQueryArrow>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceQueryArrowBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof queryServiceQueryArrow>>,
+    {
+      instanceId: string;
+      data: QueryServiceQueryArrowBody;
+    }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return queryServiceQueryArrow(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof queryServiceQueryArrow>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceQueryArrowBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary Batch request with different queries
  */
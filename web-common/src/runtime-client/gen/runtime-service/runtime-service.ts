@@ -54,6 +54,8 @@ import type {
   RuntimeServiceGenerateChartSpecBody,
   V1GenerateResolverResponse,
   RuntimeServiceGenerateResolverBody,
+  V1GetLineageResponse,
+  RuntimeServiceGetLineageParams,
   V1GetLogsResponse,
   RuntimeServiceGetLogsParams,
   RuntimeServiceWatchLogs200,
@@ -1350,6 +1352,72 @@ export const createRuntimeServiceGenerateResolver = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary GetLineage returns column-level lineage between sources, models and metrics views
+ */
+export const runtimeServiceGetLineage = (
+  instanceId: string,
+  params?: RuntimeServiceGetLineageParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetLineageResponse>({
+    url: `/v1/instances/${instanceId}/lineage`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetLineageQueryKey = (
+  instanceId: string,
+  params?: RuntimeServiceGetLineageParams,
+) => [`/v1/instances/${instanceId}/lineage`, ...(params ? [params] : [])];
+
+export type RuntimeServiceGetLineageQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetLineage>>
+>;
+export type RuntimeServiceGetLineageQueryError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceGetLineage = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetLineage>>,
+  TError = ErrorType<RpcStatus>,
+>(
+  instanceId: string,
+  params?: RuntimeServiceGetLineageParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetLineage>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetLineageQueryKey(instanceId, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetLineage>>
+  > = ({ signal }) => runtimeServiceGetLineage(instanceId, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceGetLineage>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary GetLogs returns recent logs from a controller
  */